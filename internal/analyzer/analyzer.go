@@ -27,6 +27,10 @@ func AnalyzeStructs(structs []*parser.Struct) ([]*Struct, error) {
 		return nil, err
 	}
 
+	if err := analyzeFieldGroups(result); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
@@ -39,7 +43,10 @@ func analyzeFieldValidations(structs []*parser.Struct) ([]*Struct, error) {
 			Struct: *st,
 		}
 		for i, fd := range st.Fields {
-			fieldValidations, hasValidTag := parseFieldValidations(fd.Tag)
+			fieldValidations, hasValidTag, err := parseFieldValidations(fd.Tag)
+			if err != nil {
+				return nil, err
+			}
 			if hasValidTag {
 				analyzedStruct.HasValidTag = true
 			}
@@ -62,7 +69,7 @@ func analyzeFieldValidations(structs []*parser.Struct) ([]*Struct, error) {
 	return result, nil
 }
 
-func parseFieldValidations(fieldTag string) ([]string, bool) {
+func parseFieldValidations(fieldTag string) ([]string, bool, error) {
 	fieldValidations := []string{}
 	hasValidTag := false
 	prefixToSearch := validTag + ":"
@@ -71,10 +78,74 @@ func parseFieldValidations(fieldTag string) ([]string, bool) {
 		hasValidTag = true
 		tagWithoutPrefix, _ := strings.CutPrefix(fieldTag, prefixToSearch)
 		tagWithoutQuotes, _ := strconv.Unquote(tagWithoutPrefix)
-		fieldValidations = strings.Split(tagWithoutQuotes, ",")
+		validations, err := splitValidations(tagWithoutQuotes)
+		if err != nil {
+			return nil, hasValidTag, err
+		}
+
+		fieldValidations = validations
 	}
 
-	return fieldValidations, hasValidTag
+	return fieldValidations, hasValidTag, nil
+}
+
+// splitValidations splits the validations of a tag by commas.
+// Raw values with commas must be quoted (e.g. regex='^[a-z]{2,5}$'): the quoted value ends with a quote
+// followed by a comma (or by the end of the tag), so it can have commas and quotes, and a quote followed
+// by a comma inside the value must be escaped (e.g. regex='^a\',b$').
+// Pairs of numbers don't need quotes (e.g. decimal=12,2): the number after the comma is part of the value.
+func splitValidations(tag string) ([]string, error) {
+	ops := operations.New()
+	validations := []string{}
+
+	for tag != "" {
+		validation, rest, _ := strings.Cut(tag, ",")
+		name, value, ok := strings.Cut(tag, "=")
+		if ok && !strings.Contains(name, ",") {
+			op := operationName(name)
+			switch {
+			case strings.HasPrefix(value, "'") && ops.HasRawValue(op):
+				end := closingQuote(value)
+				if end < 0 {
+					return nil, types.NewValidationError("unterminated quote in validation %s", tag)
+				}
+
+				validation, rest = name+"="+value[:end+1], strings.TrimPrefix(value[end+1:], ",")
+			case ops.HasNumberPair(op):
+				second, next, _ := strings.Cut(rest, ",")
+				if isNumber(strings.TrimPrefix(validation, name+"=")) && isNumber(second) {
+					validation, rest = validation+","+second, next
+				}
+			}
+		}
+
+		validations = append(validations, validation)
+		tag = rest
+	}
+
+	return validations, nil
+}
+
+// closingQuote returns the index of the quote that ends a quoted value: the first quote, not escaped,
+// followed by a comma or by the end of the tag. It returns -1 if the value isn't terminated.
+func closingQuote(value string) int {
+	for i := 1; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value) && value[i+1] == '\'':
+			i++
+		case value[i] == '\'' && (i+1 == len(value) || value[i+1] == ','):
+			return i
+		}
+	}
+
+	return -1
+}
+
+// isNumber validates if a value is a non-negative integer (e.g. the precision of decimal=12,2).
+func isNumber(value string) bool {
+	value = strings.TrimSpace(value)
+
+	return value != "" && strings.Trim(value, "0123456789") == ""
 }
 
 func checkForInvalidOperations(structs []*Struct) error {
@@ -89,6 +160,15 @@ func checkForInvalidOperations(structs []*Struct) error {
 
 	for _, st := range structs {
 		for i, fd := range st.Fields {
+			fdType := fd.Type
+			dive := false
+
+			// The operations of optional types (e.g. sql.NullString) are checked with the type of the value.
+			optional := fdType.IsOptional()
+			if optional {
+				fdType = fdType.ValueType()
+			}
+
 			for _, val := range st.FieldsValidations[i].Validations {
 				// Check if is a valid operation.
 				op := val.Operation
@@ -96,9 +176,61 @@ func checkForInvalidOperations(structs []*Struct) error {
 					return types.NewValidationError("unsupported operation %s", op)
 				}
 
+				if optional {
+					if fd.Type.ComposedType != "" && fd.Type.ComposedType != "*" {
+						return types.NewValidationError("operation %s: unsupported %s%s type", op, fd.Type.ComposedType, fd.Type.Optional.Type)
+					}
+
+					// The values of optional types can't be nested structs.
+					if !fdType.IsGoType() {
+						return types.NewValidationError("unsupported operation %s with unknown go type %s", op, fdType.BaseType)
+					}
+
+					// The operations that use other fields or the elements aren't supported.
+					if ops.IsFieldOperation(op) || ops.IsFieldGroup(op) || op == "dive" {
+						return types.NewValidationError("operation %s: unsupported with optional type %s", op, fd.Type.Optional.Type)
+					}
+
+					// The value of an optional type is checked only if it is valid, as a nil pointer.
+					if op == "omitnil" {
+						continue
+					}
+				}
+
+				// The operations after dive check each element, so they can't use other fields.
+				if dive && (ops.IsFieldOperation(op) || ops.IsFieldGroup(op) || ops.IsModifier(op)) {
+					return types.NewValidationError("operation %s: unsupported after dive", op)
+				}
+
+				// Nested structs always have a value, so only pointers to them can be conditionally required.
+				if ops.IsConditional(op) && !fdType.IsGoType() && fdType.ComposedType != "*" {
+					return types.NewValidationError("operation %s: unsupported %s type, use a pointer to the struct", op, fdType.BaseType)
+				}
+
 				// If is a custom struct, check if it has validations.
-				fdType := fd.Type
 				if structsWithValidation[fdType.BaseType] {
+					// The elements of slices and arrays of nested structs are checked (with dive) only by the
+					// validator of the struct.
+					if fdType.ComposedType != "" && fdType.ComposedType != "*" && !ops.IsFieldGroup(op) {
+						if op != "dive" || (fdType.ComposedType != "[]" && fdType.ComposedType != "[N]") {
+							return types.NewValidationError("operation %s: unsupported %s%s type, only dive is supported", op, fdType.ComposedType, fdType.BaseType)
+						}
+
+						if len(val.Groups) > 0 {
+							return types.NewValidationError("operation dive: unsupported groups")
+						}
+
+						fdType = common.FieldType{BaseType: fdType.BaseType}
+						dive = true
+					} else if dive {
+						return types.NewValidationError("operation %s: unsupported after dive", op)
+					}
+
+					continue
+				}
+
+				// Field group operations can be used with pointers to any type.
+				if ops.IsFieldGroup(op) && fdType.ComposedType == "*" {
 					continue
 				}
 
@@ -111,6 +243,20 @@ func checkForInvalidOperations(structs []*Struct) error {
 				if !ops.IsValidByType(op, fdType.ToNormalizedString()) {
 					return types.NewValidationError("operation %s: invalid %s(%s) type", op, fdType.BaseType, fdType.ToNormalizedString())
 				}
+
+				// Check the values of the operation (e.g. regex patterns are compiled).
+				if err := ops.ValidateValues(op, fdType, val.Values); err != nil {
+					return types.NewValidationError("operation %s: %s", op, err.Error())
+				}
+
+				// The following operations are checked with the type of the elements.
+				if op == "dive" {
+					if len(val.Groups) > 0 {
+						return types.NewValidationError("operation dive: unsupported groups")
+					}
+					fdType = common.FieldType{BaseType: fdType.BaseType}
+					dive = true
+				}
 			}
 		}
 	}
@@ -139,27 +285,18 @@ func analyzeFieldOperations(structs []*Struct) error {
 					continue
 				}
 
-				fd1Name := fd.FieldName
-				fd2Name := val.Values[0]
-
-				// Check if field exists.
-				fd2NameToSearch := ""
-				qualifiedField, qualifiedNestedField, ok := strings.Cut(fd2Name, ".")
-				if !ok {
-					// If the operation is with an inner field, assume it's in the same struct.
-					fd2NameToSearch = common.KeyPath(st.PackageName, st.StructName, fd2Name)
-				} else {
-					// If the field is qualified with a nested field, use its type.
-					qFieldType, ok := fieldsType[common.KeyPath(st.PackageName, st.StructName, qualifiedField)]
-					if !ok {
-						return types.NewValidationError("operation %s: undefined nested field %s", op, qualifiedField)
+				if ops.IsConditional(op) {
+					if err := analyzeConditionalOperation(fieldsType, st, val); err != nil {
+						return err
 					}
-					fd2NameToSearch = common.KeyPath(qFieldType.BaseType, qualifiedNestedField)
+					continue
 				}
 
-				f2Type, ok := fieldsType[fd2NameToSearch]
-				if !ok {
-					return types.NewValidationError("operation %s: undefined field %s", op, fd2Name)
+				fd1Name := fd.FieldName
+				fd2Name := val.Values[0]
+				f2Type, err := findFieldType(fieldsType, st, op, fd2Name)
+				if err != nil {
+					return err
 				}
 
 				// Check if fields have the same type.
@@ -172,3 +309,125 @@ func analyzeFieldOperations(structs []*Struct) error {
 
 	return nil
 }
+
+// analyzeFieldGroups finds the fields of each field group operation (e.g. exactly_one_of=payment)
+// and keeps them in the validation of the first field of the group.
+func analyzeFieldGroups(structs []*Struct) error {
+	for _, st := range structs {
+		groupsValidation := map[string]*Validation{}
+		groupsOrder := []string{}
+
+		for i, fd := range st.Fields {
+			for _, val := range st.FieldsValidations[i].Validations {
+				op := val.Operation
+				if !operations.New().IsFieldGroup(op) {
+					continue
+				}
+
+				if !fd.Type.IsGoType() && fd.Type.ComposedType != "*" {
+					return types.NewValidationError("operation %s: field %s must be a pointer or a go type", op, fd.FieldName)
+				}
+
+				groupKey := op + "=" + val.Values[0]
+				firstVal, ok := groupsValidation[groupKey]
+				if !ok {
+					firstVal = val
+					firstVal.TargetTypes = map[string]common.FieldType{}
+					groupsValidation[groupKey] = firstVal
+					groupsOrder = append(groupsOrder, groupKey)
+				}
+
+				if _, ok := firstVal.TargetTypes[fd.FieldName]; ok {
+					return types.NewValidationError("operation %s: field %s is repeated in group %s", op, fd.FieldName, val.Values[0])
+				}
+
+				firstVal.GroupFields = append(firstVal.GroupFields, fd.FieldName)
+				firstVal.TargetTypes[fd.FieldName] = fd.Type
+			}
+		}
+
+		for _, groupKey := range groupsOrder {
+			val := groupsValidation[groupKey]
+			if len(val.GroupFields) < 2 {
+				return types.NewValidationError("operation %s: group %s must have at least 2 fields", val.Operation, val.Values[0])
+			}
+		}
+	}
+
+	return nil
+}
+
+// analyzeConditionalOperation checks the fields referenced by a conditional operation (e.g. required_if)
+// and keeps their types to be used by the code generator.
+func analyzeConditionalOperation(fieldsType map[string]common.FieldType, st *Struct, val *Validation) error {
+	op := val.Operation
+	ops := operations.New()
+
+	fieldNames := val.Values
+	targets := []string{}
+	if ops.HasFieldValuePairs(op) {
+		if len(val.Values)%2 != 0 {
+			return types.NewValidationError("operation %s: expected pairs of field and value, but has %s", op, strings.Join(val.Values, " "))
+		}
+
+		fieldNames = []string{}
+		for i := 0; i < len(val.Values); i += 2 {
+			fieldNames = append(fieldNames, val.Values[i])
+			targets = append(targets, val.Values[i+1])
+		}
+	}
+
+	val.TargetTypes = map[string]common.FieldType{}
+	for i, fieldName := range fieldNames {
+		fieldType, err := findFieldType(fieldsType, st, op, fieldName)
+		if err != nil {
+			return err
+		}
+
+		if fieldType.IsOptional() {
+			return types.NewValidationError("operation %s: unsupported optional type %s of field %s", op, fieldType.Optional.Type, fieldName)
+		}
+
+		if !fieldType.IsGoType() || !ops.IsValidByType("required", fieldType.ToNormalizedString()) {
+			return types.NewValidationError("operation %s: invalid %s(%s) type of field %s", op, fieldType.BaseType, fieldType.ToNormalizedString(), fieldName)
+		}
+
+		if len(targets) > 0 {
+			if !ops.IsValidByType("eq", fieldType.ToNormalizedString()) {
+				return types.NewValidationError("operation %s: invalid %s(%s) type of field %s", op, fieldType.BaseType, fieldType.ToNormalizedString(), fieldName)
+			}
+
+			if err := operations.CheckTargetValue(fieldType, targets[i]); err != nil {
+				return types.NewValidationError("operation %s: invalid value %s for field %s", op, targets[i], fieldName)
+			}
+		}
+
+		val.TargetTypes[fieldName] = fieldType
+	}
+
+	return nil
+}
+
+// findFieldType returns the type of an inner (e.g. Field) or nested (e.g. Nested.Field) field.
+func findFieldType(fieldsType map[string]common.FieldType, st *Struct, op, fieldName string) (common.FieldType, error) {
+	fieldNameToSearch := ""
+	qualifiedField, qualifiedNestedField, ok := strings.Cut(fieldName, ".")
+	if !ok {
+		// If the operation is with an inner field, assume it's in the same struct.
+		fieldNameToSearch = common.KeyPath(st.PackageName, st.StructName, fieldName)
+	} else {
+		// If the field is qualified with a nested field, use its type.
+		qFieldType, ok := fieldsType[common.KeyPath(st.PackageName, st.StructName, qualifiedField)]
+		if !ok {
+			return common.FieldType{}, types.NewValidationError("operation %s: undefined nested field %s", op, qualifiedField)
+		}
+		fieldNameToSearch = common.KeyPath(qFieldType.BaseType, qualifiedNestedField)
+	}
+
+	fieldType, ok := fieldsType[fieldNameToSearch]
+	if !ok {
+		return common.FieldType{}, types.NewValidationError("operation %s: undefined field %s", op, fieldName)
+	}
+
+	return fieldType, nil
+}
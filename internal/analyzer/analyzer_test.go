@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/opencodeco/validgen/internal/common"
@@ -373,3 +374,516 @@ func TestAnalyzeStructsWithInvalidNestedFieldOperations(t *testing.T) {
 		})
 	}
 }
+
+func TestAnalyzeStructsWithValidConditionalOperations(t *testing.T) {
+	tests := []struct {
+		name            string
+		tag             string
+		wantTargetTypes map[string]common.FieldType
+	}{
+		{
+			name: "required_if with string and int fields",
+			tag:  `valid:"required_if=Country BR Age 18"`,
+			wantTargetTypes: map[string]common.FieldType{
+				"Country": {BaseType: "string"},
+				"Age":     {BaseType: "uint8"},
+			},
+		},
+		{
+			name: "excluded_unless with pointer field",
+			tag:  `valid:"excluded_unless=Active true"`,
+			wantTargetTypes: map[string]common.FieldType{
+				"Active": {BaseType: "bool", ComposedType: "*"},
+			},
+		},
+		{
+			name: "required_with_all with slice and pointer fields",
+			tag:  `valid:"required_with_all=Tags Active"`,
+			wantTargetTypes: map[string]common.FieldType{
+				"Tags":   {BaseType: "string", ComposedType: "[]"},
+				"Active": {BaseType: "bool", ComposedType: "*"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg := []*parser.Struct{
+				{
+					Fields: []parser.Field{
+						{
+							FieldName: "Field1",
+							Type:      common.FieldType{BaseType: "string", ComposedType: "*"},
+							Tag:       tt.tag,
+						},
+						{FieldName: "Country", Type: common.FieldType{BaseType: "string"}},
+						{FieldName: "Age", Type: common.FieldType{BaseType: "uint8"}},
+						{FieldName: "Active", Type: common.FieldType{BaseType: "bool", ComposedType: "*"}},
+						{FieldName: "Tags", Type: common.FieldType{BaseType: "string", ComposedType: "[]"}},
+					},
+				},
+			}
+
+			got, err := AnalyzeStructs(arg)
+			if err != nil {
+				t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, nil)
+				return
+			}
+			gotTargetTypes := got[0].FieldsValidations[0].Validations[0].TargetTypes
+			if !reflect.DeepEqual(gotTargetTypes, tt.wantTargetTypes) {
+				t.Errorf("AnalyzeStructs() target types = %v, want %v", gotTargetTypes, tt.wantTargetTypes)
+			}
+		})
+	}
+}
+
+func TestAnalyzeStructsWithInvalidConditionalOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		wantErr error
+	}{
+		{
+			name:    "undefined field",
+			tag:     `valid:"required_with=Email"`,
+			wantErr: types.NewValidationError("operation required_with: undefined field Email"),
+		},
+		{
+			name:    "missing value in pair",
+			tag:     `valid:"required_if=Country BR Age"`,
+			wantErr: types.NewValidationError("operation required_if: expected pairs of field and value, but has Country BR Age"),
+		},
+		{
+			name:    "invalid value for numeric field",
+			tag:     `valid:"required_if=Age abc"`,
+			wantErr: types.NewValidationError("operation required_if: invalid value abc for field Age"),
+		},
+		{
+			name:    "negative value for unsigned field",
+			tag:     `valid:"required_if=Age -1"`,
+			wantErr: types.NewValidationError("operation required_if: invalid value -1 for field Age"),
+		},
+		{
+			name:    "invalid comparison with slice field",
+			tag:     `valid:"excluded_if=Tags a"`,
+			wantErr: types.NewValidationError("operation excluded_if: invalid string([]<STRING>) type of field Tags"),
+		},
+		{
+			name:    "invalid array field",
+			tag:     `valid:"required_without=Codes"`,
+			wantErr: types.NewValidationError("operation required_without: invalid int([N]<INT>) type of field Codes"),
+		},
+		{
+			name:    "optional field",
+			tag:     `valid:"required_if=Nickname bob"`,
+			wantErr: types.NewValidationError("operation required_if: unsupported optional type sql.NullString of field Nickname"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg := []*parser.Struct{
+				{
+					Fields: []parser.Field{
+						{
+							FieldName: "Field1",
+							Type:      common.FieldType{BaseType: "string"},
+							Tag:       tt.tag,
+						},
+						{FieldName: "Country", Type: common.FieldType{BaseType: "string"}},
+						{FieldName: "Age", Type: common.FieldType{BaseType: "uint8"}},
+						{FieldName: "Tags", Type: common.FieldType{BaseType: "string", ComposedType: "[]"}},
+						{FieldName: "Codes", Type: common.FieldType{BaseType: "int", ComposedType: "[N]", Size: "3"}},
+						{FieldName: "Nickname", Type: common.FieldType{BaseType: "string", Optional: common.Optional{Type: "sql.NullString", ValueField: "String"}}},
+					},
+				},
+			}
+
+			_, err := AnalyzeStructs(arg)
+			if err != tt.wantErr {
+				t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestAnalyzeStructsWithFieldGroups(t *testing.T) {
+	arg := []*parser.Struct{
+		{
+			PackageName: "main",
+			StructName:  "Payment",
+			Fields: []parser.Field{
+				{
+					FieldName: "Card",
+					Type:      common.FieldType{BaseType: "main.Card", ComposedType: "*"},
+					Tag:       `valid:"exactly_one_of=payment"`,
+				},
+				{
+					FieldName: "Pix",
+					Type:      common.FieldType{BaseType: "string", ComposedType: "*"},
+					Tag:       `valid:"exactly_one_of=payment"`,
+				},
+				{
+					FieldName: "Boleto",
+					Type:      common.FieldType{BaseType: "string"},
+					Tag:       `valid:"exactly_one_of=payment"`,
+				},
+			},
+		},
+	}
+
+	got, err := AnalyzeStructs(arg)
+	if err != nil {
+		t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, nil)
+		return
+	}
+
+	wantGroupFields := []string{"Card", "Pix", "Boleto"}
+	gotGroupFields := got[0].FieldsValidations[0].Validations[0].GroupFields
+	if !reflect.DeepEqual(gotGroupFields, wantGroupFields) {
+		t.Errorf("AnalyzeStructs() group fields = %v, want %v", gotGroupFields, wantGroupFields)
+	}
+
+	for i := 1; i < len(got[0].FieldsValidations); i++ {
+		if groupFields := got[0].FieldsValidations[i].Validations[0].GroupFields; groupFields != nil {
+			t.Errorf("AnalyzeStructs() group fields in field %d = %v, want nil", i, groupFields)
+		}
+	}
+}
+
+func TestAnalyzeStructsWithInvalidFieldGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []parser.Field
+		wantErr error
+	}{
+		{
+			name: "group with one field",
+			fields: []parser.Field{
+				{FieldName: "Card", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"exactly_one_of=payment"`},
+				{FieldName: "Pix", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"at_most_one_of=payment"`},
+			},
+			wantErr: types.NewValidationError("operation exactly_one_of: group payment must have at least 2 fields"),
+		},
+		{
+			name: "struct field is not a pointer",
+			fields: []parser.Field{
+				{FieldName: "Card", Type: common.FieldType{BaseType: "main.Card"}, Tag: `valid:"exactly_one_of=payment"`},
+				{FieldName: "Pix", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"exactly_one_of=payment"`},
+			},
+			wantErr: types.NewValidationError("unsupported operation exactly_one_of with unknown go type main.Card"),
+		},
+		{
+			name: "repeated field in group",
+			fields: []parser.Field{
+				{FieldName: "Card", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"exactly_one_of=payment,exactly_one_of=payment"`},
+				{FieldName: "Pix", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"exactly_one_of=payment"`},
+			},
+			wantErr: types.NewValidationError("operation exactly_one_of: field Card is repeated in group payment"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg := []*parser.Struct{
+				{
+					PackageName: "main",
+					StructName:  "Payment",
+					Fields:      tt.fields,
+				},
+			}
+
+			_, err := AnalyzeStructs(arg)
+			if err != tt.wantErr {
+				t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestAnalyzeStructsWithInvalidNestedStructSlices(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   parser.Field
+		wantErr error
+	}{
+		{
+			name:    "operation with slice of nested structs",
+			field:   parser.Field{FieldName: "Items", Type: common.FieldType{BaseType: "main.Item", ComposedType: "[]"}, Tag: `valid:"min=1,dive"`},
+			wantErr: types.NewValidationError("operation min: unsupported []main.Item type, only dive is supported"),
+		},
+		{
+			name:    "dive with slice of pointers to nested structs",
+			field:   parser.Field{FieldName: "Items", Type: common.FieldType{BaseType: "main.Item", ComposedType: "*[]"}, Tag: `valid:"dive"`},
+			wantErr: types.NewValidationError("operation dive: unsupported *[]main.Item type, only dive is supported"),
+		},
+		{
+			name:    "operation after dive with nested structs",
+			field:   parser.Field{FieldName: "Items", Type: common.FieldType{BaseType: "main.Item", ComposedType: "[N]", Size: "2"}, Tag: `valid:"dive,required"`},
+			wantErr: types.NewValidationError("operation required: unsupported after dive"),
+		},
+		{
+			name:    "dive with groups and nested structs",
+			field:   parser.Field{FieldName: "Items", Type: common.FieldType{BaseType: "main.Item", ComposedType: "[]"}, Tag: `valid:"dive@create"`},
+			wantErr: types.NewValidationError("operation dive: unsupported groups"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg := []*parser.Struct{
+				{
+					PackageName: "main",
+					StructName:  "Item",
+					Fields:      []parser.Field{{FieldName: "Name", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"required"`}},
+				},
+				{
+					PackageName: "main",
+					StructName:  "Order",
+					Fields:      []parser.Field{tt.field},
+				},
+			}
+			_, err := AnalyzeStructs(arg)
+			if err != tt.wantErr {
+				t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
+
+func TestParseFieldValidations(t *testing.T) {
+	tests := []struct {
+		name     string
+		fieldTag string
+		want     []string
+		wantErr  error
+	}{
+		{
+			name:     "validations without raw values",
+			fieldTag: `valid:"required,min=2,in=a,b"`,
+			want:     []string{"required", "min=2", "in=a", "b"},
+		},
+		{
+			name:     "quoted raw value with commas",
+			fieldTag: `valid:"required,regex='^[a-z]{2,5}$',max=10"`,
+			want:     []string{"required", "regex='^[a-z]{2,5}$'", "max=10"},
+		},
+		{
+			name:     "quoted raw value with commas and quotes in the last validation",
+			fieldTag: `valid:"regex='^(a,'b'|c),d$'"`,
+			want:     []string{"regex='^(a,'b'|c),d$'"},
+		},
+		{
+			name:     "quoted raw value with escaped quotes followed by commas",
+			fieldTag: `valid:"regex='^a\\',b$',required"`,
+			want:     []string{`regex='^a\',b$'`, "required"},
+		},
+		{
+			name:     "unterminated quoted raw value",
+			fieldTag: `valid:"regex='abc,required"`,
+			wantErr:  types.NewValidationError("unterminated quote in validation regex='abc,required"),
+		},
+		{
+			name:     "quoted raw value terminated by an escaped quote",
+			fieldTag: `valid:"regex='abc\\'"`,
+			wantErr:  types.NewValidationError(`unterminated quote in validation regex='abc\'`),
+		},
+		{
+			name:     "pair of numbers",
+			fieldTag: `valid:"required,decimal=12,2,max=10"`,
+			want:     []string{"required", "decimal=12,2", "max=10"},
+		},
+		{
+			name:     "pair of numbers with groups in the last validation",
+			fieldTag: `valid:"decimal@create=12,2"`,
+			want:     []string{"decimal@create=12,2"},
+		},
+		{
+			name:     "quoted pair of numbers",
+			fieldTag: `valid:"decimal='12,2',required"`,
+			want:     []string{"decimal='12,2'", "required"},
+		},
+		{
+			name:     "pair of numbers doesn't take the next validation",
+			fieldTag: `valid:"decimal=12,required"`,
+			want:     []string{"decimal=12", "required"},
+		},
+		{
+			name:     "unquoted raw value doesn't take the next validations",
+			fieldTag: `valid:"regex=^a$,requird"`,
+			want:     []string{"regex=^a$", "requird"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := parseFieldValidations(tt.fieldTag)
+			if err != tt.wantErr {
+				t.Errorf("parseFieldValidations() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseFieldValidations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeStructsWithInvalidRegexAndDive(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   parser.Field
+		wantErr error
+	}{
+		{
+			name:    "invalid regex pattern",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"regex=^[a-z$"`},
+			wantErr: types.NewValidationError("operation regex: invalid pattern ^[a-z$: error parsing regexp: missing closing ]: `[a-z$`"),
+		},
+		{
+			name:    "datetime without layout elements",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"datetime=YYYY-MM-DD"`},
+			wantErr: types.NewValidationError("operation datetime: invalid layout YYYY-MM-DD"),
+		},
+		{
+			name:    "postcode with unsupported country",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"postcode_iso3166_alpha2=XX"`},
+			wantErr: types.NewValidationError("operation postcode_iso3166_alpha2: unsupported country XX"),
+		},
+		{
+			name:    "containsrune with many characters",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"containsrune=ab"`},
+			wantErr: types.NewValidationError("operation containsrune: value ab must be a single character"),
+		},
+		{
+			name:    "multipleof zero",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "float64"}, Tag: `valid:"multipleof=0.0"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value 0.0"),
+		},
+		{
+			name:    "multipleof infinity",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "float64"}, Tag: `valid:"multipleof=Inf"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value Inf"),
+		},
+		{
+			name:    "multipleof NaN",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "float32"}, Tag: `valid:"multipleof=NaN"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value NaN"),
+		},
+		{
+			name:    "multipleof negative with uint field",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "uint"}, Tag: `valid:"multipleof=-5"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value -5"),
+		},
+		{
+			name:    "multipleof out of the range of the field",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "int8"}, Tag: `valid:"multipleof=200"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value 200"),
+		},
+		{
+			name:    "negative with uint field",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "uint16"}, Tag: `valid:"negative"`},
+			wantErr: types.NewValidationError("operation negative: invalid uint16(<INT>) type"),
+		},
+		{
+			name:    "multipleof float with int field",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "int"}, Tag: `valid:"multipleof=0.5"`},
+			wantErr: types.NewValidationError("operation multipleof: invalid value 0.5"),
+		},
+		{
+			name:    "maxdecimals negative",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "float64"}, Tag: `valid:"maxdecimals=-1"`},
+			wantErr: types.NewValidationError("operation maxdecimals: invalid value -1"),
+		},
+		{
+			name:    "decimal with scale greater than precision",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"decimal=2,4"`},
+			wantErr: types.NewValidationError("operation decimal: invalid precision and scale 2,4"),
+		},
+		{
+			name:    "decimal without scale",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"decimal=12"`},
+			wantErr: types.NewValidationError("operation decimal: invalid precision and scale 12"),
+		},
+		{
+			name:    "decimal_gte with invalid decimal",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"decimal_gte=1e3"`},
+			wantErr: types.NewValidationError("operation decimal_gte: invalid decimal 1e3"),
+		},
+		{
+			name:    "big int with float value",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "big.Int", ComposedType: "*"}, Tag: `valid:"gte=0.5"`},
+			wantErr: types.NewValidationError("operation gte: invalid big.Int value 0.5"),
+		},
+		{
+			name:    "big rat with invalid fraction",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "big.Rat", ComposedType: "*"}, Tag: `valid:"lt=1/0"`},
+			wantErr: types.NewValidationError("operation lt: invalid big.Rat value 1/0"),
+		},
+		{
+			name:    "big float with length operation",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "big.Float", ComposedType: "*"}, Tag: `valid:"min=1"`},
+			wantErr: types.NewValidationError("operation min: invalid big.Float(*<BIGFLOAT>) type"),
+		},
+		{
+			name:    "dive with string",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string"}, Tag: `valid:"dive,regex=^a$"`},
+			wantErr: types.NewValidationError("operation dive: invalid string(<STRING>) type"),
+		},
+		{
+			name:    "invalid operation for the elements",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "int", ComposedType: "[]"}, Tag: `valid:"dive,regex=^a$"`},
+			wantErr: types.NewValidationError("operation regex: invalid int(<INT>) type"),
+		},
+		{
+			name:    "modifier after dive",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string", ComposedType: "[]"}, Tag: `valid:"dive,omitempty"`},
+			wantErr: types.NewValidationError("operation omitempty: unsupported after dive"),
+		},
+		{
+			name:    "conditional operation with nested struct",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "main.Card"}, Tag: `valid:"required_with=Field"`},
+			wantErr: types.NewValidationError("operation required_with: unsupported main.Card type, use a pointer to the struct"),
+		},
+		{
+			name:    "uuid with byte array that isn't 16 bytes",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "byte", ComposedType: "[N]", Size: "32"}, Tag: `valid:"uuid4"`},
+			wantErr: types.NewValidationError("operation uuid4: invalid array size 32, a UUID has 16 bytes"),
+		},
+		{
+			name:    "uuid with pointer to byte array that isn't 16 bytes",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "byte", ComposedType: "*[N]", Size: "8"}, Tag: `valid:"uuid"`},
+			wantErr: types.NewValidationError("operation uuid: invalid array size 8, a UUID has 16 bytes"),
+		},
+		{
+			name:    "invalid operation for the value of optional type",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "int64", Optional: common.Optional{Type: "sql.NullInt64", ValueField: "Int64"}}, Tag: `valid:"email"`},
+			wantErr: types.NewValidationError("operation email: invalid int64(<INT>) type"),
+		},
+		{
+			name:    "field operation with optional type",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string", Optional: common.Optional{Type: "sql.NullString", ValueField: "String"}}, Tag: `valid:"eqfield=Other"`},
+			wantErr: types.NewValidationError("operation eqfield: unsupported with optional type sql.NullString"),
+		},
+		{
+			name:    "slice of optional type",
+			field:   parser.Field{FieldName: "Field", Type: common.FieldType{BaseType: "string", ComposedType: "[]", Optional: common.Optional{Type: "sql.NullString", ValueField: "String"}}, Tag: `valid:"required"`},
+			wantErr: types.NewValidationError("operation required: unsupported []sql.NullString type"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg := []*parser.Struct{{Fields: []parser.Field{tt.field}}}
+			_, err := AnalyzeStructs(arg)
+			if err != tt.wantErr {
+				t.Errorf("AnalyzeStructs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+		})
+	}
+}
@@ -10,7 +10,22 @@ import (
 type Operation struct {
 	CountValues      common.CountValues
 	IsFieldOperation bool
-	ValidTypes       []string
+	// IsConditional is true when the operation checks if the field is required (or excluded)
+	// based on the values of other fields (e.g. required_if).
+	IsConditional bool
+	// HasFieldValuePairs is true when the values are pairs of field and value (e.g. required_if=Country BR).
+	HasFieldValuePairs bool
+	// IsFieldGroup is true when the operation checks a group of fields of the struct (e.g. exactly_one_of=payment).
+	IsFieldGroup bool
+	// RawValue is true when the value is used as is (e.g. regex), so it can have commas, spaces and '='.
+	RawValue bool
+	// NumberPair is true when the value is a pair of numbers separated by a comma (e.g. decimal=12,2),
+	// so the comma doesn't separate the validations.
+	NumberPair bool
+	ValidTypes []string
+	// ValidateValues checks the values of the operation with the type of the field when the validators
+	// are generated (e.g. regex patterns are compiled). It's nil when any value is accepted.
+	ValidateValues func(fieldType common.FieldType, values []string) error
 }
 
 type Operations struct {
@@ -39,6 +54,21 @@ func (o *Operations) IsValidByType(op, fieldType string) bool {
 		return true
 	}
 
+	// Omitempty and omitnil can be used with all pointer types.
+	if pointer && (op == "omitempty" || op == "omitnil") {
+		return true
+	}
+
+	// Dive can't be used with pointer types.
+	if pointer && op == "dive" {
+		return false
+	}
+
+	// Conditional and field group operations can be used with all pointer types too.
+	if pointer && (o.operations[op].IsConditional || o.operations[op].IsFieldGroup) {
+		return true
+	}
+
 	return slices.Contains(o.operations[op].ValidTypes, fieldType)
 }
 
@@ -46,6 +76,43 @@ func (o *Operations) IsFieldOperation(op string) bool {
 	return o.operations[op].IsFieldOperation
 }
 
+func (o *Operations) IsConditional(op string) bool {
+	return o.operations[op].IsConditional
+}
+
+func (o *Operations) HasFieldValuePairs(op string) bool {
+	return o.operations[op].HasFieldValuePairs
+}
+
+func (o *Operations) IsFieldGroup(op string) bool {
+	return o.operations[op].IsFieldGroup
+}
+
+func (o *Operations) HasRawValue(op string) bool {
+	return o.operations[op].RawValue
+}
+
+func (o *Operations) HasNumberPair(op string) bool {
+	return o.operations[op].NumberPair
+}
+
+// ValidateValues checks the values of the operation with the type of the field, if the operation
+// has a ValidateValues function.
+func (o *Operations) ValidateValues(op string, fieldType common.FieldType, values []string) error {
+	validate := o.operations[op].ValidateValues
+	if validate == nil {
+		return nil
+	}
+
+	return validate(fieldType, values)
+}
+
+// IsModifier reports whether the operation changes how the following operations are checked
+// instead of checking the field value (e.g. omitempty and dive).
+func (o *Operations) IsModifier(op string) bool {
+	return op == "omitempty" || op == "omitnil" || op == "dive"
+}
+
 func (o *Operations) ArgsCount(op string) common.CountValues {
 	return o.operations[op].CountValues
 }
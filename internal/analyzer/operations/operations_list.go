@@ -6,35 +6,60 @@ var operationsList = map[string]Operation{
 	"eq": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>"},
+		ValidTypes:       []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"required": {
 		CountValues:      common.ZeroValue,
 		IsFieldOperation: false,
 		ValidTypes: []string{
 			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
-			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+			"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>",
+			"<IPADDR>", "<IPPREFIX>", "<URL>", "<MAILADDRESS>", "[N]<BYTE>", "<TIME>"},
+	},
+	"omitempty": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+			"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>",
+			"<IPADDR>", "<IPPREFIX>", "<URL>", "<MAILADDRESS>", "[N]<BYTE>", "<TIME>",
+			"[N]<STRING>", "[N]<INT>", "[N]<FLOAT>", "[N]<BOOL>"},
+	},
+	"omitnil": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes: []string{
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
 			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
 	},
 	"gt": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidTypes:       []string{"<INT>", "<FLOAT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"gte": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidTypes:       []string{"<INT>", "<FLOAT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"lte": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidTypes:       []string{"<INT>", "<FLOAT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"lt": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidTypes:       []string{"<INT>", "<FLOAT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"min": {
 		CountValues:      common.OneValue,
@@ -68,7 +93,8 @@ var operationsList = map[string]Operation{
 	"neq": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<STRING>", "<BOOL>", "<INT>", "<FLOAT>"},
+		ValidTypes:       []string{"<STRING>", "<BOOL>", "<INT>", "<FLOAT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+		ValidateValues:   validateBigNumber,
 	},
 	"neq_ignore_case": {
 		CountValues:      common.OneValue,
@@ -98,36 +124,755 @@ var operationsList = map[string]Operation{
 	"email": {
 		CountValues:      common.ZeroValue,
 		IsFieldOperation: false,
-		ValidTypes:       []string{"<STRING>"},
+		ValidTypes:       []string{"<STRING>", "<MAILADDRESS>"},
 	},
 	"eqfield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<STRING>", "<INT>", "<BOOL>"},
+		ValidTypes:       []string{"<STRING>", "<INT>", "<BOOL>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
 	},
 	"neqfield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<STRING>", "<INT>", "<BOOL>"},
+		ValidTypes:       []string{"<STRING>", "<INT>", "<BOOL>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
 	},
 	"gtefield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<INT>"},
+		ValidTypes:       []string{"<INT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
 	},
 	"gtfield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<INT>"},
+		ValidTypes:       []string{"<INT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
 	},
 	"ltefield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<INT>"},
+		ValidTypes:       []string{"<INT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
 	},
 	"ltfield": {
 		CountValues:      common.OneValue,
 		IsFieldOperation: true,
-		ValidTypes:       []string{"<INT>"},
+		ValidTypes:       []string{"<INT>", "<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+	},
+	"required_if": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"required_unless": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"required_with": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"required_with_all": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"required_without": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"required_without_all": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_if": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_unless": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_with": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_with_all": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_without": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"excluded_without_all": {
+		CountValues:        common.ManyValues,
+		IsFieldOperation:   true,
+		IsConditional:      true,
+		HasFieldValuePairs: false,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"exactly_one_of": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		IsFieldGroup:     true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"at_most_one_of": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		IsFieldGroup:     true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"at_least_one_of": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		IsFieldGroup:     true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"all_or_none_of": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		IsFieldGroup:     true,
+		ValidTypes: []string{
+			"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+	},
+	"regex": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		RawValue:         true,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateRegex,
+	},
+	"dive": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes: []string{
+			"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+			"[N]<STRING>", "[N]<INT>", "[N]<FLOAT>", "[N]<BOOL>"},
+	},
+	"url": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<URL>"},
+	},
+	"uri": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<URL>"},
+	},
+	"http_url": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<URL>"},
+	},
+	"url_scheme": {
+		CountValues:      common.ManyValues,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<URL>"},
+	},
+	"ip": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPADDR>"},
+	},
+	"ipv4": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPADDR>"},
+	},
+	"ipv6": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPADDR>"},
+	},
+	"cidr": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPPREFIX>"},
+	},
+	"cidrv4": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPPREFIX>"},
+	},
+	"cidrv6": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPPREFIX>"},
+	},
+	"mac": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"hostname": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"fqdn": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"hostname_port": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"uuid": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[N]<BYTE>"},
+		ValidateValues:   validateUUIDArray,
+	},
+	"uuid3": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[N]<BYTE>"},
+		ValidateValues:   validateUUIDArray,
+	},
+	"uuid4": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[N]<BYTE>"},
+		ValidateValues:   validateUUIDArray,
+	},
+	"uuid5": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[N]<BYTE>"},
+		ValidateValues:   validateUUIDArray,
+	},
+	"uuid7": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[N]<BYTE>"},
+		ValidateValues:   validateUUIDArray,
+	},
+	"ulid": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"mongodb": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"alpha": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"alphanum": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"alphaunicode": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"alphanumunicode": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"numeric": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"number": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"ascii": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"printascii": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"lowercase": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"uppercase": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"notblank": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"contains": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"containsany": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"containsrune": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateRune,
+	},
+	"excludes": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"excludesall": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"startswith": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"endswith": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"startsnotwith": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"endsnotwith": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"in_ignore_case": {
+		CountValues:      common.ManyValues,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"nin_ignore_case": {
+		CountValues:      common.ManyValues,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"base64": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"base64url": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"base64rawurl": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"hexadecimal": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"json": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"jwt": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"hexcolor": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"rgb": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"rgba": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"hsl": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"datauri": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"mimetype": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "[]<BYTE>"},
+	},
+	"datetime": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		RawValue:         true,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateDatetimeLayout,
+	},
+	"timezone": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"duration": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf_formatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf_unformatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cnpj": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cnpj_formatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cnpj_unformatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cep": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cep_formatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cep_unformatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf_cnpj": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf_cnpj_formatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cpf_cnpj_unformatted": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"luhn_checksum": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"credit_card": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"iban": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"bic": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"isbn10": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"isbn13": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"issn": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"ean8": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"ean13": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"upc": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"iso3166_1_alpha2": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"iso3166_1_alpha3": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"iso3166_1_numeric": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<INT>"},
+	},
+	"iso4217": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"iso4217_numeric": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<INT>"},
+	},
+	"bcp47_language_tag": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"e164": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"postcode_iso3166_alpha2": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validatePostcodeCountry,
+	},
+	"postcode_iso3166_alpha2_field": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: true,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"latitude": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<FLOAT>"},
+	},
+	"longitude": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<FLOAT>"},
+	},
+	"semver": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"cron": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+	},
+	"finite": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<FLOAT>"},
+	},
+	"multipleof": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidateValues:   validateMultipleOf,
+	},
+	"maxdecimals": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<FLOAT>"},
+		ValidateValues:   validateMaxDecimals,
+	},
+	"positive": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+	},
+	"negative": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+		ValidateValues:   validateNegative,
+	},
+	"nonzero": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<INT>", "<FLOAT>"},
+	},
+	"decimal": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		RawValue:         true,
+		NumberPair:       true,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validatePrecisionAndScale,
+	},
+	"decimal_gt": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateDecimal,
+	},
+	"decimal_gte": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateDecimal,
+	},
+	"decimal_lt": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateDecimal,
+	},
+	"decimal_lte": {
+		CountValues:      common.OneValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>"},
+		ValidateValues:   validateDecimal,
+	},
+	"private_ip": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPADDR>"},
+	},
+	"public_ip": {
+		CountValues:      common.ZeroValue,
+		IsFieldOperation: false,
+		ValidTypes:       []string{"<STRING>", "<IPADDR>"},
 	},
 }
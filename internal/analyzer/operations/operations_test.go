@@ -34,6 +34,132 @@ func TestOperationsIsValid(t *testing.T) {
 		{op: "gtfield", want: true},
 		{op: "ltefield", want: true},
 		{op: "ltfield", want: true},
+		{op: "omitempty", want: true},
+		{op: "omitnil", want: true},
+		{op: "required_if", want: true},
+		{op: "required_unless", want: true},
+		{op: "required_with", want: true},
+		{op: "required_with_all", want: true},
+		{op: "required_without", want: true},
+		{op: "required_without_all", want: true},
+		{op: "excluded_if", want: true},
+		{op: "excluded_unless", want: true},
+		{op: "excluded_with", want: true},
+		{op: "excluded_with_all", want: true},
+		{op: "excluded_without", want: true},
+		{op: "excluded_without_all", want: true},
+		{op: "exactly_one_of", want: true},
+		{op: "at_most_one_of", want: true},
+		{op: "at_least_one_of", want: true},
+		{op: "all_or_none_of", want: true},
+		{op: "regex", want: true},
+		{op: "dive", want: true},
+		{op: "url", want: true},
+		{op: "uri", want: true},
+		{op: "http_url", want: true},
+		{op: "url_scheme", want: true},
+		{op: "ip", want: true},
+		{op: "ipv4", want: true},
+		{op: "ipv6", want: true},
+		{op: "cidr", want: true},
+		{op: "cidrv4", want: true},
+		{op: "cidrv6", want: true},
+		{op: "mac", want: true},
+		{op: "hostname", want: true},
+		{op: "fqdn", want: true},
+		{op: "hostname_port", want: true},
+		{op: "uuid", want: true},
+		{op: "uuid3", want: true},
+		{op: "uuid4", want: true},
+		{op: "uuid5", want: true},
+		{op: "uuid7", want: true},
+		{op: "ulid", want: true},
+		{op: "mongodb", want: true},
+		{op: "alpha", want: true},
+		{op: "alphanum", want: true},
+		{op: "alphaunicode", want: true},
+		{op: "alphanumunicode", want: true},
+		{op: "numeric", want: true},
+		{op: "number", want: true},
+		{op: "ascii", want: true},
+		{op: "printascii", want: true},
+		{op: "lowercase", want: true},
+		{op: "uppercase", want: true},
+		{op: "notblank", want: true},
+		{op: "contains", want: true},
+		{op: "containsany", want: true},
+		{op: "containsrune", want: true},
+		{op: "excludes", want: true},
+		{op: "excludesall", want: true},
+		{op: "startswith", want: true},
+		{op: "endswith", want: true},
+		{op: "startsnotwith", want: true},
+		{op: "endsnotwith", want: true},
+		{op: "in_ignore_case", want: true},
+		{op: "nin_ignore_case", want: true},
+		{op: "base64", want: true},
+		{op: "base64url", want: true},
+		{op: "base64rawurl", want: true},
+		{op: "hexadecimal", want: true},
+		{op: "json", want: true},
+		{op: "jwt", want: true},
+		{op: "hexcolor", want: true},
+		{op: "rgb", want: true},
+		{op: "rgba", want: true},
+		{op: "hsl", want: true},
+		{op: "datauri", want: true},
+		{op: "mimetype", want: true},
+		{op: "datetime", want: true},
+		{op: "timezone", want: true},
+		{op: "duration", want: true},
+		{op: "cpf", want: true},
+		{op: "cpf_formatted", want: true},
+		{op: "cpf_unformatted", want: true},
+		{op: "cnpj", want: true},
+		{op: "cnpj_formatted", want: true},
+		{op: "cnpj_unformatted", want: true},
+		{op: "cep", want: true},
+		{op: "cep_formatted", want: true},
+		{op: "cep_unformatted", want: true},
+		{op: "cpf_cnpj", want: true},
+		{op: "cpf_cnpj_formatted", want: true},
+		{op: "cpf_cnpj_unformatted", want: true},
+		{op: "luhn_checksum", want: true},
+		{op: "credit_card", want: true},
+		{op: "iban", want: true},
+		{op: "bic", want: true},
+		{op: "isbn10", want: true},
+		{op: "isbn13", want: true},
+		{op: "issn", want: true},
+		{op: "ean8", want: true},
+		{op: "ean13", want: true},
+		{op: "upc", want: true},
+		{op: "iso3166_1_alpha2", want: true},
+		{op: "iso3166_1_alpha3", want: true},
+		{op: "iso3166_1_numeric", want: true},
+		{op: "iso4217", want: true},
+		{op: "iso4217_numeric", want: true},
+		{op: "bcp47_language_tag", want: true},
+		{op: "e164", want: true},
+		{op: "postcode_iso3166_alpha2", want: true},
+		{op: "postcode_iso3166_alpha2_field", want: true},
+		{op: "latitude", want: true},
+		{op: "longitude", want: true},
+		{op: "semver", want: true},
+		{op: "cron", want: true},
+		{op: "finite", want: true},
+		{op: "multipleof", want: true},
+		{op: "maxdecimals", want: true},
+		{op: "positive", want: true},
+		{op: "negative", want: true},
+		{op: "nonzero", want: true},
+		{op: "decimal", want: true},
+		{op: "decimal_gt", want: true},
+		{op: "decimal_gte", want: true},
+		{op: "decimal_lt", want: true},
+		{op: "decimal_lte", want: true},
+		{op: "private_ip", want: true},
+		{op: "public_ip", want: true},
 		{op: "invalid_op", want: false},
 	}
 
@@ -69,6 +195,283 @@ func TestOperationsIsValidByType(t *testing.T) {
 			op: "required",
 			fieldTypes: []string{
 				"<STRING>", "<INT>", "<FLOAT>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// omitempty operations
+		{
+			op: "omitempty",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"[N]<STRING>", "[N]<INT>", "[N]<FLOAT>", "[N]<BOOL>",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// omitnil operations
+		{
+			op: "omitnil",
+			fieldTypes: []string{
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+		{
+			op: "omitnil",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+			},
+			valid: false,
+		},
+
+		// required_if operations
+		{
+			op: "required_if",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// required_unless operations
+		{
+			op: "required_unless",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// required_with operations
+		{
+			op: "required_with",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// required_with_all operations
+		{
+			op: "required_with_all",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// required_without operations
+		{
+			op: "required_without",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// required_without_all operations
+		{
+			op: "required_without_all",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_if operations
+		{
+			op: "excluded_if",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_unless operations
+		{
+			op: "excluded_unless",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_with operations
+		{
+			op: "excluded_with",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_with_all operations
+		{
+			op: "excluded_with_all",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_without operations
+		{
+			op: "excluded_without",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// excluded_without_all operations
+		{
+			op: "excluded_without_all",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// exactly_one_of operations
+		{
+			op: "exactly_one_of",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// at_most_one_of operations
+		{
+			op: "at_most_one_of",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// at_least_one_of operations
+		{
+			op: "at_least_one_of",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
+				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
+				"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>",
+				"*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>",
+				"*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]",
+			},
+			valid: true,
+		},
+
+		// all_or_none_of operations
+		{
+			op: "all_or_none_of",
+			fieldTypes: []string{
+				"<STRING>", "<INT>", "<FLOAT>", "<BOOL>",
 				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
 				"map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]",
 				"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>",
@@ -79,6 +482,1526 @@ func TestOperationsIsValidByType(t *testing.T) {
 			valid: true,
 		},
 
+		// regex operations
+		{
+			op:         "regex",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "regex",
+			fieldTypes: []string{"<INT>", "[]<STRING>", "*[]<STRING>"},
+			valid:      false,
+		},
+
+		// dive operations
+		{
+			op: "dive",
+			fieldTypes: []string{
+				"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>",
+				"[N]<STRING>", "[N]<INT>", "[N]<FLOAT>", "[N]<BOOL>",
+			},
+			valid: true,
+		},
+		{
+			op:         "dive",
+			fieldTypes: []string{"<STRING>", "*[]<STRING>", "map[<STRING>]"},
+			valid:      false,
+		},
+
+		// url operations
+		{
+			op:         "url",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "url",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uri operations
+		{
+			op:         "uri",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uri",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// http_url operations
+		{
+			op:         "http_url",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "http_url",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// url_scheme operations
+		{
+			op:         "url_scheme",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "url_scheme",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ip operations
+		{
+			op:         "ip",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ip",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ipv4 operations
+		{
+			op:         "ipv4",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ipv4",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ipv6 operations
+		{
+			op:         "ipv6",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ipv6",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cidr operations
+		{
+			op:         "cidr",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cidr",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cidrv4 operations
+		{
+			op:         "cidrv4",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cidrv4",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cidrv6 operations
+		{
+			op:         "cidrv6",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cidrv6",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// mac operations
+		{
+			op:         "mac",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "mac",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// hostname operations
+		{
+			op:         "hostname",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "hostname",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// fqdn operations
+		{
+			op:         "fqdn",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "fqdn",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// hostname_port operations
+		{
+			op:         "hostname_port",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "hostname_port",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uuid operations
+		{
+			op:         "uuid",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uuid3 operations
+		{
+			op:         "uuid3",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid3",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uuid4 operations
+		{
+			op:         "uuid4",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid4",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uuid5 operations
+		{
+			op:         "uuid5",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid5",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uuid7 operations
+		{
+			op:         "uuid7",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid7",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ulid operations
+		{
+			op:         "ulid",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ulid",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// mongodb operations
+		{
+			op:         "mongodb",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "mongodb",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// alpha operations
+		{
+			op:         "alpha",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "alpha",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// alphanum operations
+		{
+			op:         "alphanum",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "alphanum",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// alphaunicode operations
+		{
+			op:         "alphaunicode",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "alphaunicode",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// alphanumunicode operations
+		{
+			op:         "alphanumunicode",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "alphanumunicode",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// numeric operations
+		{
+			op:         "numeric",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "numeric",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// number operations
+		{
+			op:         "number",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "number",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ascii operations
+		{
+			op:         "ascii",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ascii",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// printascii operations
+		{
+			op:         "printascii",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "printascii",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// lowercase operations
+		{
+			op:         "lowercase",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "lowercase",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// uppercase operations
+		{
+			op:         "uppercase",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "uppercase",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// notblank operations
+		{
+			op:         "notblank",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "notblank",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// contains operations
+		{
+			op:         "contains",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "contains",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// containsany operations
+		{
+			op:         "containsany",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "containsany",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// containsrune operations
+		{
+			op:         "containsrune",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "containsrune",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// excludes operations
+		{
+			op:         "excludes",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "excludes",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// excludesall operations
+		{
+			op:         "excludesall",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "excludesall",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// startswith operations
+		{
+			op:         "startswith",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "startswith",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// endswith operations
+		{
+			op:         "endswith",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "endswith",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// startsnotwith operations
+		{
+			op:         "startsnotwith",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "startsnotwith",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// endsnotwith operations
+		{
+			op:         "endsnotwith",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "endsnotwith",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// in_ignore_case operations
+		{
+			op:         "in_ignore_case",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "in_ignore_case",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// nin_ignore_case operations
+		{
+			op:         "nin_ignore_case",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "nin_ignore_case",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// base64 operations
+		{
+			op:         "base64",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "base64",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// base64url operations
+		{
+			op:         "base64url",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "base64url",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// base64rawurl operations
+		{
+			op:         "base64rawurl",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "base64rawurl",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// hexadecimal operations
+		{
+			op:         "hexadecimal",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "hexadecimal",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// json operations
+		{
+			op:         "json",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "json",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// jwt operations
+		{
+			op:         "jwt",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "jwt",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// hexcolor operations
+		{
+			op:         "hexcolor",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "hexcolor",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// rgb operations
+		{
+			op:         "rgb",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "rgb",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// rgba operations
+		{
+			op:         "rgba",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "rgba",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// hsl operations
+		{
+			op:         "hsl",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "hsl",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// datauri operations
+		{
+			op:         "datauri",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "datauri",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// mimetype operations
+		{
+			op:         "mimetype",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "[]<BYTE>", "*[]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "mimetype",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// datetime operations
+		{
+			op:         "datetime",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "datetime",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// timezone operations
+		{
+			op:         "timezone",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "timezone",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// duration operations
+		{
+			op:         "duration",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "duration",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf operations
+		{
+			op:         "cpf",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf_formatted operations
+		{
+			op:         "cpf_formatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf_formatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf_unformatted operations
+		{
+			op:         "cpf_unformatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf_unformatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cnpj operations
+		{
+			op:         "cnpj",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cnpj",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cnpj_formatted operations
+		{
+			op:         "cnpj_formatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cnpj_formatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cnpj_unformatted operations
+		{
+			op:         "cnpj_unformatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cnpj_unformatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cep operations
+		{
+			op:         "cep",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cep",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cep_formatted operations
+		{
+			op:         "cep_formatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cep_formatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cep_unformatted operations
+		{
+			op:         "cep_unformatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cep_unformatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf_cnpj operations
+		{
+			op:         "cpf_cnpj",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf_cnpj",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf_cnpj_formatted operations
+		{
+			op:         "cpf_cnpj_formatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf_cnpj_formatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cpf_cnpj_unformatted operations
+		{
+			op:         "cpf_cnpj_unformatted",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cpf_cnpj_unformatted",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// luhn_checksum operations
+		{
+			op:         "luhn_checksum",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "luhn_checksum",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// credit_card operations
+		{
+			op:         "credit_card",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "credit_card",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iban operations
+		{
+			op:         "iban",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "iban",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// bic operations
+		{
+			op:         "bic",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "bic",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// isbn10 operations
+		{
+			op:         "isbn10",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "isbn10",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// isbn13 operations
+		{
+			op:         "isbn13",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "isbn13",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// issn operations
+		{
+			op:         "issn",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "issn",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ean8 operations
+		{
+			op:         "ean8",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ean8",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// ean13 operations
+		{
+			op:         "ean13",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "ean13",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// upc operations
+		{
+			op:         "upc",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "upc",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iso3166_1_alpha2 operations
+		{
+			op:         "iso3166_1_alpha2",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "iso3166_1_alpha2",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iso3166_1_alpha3 operations
+		{
+			op:         "iso3166_1_alpha3",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "iso3166_1_alpha3",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iso3166_1_numeric operations
+		{
+			op:         "iso3166_1_numeric",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "<INT>", "*<INT>"},
+			valid:      true,
+		},
+		{
+			op:         "iso3166_1_numeric",
+			fieldTypes: []string{"<FLOAT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iso4217 operations
+		{
+			op:         "iso4217",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "iso4217",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// iso4217_numeric operations
+		{
+			op:         "iso4217_numeric",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "<INT>", "*<INT>"},
+			valid:      true,
+		},
+		{
+			op:         "iso4217_numeric",
+			fieldTypes: []string{"<FLOAT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// bcp47_language_tag operations
+		{
+			op:         "bcp47_language_tag",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "bcp47_language_tag",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// e164 operations
+		{
+			op:         "e164",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "e164",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// postcode_iso3166_alpha2 operations
+		{
+			op:         "postcode_iso3166_alpha2",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "postcode_iso3166_alpha2",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// postcode_iso3166_alpha2_field operations
+		{
+			op:         "postcode_iso3166_alpha2_field",
+			fieldTypes: []string{"<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "postcode_iso3166_alpha2_field",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// latitude operations
+		{
+			op:         "latitude",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "latitude",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// longitude operations
+		{
+			op:         "longitude",
+			fieldTypes: []string{"<STRING>", "*<STRING>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "longitude",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// semver operations
+		{
+			op:         "semver",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "semver",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// cron operations
+		{
+			op:         "cron",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "cron",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// finite operations
+		{
+			op:         "finite",
+			fieldTypes: []string{"<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "finite",
+			fieldTypes: []string{"<INT>", "<STRING>"},
+			valid:      false,
+		},
+
+		// multipleof operations
+		{
+			op:         "multipleof",
+			fieldTypes: []string{"<INT>", "*<INT>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "multipleof",
+			fieldTypes: []string{"<STRING>", "[]<INT>"},
+			valid:      false,
+		},
+
+		// maxdecimals operations
+		{
+			op:         "maxdecimals",
+			fieldTypes: []string{"<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "maxdecimals",
+			fieldTypes: []string{"<INT>", "<STRING>"},
+			valid:      false,
+		},
+
+		// positive operations
+		{
+			op:         "positive",
+			fieldTypes: []string{"<INT>", "*<INT>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "positive",
+			fieldTypes: []string{"<STRING>", "[]<INT>"},
+			valid:      false,
+		},
+
+		// negative operations
+		{
+			op:         "negative",
+			fieldTypes: []string{"<INT>", "*<INT>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "negative",
+			fieldTypes: []string{"<STRING>", "[]<INT>"},
+			valid:      false,
+		},
+
+		// nonzero operations
+		{
+			op:         "nonzero",
+			fieldTypes: []string{"<INT>", "*<INT>", "<FLOAT>", "*<FLOAT>"},
+			valid:      true,
+		},
+		{
+			op:         "nonzero",
+			fieldTypes: []string{"<STRING>", "[]<INT>"},
+			valid:      false,
+		},
+
+		// decimal operations
+		{
+			op:         "decimal",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "decimal",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// decimal_gt operations
+		{
+			op:         "decimal_gt",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "decimal_gt",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// decimal_gte operations
+		{
+			op:         "decimal_gte",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "decimal_gte",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// decimal_lt operations
+		{
+			op:         "decimal_lt",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "decimal_lt",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// decimal_lte operations
+		{
+			op:         "decimal_lte",
+			fieldTypes: []string{"<STRING>", "*<STRING>"},
+			valid:      true,
+		},
+		{
+			op:         "decimal_lte",
+			fieldTypes: []string{"<INT>", "[]<STRING>"},
+			valid:      false,
+		},
+
+		// math/big operations
+		{
+			op:         "eq",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "neq",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "gt",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "gte",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "lt",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "lte",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "eqfield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "neqfield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "gtfield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "gtefield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "ltfield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "ltefield",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "required",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "omitempty",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "omitnil",
+			fieldTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      true,
+		},
+		{
+			op:         "min",
+			fieldTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+			valid:      false,
+		},
+
+		// net/netip, net/url, net/mail and [16]byte operations
+		{
+			op:         "required",
+			fieldTypes: []string{"<IPADDR>", "<IPPREFIX>", "<URL>", "<MAILADDRESS>", "[N]<BYTE>", "*<IPADDR>", "*<IPPREFIX>", "*<URL>", "*<MAILADDRESS>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "omitempty",
+			fieldTypes: []string{"<IPADDR>", "<IPPREFIX>", "<URL>", "<MAILADDRESS>", "[N]<BYTE>", "*<IPADDR>", "*<IPPREFIX>", "*<URL>", "*<MAILADDRESS>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "omitnil",
+			fieldTypes: []string{"*<IPADDR>", "*<IPPREFIX>", "*<URL>", "*<MAILADDRESS>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "ip",
+			fieldTypes: []string{"<IPADDR>", "*<IPADDR>"},
+			valid:      true,
+		},
+		{
+			op:         "ipv4",
+			fieldTypes: []string{"<IPADDR>", "*<IPADDR>"},
+			valid:      true,
+		},
+		{
+			op:         "ipv6",
+			fieldTypes: []string{"<IPADDR>", "*<IPADDR>"},
+			valid:      true,
+		},
+		{
+			op:         "cidr",
+			fieldTypes: []string{"<IPPREFIX>", "*<IPPREFIX>"},
+			valid:      true,
+		},
+		{
+			op:         "cidrv4",
+			fieldTypes: []string{"<IPPREFIX>", "*<IPPREFIX>"},
+			valid:      true,
+		},
+		{
+			op:         "cidrv6",
+			fieldTypes: []string{"<IPPREFIX>", "*<IPPREFIX>"},
+			valid:      true,
+		},
+		{
+			op:         "url",
+			fieldTypes: []string{"<URL>", "*<URL>"},
+			valid:      true,
+		},
+		{
+			op:         "uri",
+			fieldTypes: []string{"<URL>", "*<URL>"},
+			valid:      true,
+		},
+		{
+			op:         "http_url",
+			fieldTypes: []string{"<URL>", "*<URL>"},
+			valid:      true,
+		},
+		{
+			op:         "url_scheme",
+			fieldTypes: []string{"<URL>", "*<URL>"},
+			valid:      true,
+		},
+		{
+			op:         "email",
+			fieldTypes: []string{"<MAILADDRESS>", "*<MAILADDRESS>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid",
+			fieldTypes: []string{"[N]<BYTE>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid3",
+			fieldTypes: []string{"[N]<BYTE>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid4",
+			fieldTypes: []string{"[N]<BYTE>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid5",
+			fieldTypes: []string{"[N]<BYTE>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "uuid7",
+			fieldTypes: []string{"[N]<BYTE>", "*[N]<BYTE>"},
+			valid:      true,
+		},
+		{
+			op:         "ip",
+			fieldTypes: []string{"<IPPREFIX>", "<URL>"},
+			valid:      false,
+		},
+		{
+			op:         "url",
+			fieldTypes: []string{"<IPADDR>", "<MAILADDRESS>"},
+			valid:      false,
+		},
+		{
+			op:         "uuid",
+			fieldTypes: []string{"[]<BYTE>", "[N]<STRING>"},
+			valid:      false,
+		},
+		{
+			op:         "min",
+			fieldTypes: []string{"<IPADDR>", "<URL>", "<MAILADDRESS>"},
+			valid:      false,
+		},
+
+		// time.Time operations
+		{
+			op:         "required",
+			fieldTypes: []string{"<TIME>", "*<TIME>"},
+			valid:      true,
+		},
+		{
+			op:         "omitempty",
+			fieldTypes: []string{"<TIME>", "*<TIME>"},
+			valid:      true,
+		},
+		{
+			op:         "omitnil",
+			fieldTypes: []string{"*<TIME>"},
+			valid:      true,
+		},
+		{
+			op:         "eq",
+			fieldTypes: []string{"<TIME>", "*<TIME>"},
+			valid:      false,
+		},
+
+		// private_ip operations
+		{
+			op:         "private_ip",
+			fieldTypes: []string{"<STRING>", "<IPADDR>"},
+			valid:      true,
+		},
+		{
+			op:         "private_ip",
+			fieldTypes: []string{"<INT>"},
+			valid:      false,
+		},
+
+		// public_ip operations
+		{
+			op:         "public_ip",
+			fieldTypes: []string{"<STRING>", "<IPADDR>"},
+			valid:      true,
+		},
+		{
+			op:         "public_ip",
+			fieldTypes: []string{"<INT>"},
+			valid:      false,
+		},
+
 		// gt operations
 		{
 			op: "gt",
@@ -310,6 +2233,132 @@ func TestOperationsIsFieldOperation(t *testing.T) {
 		{op: "gtfield", want: true},
 		{op: "ltefield", want: true},
 		{op: "ltfield", want: true},
+		{op: "omitempty", want: false},
+		{op: "omitnil", want: false},
+		{op: "required_if", want: true},
+		{op: "required_unless", want: true},
+		{op: "required_with", want: true},
+		{op: "required_with_all", want: true},
+		{op: "required_without", want: true},
+		{op: "required_without_all", want: true},
+		{op: "excluded_if", want: true},
+		{op: "excluded_unless", want: true},
+		{op: "excluded_with", want: true},
+		{op: "excluded_with_all", want: true},
+		{op: "excluded_without", want: true},
+		{op: "excluded_without_all", want: true},
+		{op: "exactly_one_of", want: false},
+		{op: "at_most_one_of", want: false},
+		{op: "at_least_one_of", want: false},
+		{op: "all_or_none_of", want: false},
+		{op: "regex", want: false},
+		{op: "dive", want: false},
+		{op: "url", want: false},
+		{op: "uri", want: false},
+		{op: "http_url", want: false},
+		{op: "url_scheme", want: false},
+		{op: "ip", want: false},
+		{op: "ipv4", want: false},
+		{op: "ipv6", want: false},
+		{op: "cidr", want: false},
+		{op: "cidrv4", want: false},
+		{op: "cidrv6", want: false},
+		{op: "mac", want: false},
+		{op: "hostname", want: false},
+		{op: "fqdn", want: false},
+		{op: "hostname_port", want: false},
+		{op: "uuid", want: false},
+		{op: "uuid3", want: false},
+		{op: "uuid4", want: false},
+		{op: "uuid5", want: false},
+		{op: "uuid7", want: false},
+		{op: "ulid", want: false},
+		{op: "mongodb", want: false},
+		{op: "alpha", want: false},
+		{op: "alphanum", want: false},
+		{op: "alphaunicode", want: false},
+		{op: "alphanumunicode", want: false},
+		{op: "numeric", want: false},
+		{op: "number", want: false},
+		{op: "ascii", want: false},
+		{op: "printascii", want: false},
+		{op: "lowercase", want: false},
+		{op: "uppercase", want: false},
+		{op: "notblank", want: false},
+		{op: "contains", want: false},
+		{op: "containsany", want: false},
+		{op: "containsrune", want: false},
+		{op: "excludes", want: false},
+		{op: "excludesall", want: false},
+		{op: "startswith", want: false},
+		{op: "endswith", want: false},
+		{op: "startsnotwith", want: false},
+		{op: "endsnotwith", want: false},
+		{op: "in_ignore_case", want: false},
+		{op: "nin_ignore_case", want: false},
+		{op: "base64", want: false},
+		{op: "base64url", want: false},
+		{op: "base64rawurl", want: false},
+		{op: "hexadecimal", want: false},
+		{op: "json", want: false},
+		{op: "jwt", want: false},
+		{op: "hexcolor", want: false},
+		{op: "rgb", want: false},
+		{op: "rgba", want: false},
+		{op: "hsl", want: false},
+		{op: "datauri", want: false},
+		{op: "mimetype", want: false},
+		{op: "datetime", want: false},
+		{op: "timezone", want: false},
+		{op: "duration", want: false},
+		{op: "cpf", want: false},
+		{op: "cpf_formatted", want: false},
+		{op: "cpf_unformatted", want: false},
+		{op: "cnpj", want: false},
+		{op: "cnpj_formatted", want: false},
+		{op: "cnpj_unformatted", want: false},
+		{op: "cep", want: false},
+		{op: "cep_formatted", want: false},
+		{op: "cep_unformatted", want: false},
+		{op: "cpf_cnpj", want: false},
+		{op: "cpf_cnpj_formatted", want: false},
+		{op: "cpf_cnpj_unformatted", want: false},
+		{op: "luhn_checksum", want: false},
+		{op: "credit_card", want: false},
+		{op: "iban", want: false},
+		{op: "bic", want: false},
+		{op: "isbn10", want: false},
+		{op: "isbn13", want: false},
+		{op: "issn", want: false},
+		{op: "ean8", want: false},
+		{op: "ean13", want: false},
+		{op: "upc", want: false},
+		{op: "iso3166_1_alpha2", want: false},
+		{op: "iso3166_1_alpha3", want: false},
+		{op: "iso3166_1_numeric", want: false},
+		{op: "iso4217", want: false},
+		{op: "iso4217_numeric", want: false},
+		{op: "bcp47_language_tag", want: false},
+		{op: "e164", want: false},
+		{op: "postcode_iso3166_alpha2", want: false},
+		{op: "postcode_iso3166_alpha2_field", want: true},
+		{op: "latitude", want: false},
+		{op: "longitude", want: false},
+		{op: "semver", want: false},
+		{op: "cron", want: false},
+		{op: "finite", want: false},
+		{op: "multipleof", want: false},
+		{op: "maxdecimals", want: false},
+		{op: "positive", want: false},
+		{op: "negative", want: false},
+		{op: "nonzero", want: false},
+		{op: "decimal", want: false},
+		{op: "decimal_gt", want: false},
+		{op: "decimal_gte", want: false},
+		{op: "decimal_lt", want: false},
+		{op: "decimal_lte", want: false},
+		{op: "private_ip", want: false},
+		{op: "public_ip", want: false},
 		{op: "invalid_op", want: false},
 	}
 
@@ -350,6 +2399,132 @@ func TestOperationsArgsCount(t *testing.T) {
 		{op: "gtfield", want: common.OneValue},
 		{op: "ltefield", want: common.OneValue},
 		{op: "ltfield", want: common.OneValue},
+		{op: "omitempty", want: common.ZeroValue},
+		{op: "omitnil", want: common.ZeroValue},
+		{op: "required_if", want: common.ManyValues},
+		{op: "required_unless", want: common.ManyValues},
+		{op: "required_with", want: common.ManyValues},
+		{op: "required_with_all", want: common.ManyValues},
+		{op: "required_without", want: common.ManyValues},
+		{op: "required_without_all", want: common.ManyValues},
+		{op: "excluded_if", want: common.ManyValues},
+		{op: "excluded_unless", want: common.ManyValues},
+		{op: "excluded_with", want: common.ManyValues},
+		{op: "excluded_with_all", want: common.ManyValues},
+		{op: "excluded_without", want: common.ManyValues},
+		{op: "excluded_without_all", want: common.ManyValues},
+		{op: "exactly_one_of", want: common.OneValue},
+		{op: "at_most_one_of", want: common.OneValue},
+		{op: "at_least_one_of", want: common.OneValue},
+		{op: "all_or_none_of", want: common.OneValue},
+		{op: "regex", want: common.OneValue},
+		{op: "dive", want: common.ZeroValue},
+		{op: "url", want: common.ZeroValue},
+		{op: "uri", want: common.ZeroValue},
+		{op: "http_url", want: common.ZeroValue},
+		{op: "url_scheme", want: common.ManyValues},
+		{op: "ip", want: common.ZeroValue},
+		{op: "ipv4", want: common.ZeroValue},
+		{op: "ipv6", want: common.ZeroValue},
+		{op: "cidr", want: common.ZeroValue},
+		{op: "cidrv4", want: common.ZeroValue},
+		{op: "cidrv6", want: common.ZeroValue},
+		{op: "mac", want: common.ZeroValue},
+		{op: "hostname", want: common.ZeroValue},
+		{op: "fqdn", want: common.ZeroValue},
+		{op: "hostname_port", want: common.ZeroValue},
+		{op: "uuid", want: common.ZeroValue},
+		{op: "uuid3", want: common.ZeroValue},
+		{op: "uuid4", want: common.ZeroValue},
+		{op: "uuid5", want: common.ZeroValue},
+		{op: "uuid7", want: common.ZeroValue},
+		{op: "ulid", want: common.ZeroValue},
+		{op: "mongodb", want: common.ZeroValue},
+		{op: "alpha", want: common.ZeroValue},
+		{op: "alphanum", want: common.ZeroValue},
+		{op: "alphaunicode", want: common.ZeroValue},
+		{op: "alphanumunicode", want: common.ZeroValue},
+		{op: "numeric", want: common.ZeroValue},
+		{op: "number", want: common.ZeroValue},
+		{op: "ascii", want: common.ZeroValue},
+		{op: "printascii", want: common.ZeroValue},
+		{op: "lowercase", want: common.ZeroValue},
+		{op: "uppercase", want: common.ZeroValue},
+		{op: "notblank", want: common.ZeroValue},
+		{op: "contains", want: common.OneValue},
+		{op: "containsany", want: common.OneValue},
+		{op: "containsrune", want: common.OneValue},
+		{op: "excludes", want: common.OneValue},
+		{op: "excludesall", want: common.OneValue},
+		{op: "startswith", want: common.OneValue},
+		{op: "endswith", want: common.OneValue},
+		{op: "startsnotwith", want: common.OneValue},
+		{op: "endsnotwith", want: common.OneValue},
+		{op: "in_ignore_case", want: common.ManyValues},
+		{op: "nin_ignore_case", want: common.ManyValues},
+		{op: "base64", want: common.ZeroValue},
+		{op: "base64url", want: common.ZeroValue},
+		{op: "base64rawurl", want: common.ZeroValue},
+		{op: "hexadecimal", want: common.ZeroValue},
+		{op: "json", want: common.ZeroValue},
+		{op: "jwt", want: common.ZeroValue},
+		{op: "hexcolor", want: common.ZeroValue},
+		{op: "rgb", want: common.ZeroValue},
+		{op: "rgba", want: common.ZeroValue},
+		{op: "hsl", want: common.ZeroValue},
+		{op: "datauri", want: common.ZeroValue},
+		{op: "mimetype", want: common.ZeroValue},
+		{op: "datetime", want: common.OneValue},
+		{op: "timezone", want: common.ZeroValue},
+		{op: "duration", want: common.ZeroValue},
+		{op: "cpf", want: common.ZeroValue},
+		{op: "cpf_formatted", want: common.ZeroValue},
+		{op: "cpf_unformatted", want: common.ZeroValue},
+		{op: "cnpj", want: common.ZeroValue},
+		{op: "cnpj_formatted", want: common.ZeroValue},
+		{op: "cnpj_unformatted", want: common.ZeroValue},
+		{op: "cep", want: common.ZeroValue},
+		{op: "cep_formatted", want: common.ZeroValue},
+		{op: "cep_unformatted", want: common.ZeroValue},
+		{op: "cpf_cnpj", want: common.ZeroValue},
+		{op: "cpf_cnpj_formatted", want: common.ZeroValue},
+		{op: "cpf_cnpj_unformatted", want: common.ZeroValue},
+		{op: "luhn_checksum", want: common.ZeroValue},
+		{op: "credit_card", want: common.ZeroValue},
+		{op: "iban", want: common.ZeroValue},
+		{op: "bic", want: common.ZeroValue},
+		{op: "isbn10", want: common.ZeroValue},
+		{op: "isbn13", want: common.ZeroValue},
+		{op: "issn", want: common.ZeroValue},
+		{op: "ean8", want: common.ZeroValue},
+		{op: "ean13", want: common.ZeroValue},
+		{op: "upc", want: common.ZeroValue},
+		{op: "iso3166_1_alpha2", want: common.ZeroValue},
+		{op: "iso3166_1_alpha3", want: common.ZeroValue},
+		{op: "iso3166_1_numeric", want: common.ZeroValue},
+		{op: "iso4217", want: common.ZeroValue},
+		{op: "iso4217_numeric", want: common.ZeroValue},
+		{op: "bcp47_language_tag", want: common.ZeroValue},
+		{op: "e164", want: common.ZeroValue},
+		{op: "postcode_iso3166_alpha2", want: common.OneValue},
+		{op: "postcode_iso3166_alpha2_field", want: common.OneValue},
+		{op: "latitude", want: common.ZeroValue},
+		{op: "longitude", want: common.ZeroValue},
+		{op: "semver", want: common.ZeroValue},
+		{op: "cron", want: common.ZeroValue},
+		{op: "finite", want: common.ZeroValue},
+		{op: "multipleof", want: common.OneValue},
+		{op: "maxdecimals", want: common.OneValue},
+		{op: "positive", want: common.ZeroValue},
+		{op: "negative", want: common.ZeroValue},
+		{op: "nonzero", want: common.ZeroValue},
+		{op: "decimal", want: common.OneValue},
+		{op: "decimal_gt", want: common.OneValue},
+		{op: "decimal_gte", want: common.OneValue},
+		{op: "decimal_lt", want: common.OneValue},
+		{op: "decimal_lte", want: common.OneValue},
+		{op: "private_ip", want: common.ZeroValue},
+		{op: "public_ip", want: common.ZeroValue},
 		{op: "invalid_op", want: common.UndefinedValue},
 	}
 
@@ -363,3 +2538,193 @@ func TestOperationsArgsCount(t *testing.T) {
 		})
 	}
 }
+
+func TestOperationsValidateValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		op        string
+		fieldType common.FieldType
+		values    []string
+		wantErr   string
+	}{
+		{
+			name:      "operation without value validation",
+			op:        "email",
+			fieldType: common.FieldType{BaseType: "string"},
+		},
+		{
+			name:      "valid regex",
+			op:        "regex",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"^[a-z]{2,5}$"},
+		},
+		{
+			name:      "invalid regex",
+			op:        "regex",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"^[a-z$"},
+			wantErr:   "invalid pattern ^[a-z$: error parsing regexp: missing closing ]: `[a-z$`",
+		},
+		{
+			name:      "containsrune with a multibyte character",
+			op:        "containsrune",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"é"},
+		},
+		{
+			name:      "containsrune with many characters",
+			op:        "containsrune",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"ab"},
+			wantErr:   "value ab must be a single character",
+		},
+		{
+			name:      "valid datetime layout",
+			op:        "datetime",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"2006-01-02"},
+		},
+		{
+			name:      "datetime without layout elements",
+			op:        "datetime",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"YYYY-MM-DD"},
+			wantErr:   "invalid layout YYYY-MM-DD",
+		},
+		{
+			name:      "postcode with supported country",
+			op:        "postcode_iso3166_alpha2",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"BR"},
+		},
+		{
+			name:      "postcode with unsupported country",
+			op:        "postcode_iso3166_alpha2",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"XX"},
+			wantErr:   "unsupported country XX",
+		},
+		{
+			name:      "negative with int field",
+			op:        "negative",
+			fieldType: common.FieldType{BaseType: "int16"},
+		},
+		{
+			name:      "negative with uint field",
+			op:        "negative",
+			fieldType: common.FieldType{BaseType: "uint16"},
+			wantErr:   "invalid uint16(<INT>) type",
+		},
+		{
+			name:      "multipleof float",
+			op:        "multipleof",
+			fieldType: common.FieldType{BaseType: "float64"},
+			values:    []string{"0.25"},
+		},
+		{
+			name:      "multipleof zero",
+			op:        "multipleof",
+			fieldType: common.FieldType{BaseType: "float64"},
+			values:    []string{"0.0"},
+			wantErr:   "invalid value 0.0",
+		},
+		{
+			name:      "multipleof infinity",
+			op:        "multipleof",
+			fieldType: common.FieldType{BaseType: "float64"},
+			values:    []string{"+Inf"},
+			wantErr:   "invalid value +Inf",
+		},
+		{
+			name:      "multipleof float with int field",
+			op:        "multipleof",
+			fieldType: common.FieldType{BaseType: "int"},
+			values:    []string{"0.5"},
+			wantErr:   "invalid value 0.5",
+		},
+		{
+			name:      "maxdecimals",
+			op:        "maxdecimals",
+			fieldType: common.FieldType{BaseType: "float32"},
+			values:    []string{"2"},
+		},
+		{
+			name:      "maxdecimals negative",
+			op:        "maxdecimals",
+			fieldType: common.FieldType{BaseType: "float32"},
+			values:    []string{"-1"},
+			wantErr:   "invalid value -1",
+		},
+		{
+			name:      "decimal precision and scale",
+			op:        "decimal",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"12,2"},
+		},
+		{
+			name:      "decimal with scale greater than precision",
+			op:        "decimal",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"2,4"},
+			wantErr:   "invalid precision and scale 2,4",
+		},
+		{
+			name:      "decimal_gte",
+			op:        "decimal_gte",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"-0.01"},
+		},
+		{
+			name:      "decimal_lt with invalid decimal",
+			op:        "decimal_lt",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"1e3"},
+			wantErr:   "invalid decimal 1e3",
+		},
+		{
+			name:      "big.Rat fraction",
+			op:        "gt",
+			fieldType: common.FieldType{BaseType: "big.Rat", ComposedType: "*"},
+			values:    []string{"1/3"},
+		},
+		{
+			name:      "big.Int with invalid value",
+			op:        "eq",
+			fieldType: common.FieldType{BaseType: "big.Int", ComposedType: "*"},
+			values:    []string{"1.5"},
+			wantErr:   "invalid big.Int value 1.5",
+		},
+		{
+			name:      "string field with a non-numeric value",
+			op:        "eq",
+			fieldType: common.FieldType{BaseType: "string"},
+			values:    []string{"abc"},
+		},
+		{
+			name:      "uuid with 16 bytes array",
+			op:        "uuid4",
+			fieldType: common.FieldType{BaseType: "byte", ComposedType: "[N]", Size: "16"},
+		},
+		{
+			name:      "uuid with array pointer of another size",
+			op:        "uuid",
+			fieldType: common.FieldType{BaseType: "byte", ComposedType: "*[N]", Size: "8"},
+			wantErr:   "invalid array size 8, a UUID has 16 bytes",
+		},
+	}
+
+	ops := New()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotErr := ""
+			if err := ops.ValidateValues(tt.op, tt.fieldType, tt.values); err != nil {
+				gotErr = err.Error()
+			}
+
+			if gotErr != tt.wantErr {
+				t.Errorf("ValidateValues() error = %v, wantErr %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package operations
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/opencodeco/validgen/internal/common"
+	"github.com/opencodeco/validgen/types"
+)
+
+// validateRegex compiles the pattern, so invalid patterns are found when the validators are generated.
+func validateRegex(_ common.FieldType, values []string) error {
+	if _, err := regexp.Compile(values[0]); err != nil {
+		return fmt.Errorf("invalid pattern %s: %s", values[0], err.Error())
+	}
+
+	return nil
+}
+
+// validateRune checks if the value is a single character (a rune), not a substring.
+func validateRune(_ common.FieldType, values []string) error {
+	if utf8.RuneCountInString(values[0]) != 1 {
+		return fmt.Errorf("value %s must be a single character", values[0])
+	}
+
+	return nil
+}
+
+// validateDatetimeLayout checks if the value is a time layout, so invalid layouts are found when the
+// validators are generated.
+func validateDatetimeLayout(_ common.FieldType, values []string) error {
+	if !types.IsValidDatetimeLayout(values[0]) {
+		return fmt.Errorf("invalid layout %s", values[0])
+	}
+
+	return nil
+}
+
+// validatePostcodeCountry checks if there are postal code formats for the country.
+func validatePostcodeCountry(_ common.FieldType, values []string) error {
+	if !types.HasPostcodeFormat(values[0]) {
+		return fmt.Errorf("unsupported country %s", values[0])
+	}
+
+	return nil
+}
+
+// validateNegative checks if the field can be negative, as unsigned integers are never negative.
+func validateNegative(fieldType common.FieldType, _ []string) error {
+	if isUnsigned(fieldType.BaseType) {
+		return fmt.Errorf("invalid %s(%s) type", fieldType.BaseType, fieldType.ToNormalizedString())
+	}
+
+	return nil
+}
+
+// validateMultipleOf checks if the value is a finite non-zero number of the type of the field,
+// as a multiple of zero, infinity or NaN is always invalid and an int field can't be a multiple of a float.
+func validateMultipleOf(fieldType common.FieldType, values []string) error {
+	if err := CheckTargetValue(fieldType, values[0]); err != nil || !isFiniteNonZero(values[0]) {
+		return fmt.Errorf("invalid value %s", values[0])
+	}
+
+	return nil
+}
+
+// validateMaxDecimals checks if the value is a non-negative number of decimal places.
+func validateMaxDecimals(_ common.FieldType, values []string) error {
+	if n, err := strconv.Atoi(values[0]); err != nil || n < 0 {
+		return fmt.Errorf("invalid value %s", values[0])
+	}
+
+	return nil
+}
+
+// validatePrecisionAndScale checks the decimal precision and scale, as they are used as is in the
+// generated code.
+func validatePrecisionAndScale(_ common.FieldType, values []string) error {
+	if !isValidPrecisionAndScale(values[0]) {
+		return fmt.Errorf("invalid precision and scale %s", values[0])
+	}
+
+	return nil
+}
+
+// validateDecimal checks if the value is a decimal number to compare with the decimal strings.
+func validateDecimal(_ common.FieldType, values []string) error {
+	if !types.IsNumeric(values[0]) {
+		return fmt.Errorf("invalid decimal %s", values[0])
+	}
+
+	return nil
+}
+
+// validateUUIDArray checks if a byte array has the 16 bytes of a binary UUID.
+func validateUUIDArray(fieldType common.FieldType, _ []string) error {
+	if strings.HasSuffix(fieldType.ComposedType, "[N]") && fieldType.Size != "16" {
+		return fmt.Errorf("invalid array size %s, a UUID has 16 bytes", fieldType.Size)
+	}
+
+	return nil
+}
+
+// validateBigNumber checks if the value of a math/big field can be parsed, so the generated code
+// always parses it. The values of the other types are used as is.
+func validateBigNumber(fieldType common.FieldType, values []string) error {
+	if !fieldType.IsBigNumber() {
+		return nil
+	}
+
+	return CheckTargetValue(fieldType, values[0])
+}
+
+// isFiniteNonZero validates if a numeric value is not zero, infinity or NaN.
+func isFiniteNonZero(value string) bool {
+	f, err := strconv.ParseFloat(value, 64)
+
+	return err == nil && f != 0 && types.IsFinite(f)
+}
+
+// CheckTargetValue checks if the value can be compared with a field of the type.
+func CheckTargetValue(fieldType common.FieldType, value string) error {
+	var err error
+
+	switch fieldType.NormalizeBaseType() {
+	case common.IntType:
+		// The value must fit in the field (e.g. a uint8 field can't be compared with -1 or 256).
+		if isUnsigned(fieldType.BaseType) {
+			_, err = strconv.ParseUint(value, 10, bitSize(fieldType.BaseType))
+		} else {
+			_, err = strconv.ParseInt(value, 10, bitSize(fieldType.BaseType))
+		}
+	case common.FloatType:
+		_, err = strconv.ParseFloat(value, bitSize(fieldType.BaseType))
+	case common.BoolType:
+		_, err = strconv.ParseBool(value)
+	case common.BigIntType, common.BigFloatType, common.BigRatType:
+		if !isValidBigNumber(fieldType.NormalizeBaseType(), value) {
+			err = fmt.Errorf("invalid %s value %s", fieldType.BaseType, value)
+		}
+	}
+
+	return err
+}
+
+// isUnsigned checks if the type is an unsigned integer (e.g. uint8).
+func isUnsigned(baseType string) bool {
+	return strings.HasPrefix(baseType, "uint")
+}
+
+// bitSize returns the bit size of a numeric type (e.g. 8 for int8 and uint8).
+// int and uint return 0, the size of the platform.
+func bitSize(baseType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeft(baseType, "uintfloa"))
+
+	return size
+}
+
+// isValidBigNumber checks if the value can be parsed at runtime as the math/big type.
+func isValidBigNumber(bigType common.NormalizedBaseType, value string) bool {
+	var ok bool
+
+	switch bigType {
+	case common.BigIntType:
+		_, ok = types.ParseBigInt(value)
+	case common.BigFloatType:
+		_, ok = types.ParseBigFloat(value)
+	case common.BigRatType:
+		_, ok = types.ParseBigRat(value)
+	}
+
+	return ok
+}
+
+// isValidPrecisionAndScale validates a decimal precision and scale (e.g. 12,2), where the scale can't be
+// greater than the precision.
+func isValidPrecisionAndScale(value string) bool {
+	p, s, found := strings.Cut(value, ",")
+	if !found {
+		return false
+	}
+
+	precision, err := strconv.Atoi(strings.TrimSpace(p))
+	if err != nil {
+		return false
+	}
+
+	scale, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return false
+	}
+
+	return precision > 0 && scale >= 0 && scale <= precision
+}
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"strings"
+	"unicode"
 
 	"github.com/opencodeco/validgen/internal/analyzer/operations"
 	"github.com/opencodeco/validgen/internal/common"
@@ -12,6 +13,13 @@ type Validation struct {
 	Operation      string
 	ExpectedValues common.CountValues
 	Values         []string
+	Groups         []string // groups where the validation is active (empty means always active)
+	// TargetTypes has the types of the fields referenced by conditional operations (e.g. required_if).
+	// It's filled by the analyzer.
+	TargetTypes map[string]common.FieldType
+	// GroupFields has the fields of a field group operation (e.g. exactly_one_of=payment).
+	// It's filled by the analyzer only in the validation of the first field of the group.
+	GroupFields []string
 }
 
 func ParserValidation(fieldValidation string) (*Validation, error) {
@@ -20,26 +28,53 @@ func ParserValidation(fieldValidation string) (*Validation, error) {
 		return nil, err
 	}
 
+	validation, groups, err := parserValidationGroups(validation)
+	if err != nil {
+		return nil, err
+	}
+
 	ops := operations.New()
 
+	if !ops.HasRawValue(validation) && hasMisplacedGroup(values) {
+		return nil, types.NewValidationError("misplaced group in validation %s, groups must follow the operation name (e.g. %s@group=value)", fieldValidation, validation)
+	}
+
 	valuesCount := ops.ArgsCount(validation)
 	if valuesCount == common.UndefinedValue {
 		return nil, types.NewValidationError("unsupported validation %s", validation)
 	}
 
+	var val *Validation
 	switch valuesCount {
 	case common.ZeroValue:
-		return parserZeroValue(validation, valuesCount, values)
+		val, err = parserZeroValue(validation, valuesCount, values)
 	case common.OneValue:
-		return parserOneValue(validation, valuesCount, values)
+		val, err = parserOneValue(validation, valuesCount, values)
 	case common.ManyValues:
-		return parserManyValues(validation, valuesCount, values)
+		val, err = parserManyValues(validation, valuesCount, values)
 	default:
 		return nil, types.NewValidationError("invalid value in validation %s", validation)
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	val.Groups = groups
+
+	return val, nil
 }
 
 func parserValidationString(tag string) (string, string, error) {
+	if validation, values, ok := strings.Cut(tag, "="); ok && operations.New().HasRawValue(operationName(validation)) {
+		// Raw values are used as is (e.g. regex patterns can have '='), without the quotes (and the escapes
+		// of quotes) of values with commas.
+		if len(values) >= 2 && strings.HasPrefix(values, "'") && strings.HasSuffix(values, "'") {
+			values = strings.ReplaceAll(values[1:len(values)-1], `\'`, "'")
+		}
+
+		return strings.TrimSpace(validation), values, nil
+	}
+
 	tokens := removeEmptyValues(strings.Split(tag, "="))
 	if len(tokens) > 2 {
 		return "", "", types.NewValidationError("malformed validation %s", tag)
@@ -54,6 +89,67 @@ func parserValidationString(tag string) (string, string, error) {
 	return validation, values, nil
 }
 
+// parserValidationGroups splits a validation like "required@create@update" into
+// the operation ("required") and its groups ("create" and "update").
+func parserValidationGroups(validation string) (string, []string, error) {
+	operation, groupsTag, hasGroups := strings.Cut(validation, "@")
+	if !hasGroups {
+		return validation, nil, nil
+	}
+
+	groups := strings.Split(groupsTag, "@")
+	for _, group := range groups {
+		if strings.TrimSpace(group) == "" {
+			return "", nil, types.NewValidationError("empty group in validation %s", validation)
+		}
+	}
+
+	return strings.TrimSpace(operation), groups, nil
+}
+
+// hasMisplacedGroup checks if a value is followed by a group: a number followed by '@' (e.g. "3@create"
+// in "min=3@create") or a value ending with '@' and a name (e.g. "abc@create" in "eq=abc@create").
+// Other values can have '@' (e.g. "eq=user@example.com" or "containsany=!@#"), and quoted values are
+// used as is (e.g. "in='admin@localhost'").
+func hasMisplacedGroup(values string) bool {
+	if strings.HasPrefix(values, "'") {
+		return false
+	}
+
+	for _, value := range strings.FieldsFunc(values, func(r rune) bool { return r == ' ' || r == ',' }) {
+		if number, _, ok := strings.Cut(value, "@"); ok && types.IsNumeric(number) {
+			return true
+		}
+
+		if i := strings.LastIndex(value, "@"); i >= 0 && isGroupName(value[i+1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isGroupName checks if a value looks like a group name: a letter or '_' followed by letters,
+// digits or '_' (e.g. "create" or "step_2").
+func isGroupName(value string) bool {
+	for i, r := range value {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return value != ""
+}
+
+// operationName returns the operation of a validation without its groups and values
+// (e.g. "min" in "min@create=5").
+func operationName(validation string) string {
+	validation, _, _ = strings.Cut(validation, "=")
+	validation, _, _ = strings.Cut(validation, "@")
+
+	return strings.TrimSpace(validation)
+}
+
 func parserZeroValue(validation string, valuesCount common.CountValues, targets string) (*Validation, error) {
 	if targets != "" {
 		return nil, types.NewValidationError("expected zero target, but has %s", targets)
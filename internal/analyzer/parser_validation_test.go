@@ -132,6 +132,90 @@ func TestValidParserValidation(t *testing.T) {
 				Values:         []string{"Nested.field123"},
 			},
 		},
+		{
+			name:       "tag without value in a group",
+			validation: "required@create",
+			want: &Validation{
+				Operation:      "required",
+				ExpectedValues: common.ZeroValue,
+				Values:         []string{},
+				Groups:         []string{"create"},
+			},
+		},
+		{
+			name:       "tag with value in many groups",
+			validation: "min@create@update=3",
+			want: &Validation{
+				Operation:      "min",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"3"},
+				Groups:         []string{"create", "update"},
+			},
+		},
+		{
+			name:       "tag with @ in the value",
+			validation: "eq=user@example.com",
+			want: &Validation{
+				Operation:      "eq",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"user@example.com"},
+			},
+		},
+		{
+			name:       "tag with @ between symbols in the value",
+			validation: "containsany=!@#",
+			want: &Validation{
+				Operation:      "containsany",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"!@#"},
+			},
+		},
+		{
+			name:       "tag with @ and a name in a quoted value",
+			validation: "in='admin@localhost' 'root'",
+			want: &Validation{
+				Operation:      "in",
+				ExpectedValues: common.ManyValues,
+				Values:         []string{"admin@localhost", "root"},
+			},
+		},
+		{
+			name:       "tag with raw value",
+			validation: "regex=^[a-z]{2,5}=( x)?$",
+			want: &Validation{
+				Operation:      "regex",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"^[a-z]{2,5}=( x)?$"},
+			},
+		},
+		{
+			name:       "tag with raw value and groups",
+			validation: "regex@create=^a=b$",
+			want: &Validation{
+				Operation:      "regex",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"^a=b$"},
+				Groups:         []string{"create"},
+			},
+		},
+		{
+			name:       "tag with quoted raw value with escaped quotes",
+			validation: `regex='^a\',b$'`,
+			want: &Validation{
+				Operation:      "regex",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"^a',b$"},
+			},
+		},
+		{
+			name:       "tag with quoted raw value",
+			validation: "decimal='12,2'",
+			want: &Validation{
+				Operation:      "decimal",
+				ExpectedValues: common.OneValue,
+				Values:         []string{"12,2"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -185,6 +269,36 @@ func TestParserInvalidValidation(t *testing.T) {
 			validation:  "in='a ' b ' 'c '",
 			expectedErr: types.NewValidationError("invalid quote value in 'a ' b ' 'c '"),
 		},
+		{
+			name:        "empty group",
+			validation:  "required@",
+			expectedErr: types.NewValidationError("empty group in validation required@"),
+		},
+		{
+			name:        "group after the value",
+			validation:  "min=3@create",
+			expectedErr: types.NewValidationError("misplaced group in validation min=3@create, groups must follow the operation name (e.g. min@group=value)"),
+		},
+		{
+			name:        "group after one of the values",
+			validation:  "in=1 2@update 3",
+			expectedErr: types.NewValidationError("misplaced group in validation in=1 2@update 3, groups must follow the operation name (e.g. in@group=value)"),
+		},
+		{
+			name:        "group after a string value",
+			validation:  "eq=abc@create",
+			expectedErr: types.NewValidationError("misplaced group in validation eq=abc@create, groups must follow the operation name (e.g. eq@group=value)"),
+		},
+		{
+			name:        "group after the last string value",
+			validation:  "in=a b@create",
+			expectedErr: types.NewValidationError("misplaced group in validation in=a b@create, groups must follow the operation name (e.g. in@group=value)"),
+		},
+		{
+			name:        "undefined validation in a group",
+			validation:  "xpto@create=a",
+			expectedErr: types.NewValidationError("unsupported validation xpto"),
+		},
 	}
 
 	for _, tt := range tests {
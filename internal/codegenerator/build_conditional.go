@@ -0,0 +1,136 @@
+package codegenerator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/internal/analyzer/operations"
+	"github.com/opencodeco/validgen/internal/common"
+	"github.com/opencodeco/validgen/types"
+)
+
+// buildConditionalCondition builds the condition (true means invalid) and the error message
+// of a conditional operation (e.g. required_if=Country BR or excluded_with=Email).
+func buildConditionalCondition(fieldName string, fieldType common.FieldType, fieldValidation *analyzer.Validation) (string, string, error) {
+	op := fieldValidation.Operation
+	kind, mode, _ := strings.Cut(op, "_")
+
+	// The field condition is true when the field is invalid.
+	fieldCondition, err := conditionalHasValue(fieldName, fieldType)
+	if err != nil {
+		return "", "", err
+	}
+	fieldMessage := fieldName + " must be empty"
+	if kind == "required" {
+		fieldCondition = fmt.Sprintf("!(%s)", fieldCondition)
+		fieldMessage = fieldName + " is required"
+	}
+
+	fieldNames := conditionalFieldNames(fieldValidation)
+	conditions := []string{}
+	for i, name := range fieldNames {
+		targetType, ok := fieldValidation.TargetTypes[name]
+		if !ok {
+			return "", "", types.NewValidationError("INTERNAL ERROR: undefined type of field %s", name)
+		}
+
+		condition := ""
+		switch mode {
+		case "if", "unless":
+			condition, err = conditionalIsEqual(name, targetType, fieldValidation.Values[2*i+1])
+		case "with", "with_all":
+			condition, err = conditionalHasValue(name, targetType)
+		case "without", "without_all":
+			condition, err = conditionalHasValue(name, targetType)
+			condition = fmt.Sprintf("!(%s)", condition)
+		default:
+			err = types.NewValidationError("INTERNAL ERROR: unsupported operation %s", op)
+		}
+		if err != nil {
+			return "", "", err
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	concatOperator := " && "
+	if mode == "with" || mode == "without" {
+		concatOperator = " || "
+	}
+	condition := strings.Join(conditions, concatOperator)
+	if mode == "unless" {
+		condition = fmt.Sprintf("!(%s)", condition)
+	} else if len(conditions) > 1 {
+		condition = fmt.Sprintf("(%s)", condition)
+	}
+
+	errorMessage := ""
+	switch mode {
+	case "if", "unless":
+		pairs := []string{}
+		for i, name := range fieldNames {
+			pairs = append(pairs, fmt.Sprintf("%s is '%s'", name, fieldValidation.Values[2*i+1]))
+		}
+		when := "when"
+		if mode == "unless" {
+			when = "unless"
+		}
+		errorMessage = fmt.Sprintf("%s %s %s", fieldMessage, when, strings.Join(pairs, " and "))
+	case "with":
+		errorMessage = fmt.Sprintf("%s when any of %s is present", fieldMessage, quoteTargets(fieldNames))
+	case "with_all":
+		errorMessage = fmt.Sprintf("%s when all of %s are present", fieldMessage, quoteTargets(fieldNames))
+	case "without":
+		errorMessage = fmt.Sprintf("%s when any of %s is missing", fieldMessage, quoteTargets(fieldNames))
+	case "without_all":
+		errorMessage = fmt.Sprintf("%s when all of %s are missing", fieldMessage, quoteTargets(fieldNames))
+	}
+
+	return fmt.Sprintf("%s && %s", condition, fieldCondition), errorMessage, nil
+}
+
+// conditionalFieldNames returns the fields referenced by a conditional operation.
+func conditionalFieldNames(fieldValidation *analyzer.Validation) []string {
+	if !operations.New().HasFieldValuePairs(fieldValidation.Operation) {
+		return fieldValidation.Values
+	}
+
+	fieldNames := []string{}
+	for i := 0; i < len(fieldValidation.Values); i += 2 {
+		fieldNames = append(fieldNames, fieldValidation.Values[i])
+	}
+
+	return fieldNames
+}
+
+// conditionalHasValue returns the condition to check if the field is not empty (the same used by required).
+func conditionalHasValue(fieldName string, fieldType common.FieldType) (string, error) {
+	// Pointers to nested structs have a value if they aren't nil.
+	if !fieldType.IsGoType() {
+		return fmt.Sprintf("obj.%s != nil", fieldName), nil
+	}
+
+	condition, err := GetConditionTable("required", fieldType)
+	if err != nil {
+		return "", err
+	}
+
+	return replaceNameAndTarget(condition.operation, fieldName, ""), nil
+}
+
+// conditionalIsEqual returns the condition to check if the field is equal to the value (the same used by eq).
+func conditionalIsEqual(fieldName string, fieldType common.FieldType, value string) (string, error) {
+	condition, err := GetConditionTable("eq", fieldType)
+	if err != nil {
+		return "", err
+	}
+
+	operation := replaceNameAndTarget(condition.operation, fieldName, value)
+
+	return strings.ReplaceAll(operation, "{{.BigVar}}", bigNumberVarName(fieldType, value)), nil
+}
+
+func quoteTargets(values []string) string {
+	return "'" + strings.Join(values, "' '") + "'"
+}
@@ -0,0 +1,50 @@
+package codegenerator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/types"
+)
+
+// buildFieldGroupCondition builds the condition (true means invalid) and the error message
+// of a field group operation (e.g. exactly_one_of=payment).
+func buildFieldGroupCondition(fieldValidation *analyzer.Validation) (string, string, error) {
+	presentConditions := []string{}
+	for _, name := range fieldValidation.GroupFields {
+		fieldType, ok := fieldValidation.TargetTypes[name]
+		if !ok {
+			return "", "", types.NewValidationError("INTERNAL ERROR: undefined type of field %s", name)
+		}
+
+		// Pointers are present if not nil and other types if not empty.
+		presentCondition := fmt.Sprintf("obj.%s != nil", name)
+		if fieldType.IsGoType() {
+			condition, err := GetConditionTable("omitempty", fieldType)
+			if err != nil {
+				return "", "", err
+			}
+			presentCondition = replaceNameAndTarget(condition.operation, name, "")
+		}
+
+		presentConditions = append(presentConditions, presentCondition)
+	}
+
+	count := fmt.Sprintf("types.CountTrue(%s)", strings.Join(presentConditions, ", "))
+	group := fieldValidation.Values[0]
+	fields := quoteTargets(fieldValidation.GroupFields)
+
+	switch fieldValidation.Operation {
+	case "exactly_one_of":
+		return count + " != 1", fmt.Sprintf("%s must have exactly one of %s", group, fields), nil
+	case "at_most_one_of":
+		return count + " > 1", fmt.Sprintf("%s must have at most one of %s", group, fields), nil
+	case "at_least_one_of":
+		return count + " == 0", fmt.Sprintf("%s must have at least one of %s", group, fields), nil
+	case "all_or_none_of":
+		return fmt.Sprintf("%s %% %d != 0", count, len(presentConditions)), fmt.Sprintf("%s must have all or none of %s", group, fields), nil
+	}
+
+	return "", "", types.NewValidationError("INTERNAL ERROR: unsupported operation %s", fieldValidation.Operation)
+}
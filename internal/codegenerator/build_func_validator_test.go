@@ -119,3 +119,323 @@ return errs
 		})
 	}
 }
+
+func TestBuildFuncValidatorCodeWithContext(t *testing.T) {
+	tests := []struct {
+		name string
+		st   *analyzer.Struct
+		want string
+	}{
+		{
+			name: "Field op",
+			st: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "TestStruct",
+					Fields: []parser.Field{
+						{
+							FieldName: "Field1",
+							Type:      common.FieldType{BaseType: "string"},
+							Tag:       `validate:"required"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, "required")},
+					},
+				},
+			},
+			want: `func TestStructValidate(obj *TestStruct) []error {
+return TestStructValidateContext(context.Background(), obj)
+}
+
+func TestStructValidateContext(ctx context.Context, obj *TestStruct) []error {
+if err := ctx.Err(); err != nil {
+return []error{err}
+}
+var errs []error
+if !(obj.Field1 != "") {
+errs = append(errs, types.NewValidationError("Field1 is required"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "Nested struct",
+			st: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "TestStruct",
+					Fields: []parser.Field{
+						{
+							FieldName: "Nested",
+							Type:      common.FieldType{BaseType: "main.NestedStruct"},
+							Tag:       `validate:"required"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, "required")},
+					},
+				},
+			},
+			want: `func TestStructValidate(obj *TestStruct) []error {
+return TestStructValidateContext(context.Background(), obj)
+}
+
+func TestStructValidateContext(ctx context.Context, obj *TestStruct) []error {
+if err := ctx.Err(); err != nil {
+return []error{err}
+}
+var errs []error
+errs = append(errs, NestedStructValidateContext(ctx, &obj.Nested)...)
+return errs
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{
+				Options: Options{WithContext: true},
+				Struct:  tt.st,
+				StructsWithValidation: map[string]struct{}{
+					"main.NestedStruct": {},
+				},
+			}
+			got, err := gv.BuildFuncValidatorCode()
+			if err != nil {
+				t.Errorf("FileValidator.GenerateValidator() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FileValidator.GenerateValidator() = %v, want %v", got, tt.want)
+				dmp := diffmatchpatch.New()
+				diffs := dmp.DiffMain(tt.want, got, false)
+				if len(diffs) > 1 {
+					t.Errorf("FileValidator.GenerateValidator() diff = \n%v", dmp.DiffPrettyText(diffs))
+				}
+			}
+		})
+	}
+}
+
+func TestBuildFuncValidatorCodeWithGroups(t *testing.T) {
+	st := &analyzer.Struct{
+		Struct: parser.Struct{
+			PackageName: "main",
+			StructName:  "TestStruct",
+			Fields: []parser.Field{
+				{
+					FieldName: "Field1",
+					Type:      common.FieldType{BaseType: "string"},
+					Tag:       `validate:"required@create,min=3"`,
+				},
+				{
+					FieldName: "Nested",
+					Type:      common.FieldType{BaseType: "main.NestedStruct"},
+					Tag:       `validate:"required"`,
+				},
+			},
+		},
+		FieldsValidations: []analyzer.FieldValidations{
+			{
+				Validations: []*analyzer.Validation{
+					AssertParserValidation(t, "required@create"),
+					AssertParserValidation(t, "min=3"),
+				},
+			},
+			{
+				Validations: []*analyzer.Validation{AssertParserValidation(t, "required")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		withContext bool
+		want        string
+	}{
+		{
+			name:        "without context",
+			withContext: false,
+			want: `func TestStructValidate(obj *TestStruct) []error {
+return TestStructValidateGroups(obj)
+}
+
+func TestStructValidateGroups(obj *TestStruct, groups ...string) []error {
+var errs []error
+if types.InGroups(groups, "create") && !(obj.Field1 != "") {
+errs = append(errs, types.NewValidationError("Field1 is required"))
+}
+if !(len(obj.Field1) >= 3) {
+errs = append(errs, types.NewValidationError("Field1 length must be >= 3"))
+}
+errs = append(errs, NestedStructValidateGroups(&obj.Nested, groups...)...)
+return errs
+}
+`,
+		},
+		{
+			name:        "with context",
+			withContext: true,
+			want: `func TestStructValidate(obj *TestStruct) []error {
+return TestStructValidateGroupsContext(context.Background(), obj)
+}
+
+func TestStructValidateContext(ctx context.Context, obj *TestStruct) []error {
+return TestStructValidateGroupsContext(ctx, obj)
+}
+
+func TestStructValidateGroups(obj *TestStruct, groups ...string) []error {
+return TestStructValidateGroupsContext(context.Background(), obj, groups...)
+}
+
+func TestStructValidateGroupsContext(ctx context.Context, obj *TestStruct, groups ...string) []error {
+if err := ctx.Err(); err != nil {
+return []error{err}
+}
+var errs []error
+if types.InGroups(groups, "create") && !(obj.Field1 != "") {
+errs = append(errs, types.NewValidationError("Field1 is required"))
+}
+if !(len(obj.Field1) >= 3) {
+errs = append(errs, types.NewValidationError("Field1 length must be >= 3"))
+}
+errs = append(errs, NestedStructValidateGroupsContext(ctx, &obj.Nested, groups...)...)
+return errs
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{
+				Options: Options{WithContext: tt.withContext},
+				Struct:  st,
+				StructsWithValidation: map[string]struct{}{
+					"main.NestedStruct": {},
+				},
+				StructsWithGroups: map[string]struct{}{
+					"main.TestStruct":   {},
+					"main.NestedStruct": {},
+				},
+			}
+			got, err := gv.BuildFuncValidatorCode()
+			if err != nil {
+				t.Errorf("FileValidator.GenerateValidator() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FileValidator.GenerateValidator() = %v, want %v", got, tt.want)
+				dmp := diffmatchpatch.New()
+				diffs := dmp.DiffMain(tt.want, got, false)
+				if len(diffs) > 1 {
+					t.Errorf("FileValidator.GenerateValidator() diff = \n%v", dmp.DiffPrettyText(diffs))
+				}
+			}
+		})
+	}
+}
+
+func TestBuildFuncValidatorCodeWithPartial(t *testing.T) {
+	st := &analyzer.Struct{
+		Struct: parser.Struct{
+			PackageName: "main",
+			StructName:  "TestStruct",
+			Fields: []parser.Field{
+				{
+					FieldName: "Field1",
+					Type:      common.FieldType{BaseType: "string"},
+					Tag:       `validate:"required"`,
+				},
+				{
+					FieldName: "Field2",
+					Type:      common.FieldType{BaseType: "string"},
+					Tag:       `validate:"eqfield=Field1"`,
+				},
+				{
+					FieldName: "Nested",
+					Type:      common.FieldType{BaseType: "main.NestedStruct"},
+					Tag:       `validate:"required"`,
+				},
+			},
+		},
+		FieldsValidations: []analyzer.FieldValidations{
+			{
+				Validations: []*analyzer.Validation{AssertParserValidation(t, "required")},
+			},
+			{
+				Validations: []*analyzer.Validation{AssertParserValidation(t, "eqfield=Field1")},
+			},
+			{
+				Validations: []*analyzer.Validation{AssertParserValidation(t, "required")},
+			},
+		},
+	}
+
+	want := `func TestStructValidate(obj *TestStruct) []error {
+var errs []error
+if !(obj.Field1 != "") {
+errs = append(errs, types.NewValidationError("Field1 is required"))
+}
+if !(obj.Field2 == obj.Field1) {
+errs = append(errs, types.NewValidationError("Field2 must be equal to Field1"))
+}
+errs = append(errs, NestedStructValidate(&obj.Nested)...)
+return errs
+}
+
+func TestStructValidateFields(obj *TestStruct, fields ...string) []error {
+return TestStructValidatePartial(obj, types.SelectFields(fields...))
+}
+
+func TestStructValidateExcept(obj *TestStruct, fields ...string) []error {
+return TestStructValidatePartial(obj, types.ExceptFields(fields...))
+}
+
+func TestStructValidatePartial(obj *TestStruct, selection types.FieldSelection) []error {
+var errs []error
+if selection.Has("Field1") {
+if !(obj.Field1 != "") {
+errs = append(errs, types.NewValidationError("Field1 is required"))
+}
+}
+if selection.Has("Field2") {
+if selection.Has("Field1") && !(obj.Field2 == obj.Field1) {
+errs = append(errs, types.NewValidationError("Field2 must be equal to Field1"))
+}
+}
+if selection.Has("Nested") {
+errs = append(errs, NestedStructValidatePartial(&obj.Nested, selection.Nested("Nested"))...)
+}
+return errs
+}
+`
+
+	gv := GenValidations{
+		Options: Options{Partial: true},
+		Struct:  st,
+		StructsWithValidation: map[string]struct{}{
+			"main.NestedStruct": {},
+		},
+	}
+	got, err := gv.BuildFuncValidatorCode()
+	if err != nil {
+		t.Errorf("FileValidator.GenerateValidator() error = %v, wantErr %v", err, nil)
+		return
+	}
+	if got != want {
+		t.Errorf("FileValidator.GenerateValidator() = %v, want %v", got, want)
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(want, got, false)
+		if len(diffs) > 1 {
+			t.Errorf("FileValidator.GenerateValidator() diff = \n%v", dmp.DiffPrettyText(diffs))
+		}
+	}
+}
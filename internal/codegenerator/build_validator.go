@@ -3,22 +3,34 @@ package codegenerator
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"strings"
 	"text/template"
 
 	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/internal/analyzer/operations"
 	"github.com/opencodeco/validgen/internal/common"
 )
 
-var funcValidatorTpl = `func {{.StructName}}Validate(obj *{{.StructName}}) []error {
-var errs []error
-{{range .Fields}}{{buildValidationCode .FieldName .Type .Validations}}{{end}}return errs
+var funcValidatorTpl = `{{range $i, $fn := .Funcs}}{{if $i}}
+{{end}}{{range .Wrappers}}func {{$.StructName}}{{.FuncName}}({{.Params}}) []error {
+return {{$.StructName}}{{$fn.FuncName}}({{.Args}})
 }
-`
+
+{{end}}func {{$.StructName}}{{.FuncName}}({{.Params}}) []error {
+{{if $.WithContext}}if err := ctx.Err(); err != nil {
+return []error{err}
+}
+{{end}}var errs []error
+{{range $.Fields}}{{buildValidationCode .FieldName .Type .Validations $fn.Partial}}{{end}}return errs
+}
+{{end}}`
 
 type structTpl struct {
-	StructName string
-	Fields     []fieldTpl
+	StructName  string
+	WithContext bool
+	Funcs       []funcTpl
+	Fields      []fieldTpl
 }
 
 type fieldTpl struct {
@@ -27,12 +39,48 @@ type fieldTpl struct {
 	Validations []*analyzer.Validation
 }
 
+// funcTpl is a validator function with the validation code and its wrappers.
+type funcTpl struct {
+	FuncName string
+	Params   string
+	Partial  bool
+	Wrappers []wrapperTpl
+}
+
+// wrapperTpl is a public validator function that just calls the main validator function
+// with default values for the features it doesn't expose (e.g. context or groups).
+type wrapperTpl struct {
+	FuncName string
+	Params   string
+	Args     string
+}
+
 func (gv *GenValidations) BuildFuncValidatorCode() (string, error) {
 
 	stTpl := StructToTpl(gv.Struct)
+	stTpl.WithContext = gv.WithContext
+
+	_, withGroups := gv.StructsWithGroups[common.KeyPath(gv.Struct.PackageName, gv.Struct.StructName)]
+	stTpl.Funcs = []funcTpl{
+		{
+			FuncName: validatorFuncName(false, withGroups, gv.WithContext),
+			Params:   validatorFuncParams(stTpl.StructName, false, withGroups, gv.WithContext),
+			Partial:  false,
+			Wrappers: validatorWrappers(stTpl.StructName, withGroups, gv.WithContext),
+		},
+	}
+
+	if gv.Partial {
+		stTpl.Funcs = append(stTpl.Funcs, funcTpl{
+			FuncName: validatorFuncName(true, withGroups, gv.WithContext),
+			Params:   validatorFuncParams(stTpl.StructName, true, withGroups, gv.WithContext),
+			Partial:  true,
+			Wrappers: partialValidatorWrappers(stTpl.StructName, gv.WithContext),
+		})
+	}
 
 	funcMap := template.FuncMap{
-		"buildValidationCode": gv.BuildValidationCode,
+		"buildValidationCode": gv.buildValidationCode,
 	}
 
 	tmpl, err := template.New("FuncValidator").Funcs(funcMap).Parse(funcValidatorTpl)
@@ -48,66 +96,417 @@ func (gv *GenValidations) BuildFuncValidatorCode() (string, error) {
 	return code.String(), nil
 }
 
+func validatorFuncName(partial, withGroups, withContext bool) string {
+	funcName := "Validate"
+	if partial {
+		funcName += "Partial"
+	}
+	if withGroups {
+		funcName += "Groups"
+	}
+	if withContext {
+		funcName += "Context"
+	}
+
+	return funcName
+}
+
+func validatorFuncParams(structName string, partial, withGroups, withContext bool) string {
+	params := "obj *" + structName
+	if withContext {
+		params = "ctx context.Context, " + params
+	}
+	if partial {
+		params += ", selection types.FieldSelection"
+	}
+	if withGroups {
+		params += ", groups ...string"
+	}
+
+	return params
+}
+
+func validatorWrappers(structName string, withGroups, withContext bool) []wrapperTpl {
+	wrappers := []wrapperTpl{}
+
+	for _, groups := range []bool{false, true} {
+		for _, ctx := range []bool{false, true} {
+			if (groups && !withGroups) || (ctx && !withContext) || (groups == withGroups && ctx == withContext) {
+				continue
+			}
+
+			args := "obj"
+			if withContext {
+				if ctx {
+					args = "ctx, " + args
+				} else {
+					args = "context.Background(), " + args
+				}
+			}
+			if groups {
+				args += ", groups..."
+			}
+
+			wrappers = append(wrappers, wrapperTpl{
+				FuncName: validatorFuncName(false, groups, ctx),
+				Params:   validatorFuncParams(structName, false, groups, ctx),
+				Args:     args,
+			})
+		}
+	}
+
+	return wrappers
+}
+
+func partialValidatorWrappers(structName string, withContext bool) []wrapperTpl {
+	args := "obj"
+	if withContext {
+		args = "context.Background(), " + args
+	}
+
+	return []wrapperTpl{
+		{
+			FuncName: "ValidateFields",
+			Params:   "obj *" + structName + ", fields ...string",
+			Args:     args + ", types.SelectFields(fields...)",
+		},
+		{
+			FuncName: "ValidateExcept",
+			Params:   "obj *" + structName + ", fields ...string",
+			Args:     args + ", types.ExceptFields(fields...)",
+		},
+	}
+}
+
 func (gv *GenValidations) BuildValidationCode(fieldName string, fieldType common.FieldType, fieldValidations []*analyzer.Validation) (string, error) {
+	return gv.buildValidationCode(fieldName, fieldType, fieldValidations, false)
+}
+
+// buildValidationCode builds the validation code of a field.
+// In partial validators, the code runs only if the field is in the selection.
+func (gv *GenValidations) buildValidationCode(fieldName string, fieldType common.FieldType, fieldValidations []*analyzer.Validation, partial bool) (string, error) {
 
+	ops := operations.New()
 	tests := ""
-	for _, fieldValidation := range fieldValidations {
+	omitCondition := ""
+	omittedTests := ""
+	nested := false
+	modifier := false
+	if fieldType.IsOptional() {
+		// The operations of optional fields (e.g. sql.NullString) check the value only if it is valid.
+		omitCondition = optionalValidCondition(fieldName, fieldType)
+	}
+
+	for i, fieldValidation := range fieldValidations {
 		var testCode = ""
 		var err error
 
-		if fieldType.IsGoType() {
-			testCode, err = gv.buildIfCode(fieldName, fieldType, fieldValidation)
+		op := fieldValidation.Operation
+		if op == "dive" && fieldType.IsGoType() {
+			// The following operations check each element.
+			testCode, err = gv.buildDiveCode(fieldName, fieldType, fieldValidations[i+1:])
 			if err != nil {
 				return "", err
 			}
-		} else {
-			testCode, err = gv.buildIfNestedCode(fieldName, fieldType)
+
+			if omitCondition != "" {
+				omittedTests += testCode
+			} else {
+				tests += testCode
+			}
+			break
+		}
+
+		switch {
+		case ops.IsFieldGroup(op):
+			// The group is checked only once (in the first field of the group).
+			if len(fieldValidation.GroupFields) == 0 {
+				continue
+			}
+
+			testCode, err = gv.buildIfCode(fieldName, fieldType, fieldValidation, partial)
 			if err != nil {
 				return "", err
 			}
+
+			// Field groups don't depend on the value of this field only, so they are never omitted.
+			tests += testCode
+			continue
+		case !fieldType.IsGoType():
+			// Pointers to nested structs can be required (or conditionally required).
+			// The other operations only validate the nested struct (after the loop).
+			if fieldType.ComposedType != "*" || (op != "required" && !ops.IsConditional(op)) {
+				nested = true
+				continue
+			}
+
+			testCode, err = gv.buildIfCode(fieldName, fieldType, fieldValidation, partial)
+			if err != nil {
+				return "", err
+			}
+		case ops.IsModifier(op):
+			// Only the first modifier is used.
+			if modifier {
+				continue
+			}
+
+			modifier = true
+			omitCondition, err = gv.buildOmitCondition(fieldName, fieldType, fieldValidation)
+			if err != nil {
+				return "", err
+			}
+
+			continue
+		default:
+			testCode, err = gv.buildIfCode(fieldName, fieldType, fieldValidation, partial)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		// Required checks optional fields even if the value isn't valid.
+		if omitCondition != "" && (op != "required" || !fieldType.IsOptional()) {
+			omittedTests += testCode
+		} else {
+			tests += testCode
 		}
+	}
 
+	// Nested structs are validated after the checks of the field (e.g. required), even if the field has only
+	// field groups or conditional operations.
+	_, hasValidator := gv.StructsWithValidation[fieldType.BaseType]
+	if !fieldType.IsGoType() && (nested || hasValidator && len(fieldValidations) > 0) {
+		testCode, err := gv.buildIfNestedCode(fieldName, fieldType, partial)
+		if err != nil {
+			return "", err
+		}
 		tests += testCode
 	}
 
+	if omittedTests != "" {
+		// Operations after omitempty/omitnil run only if the field has a value.
+		tests += fmt.Sprintf("if %s {\n%s}\n", omitCondition, omittedTests)
+	}
+
+	if partial && tests != "" {
+		tests = fmt.Sprintf("if selection.Has(%q) {\n%s}\n", fieldName, tests)
+	}
+
 	return tests, nil
 }
 
-func (gv *GenValidations) buildIfCode(fieldName string, fieldType common.FieldType, fieldValidation *analyzer.Validation) (string, error) {
-	testElements, err := DefineTestElements(fieldName, fieldType, fieldValidation)
+// buildDiveCode builds the code to check each element of a slice or array with the operations after dive.
+func (gv *GenValidations) buildDiveCode(fieldName string, fieldType common.FieldType, fieldValidations []*analyzer.Validation) (string, error) {
+	elemType := common.FieldType{BaseType: fieldType.BaseType}
+
+	tests := ""
+	for _, fieldValidation := range fieldValidations {
+		ifCondition, _, err := gv.buildIfCondition(fieldName+"[i]", elemType, fieldValidation, false)
+		if err != nil {
+			return "", err
+		}
+
+		// The error message has the index of the element (e.g. Tags[%d]), so only the rest of the message is escaped.
+		indexName := fieldName + "[%d]"
+		_, errorMessage, err := gv.buildIfCondition(indexName, elemType, fieldValidation, false)
+		if err != nil {
+			return "", err
+		}
+		errorMessage = indexMessageCode(errorMessage, indexName)
+
+		tests += fmt.Sprintf(
+			`if %s {
+errs = append(errs, types.NewValidationError(%s, i))
+}
+`, ifCondition, errorMessage)
+	}
+
+	if tests == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("for i := range obj.%s {\n%s}\n", fieldName, tests), nil
+}
+
+func (gv *GenValidations) buildOmitCondition(fieldName string, fieldType common.FieldType, fieldValidation *analyzer.Validation) (string, error) {
+	validCondition := ""
+	valueName, valueType := fieldName, fieldType
+	if fieldType.IsOptional() {
+		// Optional fields are omitted if the value isn't valid (omitnil) or is empty too (omitempty).
+		validCondition = optionalValidCondition(fieldName, fieldType)
+		if fieldValidation.Operation == "omitnil" {
+			return validCondition, nil
+		}
+
+		valueName, valueType = optionalValue(fieldName, fieldType)
+	}
+
+	testElements, err := DefineTestElements(valueName, valueType, fieldValidation)
 	if err != nil {
 		return "", fmt.Errorf("field %s: %w", fieldName, err)
 	}
 
-	booleanCondition := ""
-	for _, condition := range testElements.conditions {
-		if booleanCondition != "" {
-			booleanCondition += " " + testElements.concatOperator + " "
+	omitCondition := testElements.conditions[0]
+	if len(fieldValidation.Groups) > 0 {
+		omitCondition = fmt.Sprintf("!types.InGroups(groups, %s) || %s", quoteValues(fieldValidation.Groups), omitCondition)
+		if validCondition != "" {
+			omitCondition = "(" + omitCondition + ")"
 		}
+	}
+
+	if validCondition != "" {
+		omitCondition = validCondition + " && " + omitCondition
+	}
+
+	return omitCondition, nil
+}
 
-		booleanCondition += condition
+func (gv *GenValidations) buildIfCode(fieldName string, fieldType common.FieldType, fieldValidation *analyzer.Validation, partial bool) (string, error) {
+	ifCondition, errorMessage, err := gv.buildIfCondition(fieldName, fieldType, fieldValidation, partial)
+	if err != nil {
+		return "", err
 	}
 
 	return fmt.Sprintf(
-		`if !(%s) {
-errs = append(errs, types.NewValidationError("%s"))
+		`if %s {
+errs = append(errs, types.NewValidationError(%s))
 }
-`, booleanCondition, testElements.errorMessage), nil
+`, ifCondition, errorMessageCode(errorMessage)), nil
 }
 
-func (gv *GenValidations) buildIfNestedCode(fieldName string, fieldType common.FieldType) (string, error) {
+// buildIfCondition returns the condition (true means invalid) and the error message of a validation.
+func (gv *GenValidations) buildIfCondition(fieldName string, fieldType common.FieldType, fieldValidation *analyzer.Validation, partial bool) (string, string, error) {
+	ops := operations.New()
+	ifCondition, errorMessage := "", ""
+	if ops.IsConditional(fieldValidation.Operation) || ops.IsFieldGroup(fieldValidation.Operation) {
+		var err error
+		var fieldNames []string
+		if ops.IsConditional(fieldValidation.Operation) {
+			ifCondition, errorMessage, err = buildConditionalCondition(fieldName, fieldType, fieldValidation)
+			fieldNames = conditionalFieldNames(fieldValidation)
+		} else {
+			ifCondition, errorMessage, err = buildFieldGroupCondition(fieldValidation)
+			fieldNames = slices.DeleteFunc(slices.Clone(fieldValidation.GroupFields), func(name string) bool { return name == fieldName })
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("field %s: %w", fieldName, err)
+		}
+
+		if partial {
+			// These operations run only if the referenced fields are selected too.
+			for i := len(fieldNames) - 1; i >= 0; i-- {
+				ifCondition = fmt.Sprintf("selection.Has(%q) && %s", fieldNames[i], ifCondition)
+			}
+		}
+	} else if !fieldType.IsGoType() {
+		// A pointer to a nested struct is required if it isn't nil.
+		ifCondition = fmt.Sprintf("!(obj.%s != nil)", fieldName)
+		errorMessage = fieldName + " is required"
+	} else {
+		valueName, valueType := fieldName, fieldType
+		if fieldType.IsOptional() {
+			valueName, valueType = optionalValue(fieldName, fieldType)
+		}
+
+		testElements, err := DefineTestElements(valueName, valueType, fieldValidation)
+		if err != nil {
+			return "", "", fmt.Errorf("field %s: %w", fieldName, err)
+		}
+
+		booleanCondition := ""
+		for _, condition := range testElements.conditions {
+			if booleanCondition != "" {
+				booleanCondition += " " + testElements.concatOperator + " "
+			}
+
+			booleanCondition += condition
+		}
+
+		errorMessage = testElements.errorMessage
+		if fieldType.IsOptional() {
+			// Required means a valid and not empty value.
+			if fieldValidation.Operation == "required" {
+				booleanCondition = optionalValidCondition(fieldName, fieldType) + " && " + booleanCondition
+			}
+
+			// The error message has the name of the field (e.g. Name instead of Name.String).
+			msgElements, err := DefineTestElements(fieldName, valueType, fieldValidation)
+			if err != nil {
+				return "", "", fmt.Errorf("field %s: %w", fieldName, err)
+			}
+			errorMessage = msgElements.errorMessage
+		}
+
+		ifCondition = fmt.Sprintf("!(%s)", booleanCondition)
+		if partial && ops.IsFieldOperation(fieldValidation.Operation) {
+			// Operations between fields run only if the other field is selected too.
+			ifCondition = fmt.Sprintf("selection.Has(%q) && %s", fieldValidation.Values[0], ifCondition)
+		}
+	}
+
+	if len(fieldValidation.Groups) > 0 {
+		ifCondition = fmt.Sprintf("types.InGroups(groups, %s) && %s", quoteValues(fieldValidation.Groups), ifCondition)
+	}
+
+	return ifCondition, errorMessage, nil
+}
+
+func (gv *GenValidations) buildIfNestedCode(fieldName string, fieldType common.FieldType, partial bool) (string, error) {
 	_, ok := gv.StructsWithValidation[fieldType.BaseType]
 	if !ok {
 		return "", fmt.Errorf("no validator found for struct type %s", fieldType)
 	}
 
+	_, withGroups := gv.StructsWithGroups[fieldType.BaseType]
+
 	pkg := common.ExtractPackage(fieldType.BaseType)
 	if pkg == gv.Struct.PackageName {
 		fieldType.BaseType = strings.TrimPrefix(fieldType.BaseType, pkg+".")
 	}
 
-	funcName := fieldType.BaseType + "Validate"
-	fieldParam := "&obj." + fieldName
+	// The elements of slices and arrays (with dive) are validated one by one.
+	elements := fieldType.ComposedType == "[]" || fieldType.ComposedType == "[N]"
+
+	funcName := fieldType.BaseType + validatorFuncName(partial, withGroups, gv.WithContext)
+	funcParams := "&obj." + fieldName
+	switch {
+	case fieldType.ComposedType == "*":
+		funcParams = "obj." + fieldName
+	case elements:
+		funcParams = "&obj." + fieldName + "[i]"
+	}
+	if gv.WithContext {
+		funcParams = "ctx, " + funcParams
+	}
+	if partial {
+		funcParams += fmt.Sprintf(", selection.Nested(%q)", fieldName)
+	}
+	if withGroups {
+		funcParams += ", groups..."
+	}
+
+	code := fmt.Sprintf("errs = append(errs, %s(%s)...)\n", funcName, funcParams)
+	switch {
+	case fieldType.ComposedType == "*":
+		code = fmt.Sprintf("if obj.%s != nil {\n%s}\n", fieldName, code)
+	case elements:
+		code = fmt.Sprintf("for i := range obj.%s {\n%s}\n", fieldName, code)
+	}
+
+	return code, nil
+}
+
+// optionalValue returns the name and the type of the value of an optional field (e.g. Name.String and string
+// for a sql.NullString field).
+func optionalValue(fieldName string, fieldType common.FieldType) (string, common.FieldType) {
+	return fieldName + "." + fieldType.Optional.ValueField, fieldType.ValueType()
+}
+
+// optionalValidCondition returns the condition of an optional field with a valid value (e.g. obj.Name.Valid).
+func optionalValidCondition(fieldName string, fieldType common.FieldType) string {
+	if fieldType.ComposedType == "*" {
+		return fmt.Sprintf("obj.%s != nil && obj.%s.Valid", fieldName, fieldName)
+	}
 
-	return fmt.Sprintf("errs = append(errs, %s(%s)...)\n", funcName, fieldParam), nil
+	return fmt.Sprintf("obj.%s.Valid", fieldName)
 }
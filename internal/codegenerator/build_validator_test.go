@@ -66,6 +66,7 @@ func TestBuildValidationCodeWithNestedStructs(t *testing.T) {
 		fieldName       string
 		fieldType       common.FieldType
 		fieldValidation string
+		withContext     bool
 	}
 	tests := []struct {
 		name string
@@ -90,6 +91,44 @@ func TestBuildValidationCodeWithNestedStructs(t *testing.T) {
 			},
 			want: "errs = append(errs, mypkg.InnerStructTypeValidate(&obj.Field)...)\n",
 		},
+		{
+			name: "test code with pointer to inner struct",
+			args: args{
+				fieldName:       "Field",
+				fieldType:       common.FieldType{BaseType: "main.InnerStructType", ComposedType: "*"},
+				fieldValidation: "required",
+			},
+			want: "if !(obj.Field != nil) {\nerrs = append(errs, types.NewValidationError(\"Field is required\"))\n}\nif obj.Field != nil {\nerrs = append(errs, InnerStructTypeValidate(obj.Field)...)\n}\n",
+		},
+		{
+			name: "test code with inner struct and context",
+			args: args{
+				fieldName:       "Field",
+				fieldType:       common.FieldType{BaseType: "main.InnerStructType"},
+				fieldValidation: "required",
+				withContext:     true,
+			},
+			want: "errs = append(errs, InnerStructTypeValidateContext(ctx, &obj.Field)...)\n",
+		},
+		{
+			name: "test code with dive on slice of inner structs",
+			args: args{
+				fieldName:       "Field",
+				fieldType:       common.FieldType{BaseType: "main.InnerStructType", ComposedType: "[]"},
+				fieldValidation: "dive",
+			},
+			want: "for i := range obj.Field {\nerrs = append(errs, InnerStructTypeValidate(&obj.Field[i])...)\n}\n",
+		},
+		{
+			name: "test code with dive on array of inner structs and context",
+			args: args{
+				fieldName:       "Field",
+				fieldType:       common.FieldType{BaseType: "main.InnerStructType", ComposedType: "[N]", Size: "2"},
+				fieldValidation: "dive",
+				withContext:     true,
+			},
+			want: "for i := range obj.Field {\nerrs = append(errs, InnerStructTypeValidateContext(ctx, &obj.Field[i])...)\n}\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,6 +140,7 @@ func TestBuildValidationCodeWithNestedStructs(t *testing.T) {
 					},
 				},
 				StructsWithValidation: map[string]struct{}{},
+				Options:               Options{WithContext: tt.args.withContext},
 			}
 			gv.StructsWithValidation[tt.args.fieldType.BaseType] = struct{}{}
 			validation := AssertParserValidation(t, tt.args.fieldValidation)
@@ -115,3 +155,846 @@ func TestBuildValidationCodeWithNestedStructs(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildValidationCodeWithOmitModifiers(t *testing.T) {
+	type args struct {
+		fieldName        string
+		fieldType        common.FieldType
+		fieldValidations []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "omitempty with string",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string"},
+				fieldValidations: []string{"omitempty", "min=3"},
+			},
+			want: `if obj.Field != "" {
+if !(len(obj.Field) >= 3) {
+errs = append(errs, types.NewValidationError("Field length must be >= 3"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty with numeric",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "int"},
+				fieldValidations: []string{"omitempty", "gte=10"},
+			},
+			want: `if obj.Field != 0 {
+if !(obj.Field >= 10) {
+errs = append(errs, types.NewValidationError("Field must be >= 10"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty with slice",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "[]"},
+				fieldValidations: []string{"omitempty", "min=2"},
+			},
+			want: `if len(obj.Field) != 0 {
+if !(len(obj.Field) >= 2) {
+errs = append(errs, types.NewValidationError("Field must have at least 2 elements"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty with array",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "int", ComposedType: "[N]", Size: "3"},
+				fieldValidations: []string{"omitempty", "in=1 2"},
+			},
+			want: `if !types.IsZeroSlice(obj.Field[:]) {
+if !(types.SliceOnlyContains(obj.Field[:], []int{1, 2})) {
+errs = append(errs, types.NewValidationError("Field elements must be one of '1' '2'"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty with pointer",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "*"},
+				fieldValidations: []string{"omitempty", "eq=abc"},
+			},
+			want: `if obj.Field != nil {
+if !(obj.Field != nil && *obj.Field == "abc") {
+errs = append(errs, types.NewValidationError("Field must be equal to 'abc'"))
+}
+}
+`,
+		},
+		{
+			name: "omitnil with map",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "map"},
+				fieldValidations: []string{"omitnil", "len=2"},
+			},
+			want: `if obj.Field != nil {
+if !(len(obj.Field) == 2) {
+errs = append(errs, types.NewValidationError("Field must have exactly 2 elements"))
+}
+}
+`,
+		},
+		{
+			name: "rules before omitempty always run",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string"},
+				fieldValidations: []string{"max=5", "omitempty", "min=3"},
+			},
+			want: `if !(len(obj.Field) <= 5) {
+errs = append(errs, types.NewValidationError("Field length must be <= 5"))
+}
+if obj.Field != "" {
+if !(len(obj.Field) >= 3) {
+errs = append(errs, types.NewValidationError("Field length must be >= 3"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty with groups",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string"},
+				fieldValidations: []string{"omitempty@update", "min=3"},
+			},
+			want: `if !types.InGroups(groups, "update") || obj.Field != "" {
+if !(len(obj.Field) >= 3) {
+errs = append(errs, types.NewValidationError("Field length must be >= 3"))
+}
+}
+`,
+		},
+		{
+			name: "only omitempty",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string"},
+				fieldValidations: []string{"omitempty"},
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validations := []*analyzer.Validation{}
+			for _, fieldValidation := range tt.args.fieldValidations {
+				validations = append(validations, AssertParserValidation(t, fieldValidation))
+			}
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, validations)
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithConditionalOperations(t *testing.T) {
+	type args struct {
+		fieldName       string
+		fieldType       common.FieldType
+		fieldValidation string
+		targetTypes     map[string]common.FieldType
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "required_if with string",
+			args: args{
+				fieldName:       "TaxID",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "required_if=Country BR",
+				targetTypes:     map[string]common.FieldType{"Country": {BaseType: "string"}},
+			},
+			want: `if obj.Country == "BR" && !(obj.TaxID != "") {
+errs = append(errs, types.NewValidationError("TaxID is required when Country is 'BR'"))
+}
+`,
+		},
+		{
+			name: "required_if with many pairs",
+			args: args{
+				fieldName:       "TaxID",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "required_if=Country BR Age 18",
+				targetTypes: map[string]common.FieldType{
+					"Country": {BaseType: "string"},
+					"Age":     {BaseType: "uint8"},
+				},
+			},
+			want: `if (obj.Country == "BR" && obj.Age == 18) && !(obj.TaxID != "") {
+errs = append(errs, types.NewValidationError("TaxID is required when Country is 'BR' and Age is '18'"))
+}
+`,
+		},
+		{
+			name: "required_unless with pointer",
+			args: args{
+				fieldName:       "Phone",
+				fieldType:       common.FieldType{BaseType: "string", ComposedType: "*"},
+				fieldValidation: "required_unless=Active false",
+				targetTypes:     map[string]common.FieldType{"Active": {BaseType: "bool", ComposedType: "*"}},
+			},
+			want: `if !(obj.Active != nil && *obj.Active == false) && !(obj.Phone != nil && *obj.Phone != "") {
+errs = append(errs, types.NewValidationError("Phone is required unless Active is 'false'"))
+}
+`,
+		},
+		{
+			name: "required_if with big int pointer",
+			args: args{
+				fieldName:       "Reason",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "required_if=Amount 0",
+				targetTypes:     map[string]common.FieldType{"Amount": {BaseType: "big.Int", ComposedType: "*"}},
+			},
+			want: `if obj.Amount != nil && obj.Amount.Cmp(validgenBigInt350ca8af) == 0 && !(obj.Reason != "") {
+errs = append(errs, types.NewValidationError("Reason is required when Amount is '0'"))
+}
+`,
+		},
+		{
+			name: "required_with",
+			args: args{
+				fieldName:       "Phone",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "required_with=Email Tags",
+				targetTypes: map[string]common.FieldType{
+					"Email": {BaseType: "string", ComposedType: "*"},
+					"Tags":  {BaseType: "string", ComposedType: "[]"},
+				},
+			},
+			want: `if (obj.Email != nil && *obj.Email != "" || len(obj.Tags) != 0) && !(obj.Phone != "") {
+errs = append(errs, types.NewValidationError("Phone is required when any of 'Email' 'Tags' is present"))
+}
+`,
+		},
+		{
+			name: "required_without_all",
+			args: args{
+				fieldName:       "Phone",
+				fieldType:       common.FieldType{BaseType: "int"},
+				fieldValidation: "required_without_all=Email Mobile",
+				targetTypes: map[string]common.FieldType{
+					"Email":  {BaseType: "string"},
+					"Mobile": {BaseType: "int"},
+				},
+			},
+			want: `if (!(obj.Email != "") && !(obj.Mobile != 0)) && !(obj.Phone != 0) {
+errs = append(errs, types.NewValidationError("Phone is required when all of 'Email' 'Mobile' are missing"))
+}
+`,
+		},
+		{
+			name: "excluded_with",
+			args: args{
+				fieldName:       "Pix",
+				fieldType:       common.FieldType{BaseType: "string", ComposedType: "*"},
+				fieldValidation: "excluded_with=Card",
+				targetTypes:     map[string]common.FieldType{"Card": {BaseType: "string", ComposedType: "*"}},
+			},
+			want: `if obj.Card != nil && *obj.Card != "" && obj.Pix != nil && *obj.Pix != "" {
+errs = append(errs, types.NewValidationError("Pix must be empty when any of 'Card' is present"))
+}
+`,
+		},
+		{
+			name: "excluded_if with nested field",
+			args: args{
+				fieldName:       "Discount",
+				fieldType:       common.FieldType{BaseType: "float64"},
+				fieldValidation: "excluded_if=Nested.Kind 1",
+				targetTypes:     map[string]common.FieldType{"Nested.Kind": {BaseType: "int"}},
+			},
+			want: `if obj.Nested.Kind == 1 && obj.Discount != 0 {
+errs = append(errs, types.NewValidationError("Discount must be empty when Nested.Kind is '1'"))
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validation := AssertParserValidation(t, tt.args.fieldValidation)
+			validation.TargetTypes = tt.args.targetTypes
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, []*analyzer.Validation{validation})
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithFieldGroups(t *testing.T) {
+	type args struct {
+		fieldName       string
+		fieldType       common.FieldType
+		fieldValidation string
+		groupFields     []string
+		targetTypes     map[string]common.FieldType
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "exactly_one_of with pointers to structs",
+			args: args{
+				fieldName:       "Card",
+				fieldType:       common.FieldType{BaseType: "main.Card", ComposedType: "*"},
+				fieldValidation: "exactly_one_of=payment",
+				groupFields:     []string{"Card", "Pix"},
+				targetTypes: map[string]common.FieldType{
+					"Card": {BaseType: "main.Card", ComposedType: "*"},
+					"Pix":  {BaseType: "main.Pix", ComposedType: "*"},
+				},
+			},
+			want: `if types.CountTrue(obj.Card != nil, obj.Pix != nil) != 1 {
+errs = append(errs, types.NewValidationError("payment must have exactly one of 'Card' 'Pix'"))
+}
+`,
+		},
+		{
+			name: "at_most_one_of with go types",
+			args: args{
+				fieldName:       "Email",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "at_most_one_of=contact",
+				groupFields:     []string{"Email", "Phone", "Tags"},
+				targetTypes: map[string]common.FieldType{
+					"Email": {BaseType: "string"},
+					"Phone": {BaseType: "int", ComposedType: "*"},
+					"Tags":  {BaseType: "string", ComposedType: "[]"},
+				},
+			},
+			want: `if types.CountTrue(obj.Email != "", obj.Phone != nil, len(obj.Tags) != 0) > 1 {
+errs = append(errs, types.NewValidationError("contact must have at most one of 'Email' 'Phone' 'Tags'"))
+}
+`,
+		},
+		{
+			name: "at_least_one_of",
+			args: args{
+				fieldName:       "Email",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "at_least_one_of=contact",
+				groupFields:     []string{"Email", "Phone"},
+				targetTypes: map[string]common.FieldType{
+					"Email": {BaseType: "string"},
+					"Phone": {BaseType: "string"},
+				},
+			},
+			want: `if types.CountTrue(obj.Email != "", obj.Phone != "") == 0 {
+errs = append(errs, types.NewValidationError("contact must have at least one of 'Email' 'Phone'"))
+}
+`,
+		},
+		{
+			name: "all_or_none_of",
+			args: args{
+				fieldName:       "Latitude",
+				fieldType:       common.FieldType{BaseType: "float64", ComposedType: "*"},
+				fieldValidation: "all_or_none_of=location",
+				groupFields:     []string{"Latitude", "Longitude"},
+				targetTypes: map[string]common.FieldType{
+					"Latitude":  {BaseType: "float64", ComposedType: "*"},
+					"Longitude": {BaseType: "float64", ComposedType: "*"},
+				},
+			},
+			want: `if types.CountTrue(obj.Latitude != nil, obj.Longitude != nil) % 2 != 0 {
+errs = append(errs, types.NewValidationError("location must have all or none of 'Latitude' 'Longitude'"))
+}
+`,
+		},
+		{
+			name: "not the first field of the group",
+			args: args{
+				fieldName:       "Phone",
+				fieldType:       common.FieldType{BaseType: "string"},
+				fieldValidation: "at_least_one_of=contact",
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validation := AssertParserValidation(t, tt.args.fieldValidation)
+			validation.GroupFields = tt.args.groupFields
+			validation.TargetTypes = tt.args.targetTypes
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, []*analyzer.Validation{validation})
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithRegexAndDive(t *testing.T) {
+	type args struct {
+		fieldName        string
+		fieldType        common.FieldType
+		fieldValidations []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "regex with string",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string"},
+				fieldValidations: []string{`regex=^\d{2,3}%$`},
+			},
+			want: `if !(validgenRegex38c1c4fd.MatchString(obj.Field)) {
+errs = append(errs, types.NewValidationError("Field must match the pattern '^\\d{2,3}%%$'"))
+}
+`,
+		},
+		{
+			name: "regex with string pointer",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "*"},
+				fieldValidations: []string{`regex=^[a-z]+$`},
+			},
+			want: `if !(obj.Field != nil && validgenRegexc37a8736.MatchString(*obj.Field)) {
+errs = append(errs, types.NewValidationError("Field must match the pattern '^[a-z]+$'"))
+}
+`,
+		},
+		{
+			name: "dive with slice",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "[]"},
+				fieldValidations: []string{"min=1", "dive", `regex=^[a-z]+$`, "max=5"},
+			},
+			want: `if !(len(obj.Field) >= 1) {
+errs = append(errs, types.NewValidationError("Field must have at least 1 elements"))
+}
+for i := range obj.Field {
+if !(validgenRegexc37a8736.MatchString(obj.Field[i])) {
+errs = append(errs, types.NewValidationError("Field[%d] must match the pattern '^[a-z]+$'", i))
+}
+if !(len(obj.Field[i]) <= 5) {
+errs = append(errs, types.NewValidationError("Field[%d] length must be <= 5", i))
+}
+}
+`,
+		},
+		{
+			name: "dive with percent in the pattern",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "string", ComposedType: "[]"},
+				fieldValidations: []string{"dive", `regex=^\d{2,3}%$`},
+			},
+			want: `for i := range obj.Field {
+if !(validgenRegex38c1c4fd.MatchString(obj.Field[i])) {
+errs = append(errs, types.NewValidationError("Field[%d] must match the pattern '^\\d{2,3}%%$'", i))
+}
+}
+`,
+		},
+		{
+			name: "dive with array",
+			args: args{
+				fieldName:        "Field",
+				fieldType:        common.FieldType{BaseType: "int", ComposedType: "[N]", Size: "3"},
+				fieldValidations: []string{"dive", "gte=10"},
+			},
+			want: `for i := range obj.Field {
+if !(obj.Field[i] >= 10) {
+errs = append(errs, types.NewValidationError("Field[%d] must be >= 10", i))
+}
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validations := []*analyzer.Validation{}
+			for _, fieldValidation := range tt.args.fieldValidations {
+				validations = append(validations, AssertParserValidation(t, fieldValidation))
+			}
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, validations)
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithBigNumbers(t *testing.T) {
+	type args struct {
+		fieldName        string
+		fieldType        common.FieldType
+		fieldValidations []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "required and gte with big int pointer",
+			args: args{
+				fieldName:        "Balance",
+				fieldType:        common.FieldType{BaseType: "big.Int", ComposedType: "*"},
+				fieldValidations: []string{"required", "gte=0"},
+			},
+			want: `if !(obj.Balance != nil && obj.Balance.Sign() != 0) {
+errs = append(errs, types.NewValidationError("Balance is required"))
+}
+if !(obj.Balance != nil && obj.Balance.Cmp(validgenBigInt350ca8af) >= 0) {
+errs = append(errs, types.NewValidationError("Balance must be >= 0"))
+}
+`,
+		},
+		{
+			name: "lt with big rat pointer",
+			args: args{
+				fieldName:        "Rate",
+				fieldType:        common.FieldType{BaseType: "big.Rat", ComposedType: "*"},
+				fieldValidations: []string{"lt=1/3"},
+			},
+			want: `if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat72452f0e) < 0) {
+errs = append(errs, types.NewValidationError("Rate must be < 1/3"))
+}
+`,
+		},
+		{
+			name: "omitempty and gt with big float",
+			args: args{
+				fieldName:        "Fee",
+				fieldType:        common.FieldType{BaseType: "big.Float"},
+				fieldValidations: []string{"omitempty", "gt=0.01"},
+			},
+			want: `if obj.Fee.Sign() != 0 {
+if !(obj.Fee.Cmp(validgenBigFloat75954ef8) > 0) {
+errs = append(errs, types.NewValidationError("Fee must be > 0.01"))
+}
+}
+`,
+		},
+		{
+			name: "gtefield with big int pointers",
+			args: args{
+				fieldName:        "Max",
+				fieldType:        common.FieldType{BaseType: "big.Int", ComposedType: "*"},
+				fieldValidations: []string{"gtefield=Min"},
+			},
+			want: `if !(obj.Max != nil && obj.Min != nil && obj.Max.Cmp(obj.Min) >= 0) {
+errs = append(errs, types.NewValidationError("Max must be >= Min"))
+}
+`,
+		},
+		{
+			name: "neqfield with big rat",
+			args: args{
+				fieldName:        "Buy",
+				fieldType:        common.FieldType{BaseType: "big.Rat"},
+				fieldValidations: []string{"neqfield=Sell"},
+			},
+			want: `if !(obj.Buy.Cmp(&obj.Sell) != 0) {
+errs = append(errs, types.NewValidationError("Buy must not be equal to Sell"))
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validations := []*analyzer.Validation{}
+			for _, fieldValidation := range tt.args.fieldValidations {
+				validations = append(validations, AssertParserValidation(t, fieldValidation))
+			}
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, validations)
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithStdTypes(t *testing.T) {
+	type args struct {
+		fieldName        string
+		fieldType        common.FieldType
+		fieldValidations []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "required and private_ip with netip addr",
+			args: args{
+				fieldName:        "Addr",
+				fieldType:        common.FieldType{BaseType: "netip.Addr"},
+				fieldValidations: []string{"required", "private_ip"},
+			},
+			want: `if !(obj.Addr.IsValid()) {
+errs = append(errs, types.NewValidationError("Addr is required"))
+}
+if !(obj.Addr.IsPrivate()) {
+errs = append(errs, types.NewValidationError("Addr must be a private IP address"))
+}
+`,
+		},
+		{
+			name: "ipv4 with netip addr pointer",
+			args: args{
+				fieldName:        "Addr",
+				fieldType:        common.FieldType{BaseType: "netip.Addr", ComposedType: "*"},
+				fieldValidations: []string{"ipv4"},
+			},
+			want: `if !(obj.Addr != nil && obj.Addr.Zone() == "" && obj.Addr.Unmap().Is4()) {
+errs = append(errs, types.NewValidationError("Addr must be a valid IPv4 address"))
+}
+`,
+		},
+		{
+			name: "cidrv4 with netip prefix",
+			args: args{
+				fieldName:        "Network",
+				fieldType:        common.FieldType{BaseType: "netip.Prefix"},
+				fieldValidations: []string{"cidrv4"},
+			},
+			want: `if !(types.IsIPv4Prefix(obj.Network)) {
+errs = append(errs, types.NewValidationError("Network must be a valid IPv4 CIDR"))
+}
+`,
+		},
+		{
+			name: "required and url_scheme with url pointer",
+			args: args{
+				fieldName:        "Callback",
+				fieldType:        common.FieldType{BaseType: "url.URL", ComposedType: "*"},
+				fieldValidations: []string{"required", "url_scheme=https"},
+			},
+			want: `if !(obj.Callback != nil && !types.IsEmptyURL(obj.Callback)) {
+errs = append(errs, types.NewValidationError("Callback is required"))
+}
+if !(obj.Callback != nil && types.IsValidParsedURLWithScheme(obj.Callback, []string{"https"})) {
+errs = append(errs, types.NewValidationError("Callback must be a valid URL with scheme 'https'"))
+}
+`,
+		},
+		{
+			name: "url with url",
+			args: args{
+				fieldName:        "Homepage",
+				fieldType:        common.FieldType{BaseType: "url.URL"},
+				fieldValidations: []string{"url"},
+			},
+			want: `if !(types.IsValidParsedURL(&obj.Homepage)) {
+errs = append(errs, types.NewValidationError("Homepage must be a valid URL"))
+}
+`,
+		},
+		{
+			name: "email with mail address",
+			args: args{
+				fieldName:        "Contact",
+				fieldType:        common.FieldType{BaseType: "mail.Address"},
+				fieldValidations: []string{"email"},
+			},
+			want: `if !(types.IsValidEmail(obj.Contact.Address)) {
+errs = append(errs, types.NewValidationError("Contact must be a valid email"))
+}
+`,
+		},
+		{
+			name: "required and uuid4 with byte array",
+			args: args{
+				fieldName:        "ID",
+				fieldType:        common.FieldType{BaseType: "byte", ComposedType: "[N]", Size: "16"},
+				fieldValidations: []string{"required", "uuid4"},
+			},
+			want: `if !(!types.IsZeroBytes(obj.ID[:])) {
+errs = append(errs, types.NewValidationError("ID is required"))
+}
+if !(types.IsValidUUIDBytes(obj.ID[:], 4)) {
+errs = append(errs, types.NewValidationError("ID must be a valid UUID v4"))
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validations := []*analyzer.Validation{}
+			for _, fieldValidation := range tt.args.fieldValidations {
+				validations = append(validations, AssertParserValidation(t, fieldValidation))
+			}
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, validations)
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildValidationCodeWithOptionalTypes(t *testing.T) {
+	nullString := common.FieldType{BaseType: "string", Optional: common.Optional{Type: "sql.NullString", ValueField: "String"}}
+	nullInt64Pointer := common.FieldType{ComposedType: "*", BaseType: "int64", Optional: common.Optional{Type: "sql.Null[int64]", ValueField: "V"}}
+
+	type args struct {
+		fieldName        string
+		fieldType        common.FieldType
+		fieldValidations []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "required and min with null string",
+			args: args{
+				fieldName:        "Name",
+				fieldType:        nullString,
+				fieldValidations: []string{"required", "min=3"},
+			},
+			want: `if !(obj.Name.Valid && obj.Name.String != "") {
+errs = append(errs, types.NewValidationError("Name is required"))
+}
+if obj.Name.Valid {
+if !(len(obj.Name.String) >= 3) {
+errs = append(errs, types.NewValidationError("Name length must be >= 3"))
+}
+}
+`,
+		},
+		{
+			name: "omitempty and email with null string",
+			args: args{
+				fieldName:        "Email",
+				fieldType:        nullString,
+				fieldValidations: []string{"omitempty", "email"},
+			},
+			want: `if obj.Email.Valid && obj.Email.String != "" {
+if !(types.IsValidEmail(obj.Email.String)) {
+errs = append(errs, types.NewValidationError("Email must be a valid email"))
+}
+}
+`,
+		},
+		{
+			name: "omitnil and gte with generic null pointer",
+			args: args{
+				fieldName:        "Age",
+				fieldType:        nullInt64Pointer,
+				fieldValidations: []string{"omitnil", "gte=18"},
+			},
+			want: `if obj.Age != nil && obj.Age.Valid {
+if !(obj.Age.V >= 18) {
+errs = append(errs, types.NewValidationError("Age must be >= 18"))
+}
+}
+`,
+		},
+		{
+			name: "required with null time",
+			args: args{
+				fieldName:        "CreatedAt",
+				fieldType:        common.FieldType{BaseType: "time.Time", Optional: common.Optional{Type: "sql.NullTime", ValueField: "Time"}},
+				fieldValidations: []string{"required"},
+			},
+			want: `if !(obj.CreatedAt.Valid && !obj.CreatedAt.Time.IsZero()) {
+errs = append(errs, types.NewValidationError("CreatedAt is required"))
+}
+`,
+		},
+		{
+			name: "omitempty with groups and generic optional",
+			args: args{
+				fieldName:        "Nickname",
+				fieldType:        common.FieldType{BaseType: "string", Optional: common.Optional{Type: "Optional[string]", ValueField: "V"}},
+				fieldValidations: []string{"omitempty@create", "alpha"},
+			},
+			want: `if obj.Nickname.Valid && (!types.InGroups(groups, "create") || obj.Nickname.V != "") {
+if !(types.IsAlpha(obj.Nickname.V)) {
+errs = append(errs, types.NewValidationError("Nickname must contain only ASCII letters"))
+}
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gv := GenValidations{}
+			validations := []*analyzer.Validation{}
+			for _, fieldValidation := range tt.args.fieldValidations {
+				validations = append(validations, AssertParserValidation(t, fieldValidation))
+			}
+			got, err := gv.BuildValidationCode(tt.args.fieldName, tt.args.fieldType, validations)
+			if err != nil {
+				t.Errorf("BuildValidationCode() error = %v, wantErr %v", err, nil)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("BuildValidationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
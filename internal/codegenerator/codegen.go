@@ -2,24 +2,42 @@ package codegenerator
 
 import (
 	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/internal/analyzer/operations"
 	"github.com/opencodeco/validgen/internal/common"
 	"github.com/opencodeco/validgen/internal/parser"
+	"github.com/opencodeco/validgen/types"
 )
 
+// Options controls optional features of the generated validators.
+type Options struct {
+	// WithContext generates a <Struct>ValidateContext(ctx, obj) function for each struct.
+	// The context is threaded through nested validations and checked for cancellation.
+	WithContext bool
+	// Partial generates <Struct>ValidateFields(obj, fields...) and <Struct>ValidateExcept(obj, fields...)
+	// functions to check only the selected fields.
+	Partial bool
+}
+
 type GenValidations struct {
+	Options
 	StructsWithValidation map[string]struct{}
+	StructsWithGroups     map[string]struct{}
 	Struct                *analyzer.Struct
 }
 
-func GenerateCode(structs []*analyzer.Struct) (map[string]*Pkg, error) {
+func GenerateCode(structs []*analyzer.Struct, opts Options) (map[string]*Pkg, error) {
 	structsWithValidation := map[string]struct{}{}
 	usedPkgs := map[string]struct{}{}
 
 	for _, st := range structs {
-		structsWithValidation[common.KeyPath(st.PackageName, st.StructName)] = struct{}{}
+		if st.HasValidTag {
+			structsWithValidation[common.KeyPath(st.PackageName, st.StructName)] = struct{}{}
+		}
 		usedPkgs[st.PackageName] = struct{}{}
 	}
 
+	structsWithGroups := findStructsWithGroups(structs)
+
 	pkgs := make(map[string]*Pkg)
 	for _, st := range structs {
 		if !st.HasValidTag {
@@ -27,7 +45,9 @@ func GenerateCode(structs []*analyzer.Struct) (map[string]*Pkg, error) {
 		}
 
 		codeInfo := &GenValidations{
+			Options:               opts,
 			StructsWithValidation: structsWithValidation,
+			StructsWithGroups:     structsWithGroups,
 			Struct:                st,
 		}
 
@@ -40,14 +60,29 @@ func GenerateCode(structs []*analyzer.Struct) (map[string]*Pkg, error) {
 		pkg, ok := pkgs[pkdId]
 		if !ok {
 			pkg = &Pkg{
-				Name:    st.PackageName,
-				Path:    st.Path,
-				Imports: map[string]parser.Import{},
-				Structs: map[string]*Struct{},
+				Name:       st.PackageName,
+				Path:       st.Path,
+				Imports:    map[string]parser.Import{},
+				Structs:    map[string]*Struct{},
+				Regexes:    map[string]string{},
+				BigNumbers: map[string]string{},
 			}
 			pkgs[pkdId] = pkg
+
+			if opts.WithContext {
+				pkg.Imports["context"] = parser.Import{Name: "context", Path: "context"}
+			}
 		}
 
+		if err := addRegexes(pkg, st); err != nil {
+			return nil, err
+		}
+
+		if err := addBigNumbers(pkg, st); err != nil {
+			return nil, err
+		}
+		addTimezoneDatabase(pkg, st)
+
 		cgSt := &Struct{
 			Struct:            st,
 			ValidatorFuncCode: funcCode,
@@ -64,3 +99,119 @@ func GenerateCode(structs []*analyzer.Struct) (map[string]*Pkg, error) {
 
 	return pkgs, nil
 }
+
+// addRegexes adds the regular expressions used by the struct validations to the package.
+func addRegexes(pkg *Pkg, st *analyzer.Struct) error {
+	for _, fdValidations := range st.FieldsValidations {
+		for _, val := range fdValidations.Validations {
+			if val.Operation != "regex" {
+				continue
+			}
+
+			pattern := val.Values[0]
+			varName := regexVarName(pattern)
+			if pkgPattern, ok := pkg.Regexes[varName]; ok && pkgPattern != pattern {
+				return types.NewValidationError("INTERNAL ERROR: regex variable %s is used by %s and %s", varName, pkgPattern, pattern)
+			}
+
+			pkg.Regexes[varName] = pattern
+			pkg.Imports["regexp"] = parser.Import{Name: "regexp", Path: "regexp"}
+		}
+	}
+
+	return nil
+}
+
+// addBigNumbers adds the math/big values used by the struct validations to the package.
+// The values are checked at generation time and parsed only once, when the package is initialized.
+func addBigNumbers(pkg *Pkg, st *analyzer.Struct) error {
+	ops := operations.New()
+	for i, fdValidations := range st.FieldsValidations {
+		fdType := st.Fields[i].Type
+		for _, val := range fdValidations.Validations {
+			switch {
+			case ops.IsConditional(val.Operation) && ops.HasFieldValuePairs(val.Operation):
+				for j := 0; j+1 < len(val.Values); j += 2 {
+					if targetType := val.TargetTypes[val.Values[j]]; targetType.IsBigNumber() {
+						if err := addBigNumber(pkg, targetType, val.Values[j+1]); err != nil {
+							return err
+						}
+					}
+				}
+			case fdType.IsBigNumber() && !ops.IsFieldOperation(val.Operation) && !ops.IsFieldGroup(val.Operation):
+				for _, value := range val.Values {
+					if err := addBigNumber(pkg, fdType, value); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// addBigNumber adds a math/big value to the package, checking if its variable isn't used by another value.
+func addBigNumber(pkg *Pkg, fieldType common.FieldType, value string) error {
+	varName := bigNumberVarName(fieldType, value)
+	code := bigNumberCode(fieldType, value)
+	if pkgCode, ok := pkg.BigNumbers[varName]; ok && pkgCode != code {
+		return types.NewValidationError("INTERNAL ERROR: big number variable %s is used by %s and %s", varName, pkgCode, code)
+	}
+
+	pkg.BigNumbers[varName] = code
+
+	return nil
+}
+
+// addTimezoneDatabase embeds the IANA time zone database (time/tzdata) in the packages with timezone validations,
+// so they don't depend on the host. The other packages don't pay for its size.
+func addTimezoneDatabase(pkg *Pkg, st *analyzer.Struct) {
+	for _, fdValidations := range st.FieldsValidations {
+		for _, val := range fdValidations.Validations {
+			if val.Operation == "timezone" {
+				// Blank imports are indexed by path, because their names are always "_".
+				pkg.Imports["time/tzdata"] = parser.Import{Name: "_", Path: "time/tzdata"}
+				return
+			}
+		}
+	}
+}
+
+// findStructsWithGroups returns the structs that need a groups aware validator.
+// A struct needs it when one of its validations has groups or when one of its
+// nested structs needs it (the active groups must be forwarded).
+func findStructsWithGroups(structs []*analyzer.Struct) map[string]struct{} {
+	structsWithGroups := map[string]struct{}{}
+
+	for _, st := range structs {
+		for _, fdValidations := range st.FieldsValidations {
+			for _, val := range fdValidations.Validations {
+				if len(val.Groups) > 0 {
+					structsWithGroups[common.KeyPath(st.PackageName, st.StructName)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, st := range structs {
+			stKey := common.KeyPath(st.PackageName, st.StructName)
+			if _, ok := structsWithGroups[stKey]; ok {
+				continue
+			}
+
+			for i, fd := range st.Fields {
+				_, nestedWithGroups := structsWithGroups[fd.Type.BaseType]
+				if nestedWithGroups && len(st.FieldsValidations[i].Validations) > 0 {
+					structsWithGroups[stKey] = struct{}{}
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return structsWithGroups
+}
@@ -0,0 +1,63 @@
+package codegenerator
+
+import (
+	"testing"
+
+	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/internal/common"
+	"github.com/opencodeco/validgen/internal/parser"
+	"github.com/opencodeco/validgen/types"
+)
+
+func TestAddBigNumbers(t *testing.T) {
+	fieldType := common.FieldType{BaseType: "big.Int", ComposedType: "*"}
+	tests := []struct {
+		name       string
+		bigNumbers map[string]string
+		wantErr    error
+	}{
+		{
+			name:       "new value",
+			bigNumbers: map[string]string{},
+			wantErr:    nil,
+		},
+		{
+			name: "value already in the package",
+			bigNumbers: map[string]string{
+				bigNumberVarName(fieldType, "0"): `types.MustParseBigInt("0")`,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "variable used by another value",
+			bigNumbers: map[string]string{
+				bigNumberVarName(fieldType, "0"): `types.MustParseBigInt("1")`,
+			},
+			wantErr: types.NewValidationError(`INTERNAL ERROR: big number variable validgenBigInt350ca8af is used by types.MustParseBigInt("1") and types.MustParseBigInt("0")`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := &Pkg{BigNumbers: tt.bigNumbers}
+			st := &analyzer.Struct{
+				Struct: parser.Struct{
+					Fields: []parser.Field{{FieldName: "Balance", Type: fieldType}},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+					{Validations: []*analyzer.Validation{AssertParserValidation(t, "gte=0")}},
+				},
+			}
+
+			err := addBigNumbers(pkg, st)
+			if err != tt.wantErr {
+				t.Errorf("addBigNumbers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil && pkg.BigNumbers["validgenBigInt350ca8af"] != `types.MustParseBigInt("0")` {
+				t.Errorf("addBigNumbers() BigNumbers = %v", pkg.BigNumbers)
+			}
+		})
+	}
+}
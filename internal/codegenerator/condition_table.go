@@ -61,6 +61,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+				},
+			},
 		},
 	},
 	"required": {
@@ -90,7 +106,7 @@ var conditionTable = map[string]Operation{
 				},
 			},
 			{
-				AcceptedTypes: []string{"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+				AcceptedTypes: []string{"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>", "map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
 				ConditionTable: ConditionTable{
 					operation:      `len(obj.{{.Name}}) != 0`,
 					concatOperator: "",
@@ -122,7 +138,7 @@ var conditionTable = map[string]Operation{
 				},
 			},
 			{
-				AcceptedTypes: []string{"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]"},
+				AcceptedTypes: []string{"*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>", "*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]"},
 				ConditionTable: ConditionTable{
 					operation:      `obj.{{.Name}} != nil && len(*obj.{{.Name}}) != 0`,
 					concatOperator: "",
@@ -137,6 +153,214 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must not be empty",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Sign() != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Sign() != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>", "<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsValid()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.IsEmptyURL(&obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<MAILADDRESS>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Address != ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.IsZeroBytes(obj.{{.Name}}[:])`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>", "*<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsValid()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.IsEmptyURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<MAILADDRESS>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Address != ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.IsZeroBytes(obj.{{.Name}}[:])`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<TIME>"},
+				ConditionTable: ConditionTable{
+					operation:      `!obj.{{.Name}}.IsZero()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<TIME>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !obj.{{.Name}}.IsZero()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} is required",
+				},
+			},
+		},
+	},
+	"omitempty": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != ""`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != 0`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BOOL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != false`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>", "map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]"},
+				ConditionTable: ConditionTable{
+					operation:      `len(obj.{{.Name}}) != 0`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>", "*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>", "*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]", "*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>", "*<IPADDR>", "*<IPPREFIX>", "*<URL>", "*<MAILADDRESS>", "*[N]<BYTE>", "*<TIME>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Sign() != 0`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>", "<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsValid()`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.IsEmptyURL(&obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<MAILADDRESS>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Address != ""`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.IsZeroBytes(obj.{{.Name}}[:])`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<STRING>", "[N]<INT>", "[N]<FLOAT>", "[N]<BOOL>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.IsZeroSlice(obj.{{.Name}}[:])`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<TIME>"},
+				ConditionTable: ConditionTable{
+					operation:      `!obj.{{.Name}}.IsZero()`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
+		},
+	},
+	"omitnil": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"[]<STRING>", "[]<INT>", "[]<FLOAT>", "[]<BOOL>", "[]<BYTE>", "map[<STRING>]", "map[<INT>]", "map[<FLOAT>]", "map[<BOOL>]", "*<STRING>", "*<INT>", "*<FLOAT>", "*<BOOL>", "*[]<STRING>", "*[]<INT>", "*[]<FLOAT>", "*[]<BOOL>", "*[]<BYTE>", "*map[<STRING>]", "*map[<INT>]", "*map[<FLOAT>]", "*map[<BOOL>]", "*[N]<STRING>", "*[N]<INT>", "*[N]<FLOAT>", "*[N]<BOOL>", "*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>", "*<IPADDR>", "*<IPPREFIX>", "*<URL>", "*<MAILADDRESS>", "*[N]<BYTE>", "*<TIME>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil`,
+					concatOperator: "",
+					errorMessage:   "",
+				},
+			},
 		},
 	},
 	"gte": {
@@ -157,6 +381,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be >= {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) >= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) >= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+				},
+			},
 		},
 	},
 	"gt": {
@@ -177,6 +417,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be > {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be > {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be > {{.Target}}",
+				},
+			},
 		},
 	},
 	"lte": {
@@ -197,6 +453,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be <= {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) <= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be <= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) <= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be <= {{.Target}}",
+				},
+			},
 		},
 	},
 	"lt": {
@@ -217,6 +489,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be < {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be < {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be < {{.Target}}",
+				},
+			},
 		},
 	},
 	"min": {
@@ -381,6 +669,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp({{.BigVar}}) != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Cmp({{.BigVar}}) != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+				},
+			},
 		},
 	},
 	"neq_ignore_case": {
@@ -685,57 +989,157 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be a valid email",
 				},
 			},
-		},
-	},
-	"eqfield": {
-		ConditionByTypes: []ConditionByType{
 			{
-				AcceptedTypes: []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>"},
+				AcceptedTypes: []string{"<MAILADDRESS>"},
 				ConditionTable: ConditionTable{
-					operation:      `obj.{{.Name}} == obj.{{.Target}}`,
+					operation:      `types.IsValidEmail(obj.{{.Name}}.Address)`,
 					concatOperator: "",
-					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+					errorMessage:   "{{.Name}} must be a valid email",
 				},
 			},
-		},
-	},
-	"neqfield": {
-		ConditionByTypes: []ConditionByType{
 			{
-				AcceptedTypes: []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>"},
+				AcceptedTypes: []string{"*<MAILADDRESS>"},
 				ConditionTable: ConditionTable{
-					operation:      `obj.{{.Name}} != obj.{{.Target}}`,
+					operation:      `obj.{{.Name}} != nil && types.IsValidEmail(obj.{{.Name}}.Address)`,
 					concatOperator: "",
-					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+					errorMessage:   "{{.Name}} must be a valid email",
 				},
 			},
 		},
 	},
-	"gtefield": {
+	"regex": {
 		ConditionByTypes: []ConditionByType{
 			{
-				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				AcceptedTypes: []string{"<STRING>"},
 				ConditionTable: ConditionTable{
-					operation:      `obj.{{.Name}} >= obj.{{.Target}}`,
+					operation:      `{{.RegexVar}}.MatchString(obj.{{.Name}})`,
 					concatOperator: "",
-					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+					errorMessage:   "{{.Name}} must match the pattern '{{.Target}}'",
 				},
 			},
-		},
-	},
-	"gtfield": {
-		ConditionByTypes: []ConditionByType{
 			{
-				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				AcceptedTypes: []string{"*<STRING>"},
 				ConditionTable: ConditionTable{
-					operation:      `obj.{{.Name}} > obj.{{.Target}}`,
+					operation:      `obj.{{.Name}} != nil && {{.RegexVar}}.MatchString(*obj.{{.Name}})`,
 					concatOperator: "",
-					errorMessage:   "{{.Name}} must be > {{.Target}}",
+					errorMessage:   "{{.Name}} must match the pattern '{{.Target}}'",
 				},
 			},
 		},
 	},
-	"ltefield": {
+	"eqfield": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} == obj.{{.Target}}`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be equal to {{.Target}}",
+				},
+			},
+		},
+	},
+	"neqfield": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>", "<INT>", "<FLOAT>", "<BOOL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != obj.{{.Target}}`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be equal to {{.Target}}",
+				},
+			},
+		},
+	},
+	"gtefield": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} >= obj.{{.Target}}`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) >= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) >= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be >= {{.Target}}",
+				},
+			},
+		},
+	},
+	"gtfield": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} > obj.{{.Target}}`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be > {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be > {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be > {{.Target}}",
+				},
+			},
+		},
+	},
+	"ltefield": {
 		ConditionByTypes: []ConditionByType{
 			{
 				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
@@ -745,6 +1149,22 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be <= {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) <= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be <= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) <= 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be <= {{.Target}}",
+				},
+			},
 		},
 	},
 	"ltfield": {
@@ -757,6 +1177,2678 @@ var conditionTable = map[string]Operation{
 					errorMessage:   "{{.Name}} must be < {{.Target}}",
 				},
 			},
+			{
+				AcceptedTypes: []string{"<BIGINT>", "<BIGFLOAT>", "<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Cmp(&obj.{{.Target}}) < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be < {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<BIGINT>", "*<BIGFLOAT>", "*<BIGRAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Target}} != nil && obj.{{.Name}}.Cmp(obj.{{.Target}}) < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be < {{.Target}}",
+				},
+			},
+		},
+	},
+	"url": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidURL(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidParsedURL(&obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidParsedURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL",
+				},
+			},
+		},
+	},
+	"uri": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidURI(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidURI(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidParsedURI(&obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidParsedURI(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URI",
+				},
+			},
+		},
+	},
+	"http_url": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHTTPURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HTTP URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHTTPURL(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HTTP URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidParsedHTTPURL(&obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HTTP URL",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidParsedHTTPURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HTTP URL",
+				},
+			},
+		},
+	},
+	"url_scheme": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidURLWithScheme(obj.{{.Name}}, {{.TargetsAsStringSlice}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL with scheme {{.Targets}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidURLWithScheme(*obj.{{.Name}}, {{.TargetsAsStringSlice}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL with scheme {{.Targets}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidParsedURLWithScheme(&obj.{{.Name}}, {{.TargetsAsStringSlice}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL with scheme {{.Targets}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<URL>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidParsedURLWithScheme(obj.{{.Name}}, {{.TargetsAsStringSlice}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid URL with scheme {{.Targets}}",
+				},
+			},
+		},
+	},
+	"ip": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidIP(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidIP(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsValid() && obj.{{.Name}}.Zone() == ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsValid() && obj.{{.Name}}.Zone() == ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IP address",
+				},
+			},
+		},
+	},
+	"ipv4": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidIPv4(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidIPv4(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Zone() == "" && obj.{{.Name}}.Unmap().Is4()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Zone() == "" && obj.{{.Name}}.Unmap().Is4()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 address",
+				},
+			},
+		},
+	},
+	"ipv6": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidIPv6(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidIPv6(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.Is6() && !obj.{{.Name}}.Is4In6() && obj.{{.Name}}.Zone() == ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.Is6() && !obj.{{.Name}}.Is4In6() && obj.{{.Name}}.Zone() == ""`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 address",
+				},
+			},
+		},
+	},
+	"cidr": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCIDR(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCIDR(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsValid()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsValid()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CIDR",
+				},
+			},
+		},
+	},
+	"cidrv4": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCIDRv4(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCIDRv4(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsIPv4Prefix(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsIPv4Prefix(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv4 CIDR",
+				},
+			},
+		},
+	},
+	"cidrv6": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCIDRv6(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCIDRv6(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsValid() && obj.{{.Name}}.Addr().Is6() && !obj.{{.Name}}.Addr().Is4In6()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 CIDR",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPPREFIX>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsValid() && obj.{{.Name}}.Addr().Is6() && !obj.{{.Name}}.Addr().Is4In6()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IPv6 CIDR",
+				},
+			},
+		},
+	},
+	"mac": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidMAC(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MAC address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidMAC(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MAC address",
+				},
+			},
+		},
+	},
+	"hostname": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHostname(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hostname",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHostname(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hostname",
+				},
+			},
+		},
+	},
+	"fqdn": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidFQDN(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid FQDN",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidFQDN(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid FQDN",
+				},
+			},
+		},
+	},
+	"hostname_port": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHostnamePort(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hostname and port",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHostnamePort(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hostname and port",
+				},
+			},
+		},
+	},
+	"uuid": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUID(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUID(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUIDBytes(obj.{{.Name}}[:], 0)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUIDBytes(obj.{{.Name}}[:], 0)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID",
+				},
+			},
+		},
+	},
+	"uuid3": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUID3(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v3",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUID3(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v3",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUIDBytes(obj.{{.Name}}[:], 3)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v3",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUIDBytes(obj.{{.Name}}[:], 3)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v3",
+				},
+			},
+		},
+	},
+	"uuid4": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUID4(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v4",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUID4(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v4",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUIDBytes(obj.{{.Name}}[:], 4)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v4",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUIDBytes(obj.{{.Name}}[:], 4)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v4",
+				},
+			},
+		},
+	},
+	"uuid5": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUID5(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v5",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUID5(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v5",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUIDBytes(obj.{{.Name}}[:], 5)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v5",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUIDBytes(obj.{{.Name}}[:], 5)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v5",
+				},
+			},
+		},
+	},
+	"uuid7": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUID7(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v7",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUID7(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v7",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUUIDBytes(obj.{{.Name}}[:], 7)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v7",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[N]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUUIDBytes(obj.{{.Name}}[:], 7)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UUID v7",
+				},
+			},
+		},
+	},
+	"ulid": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidULID(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ULID",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidULID(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ULID",
+				},
+			},
+		},
+	},
+	"mongodb": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidMongoDBObjectID(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MongoDB ObjectID",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidMongoDBObjectID(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MongoDB ObjectID",
+				},
+			},
+		},
+	},
+	"alpha": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsAlpha(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII letters",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsAlpha(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII letters",
+				},
+			},
+		},
+	},
+	"alphanum": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsAlphanumeric(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII letters and digits",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsAlphanumeric(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII letters and digits",
+				},
+			},
+		},
+	},
+	"alphaunicode": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsAlphaUnicode(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only letters",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsAlphaUnicode(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only letters",
+				},
+			},
+		},
+	},
+	"alphanumunicode": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsAlphanumericUnicode(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only letters and numbers",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsAlphanumericUnicode(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only letters and numbers",
+				},
+			},
+		},
+	},
+	"numeric": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsNumeric(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a numeric value",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsNumeric(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a numeric value",
+				},
+			},
+		},
+	},
+	"number": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsNumber(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only digits",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsNumber(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only digits",
+				},
+			},
+		},
+	},
+	"ascii": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsASCII(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII characters",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsASCII(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only ASCII characters",
+				},
+			},
+		},
+	},
+	"printascii": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsPrintableASCII(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only printable ASCII characters",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsPrintableASCII(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain only printable ASCII characters",
+				},
+			},
+		},
+	},
+	"lowercase": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsLowercase(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be lowercase",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsLowercase(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be lowercase",
+				},
+			},
+		},
+	},
+	"uppercase": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsUppercase(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be uppercase",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsUppercase(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be uppercase",
+				},
+			},
+		},
+	},
+	"notblank": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsNotBlank(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be blank",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsNotBlank(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be blank",
+				},
+			},
+		},
+	},
+	"contains": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.Contains(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.Contains(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"containsany": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.ContainsAny(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain any of '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.ContainsAny(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain any of '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"containsrune": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.Contains(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.Contains(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must contain '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"excludes": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.Contains(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not contain '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.Contains(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not contain '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"excludesall": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.ContainsAny(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not contain any of '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.ContainsAny(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not contain any of '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"startswith": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.HasPrefix(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must start with '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.HasPrefix(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must start with '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"endswith": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.HasSuffix(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must end with '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.HasSuffix(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must end with '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"startsnotwith": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.HasPrefix(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not start with '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.HasPrefix(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not start with '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"endsnotwith": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.HasSuffix(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not end with '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.HasSuffix(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not end with '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"in_ignore_case": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.EqualFold(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "||",
+					errorMessage:   "{{.Name}} must be one of {{.Targets}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.EqualFold(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "||",
+					errorMessage:   "{{.Name}} must be one of {{.Targets}}",
+				},
+			},
+		},
+	},
+	"nin_ignore_case": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `!types.EqualFold(obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "&&",
+					errorMessage:   "{{.Name}} must not be one of {{.Targets}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && !types.EqualFold(*obj.{{.Name}}, {{.QuotedTarget}})`,
+					concatOperator: "&&",
+					errorMessage:   "{{.Name}} must not be one of {{.Targets}}",
+				},
+			},
+		},
+	},
+	"base64": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 string",
+				},
+			},
+		},
+	},
+	"base64url": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64URL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64URL(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64URL(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64URL(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid base64 URL string",
+				},
+			},
+		},
+	},
+	"base64rawurl": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64RawURL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid raw base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64RawURL(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid raw base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBase64RawURL(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid raw base64 URL string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBase64RawURL(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid raw base64 URL string",
+				},
+			},
+		},
+	},
+	"hexadecimal": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHexadecimal(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hexadecimal string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHexadecimal(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hexadecimal string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHexadecimal(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hexadecimal string",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHexadecimal(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hexadecimal string",
+				},
+			},
+		},
+	},
+	"json": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidJSON(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JSON",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidJSON(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JSON",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidJSON(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JSON",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidJSON(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JSON",
+				},
+			},
+		},
+	},
+	"jwt": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidJWT(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JWT",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidJWT(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JWT",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidJWT(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JWT",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidJWT(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid JWT",
+				},
+			},
+		},
+	},
+	"hexcolor": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHexColor(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hex color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHexColor(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hex color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHexColor(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hex color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHexColor(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid hex color",
+				},
+			},
+		},
+	},
+	"rgb": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidRGB(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGB color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidRGB(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGB color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidRGB(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGB color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidRGB(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGB color",
+				},
+			},
+		},
+	},
+	"rgba": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidRGBA(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGBA color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidRGBA(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGBA color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidRGBA(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGBA color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidRGBA(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid RGBA color",
+				},
+			},
+		},
+	},
+	"hsl": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHSL(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HSL color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHSL(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HSL color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidHSL(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HSL color",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidHSL(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid HSL color",
+				},
+			},
+		},
+	},
+	"datauri": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidDataURI(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid data URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidDataURI(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid data URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidDataURI(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid data URI",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidDataURI(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid data URI",
+				},
+			},
+		},
+	},
+	"mimetype": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidMIMEType(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MIME type",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidMIMEType(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MIME type",
+				},
+			},
+			{
+				AcceptedTypes: []string{"[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidMIMEType(string(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MIME type",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*[]<BYTE>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidMIMEType(string(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid MIME type",
+				},
+			},
+		},
+	},
+	"datetime": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidDatetime(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid datetime with layout '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidDatetime(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid datetime with layout '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"timezone": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidTimezone(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid time zone",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidTimezone(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid time zone",
+				},
+			},
+		},
+	},
+	"duration": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidDuration(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid duration",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidDuration(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid duration",
+				},
+			},
+		},
+	},
+	"cpf": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPF(obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CPF",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPF(*obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CPF",
+				},
+			},
+		},
+	},
+	"cpf_formatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPF(obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CPF",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPF(*obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CPF",
+				},
+			},
+		},
+	},
+	"cpf_unformatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPF(obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CPF",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPF(*obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CPF",
+				},
+			},
+		},
+	},
+	"cnpj": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCNPJ(obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCNPJ(*obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CNPJ",
+				},
+			},
+		},
+	},
+	"cnpj_formatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCNPJ(obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCNPJ(*obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CNPJ",
+				},
+			},
+		},
+	},
+	"cnpj_unformatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCNPJ(obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCNPJ(*obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CNPJ",
+				},
+			},
+		},
+	},
+	"cep": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCEP(obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CEP",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCEP(*obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CEP",
+				},
+			},
+		},
+	},
+	"cep_formatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCEP(obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CEP",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCEP(*obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CEP",
+				},
+			},
+		},
+	},
+	"cep_unformatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCEP(obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CEP",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCEP(*obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CEP",
+				},
+			},
+		},
+	},
+	"cpf_cnpj": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPFOrCNPJ(obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CPF or CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPFOrCNPJ(*obj.{{.Name}}, types.AnyDocumentFormat)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid CPF or CNPJ",
+				},
+			},
+		},
+	},
+	"cpf_cnpj_formatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPFOrCNPJ(obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CPF or CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPFOrCNPJ(*obj.{{.Name}}, types.FormattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid formatted CPF or CNPJ",
+				},
+			},
+		},
+	},
+	"cpf_cnpj_unformatted": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCPFOrCNPJ(obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CPF or CNPJ",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCPFOrCNPJ(*obj.{{.Name}}, types.UnformattedDocument)`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid unformatted CPF or CNPJ",
+				},
+			},
+		},
+	},
+	"luhn_checksum": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidLuhnChecksum(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must have a valid Luhn checksum",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidLuhnChecksum(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must have a valid Luhn checksum",
+				},
+			},
+		},
+	},
+	"credit_card": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCreditCard(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid credit card number",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCreditCard(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid credit card number",
+				},
+			},
+		},
+	},
+	"iban": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidIBAN(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IBAN",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidIBAN(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid IBAN",
+				},
+			},
+		},
+	},
+	"bic": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBIC(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid BIC",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBIC(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid BIC",
+				},
+			},
+		},
+	},
+	"isbn10": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISBN10(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISBN-10",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISBN10(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISBN-10",
+				},
+			},
+		},
+	},
+	"isbn13": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISBN13(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISBN-13",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISBN13(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISBN-13",
+				},
+			},
+		},
+	},
+	"issn": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISSN(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISSN",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISSN(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISSN",
+				},
+			},
+		},
+	},
+	"ean8": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidEAN8(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid EAN-8",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidEAN8(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid EAN-8",
+				},
+			},
+		},
+	},
+	"ean13": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidEAN13(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid EAN-13",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidEAN13(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid EAN-13",
+				},
+			},
+		},
+	},
+	"upc": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidUPC(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UPC",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidUPC(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid UPC",
+				},
+			},
+		},
+	},
+	"iso3166_1_alpha2": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO3166Alpha2(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 alpha-2 country code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO3166Alpha2(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 alpha-2 country code",
+				},
+			},
+		},
+	},
+	"iso3166_1_alpha3": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO3166Alpha3(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 alpha-3 country code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO3166Alpha3(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 alpha-3 country code",
+				},
+			},
+		},
+	},
+	"iso3166_1_numeric": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO3166NumericString(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 numeric country code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO3166NumericString(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 numeric country code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO3166Numeric(int(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 numeric country code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO3166Numeric(int(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 3166-1 numeric country code",
+				},
+			},
+		},
+	},
+	"iso4217": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO4217(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 currency code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO4217(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 currency code",
+				},
+			},
+		},
+	},
+	"iso4217_numeric": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO4217NumericString(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 numeric currency code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO4217NumericString(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 numeric currency code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidISO4217Numeric(int(obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 numeric currency code",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidISO4217Numeric(int(*obj.{{.Name}}))`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid ISO 4217 numeric currency code",
+				},
+			},
+		},
+	},
+	"bcp47_language_tag": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidBCP47LanguageTag(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid BCP 47 language tag",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidBCP47LanguageTag(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid BCP 47 language tag",
+				},
+			},
+		},
+	},
+	"e164": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidE164(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid E.164 phone number",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidE164(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid E.164 phone number",
+				},
+			},
+		},
+	},
+	"postcode_iso3166_alpha2": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidPostcode(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid postal code for '{{.Target}}'",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidPostcode(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid postal code for '{{.Target}}'",
+				},
+			},
+		},
+	},
+	"postcode_iso3166_alpha2_field": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidPostcode(obj.{{.Name}}, obj.{{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid postal code for the country in {{.Target}}",
+				},
+			},
+		},
+	},
+	"latitude": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidLatitude(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid latitude (-90 to 90)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidLatitude(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid latitude (-90 to 90)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} >= -90 && obj.{{.Name}} <= 90`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid latitude (-90 to 90)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}} >= -90 && *obj.{{.Name}} <= 90`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid latitude (-90 to 90)",
+				},
+			},
+		},
+	},
+	"longitude": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidLongitude(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid longitude (-180 to 180)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidLongitude(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid longitude (-180 to 180)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} >= -180 && obj.{{.Name}} <= 180`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid longitude (-180 to 180)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}} >= -180 && *obj.{{.Name}} <= 180`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid longitude (-180 to 180)",
+				},
+			},
+		},
+	},
+	"semver": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidSemver(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid semantic version (e.g. 1.2.3)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidSemver(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid semantic version (e.g. 1.2.3)",
+				},
+			},
+		},
+	},
+	"cron": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidCron(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid cron expression (5 fields, or 6 with seconds)",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidCron(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a valid cron expression (5 fields, or 6 with seconds)",
+				},
+			},
+		},
+	},
+	"finite": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsFinite(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a finite number",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsFinite(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a finite number",
+				},
+			},
+		},
+	},
+	"multipleof": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}%{{.Target}} == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a multiple of {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}}%{{.Target}} == 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a multiple of {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsMultipleOf(obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a multiple of {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsMultipleOf(*obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a multiple of {{.Target}}",
+				},
+			},
+		},
+	},
+	"maxdecimals": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.HasMaxDecimals(obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must have at most {{.Target}} decimal places",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.HasMaxDecimals(*obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must have at most {{.Target}} decimal places",
+				},
+			},
+		},
+	},
+	"positive": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be positive",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>", "*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}} > 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be positive",
+				},
+			},
+		},
+	},
+	"negative": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be negative",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>", "*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}} < 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be negative",
+				},
+			},
+		},
+	},
+	"nonzero": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<INT>", "<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be zero",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<INT>", "*<FLOAT>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && *obj.{{.Name}} != 0`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must not be zero",
+				},
+			},
+		},
+	},
+	"decimal": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsValidDecimal(obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal({{.Target}})",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsValidDecimal(*obj.{{.Name}}, {{.Target}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal({{.Target}})",
+				},
+			},
+		},
+	},
+	"decimal_gt": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsDecimalGT(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal > {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsDecimalGT(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal > {{.Target}}",
+				},
+			},
+		},
+	},
+	"decimal_gte": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsDecimalGTE(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal >= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsDecimalGTE(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal >= {{.Target}}",
+				},
+			},
+		},
+	},
+	"decimal_lt": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsDecimalLT(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal < {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsDecimalLT(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal < {{.Target}}",
+				},
+			},
+		},
+	},
+	"decimal_lte": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsDecimalLTE(obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal <= {{.Target}}",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsDecimalLTE(*obj.{{.Name}}, "{{.Target}}")`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a decimal <= {{.Target}}",
+				},
+			},
+		},
+	},
+	"private_ip": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsPrivateIP(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a private IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsPrivateIP(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a private IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsPrivate()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a private IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsPrivate()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a private IP address",
+				},
+			},
+		},
+	},
+	"public_ip": {
+		ConditionByTypes: []ConditionByType{
+			{
+				AcceptedTypes: []string{"<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `types.IsPublicIP(obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a public IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<STRING>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && types.IsPublicIP(*obj.{{.Name}})`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a public IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}}.IsGlobalUnicast() && !obj.{{.Name}}.IsPrivate()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a public IP address",
+				},
+			},
+			{
+				AcceptedTypes: []string{"*<IPADDR>"},
+				ConditionTable: ConditionTable{
+					operation:      `obj.{{.Name}} != nil && obj.{{.Name}}.IsGlobalUnicast() && !obj.{{.Name}}.IsPrivate()`,
+					concatOperator: "",
+					errorMessage:   "{{.Name}} must be a public IP address",
+				},
+			},
 		},
 	},
 }
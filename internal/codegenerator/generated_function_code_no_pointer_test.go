@@ -46,6 +46,4228 @@ errs = append(errs, types.NewValidationError("FieldEmailString must be a valid e
 }
 return errs
 }
+`,
+		},
+		{
+			name: "regexStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "regexStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRegexString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"regex='^[a-z]{2,5}$'"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `regex='^[a-z]{2,5}$'`)},
+					},
+				},
+			},
+			want: `func regexStructValidate(obj *regexStruct) []error {
+var errs []error
+if !(validgenRegex691022c6.MatchString(obj.FieldRegexString)) {
+errs = append(errs, types.NewValidationError("FieldRegexString must match the pattern '^[a-z]{2,5}$'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUrlString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `url`)},
+					},
+				},
+			},
+			want: `func urlStructValidate(obj *urlStruct) []error {
+var errs []error
+if !(types.IsValidURL(obj.FieldUrlString)) {
+errs = append(errs, types.NewValidationError("FieldUrlString must be a valid URL"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uriStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uriStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUriString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uri"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uri`)},
+					},
+				},
+			},
+			want: `func uriStructValidate(obj *uriStruct) []error {
+var errs []error
+if !(types.IsValidURI(obj.FieldUriString)) {
+errs = append(errs, types.NewValidationError("FieldUriString must be a valid URI"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "http_urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "http_urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHttp_urlString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"http_url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `http_url`)},
+					},
+				},
+			},
+			want: `func http_urlStructValidate(obj *http_urlStruct) []error {
+var errs []error
+if !(types.IsValidHTTPURL(obj.FieldHttp_urlString)) {
+errs = append(errs, types.NewValidationError("FieldHttp_urlString must be a valid HTTP URL"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "url_schemeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "url_schemeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUrl_schemeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"url_scheme=https wss"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `url_scheme=https wss`)},
+					},
+				},
+			},
+			want: `func url_schemeStructValidate(obj *url_schemeStruct) []error {
+var errs []error
+if !(types.IsValidURLWithScheme(obj.FieldUrl_schemeString, []string{"https", "wss"})) {
+errs = append(errs, types.NewValidationError("FieldUrl_schemeString must be a valid URL with scheme 'https' 'wss'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ip`)},
+					},
+				},
+			},
+			want: `func ipStructValidate(obj *ipStruct) []error {
+var errs []error
+if !(types.IsValidIP(obj.FieldIpString)) {
+errs = append(errs, types.NewValidationError("FieldIpString must be a valid IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipv4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipv4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpv4String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ipv4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ipv4`)},
+					},
+				},
+			},
+			want: `func ipv4StructValidate(obj *ipv4Struct) []error {
+var errs []error
+if !(types.IsValidIPv4(obj.FieldIpv4String)) {
+errs = append(errs, types.NewValidationError("FieldIpv4String must be a valid IPv4 address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipv6Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipv6Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpv6String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ipv6"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ipv6`)},
+					},
+				},
+			},
+			want: `func ipv6StructValidate(obj *ipv6Struct) []error {
+var errs []error
+if !(types.IsValidIPv6(obj.FieldIpv6String)) {
+errs = append(errs, types.NewValidationError("FieldIpv6String must be a valid IPv6 address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidr"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidr`)},
+					},
+				},
+			},
+			want: `func cidrStructValidate(obj *cidrStruct) []error {
+var errs []error
+if !(types.IsValidCIDR(obj.FieldCidrString)) {
+errs = append(errs, types.NewValidationError("FieldCidrString must be a valid CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrv4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrv4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrv4String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidrv4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidrv4`)},
+					},
+				},
+			},
+			want: `func cidrv4StructValidate(obj *cidrv4Struct) []error {
+var errs []error
+if !(types.IsValidCIDRv4(obj.FieldCidrv4String)) {
+errs = append(errs, types.NewValidationError("FieldCidrv4String must be a valid IPv4 CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrv6Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrv6Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrv6String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidrv6"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidrv6`)},
+					},
+				},
+			},
+			want: `func cidrv6StructValidate(obj *cidrv6Struct) []error {
+var errs []error
+if !(types.IsValidCIDRv6(obj.FieldCidrv6String)) {
+errs = append(errs, types.NewValidationError("FieldCidrv6String must be a valid IPv6 CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "private_ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "private_ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPrivate_ipString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"private_ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `private_ip`)},
+					},
+				},
+			},
+			want: `func private_ipStructValidate(obj *private_ipStruct) []error {
+var errs []error
+if !(types.IsPrivateIP(obj.FieldPrivate_ipString)) {
+errs = append(errs, types.NewValidationError("FieldPrivate_ipString must be a private IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "public_ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "public_ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPublic_ipString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"public_ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `public_ip`)},
+					},
+				},
+			},
+			want: `func public_ipStructValidate(obj *public_ipStruct) []error {
+var errs []error
+if !(types.IsPublicIP(obj.FieldPublic_ipString)) {
+errs = append(errs, types.NewValidationError("FieldPublic_ipString must be a public IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "macStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "macStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMacString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"mac"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mac`)},
+					},
+				},
+			},
+			want: `func macStructValidate(obj *macStruct) []error {
+var errs []error
+if !(types.IsValidMAC(obj.FieldMacString)) {
+errs = append(errs, types.NewValidationError("FieldMacString must be a valid MAC address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hostnameStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hostnameStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHostnameString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"hostname"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hostname`)},
+					},
+				},
+			},
+			want: `func hostnameStructValidate(obj *hostnameStruct) []error {
+var errs []error
+if !(types.IsValidHostname(obj.FieldHostnameString)) {
+errs = append(errs, types.NewValidationError("FieldHostnameString must be a valid hostname"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "fqdnStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "fqdnStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldFqdnString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"fqdn"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `fqdn`)},
+					},
+				},
+			},
+			want: `func fqdnStructValidate(obj *fqdnStruct) []error {
+var errs []error
+if !(types.IsValidFQDN(obj.FieldFqdnString)) {
+errs = append(errs, types.NewValidationError("FieldFqdnString must be a valid FQDN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hostname_portStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hostname_portStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHostname_portString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"hostname_port"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hostname_port`)},
+					},
+				},
+			},
+			want: `func hostname_portStructValidate(obj *hostname_portStruct) []error {
+var errs []error
+if !(types.IsValidHostnamePort(obj.FieldHostname_portString)) {
+errs = append(errs, types.NewValidationError("FieldHostname_portString must be a valid hostname and port"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuidStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuidStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuidString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid`)},
+					},
+				},
+			},
+			want: `func uuidStructValidate(obj *uuidStruct) []error {
+var errs []error
+if !(types.IsValidUUID(obj.FieldUuidString)) {
+errs = append(errs, types.NewValidationError("FieldUuidString must be a valid UUID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid3Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid3Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid3String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid3"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid3`)},
+					},
+				},
+			},
+			want: `func uuid3StructValidate(obj *uuid3Struct) []error {
+var errs []error
+if !(types.IsValidUUID3(obj.FieldUuid3String)) {
+errs = append(errs, types.NewValidationError("FieldUuid3String must be a valid UUID v3"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid4String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid4`)},
+					},
+				},
+			},
+			want: `func uuid4StructValidate(obj *uuid4Struct) []error {
+var errs []error
+if !(types.IsValidUUID4(obj.FieldUuid4String)) {
+errs = append(errs, types.NewValidationError("FieldUuid4String must be a valid UUID v4"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid5Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid5Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid5String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid5"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid5`)},
+					},
+				},
+			},
+			want: `func uuid5StructValidate(obj *uuid5Struct) []error {
+var errs []error
+if !(types.IsValidUUID5(obj.FieldUuid5String)) {
+errs = append(errs, types.NewValidationError("FieldUuid5String must be a valid UUID v5"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid7Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid7Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid7String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid7"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid7`)},
+					},
+				},
+			},
+			want: `func uuid7StructValidate(obj *uuid7Struct) []error {
+var errs []error
+if !(types.IsValidUUID7(obj.FieldUuid7String)) {
+errs = append(errs, types.NewValidationError("FieldUuid7String must be a valid UUID v7"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ulidStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ulidStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUlidString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ulid"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ulid`)},
+					},
+				},
+			},
+			want: `func ulidStructValidate(obj *ulidStruct) []error {
+var errs []error
+if !(types.IsValidULID(obj.FieldUlidString)) {
+errs = append(errs, types.NewValidationError("FieldUlidString must be a valid ULID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "mongodbStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "mongodbStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMongodbString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"mongodb"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mongodb`)},
+					},
+				},
+			},
+			want: `func mongodbStructValidate(obj *mongodbStruct) []error {
+var errs []error
+if !(types.IsValidMongoDBObjectID(obj.FieldMongodbString)) {
+errs = append(errs, types.NewValidationError("FieldMongodbString must be a valid MongoDB ObjectID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphaStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphaStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphaString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"alpha"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alpha`)},
+					},
+				},
+			},
+			want: `func alphaStructValidate(obj *alphaStruct) []error {
+var errs []error
+if !(types.IsAlpha(obj.FieldAlphaString)) {
+errs = append(errs, types.NewValidationError("FieldAlphaString must contain only ASCII letters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphanumStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphanumStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphanumString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphanum"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphanum`)},
+					},
+				},
+			},
+			want: `func alphanumStructValidate(obj *alphanumStruct) []error {
+var errs []error
+if !(types.IsAlphanumeric(obj.FieldAlphanumString)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumString must contain only ASCII letters and digits"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphaunicodeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphaunicodeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphaunicodeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphaunicode"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphaunicode`)},
+					},
+				},
+			},
+			want: `func alphaunicodeStructValidate(obj *alphaunicodeStruct) []error {
+var errs []error
+if !(types.IsAlphaUnicode(obj.FieldAlphaunicodeString)) {
+errs = append(errs, types.NewValidationError("FieldAlphaunicodeString must contain only letters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphanumunicodeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphanumunicodeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphanumunicodeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphanumunicode"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphanumunicode`)},
+					},
+				},
+			},
+			want: `func alphanumunicodeStructValidate(obj *alphanumunicodeStruct) []error {
+var errs []error
+if !(types.IsAlphanumericUnicode(obj.FieldAlphanumunicodeString)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumunicodeString must contain only letters and numbers"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNumericString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `numeric`)},
+					},
+				},
+			},
+			want: `func numericStructValidate(obj *numericStruct) []error {
+var errs []error
+if !(types.IsNumeric(obj.FieldNumericString)) {
+errs = append(errs, types.NewValidationError("FieldNumericString must be a numeric value"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "numberStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "numberStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNumberString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"number"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `number`)},
+					},
+				},
+			},
+			want: `func numberStructValidate(obj *numberStruct) []error {
+var errs []error
+if !(types.IsNumber(obj.FieldNumberString)) {
+errs = append(errs, types.NewValidationError("FieldNumberString must contain only digits"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "asciiStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "asciiStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAsciiString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ascii"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ascii`)},
+					},
+				},
+			},
+			want: `func asciiStructValidate(obj *asciiStruct) []error {
+var errs []error
+if !(types.IsASCII(obj.FieldAsciiString)) {
+errs = append(errs, types.NewValidationError("FieldAsciiString must contain only ASCII characters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "printasciiStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "printasciiStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPrintasciiString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"printascii"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `printascii`)},
+					},
+				},
+			},
+			want: `func printasciiStructValidate(obj *printasciiStruct) []error {
+var errs []error
+if !(types.IsPrintableASCII(obj.FieldPrintasciiString)) {
+errs = append(errs, types.NewValidationError("FieldPrintasciiString must contain only printable ASCII characters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "lowercaseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "lowercaseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLowercaseString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"lowercase"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `lowercase`)},
+					},
+				},
+			},
+			want: `func lowercaseStructValidate(obj *lowercaseStruct) []error {
+var errs []error
+if !(types.IsLowercase(obj.FieldLowercaseString)) {
+errs = append(errs, types.NewValidationError("FieldLowercaseString must be lowercase"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uppercaseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uppercaseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUppercaseString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"uppercase"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uppercase`)},
+					},
+				},
+			},
+			want: `func uppercaseStructValidate(obj *uppercaseStruct) []error {
+var errs []error
+if !(types.IsUppercase(obj.FieldUppercaseString)) {
+errs = append(errs, types.NewValidationError("FieldUppercaseString must be uppercase"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "notblankStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "notblankStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNotblankString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"notblank"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `notblank`)},
+					},
+				},
+			},
+			want: `func notblankStructValidate(obj *notblankStruct) []error {
+var errs []error
+if !(types.IsNotBlank(obj.FieldNotblankString)) {
+errs = append(errs, types.NewValidationError("FieldNotblankString must not be blank"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"contains=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `contains=ab`)},
+					},
+				},
+			},
+			want: `func containsStructValidate(obj *containsStruct) []error {
+var errs []error
+if !(types.Contains(obj.FieldContainsString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldContainsString must contain 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsanyStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsanyStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsanyString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"containsany=!@#"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `containsany=!@#`)},
+					},
+				},
+			},
+			want: `func containsanyStructValidate(obj *containsanyStruct) []error {
+var errs []error
+if !(types.ContainsAny(obj.FieldContainsanyString, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldContainsanyString must contain any of '!@#'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsruneStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsruneStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsruneString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"containsrune=@"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `containsrune=@`)},
+					},
+				},
+			},
+			want: `func containsruneStructValidate(obj *containsruneStruct) []error {
+var errs []error
+if !(types.Contains(obj.FieldContainsruneString, "@")) {
+errs = append(errs, types.NewValidationError("FieldContainsruneString must contain '@'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "excludesStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "excludesStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldExcludesString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"excludes=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `excludes=ab`)},
+					},
+				},
+			},
+			want: `func excludesStructValidate(obj *excludesStruct) []error {
+var errs []error
+if !(!types.Contains(obj.FieldExcludesString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldExcludesString must not contain 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "excludesallStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "excludesallStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldExcludesallString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"excludesall=!@#"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `excludesall=!@#`)},
+					},
+				},
+			},
+			want: `func excludesallStructValidate(obj *excludesallStruct) []error {
+var errs []error
+if !(!types.ContainsAny(obj.FieldExcludesallString, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldExcludesallString must not contain any of '!@#'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "startswithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "startswithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldStartswithString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"startswith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `startswith=ab`)},
+					},
+				},
+			},
+			want: `func startswithStructValidate(obj *startswithStruct) []error {
+var errs []error
+if !(types.HasPrefix(obj.FieldStartswithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartswithString must start with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "endswithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "endswithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEndswithString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"endswith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `endswith=ab`)},
+					},
+				},
+			},
+			want: `func endswithStructValidate(obj *endswithStruct) []error {
+var errs []error
+if !(types.HasSuffix(obj.FieldEndswithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndswithString must end with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "startsnotwithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "startsnotwithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldStartsnotwithString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"startsnotwith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `startsnotwith=ab`)},
+					},
+				},
+			},
+			want: `func startsnotwithStructValidate(obj *startsnotwithStruct) []error {
+var errs []error
+if !(!types.HasPrefix(obj.FieldStartsnotwithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartsnotwithString must not start with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "endsnotwithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "endsnotwithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEndsnotwithString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"endsnotwith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `endsnotwith=ab`)},
+					},
+				},
+			},
+			want: `func endsnotwithStructValidate(obj *endsnotwithStruct) []error {
+var errs []error
+if !(!types.HasSuffix(obj.FieldEndsnotwithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndsnotwithString must not end with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "in_ignore_caseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "in_ignore_caseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIn_ignore_caseString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"in_ignore_case=ab cd ef"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `in_ignore_case=ab cd ef`)},
+					},
+				},
+			},
+			want: `func in_ignore_caseStructValidate(obj *in_ignore_caseStruct) []error {
+var errs []error
+if !(types.EqualFold(obj.FieldIn_ignore_caseString, "ab") || types.EqualFold(obj.FieldIn_ignore_caseString, "cd") || types.EqualFold(obj.FieldIn_ignore_caseString, "ef")) {
+errs = append(errs, types.NewValidationError("FieldIn_ignore_caseString must be one of 'ab' 'cd' 'ef'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "nin_ignore_caseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "nin_ignore_caseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNin_ignore_caseString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"nin_ignore_case=ab cd ef"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nin_ignore_case=ab cd ef`)},
+					},
+				},
+			},
+			want: `func nin_ignore_caseStructValidate(obj *nin_ignore_caseStruct) []error {
+var errs []error
+if !(!types.EqualFold(obj.FieldNin_ignore_caseString, "ab") && !types.EqualFold(obj.FieldNin_ignore_caseString, "cd") && !types.EqualFold(obj.FieldNin_ignore_caseString, "ef")) {
+errs = append(errs, types.NewValidationError("FieldNin_ignore_caseString must not be one of 'ab' 'cd' 'ef'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64"`,
+						},
+
+						{
+							FieldName: "FieldBase64ByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64`)},
+					},
+				},
+			},
+			want: `func base64StructValidate(obj *base64Struct) []error {
+var errs []error
+if !(types.IsValidBase64(obj.FieldBase64String)) {
+errs = append(errs, types.NewValidationError("FieldBase64String must be a valid base64 string"))
+}
+if !(types.IsValidBase64(string(obj.FieldBase64ByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64ByteSlice must be a valid base64 string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64urlString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64url"`,
+						},
+
+						{
+							FieldName: "FieldBase64urlByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64url`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64url`)},
+					},
+				},
+			},
+			want: `func base64urlStructValidate(obj *base64urlStruct) []error {
+var errs []error
+if !(types.IsValidBase64URL(obj.FieldBase64urlString)) {
+errs = append(errs, types.NewValidationError("FieldBase64urlString must be a valid base64 URL string"))
+}
+if !(types.IsValidBase64URL(string(obj.FieldBase64urlByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64urlByteSlice must be a valid base64 URL string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64rawurlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64rawurlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64rawurlString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64rawurl"`,
+						},
+
+						{
+							FieldName: "FieldBase64rawurlByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64rawurl"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64rawurl`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64rawurl`)},
+					},
+				},
+			},
+			want: `func base64rawurlStructValidate(obj *base64rawurlStruct) []error {
+var errs []error
+if !(types.IsValidBase64RawURL(obj.FieldBase64rawurlString)) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlString must be a valid raw base64 URL string"))
+}
+if !(types.IsValidBase64RawURL(string(obj.FieldBase64rawurlByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlice must be a valid raw base64 URL string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hexadecimalStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hexadecimalStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHexadecimalString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"hexadecimal"`,
+						},
+
+						{
+							FieldName: "FieldHexadecimalByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hexadecimal"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexadecimal`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexadecimal`)},
+					},
+				},
+			},
+			want: `func hexadecimalStructValidate(obj *hexadecimalStruct) []error {
+var errs []error
+if !(types.IsValidHexadecimal(obj.FieldHexadecimalString)) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalString must be a valid hexadecimal string"))
+}
+if !(types.IsValidHexadecimal(string(obj.FieldHexadecimalByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlice must be a valid hexadecimal string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "jsonStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "jsonStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldJsonString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"json"`,
+						},
+
+						{
+							FieldName: "FieldJsonByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"json"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `json`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `json`)},
+					},
+				},
+			},
+			want: `func jsonStructValidate(obj *jsonStruct) []error {
+var errs []error
+if !(types.IsValidJSON(obj.FieldJsonString)) {
+errs = append(errs, types.NewValidationError("FieldJsonString must be a valid JSON"))
+}
+if !(types.IsValidJSON(string(obj.FieldJsonByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldJsonByteSlice must be a valid JSON"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "jwtStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "jwtStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldJwtString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"jwt"`,
+						},
+
+						{
+							FieldName: "FieldJwtByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"jwt"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `jwt`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `jwt`)},
+					},
+				},
+			},
+			want: `func jwtStructValidate(obj *jwtStruct) []error {
+var errs []error
+if !(types.IsValidJWT(obj.FieldJwtString)) {
+errs = append(errs, types.NewValidationError("FieldJwtString must be a valid JWT"))
+}
+if !(types.IsValidJWT(string(obj.FieldJwtByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldJwtByteSlice must be a valid JWT"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hexcolorStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hexcolorStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHexcolorString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"hexcolor"`,
+						},
+
+						{
+							FieldName: "FieldHexcolorByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hexcolor"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexcolor`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexcolor`)},
+					},
+				},
+			},
+			want: `func hexcolorStructValidate(obj *hexcolorStruct) []error {
+var errs []error
+if !(types.IsValidHexColor(obj.FieldHexcolorString)) {
+errs = append(errs, types.NewValidationError("FieldHexcolorString must be a valid hex color"))
+}
+if !(types.IsValidHexColor(string(obj.FieldHexcolorByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHexcolorByteSlice must be a valid hex color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "rgbStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "rgbStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRgbString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"rgb"`,
+						},
+
+						{
+							FieldName: "FieldRgbByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"rgb"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgb`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgb`)},
+					},
+				},
+			},
+			want: `func rgbStructValidate(obj *rgbStruct) []error {
+var errs []error
+if !(types.IsValidRGB(obj.FieldRgbString)) {
+errs = append(errs, types.NewValidationError("FieldRgbString must be a valid RGB color"))
+}
+if !(types.IsValidRGB(string(obj.FieldRgbByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldRgbByteSlice must be a valid RGB color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "rgbaStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "rgbaStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRgbaString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"rgba"`,
+						},
+
+						{
+							FieldName: "FieldRgbaByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"rgba"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgba`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgba`)},
+					},
+				},
+			},
+			want: `func rgbaStructValidate(obj *rgbaStruct) []error {
+var errs []error
+if !(types.IsValidRGBA(obj.FieldRgbaString)) {
+errs = append(errs, types.NewValidationError("FieldRgbaString must be a valid RGBA color"))
+}
+if !(types.IsValidRGBA(string(obj.FieldRgbaByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldRgbaByteSlice must be a valid RGBA color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hslStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hslStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHslString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"hsl"`,
+						},
+
+						{
+							FieldName: "FieldHslByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hsl"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hsl`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hsl`)},
+					},
+				},
+			},
+			want: `func hslStructValidate(obj *hslStruct) []error {
+var errs []error
+if !(types.IsValidHSL(obj.FieldHslString)) {
+errs = append(errs, types.NewValidationError("FieldHslString must be a valid HSL color"))
+}
+if !(types.IsValidHSL(string(obj.FieldHslByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHslByteSlice must be a valid HSL color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "datauriStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "datauriStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDatauriString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"datauri"`,
+						},
+
+						{
+							FieldName: "FieldDatauriByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"datauri"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datauri`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datauri`)},
+					},
+				},
+			},
+			want: `func datauriStructValidate(obj *datauriStruct) []error {
+var errs []error
+if !(types.IsValidDataURI(obj.FieldDatauriString)) {
+errs = append(errs, types.NewValidationError("FieldDatauriString must be a valid data URI"))
+}
+if !(types.IsValidDataURI(string(obj.FieldDatauriByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldDatauriByteSlice must be a valid data URI"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "mimetypeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "mimetypeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMimetypeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"mimetype"`,
+						},
+
+						{
+							FieldName: "FieldMimetypeByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"mimetype"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mimetype`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mimetype`)},
+					},
+				},
+			},
+			want: `func mimetypeStructValidate(obj *mimetypeStruct) []error {
+var errs []error
+if !(types.IsValidMIMEType(obj.FieldMimetypeString)) {
+errs = append(errs, types.NewValidationError("FieldMimetypeString must be a valid MIME type"))
+}
+if !(types.IsValidMIMEType(string(obj.FieldMimetypeByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldMimetypeByteSlice must be a valid MIME type"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "datetimeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "datetimeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDatetimeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"datetime=2006-01-02"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datetime=2006-01-02`)},
+					},
+				},
+			},
+			want: `func datetimeStructValidate(obj *datetimeStruct) []error {
+var errs []error
+if !(types.IsValidDatetime(obj.FieldDatetimeString, "2006-01-02")) {
+errs = append(errs, types.NewValidationError("FieldDatetimeString must be a valid datetime with layout '2006-01-02'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "timezoneStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "timezoneStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldTimezoneString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"timezone"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `timezone`)},
+					},
+				},
+			},
+			want: `func timezoneStructValidate(obj *timezoneStruct) []error {
+var errs []error
+if !(types.IsValidTimezone(obj.FieldTimezoneString)) {
+errs = append(errs, types.NewValidationError("FieldTimezoneString must be a valid time zone"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "durationStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "durationStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDurationString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"duration"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `duration`)},
+					},
+				},
+			},
+			want: `func durationStructValidate(obj *durationStruct) []error {
+var errs []error
+if !(types.IsValidDuration(obj.FieldDurationString)) {
+errs = append(errs, types.NewValidationError("FieldDurationString must be a valid duration"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpfStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpfStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpfString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf`)},
+					},
+				},
+			},
+			want: `func cpfStructValidate(obj *cpfStruct) []error {
+var errs []error
+if !(types.IsValidCPF(obj.FieldCpfString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpfString must be a valid CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_formattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_formatted`)},
+					},
+				},
+			},
+			want: `func cpf_formattedStructValidate(obj *cpf_formattedStruct) []error {
+var errs []error
+if !(types.IsValidCPF(obj.FieldCpf_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_formattedString must be a valid formatted CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_unformattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_unformatted`)},
+					},
+				},
+			},
+			want: `func cpf_unformattedStructValidate(obj *cpf_unformattedStruct) []error {
+var errs []error
+if !(types.IsValidCPF(obj.FieldCpf_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_unformattedString must be a valid unformatted CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpjStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpjStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpjString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj`)},
+					},
+				},
+			},
+			want: `func cnpjStructValidate(obj *cnpjStruct) []error {
+var errs []error
+if !(types.IsValidCNPJ(obj.FieldCnpjString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCnpjString must be a valid CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpj_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpj_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpj_formattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj_formatted`)},
+					},
+				},
+			},
+			want: `func cnpj_formattedStructValidate(obj *cnpj_formattedStruct) []error {
+var errs []error
+if !(types.IsValidCNPJ(obj.FieldCnpj_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_formattedString must be a valid formatted CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpj_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpj_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpj_unformattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj_unformatted`)},
+					},
+				},
+			},
+			want: `func cnpj_unformattedStructValidate(obj *cnpj_unformattedStruct) []error {
+var errs []error
+if !(types.IsValidCNPJ(obj.FieldCnpj_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_unformattedString must be a valid unformatted CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cepStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cepStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCepString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep`)},
+					},
+				},
+			},
+			want: `func cepStructValidate(obj *cepStruct) []error {
+var errs []error
+if !(types.IsValidCEP(obj.FieldCepString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCepString must be a valid CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cep_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cep_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCep_formattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep_formatted`)},
+					},
+				},
+			},
+			want: `func cep_formattedStructValidate(obj *cep_formattedStruct) []error {
+var errs []error
+if !(types.IsValidCEP(obj.FieldCep_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_formattedString must be a valid formatted CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cep_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cep_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCep_unformattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep_unformatted`)},
+					},
+				},
+			},
+			want: `func cep_unformattedStructValidate(obj *cep_unformattedStruct) []error {
+var errs []error
+if !(types.IsValidCEP(obj.FieldCep_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_unformattedString must be a valid unformatted CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpjStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpjStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpjString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj`)},
+					},
+				},
+			},
+			want: `func cpf_cnpjStructValidate(obj *cpf_cnpjStruct) []error {
+var errs []error
+if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpjString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpjString must be a valid CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpj_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpj_formattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj_formatted`)},
+					},
+				},
+			},
+			want: `func cpf_cnpj_formattedStructValidate(obj *cpf_cnpj_formattedStruct) []error {
+var errs []error
+if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedString must be a valid formatted CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpj_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpj_unformattedString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj_unformatted`)},
+					},
+				},
+			},
+			want: `func cpf_cnpj_unformattedStructValidate(obj *cpf_cnpj_unformattedStruct) []error {
+var errs []error
+if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedString must be a valid unformatted CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "luhn_checksumStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "luhn_checksumStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLuhn_checksumString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"luhn_checksum"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `luhn_checksum`)},
+					},
+				},
+			},
+			want: `func luhn_checksumStructValidate(obj *luhn_checksumStruct) []error {
+var errs []error
+if !(types.IsValidLuhnChecksum(obj.FieldLuhn_checksumString)) {
+errs = append(errs, types.NewValidationError("FieldLuhn_checksumString must have a valid Luhn checksum"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "credit_cardStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "credit_cardStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCredit_cardString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"credit_card"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `credit_card`)},
+					},
+				},
+			},
+			want: `func credit_cardStructValidate(obj *credit_cardStruct) []error {
+var errs []error
+if !(types.IsValidCreditCard(obj.FieldCredit_cardString)) {
+errs = append(errs, types.NewValidationError("FieldCredit_cardString must be a valid credit card number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ibanStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ibanStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIbanString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iban"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iban`)},
+					},
+				},
+			},
+			want: `func ibanStructValidate(obj *ibanStruct) []error {
+var errs []error
+if !(types.IsValidIBAN(obj.FieldIbanString)) {
+errs = append(errs, types.NewValidationError("FieldIbanString must be a valid IBAN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "bicStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "bicStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBicString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"bic"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `bic`)},
+					},
+				},
+			},
+			want: `func bicStructValidate(obj *bicStruct) []error {
+var errs []error
+if !(types.IsValidBIC(obj.FieldBicString)) {
+errs = append(errs, types.NewValidationError("FieldBicString must be a valid BIC"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "isbn10Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "isbn10Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIsbn10String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"isbn10"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `isbn10`)},
+					},
+				},
+			},
+			want: `func isbn10StructValidate(obj *isbn10Struct) []error {
+var errs []error
+if !(types.IsValidISBN10(obj.FieldIsbn10String)) {
+errs = append(errs, types.NewValidationError("FieldIsbn10String must be a valid ISBN-10"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "isbn13Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "isbn13Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIsbn13String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"isbn13"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `isbn13`)},
+					},
+				},
+			},
+			want: `func isbn13StructValidate(obj *isbn13Struct) []error {
+var errs []error
+if !(types.IsValidISBN13(obj.FieldIsbn13String)) {
+errs = append(errs, types.NewValidationError("FieldIsbn13String must be a valid ISBN-13"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "issnStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "issnStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIssnString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"issn"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `issn`)},
+					},
+				},
+			},
+			want: `func issnStructValidate(obj *issnStruct) []error {
+var errs []error
+if !(types.IsValidISSN(obj.FieldIssnString)) {
+errs = append(errs, types.NewValidationError("FieldIssnString must be a valid ISSN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ean8Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ean8Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEan8String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ean8"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ean8`)},
+					},
+				},
+			},
+			want: `func ean8StructValidate(obj *ean8Struct) []error {
+var errs []error
+if !(types.IsValidEAN8(obj.FieldEan8String)) {
+errs = append(errs, types.NewValidationError("FieldEan8String must be a valid EAN-8"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ean13Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ean13Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEan13String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"ean13"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ean13`)},
+					},
+				},
+			},
+			want: `func ean13StructValidate(obj *ean13Struct) []error {
+var errs []error
+if !(types.IsValidEAN13(obj.FieldEan13String)) {
+errs = append(errs, types.NewValidationError("FieldEan13String must be a valid EAN-13"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "upcStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "upcStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUpcString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"upc"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `upc`)},
+					},
+				},
+			},
+			want: `func upcStructValidate(obj *upcStruct) []error {
+var errs []error
+if !(types.IsValidUPC(obj.FieldUpcString)) {
+errs = append(errs, types.NewValidationError("FieldUpcString must be a valid UPC"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha2Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_alpha2Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_alpha2String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_alpha2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_alpha2`)},
+					},
+				},
+			},
+			want: `func iso3166_1_alpha2StructValidate(obj *iso3166_1_alpha2Struct) []error {
+var errs []error
+if !(types.IsValidISO3166Alpha2(obj.FieldIso3166_1_alpha2String)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2String must be a valid ISO 3166-1 alpha-2 country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha3Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_alpha3Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_alpha3String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_alpha3"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_alpha3`)},
+					},
+				},
+			},
+			want: `func iso3166_1_alpha3StructValidate(obj *iso3166_1_alpha3Struct) []error {
+var errs []error
+if !(types.IsValidISO3166Alpha3(obj.FieldIso3166_1_alpha3String)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3String must be a valid ISO 3166-1 alpha-3 country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_numericString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+				},
+			},
+			want: `func iso3166_1_numericStructValidate(obj *iso3166_1_numericStruct) []error {
+var errs []error
+if !(types.IsValidISO3166NumericString(obj.FieldIso3166_1_numericString)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericString must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt8))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt16))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt32))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt64))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint8))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint16))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint32))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32 must be a valid ISO 3166-1 numeric country code"))
+}
+if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint64))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64 must be a valid ISO 3166-1 numeric country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso4217Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso4217Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso4217String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso4217"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217`)},
+					},
+				},
+			},
+			want: `func iso4217StructValidate(obj *iso4217Struct) []error {
+var errs []error
+if !(types.IsValidISO4217(obj.FieldIso4217String)) {
+errs = append(errs, types.NewValidationError("FieldIso4217String must be a valid ISO 4217 currency code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso4217_numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso4217_numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso4217_numericString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+				},
+			},
+			want: `func iso4217_numericStructValidate(obj *iso4217_numericStruct) []error {
+var errs []error
+if !(types.IsValidISO4217NumericString(obj.FieldIso4217_numericString)) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericString must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt8))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt16))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt32))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt64))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint8))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint16))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint32))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32 must be a valid ISO 4217 numeric currency code"))
+}
+if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint64))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64 must be a valid ISO 4217 numeric currency code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "bcp47_language_tagStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "bcp47_language_tagStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBcp47_language_tagString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"bcp47_language_tag"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `bcp47_language_tag`)},
+					},
+				},
+			},
+			want: `func bcp47_language_tagStructValidate(obj *bcp47_language_tagStruct) []error {
+var errs []error
+if !(types.IsValidBCP47LanguageTag(obj.FieldBcp47_language_tagString)) {
+errs = append(errs, types.NewValidationError("FieldBcp47_language_tagString must be a valid BCP 47 language tag"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "e164Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "e164Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldE164String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"e164"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `e164`)},
+					},
+				},
+			},
+			want: `func e164StructValidate(obj *e164Struct) []error {
+var errs []error
+if !(types.IsValidE164(obj.FieldE164String)) {
+errs = append(errs, types.NewValidationError("FieldE164String must be a valid E.164 phone number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "postcode_iso3166_alpha2Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "postcode_iso3166_alpha2Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPostcode_iso3166_alpha2String",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"postcode_iso3166_alpha2=BR"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `postcode_iso3166_alpha2=BR`)},
+					},
+				},
+			},
+			want: `func postcode_iso3166_alpha2StructValidate(obj *postcode_iso3166_alpha2Struct) []error {
+var errs []error
+if !(types.IsValidPostcode(obj.FieldPostcode_iso3166_alpha2String, "BR")) {
+errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2String must be a valid postal code for 'BR'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "latitudeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "latitudeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLatitudeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+
+						{
+							FieldName: "FieldLatitudeFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+
+						{
+							FieldName: "FieldLatitudeFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+				},
+			},
+			want: `func latitudeStructValidate(obj *latitudeStruct) []error {
+var errs []error
+if !(types.IsValidLatitude(obj.FieldLatitudeString)) {
+errs = append(errs, types.NewValidationError("FieldLatitudeString must be a valid latitude (-90 to 90)"))
+}
+if !(obj.FieldLatitudeFloat32 >= -90 && obj.FieldLatitudeFloat32 <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat32 must be a valid latitude (-90 to 90)"))
+}
+if !(obj.FieldLatitudeFloat64 >= -90 && obj.FieldLatitudeFloat64 <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat64 must be a valid latitude (-90 to 90)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "longitudeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "longitudeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLongitudeString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+
+						{
+							FieldName: "FieldLongitudeFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+
+						{
+							FieldName: "FieldLongitudeFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+				},
+			},
+			want: `func longitudeStructValidate(obj *longitudeStruct) []error {
+var errs []error
+if !(types.IsValidLongitude(obj.FieldLongitudeString)) {
+errs = append(errs, types.NewValidationError("FieldLongitudeString must be a valid longitude (-180 to 180)"))
+}
+if !(obj.FieldLongitudeFloat32 >= -180 && obj.FieldLongitudeFloat32 <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat32 must be a valid longitude (-180 to 180)"))
+}
+if !(obj.FieldLongitudeFloat64 >= -180 && obj.FieldLongitudeFloat64 <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat64 must be a valid longitude (-180 to 180)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "semverStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "semverStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldSemverString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"semver"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `semver`)},
+					},
+				},
+			},
+			want: `func semverStructValidate(obj *semverStruct) []error {
+var errs []error
+if !(types.IsValidSemver(obj.FieldSemverString)) {
+errs = append(errs, types.NewValidationError("FieldSemverString must be a valid semantic version (e.g. 1.2.3)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cronStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cronStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCronString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"cron"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cron`)},
+					},
+				},
+			},
+			want: `func cronStructValidate(obj *cronStruct) []error {
+var errs []error
+if !(types.IsValidCron(obj.FieldCronString)) {
+errs = append(errs, types.NewValidationError("FieldCronString must be a valid cron expression (5 fields, or 6 with seconds)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "finiteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "finiteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldFiniteFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"finite"`,
+						},
+
+						{
+							FieldName: "FieldFiniteFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"finite"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `finite`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `finite`)},
+					},
+				},
+			},
+			want: `func finiteStructValidate(obj *finiteStruct) []error {
+var errs []error
+if !(types.IsFinite(obj.FieldFiniteFloat32)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat32 must be a finite number"))
+}
+if !(types.IsFinite(obj.FieldFiniteFloat64)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat64 must be a finite number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "multipleofStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "multipleofStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMultipleofInt",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"multipleof=0.25"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"multipleof=0.25"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=0.25`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=0.25`)},
+					},
+				},
+			},
+			want: `func multipleofStructValidate(obj *multipleofStruct) []error {
+var errs []error
+if !(obj.FieldMultipleofInt%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt8%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt8 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt16%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt16 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt32%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt32 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt64%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt64 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint8%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint8 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint16%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint16 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint32%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint32 must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint64%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint64 must be a multiple of 5"))
+}
+if !(types.IsMultipleOf(obj.FieldMultipleofFloat32, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat32 must be a multiple of 0.25"))
+}
+if !(types.IsMultipleOf(obj.FieldMultipleofFloat64, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat64 must be a multiple of 0.25"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "maxdecimalsStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "maxdecimalsStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMaxdecimalsFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"maxdecimals=2"`,
+						},
+
+						{
+							FieldName: "FieldMaxdecimalsFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"maxdecimals=2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `maxdecimals=2`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `maxdecimals=2`)},
+					},
+				},
+			},
+			want: `func maxdecimalsStructValidate(obj *maxdecimalsStruct) []error {
+var errs []error
+if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat32, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32 must have at most 2 decimal places"))
+}
+if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat64, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64 must have at most 2 decimal places"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "positiveStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "positiveStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPositiveInt",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+				},
+			},
+			want: `func positiveStructValidate(obj *positiveStruct) []error {
+var errs []error
+if !(obj.FieldPositiveInt > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt must be positive"))
+}
+if !(obj.FieldPositiveInt8 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt8 must be positive"))
+}
+if !(obj.FieldPositiveInt16 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt16 must be positive"))
+}
+if !(obj.FieldPositiveInt32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt32 must be positive"))
+}
+if !(obj.FieldPositiveInt64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt64 must be positive"))
+}
+if !(obj.FieldPositiveUint > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint must be positive"))
+}
+if !(obj.FieldPositiveUint8 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint8 must be positive"))
+}
+if !(obj.FieldPositiveUint16 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint16 must be positive"))
+}
+if !(obj.FieldPositiveUint32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint32 must be positive"))
+}
+if !(obj.FieldPositiveUint64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint64 must be positive"))
+}
+if !(obj.FieldPositiveFloat32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat32 must be positive"))
+}
+if !(obj.FieldPositiveFloat64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat64 must be positive"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "negativeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "negativeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNegativeFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"negative"`,
+						},
+
+						{
+							FieldName: "FieldNegativeFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"negative"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `negative`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `negative`)},
+					},
+				},
+			},
+			want: `func negativeStructValidate(obj *negativeStruct) []error {
+var errs []error
+if !(obj.FieldNegativeFloat32 < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat32 must be negative"))
+}
+if !(obj.FieldNegativeFloat64 < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat64 must be negative"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "nonzeroStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "nonzeroStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNonzeroInt",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint8",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint16",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroFloat32",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroFloat64",
+							Type:      common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+				},
+			},
+			want: `func nonzeroStructValidate(obj *nonzeroStruct) []error {
+var errs []error
+if !(obj.FieldNonzeroInt != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt must not be zero"))
+}
+if !(obj.FieldNonzeroInt8 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt8 must not be zero"))
+}
+if !(obj.FieldNonzeroInt16 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt16 must not be zero"))
+}
+if !(obj.FieldNonzeroInt32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt32 must not be zero"))
+}
+if !(obj.FieldNonzeroInt64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt64 must not be zero"))
+}
+if !(obj.FieldNonzeroUint != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint must not be zero"))
+}
+if !(obj.FieldNonzeroUint8 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint8 must not be zero"))
+}
+if !(obj.FieldNonzeroUint16 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint16 must not be zero"))
+}
+if !(obj.FieldNonzeroUint32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint32 must not be zero"))
+}
+if !(obj.FieldNonzeroUint64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint64 must not be zero"))
+}
+if !(obj.FieldNonzeroFloat32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat32 must not be zero"))
+}
+if !(obj.FieldNonzeroFloat64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat64 must not be zero"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimalStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimalStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimalString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal=12,2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal=12,2`)},
+					},
+				},
+			},
+			want: `func decimalStructValidate(obj *decimalStruct) []error {
+var errs []error
+if !(types.IsValidDecimal(obj.FieldDecimalString, 12,2)) {
+errs = append(errs, types.NewValidationError("FieldDecimalString must be a decimal(12,2)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_gtStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_gtStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_gtString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_gt=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_gt=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_gtStructValidate(obj *decimal_gtStruct) []error {
+var errs []error
+if !(types.IsDecimalGT(obj.FieldDecimal_gtString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gtString must be a decimal > 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_gteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_gteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_gteString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_gte=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_gte=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_gteStructValidate(obj *decimal_gteStruct) []error {
+var errs []error
+if !(types.IsDecimalGTE(obj.FieldDecimal_gteString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gteString must be a decimal >= 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_ltStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_ltStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_ltString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_lt=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_lt=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_ltStructValidate(obj *decimal_ltStruct) []error {
+var errs []error
+if !(types.IsDecimalLT(obj.FieldDecimal_ltString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_ltString must be a decimal < 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_lteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_lteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_lteString",
+							Type:      common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_lte=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_lte=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_lteStructValidate(obj *decimal_lteStruct) []error {
+var errs []error
+if !(types.IsDecimalLTE(obj.FieldDecimal_lteString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_lteString must be a decimal <= 0.01"))
+}
+return errs
+}
 `,
 		},
 		{
@@ -224,6 +4446,12 @@ return errs
 							Tag:       `validate:"required"`,
 						},
 
+						{
+							FieldName: "FieldRequiredByteSlice",
+							Type:      common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"required"`,
+						},
+
 						{
 							FieldName: "FieldRequiredStringMap",
 							Type:      common.FieldType{ComposedType: "map", BaseType: "string", Size: ""},
@@ -478,6 +4706,10 @@ return errs
 					{
 						Validations: []*analyzer.Validation{AssertParserValidation(t, `required`)},
 					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `required`)},
+					},
 				},
 			},
 			want: `func requiredStructValidate(obj *requiredStruct) []error {
@@ -566,6 +4798,9 @@ errs = append(errs, types.NewValidationError("FieldRequiredFloat64Slice must not
 if !(len(obj.FieldRequiredBoolSlice) != 0) {
 errs = append(errs, types.NewValidationError("FieldRequiredBoolSlice must not be empty"))
 }
+if !(len(obj.FieldRequiredByteSlice) != 0) {
+errs = append(errs, types.NewValidationError("FieldRequiredByteSlice must not be empty"))
+}
 if !(len(obj.FieldRequiredStringMap) != 0) {
 errs = append(errs, types.NewValidationError("FieldRequiredStringMap must not be empty"))
 }
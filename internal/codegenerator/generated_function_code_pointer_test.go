@@ -46,6 +46,4228 @@ errs = append(errs, types.NewValidationError("FieldEmailStringPointer must be a
 }
 return errs
 }
+`,
+		},
+		{
+			name: "regexStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "regexStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRegexStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"regex='^[a-z]{2,5}$'"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `regex='^[a-z]{2,5}$'`)},
+					},
+				},
+			},
+			want: `func regexStructValidate(obj *regexStruct) []error {
+var errs []error
+if !(obj.FieldRegexStringPointer != nil && validgenRegex691022c6.MatchString(*obj.FieldRegexStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRegexStringPointer must match the pattern '^[a-z]{2,5}$'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUrlStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `url`)},
+					},
+				},
+			},
+			want: `func urlStructValidate(obj *urlStruct) []error {
+var errs []error
+if !(obj.FieldUrlStringPointer != nil && types.IsValidURL(*obj.FieldUrlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUrlStringPointer must be a valid URL"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uriStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uriStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUriStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uri"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uri`)},
+					},
+				},
+			},
+			want: `func uriStructValidate(obj *uriStruct) []error {
+var errs []error
+if !(obj.FieldUriStringPointer != nil && types.IsValidURI(*obj.FieldUriStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUriStringPointer must be a valid URI"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "http_urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "http_urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHttp_urlStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"http_url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `http_url`)},
+					},
+				},
+			},
+			want: `func http_urlStructValidate(obj *http_urlStruct) []error {
+var errs []error
+if !(obj.FieldHttp_urlStringPointer != nil && types.IsValidHTTPURL(*obj.FieldHttp_urlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHttp_urlStringPointer must be a valid HTTP URL"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "url_schemeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "url_schemeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUrl_schemeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"url_scheme=https wss"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `url_scheme=https wss`)},
+					},
+				},
+			},
+			want: `func url_schemeStructValidate(obj *url_schemeStruct) []error {
+var errs []error
+if !(obj.FieldUrl_schemeStringPointer != nil && types.IsValidURLWithScheme(*obj.FieldUrl_schemeStringPointer, []string{"https", "wss"})) {
+errs = append(errs, types.NewValidationError("FieldUrl_schemeStringPointer must be a valid URL with scheme 'https' 'wss'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ip`)},
+					},
+				},
+			},
+			want: `func ipStructValidate(obj *ipStruct) []error {
+var errs []error
+if !(obj.FieldIpStringPointer != nil && types.IsValidIP(*obj.FieldIpStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpStringPointer must be a valid IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipv4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipv4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpv4StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ipv4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ipv4`)},
+					},
+				},
+			},
+			want: `func ipv4StructValidate(obj *ipv4Struct) []error {
+var errs []error
+if !(obj.FieldIpv4StringPointer != nil && types.IsValidIPv4(*obj.FieldIpv4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpv4StringPointer must be a valid IPv4 address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ipv6Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ipv6Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIpv6StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ipv6"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ipv6`)},
+					},
+				},
+			},
+			want: `func ipv6StructValidate(obj *ipv6Struct) []error {
+var errs []error
+if !(obj.FieldIpv6StringPointer != nil && types.IsValidIPv6(*obj.FieldIpv6StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpv6StringPointer must be a valid IPv6 address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidr"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidr`)},
+					},
+				},
+			},
+			want: `func cidrStructValidate(obj *cidrStruct) []error {
+var errs []error
+if !(obj.FieldCidrStringPointer != nil && types.IsValidCIDR(*obj.FieldCidrStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrStringPointer must be a valid CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrv4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrv4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrv4StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidrv4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidrv4`)},
+					},
+				},
+			},
+			want: `func cidrv4StructValidate(obj *cidrv4Struct) []error {
+var errs []error
+if !(obj.FieldCidrv4StringPointer != nil && types.IsValidCIDRv4(*obj.FieldCidrv4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrv4StringPointer must be a valid IPv4 CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cidrv6Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cidrv6Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCidrv6StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cidrv6"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cidrv6`)},
+					},
+				},
+			},
+			want: `func cidrv6StructValidate(obj *cidrv6Struct) []error {
+var errs []error
+if !(obj.FieldCidrv6StringPointer != nil && types.IsValidCIDRv6(*obj.FieldCidrv6StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrv6StringPointer must be a valid IPv6 CIDR"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "private_ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "private_ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPrivate_ipStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"private_ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `private_ip`)},
+					},
+				},
+			},
+			want: `func private_ipStructValidate(obj *private_ipStruct) []error {
+var errs []error
+if !(obj.FieldPrivate_ipStringPointer != nil && types.IsPrivateIP(*obj.FieldPrivate_ipStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPrivate_ipStringPointer must be a private IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "public_ipStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "public_ipStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPublic_ipStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"public_ip"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `public_ip`)},
+					},
+				},
+			},
+			want: `func public_ipStructValidate(obj *public_ipStruct) []error {
+var errs []error
+if !(obj.FieldPublic_ipStringPointer != nil && types.IsPublicIP(*obj.FieldPublic_ipStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPublic_ipStringPointer must be a public IP address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "macStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "macStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMacStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"mac"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mac`)},
+					},
+				},
+			},
+			want: `func macStructValidate(obj *macStruct) []error {
+var errs []error
+if !(obj.FieldMacStringPointer != nil && types.IsValidMAC(*obj.FieldMacStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMacStringPointer must be a valid MAC address"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hostnameStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hostnameStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHostnameStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"hostname"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hostname`)},
+					},
+				},
+			},
+			want: `func hostnameStructValidate(obj *hostnameStruct) []error {
+var errs []error
+if !(obj.FieldHostnameStringPointer != nil && types.IsValidHostname(*obj.FieldHostnameStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHostnameStringPointer must be a valid hostname"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "fqdnStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "fqdnStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldFqdnStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"fqdn"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `fqdn`)},
+					},
+				},
+			},
+			want: `func fqdnStructValidate(obj *fqdnStruct) []error {
+var errs []error
+if !(obj.FieldFqdnStringPointer != nil && types.IsValidFQDN(*obj.FieldFqdnStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldFqdnStringPointer must be a valid FQDN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hostname_portStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hostname_portStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHostname_portStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"hostname_port"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hostname_port`)},
+					},
+				},
+			},
+			want: `func hostname_portStructValidate(obj *hostname_portStruct) []error {
+var errs []error
+if !(obj.FieldHostname_portStringPointer != nil && types.IsValidHostnamePort(*obj.FieldHostname_portStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHostname_portStringPointer must be a valid hostname and port"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuidStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuidStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuidStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid`)},
+					},
+				},
+			},
+			want: `func uuidStructValidate(obj *uuidStruct) []error {
+var errs []error
+if !(obj.FieldUuidStringPointer != nil && types.IsValidUUID(*obj.FieldUuidStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuidStringPointer must be a valid UUID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid3Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid3Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid3StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid3"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid3`)},
+					},
+				},
+			},
+			want: `func uuid3StructValidate(obj *uuid3Struct) []error {
+var errs []error
+if !(obj.FieldUuid3StringPointer != nil && types.IsValidUUID3(*obj.FieldUuid3StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid3StringPointer must be a valid UUID v3"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid4Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid4Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid4StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid4"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid4`)},
+					},
+				},
+			},
+			want: `func uuid4StructValidate(obj *uuid4Struct) []error {
+var errs []error
+if !(obj.FieldUuid4StringPointer != nil && types.IsValidUUID4(*obj.FieldUuid4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid4StringPointer must be a valid UUID v4"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid5Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid5Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid5StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid5"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid5`)},
+					},
+				},
+			},
+			want: `func uuid5StructValidate(obj *uuid5Struct) []error {
+var errs []error
+if !(obj.FieldUuid5StringPointer != nil && types.IsValidUUID5(*obj.FieldUuid5StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid5StringPointer must be a valid UUID v5"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uuid7Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uuid7Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUuid7StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uuid7"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uuid7`)},
+					},
+				},
+			},
+			want: `func uuid7StructValidate(obj *uuid7Struct) []error {
+var errs []error
+if !(obj.FieldUuid7StringPointer != nil && types.IsValidUUID7(*obj.FieldUuid7StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid7StringPointer must be a valid UUID v7"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ulidStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ulidStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUlidStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ulid"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ulid`)},
+					},
+				},
+			},
+			want: `func ulidStructValidate(obj *ulidStruct) []error {
+var errs []error
+if !(obj.FieldUlidStringPointer != nil && types.IsValidULID(*obj.FieldUlidStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUlidStringPointer must be a valid ULID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "mongodbStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "mongodbStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMongodbStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"mongodb"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mongodb`)},
+					},
+				},
+			},
+			want: `func mongodbStructValidate(obj *mongodbStruct) []error {
+var errs []error
+if !(obj.FieldMongodbStringPointer != nil && types.IsValidMongoDBObjectID(*obj.FieldMongodbStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMongodbStringPointer must be a valid MongoDB ObjectID"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphaStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphaStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphaStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"alpha"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alpha`)},
+					},
+				},
+			},
+			want: `func alphaStructValidate(obj *alphaStruct) []error {
+var errs []error
+if !(obj.FieldAlphaStringPointer != nil && types.IsAlpha(*obj.FieldAlphaStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphaStringPointer must contain only ASCII letters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphanumStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphanumStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphanumStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphanum"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphanum`)},
+					},
+				},
+			},
+			want: `func alphanumStructValidate(obj *alphanumStruct) []error {
+var errs []error
+if !(obj.FieldAlphanumStringPointer != nil && types.IsAlphanumeric(*obj.FieldAlphanumStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumStringPointer must contain only ASCII letters and digits"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphaunicodeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphaunicodeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphaunicodeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphaunicode"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphaunicode`)},
+					},
+				},
+			},
+			want: `func alphaunicodeStructValidate(obj *alphaunicodeStruct) []error {
+var errs []error
+if !(obj.FieldAlphaunicodeStringPointer != nil && types.IsAlphaUnicode(*obj.FieldAlphaunicodeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphaunicodeStringPointer must contain only letters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "alphanumunicodeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "alphanumunicodeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAlphanumunicodeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"alphanumunicode"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `alphanumunicode`)},
+					},
+				},
+			},
+			want: `func alphanumunicodeStructValidate(obj *alphanumunicodeStruct) []error {
+var errs []error
+if !(obj.FieldAlphanumunicodeStringPointer != nil && types.IsAlphanumericUnicode(*obj.FieldAlphanumunicodeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumunicodeStringPointer must contain only letters and numbers"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNumericStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `numeric`)},
+					},
+				},
+			},
+			want: `func numericStructValidate(obj *numericStruct) []error {
+var errs []error
+if !(obj.FieldNumericStringPointer != nil && types.IsNumeric(*obj.FieldNumericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNumericStringPointer must be a numeric value"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "numberStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "numberStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNumberStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"number"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `number`)},
+					},
+				},
+			},
+			want: `func numberStructValidate(obj *numberStruct) []error {
+var errs []error
+if !(obj.FieldNumberStringPointer != nil && types.IsNumber(*obj.FieldNumberStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNumberStringPointer must contain only digits"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "asciiStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "asciiStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldAsciiStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ascii"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ascii`)},
+					},
+				},
+			},
+			want: `func asciiStructValidate(obj *asciiStruct) []error {
+var errs []error
+if !(obj.FieldAsciiStringPointer != nil && types.IsASCII(*obj.FieldAsciiStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAsciiStringPointer must contain only ASCII characters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "printasciiStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "printasciiStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPrintasciiStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"printascii"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `printascii`)},
+					},
+				},
+			},
+			want: `func printasciiStructValidate(obj *printasciiStruct) []error {
+var errs []error
+if !(obj.FieldPrintasciiStringPointer != nil && types.IsPrintableASCII(*obj.FieldPrintasciiStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPrintasciiStringPointer must contain only printable ASCII characters"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "lowercaseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "lowercaseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLowercaseStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"lowercase"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `lowercase`)},
+					},
+				},
+			},
+			want: `func lowercaseStructValidate(obj *lowercaseStruct) []error {
+var errs []error
+if !(obj.FieldLowercaseStringPointer != nil && types.IsLowercase(*obj.FieldLowercaseStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLowercaseStringPointer must be lowercase"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "uppercaseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "uppercaseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUppercaseStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"uppercase"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `uppercase`)},
+					},
+				},
+			},
+			want: `func uppercaseStructValidate(obj *uppercaseStruct) []error {
+var errs []error
+if !(obj.FieldUppercaseStringPointer != nil && types.IsUppercase(*obj.FieldUppercaseStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUppercaseStringPointer must be uppercase"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "notblankStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "notblankStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNotblankStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"notblank"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `notblank`)},
+					},
+				},
+			},
+			want: `func notblankStructValidate(obj *notblankStruct) []error {
+var errs []error
+if !(obj.FieldNotblankStringPointer != nil && types.IsNotBlank(*obj.FieldNotblankStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNotblankStringPointer must not be blank"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"contains=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `contains=ab`)},
+					},
+				},
+			},
+			want: `func containsStructValidate(obj *containsStruct) []error {
+var errs []error
+if !(obj.FieldContainsStringPointer != nil && types.Contains(*obj.FieldContainsStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldContainsStringPointer must contain 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsanyStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsanyStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsanyStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"containsany=!@#"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `containsany=!@#`)},
+					},
+				},
+			},
+			want: `func containsanyStructValidate(obj *containsanyStruct) []error {
+var errs []error
+if !(obj.FieldContainsanyStringPointer != nil && types.ContainsAny(*obj.FieldContainsanyStringPointer, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldContainsanyStringPointer must contain any of '!@#'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "containsruneStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "containsruneStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldContainsruneStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"containsrune=@"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `containsrune=@`)},
+					},
+				},
+			},
+			want: `func containsruneStructValidate(obj *containsruneStruct) []error {
+var errs []error
+if !(obj.FieldContainsruneStringPointer != nil && types.Contains(*obj.FieldContainsruneStringPointer, "@")) {
+errs = append(errs, types.NewValidationError("FieldContainsruneStringPointer must contain '@'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "excludesStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "excludesStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldExcludesStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"excludes=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `excludes=ab`)},
+					},
+				},
+			},
+			want: `func excludesStructValidate(obj *excludesStruct) []error {
+var errs []error
+if !(obj.FieldExcludesStringPointer != nil && !types.Contains(*obj.FieldExcludesStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldExcludesStringPointer must not contain 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "excludesallStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "excludesallStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldExcludesallStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"excludesall=!@#"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `excludesall=!@#`)},
+					},
+				},
+			},
+			want: `func excludesallStructValidate(obj *excludesallStruct) []error {
+var errs []error
+if !(obj.FieldExcludesallStringPointer != nil && !types.ContainsAny(*obj.FieldExcludesallStringPointer, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldExcludesallStringPointer must not contain any of '!@#'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "startswithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "startswithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldStartswithStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"startswith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `startswith=ab`)},
+					},
+				},
+			},
+			want: `func startswithStructValidate(obj *startswithStruct) []error {
+var errs []error
+if !(obj.FieldStartswithStringPointer != nil && types.HasPrefix(*obj.FieldStartswithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartswithStringPointer must start with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "endswithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "endswithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEndswithStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"endswith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `endswith=ab`)},
+					},
+				},
+			},
+			want: `func endswithStructValidate(obj *endswithStruct) []error {
+var errs []error
+if !(obj.FieldEndswithStringPointer != nil && types.HasSuffix(*obj.FieldEndswithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndswithStringPointer must end with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "startsnotwithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "startsnotwithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldStartsnotwithStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"startsnotwith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `startsnotwith=ab`)},
+					},
+				},
+			},
+			want: `func startsnotwithStructValidate(obj *startsnotwithStruct) []error {
+var errs []error
+if !(obj.FieldStartsnotwithStringPointer != nil && !types.HasPrefix(*obj.FieldStartsnotwithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartsnotwithStringPointer must not start with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "endsnotwithStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "endsnotwithStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEndsnotwithStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"endsnotwith=ab"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `endsnotwith=ab`)},
+					},
+				},
+			},
+			want: `func endsnotwithStructValidate(obj *endsnotwithStruct) []error {
+var errs []error
+if !(obj.FieldEndsnotwithStringPointer != nil && !types.HasSuffix(*obj.FieldEndsnotwithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndsnotwithStringPointer must not end with 'ab'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "in_ignore_caseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "in_ignore_caseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIn_ignore_caseStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"in_ignore_case=ab cd ef"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `in_ignore_case=ab cd ef`)},
+					},
+				},
+			},
+			want: `func in_ignore_caseStructValidate(obj *in_ignore_caseStruct) []error {
+var errs []error
+if !(obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ab") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "cd") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ef")) {
+errs = append(errs, types.NewValidationError("FieldIn_ignore_caseStringPointer must be one of 'ab' 'cd' 'ef'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "nin_ignore_caseStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "nin_ignore_caseStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNin_ignore_caseStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"nin_ignore_case=ab cd ef"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nin_ignore_case=ab cd ef`)},
+					},
+				},
+			},
+			want: `func nin_ignore_caseStructValidate(obj *nin_ignore_caseStruct) []error {
+var errs []error
+if !(obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ab") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "cd") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ef")) {
+errs = append(errs, types.NewValidationError("FieldNin_ignore_caseStringPointer must not be one of 'ab' 'cd' 'ef'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64"`,
+						},
+
+						{
+							FieldName: "FieldBase64ByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64`)},
+					},
+				},
+			},
+			want: `func base64StructValidate(obj *base64Struct) []error {
+var errs []error
+if !(obj.FieldBase64StringPointer != nil && types.IsValidBase64(*obj.FieldBase64StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64StringPointer must be a valid base64 string"))
+}
+if !(obj.FieldBase64ByteSlicePointer != nil && types.IsValidBase64(string(*obj.FieldBase64ByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64ByteSlicePointer must be a valid base64 string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64urlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64urlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64urlStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64url"`,
+						},
+
+						{
+							FieldName: "FieldBase64urlByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64url"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64url`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64url`)},
+					},
+				},
+			},
+			want: `func base64urlStructValidate(obj *base64urlStruct) []error {
+var errs []error
+if !(obj.FieldBase64urlStringPointer != nil && types.IsValidBase64URL(*obj.FieldBase64urlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64urlStringPointer must be a valid base64 URL string"))
+}
+if !(obj.FieldBase64urlByteSlicePointer != nil && types.IsValidBase64URL(string(*obj.FieldBase64urlByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64urlByteSlicePointer must be a valid base64 URL string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "base64rawurlStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "base64rawurlStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBase64rawurlStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"base64rawurl"`,
+						},
+
+						{
+							FieldName: "FieldBase64rawurlByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"base64rawurl"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64rawurl`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `base64rawurl`)},
+					},
+				},
+			},
+			want: `func base64rawurlStructValidate(obj *base64rawurlStruct) []error {
+var errs []error
+if !(obj.FieldBase64rawurlStringPointer != nil && types.IsValidBase64RawURL(*obj.FieldBase64rawurlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlStringPointer must be a valid raw base64 URL string"))
+}
+if !(obj.FieldBase64rawurlByteSlicePointer != nil && types.IsValidBase64RawURL(string(*obj.FieldBase64rawurlByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlicePointer must be a valid raw base64 URL string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hexadecimalStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hexadecimalStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHexadecimalStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"hexadecimal"`,
+						},
+
+						{
+							FieldName: "FieldHexadecimalByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hexadecimal"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexadecimal`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexadecimal`)},
+					},
+				},
+			},
+			want: `func hexadecimalStructValidate(obj *hexadecimalStruct) []error {
+var errs []error
+if !(obj.FieldHexadecimalStringPointer != nil && types.IsValidHexadecimal(*obj.FieldHexadecimalStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalStringPointer must be a valid hexadecimal string"))
+}
+if !(obj.FieldHexadecimalByteSlicePointer != nil && types.IsValidHexadecimal(string(*obj.FieldHexadecimalByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlicePointer must be a valid hexadecimal string"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "jsonStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "jsonStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldJsonStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"json"`,
+						},
+
+						{
+							FieldName: "FieldJsonByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"json"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `json`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `json`)},
+					},
+				},
+			},
+			want: `func jsonStructValidate(obj *jsonStruct) []error {
+var errs []error
+if !(obj.FieldJsonStringPointer != nil && types.IsValidJSON(*obj.FieldJsonStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldJsonStringPointer must be a valid JSON"))
+}
+if !(obj.FieldJsonByteSlicePointer != nil && types.IsValidJSON(string(*obj.FieldJsonByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldJsonByteSlicePointer must be a valid JSON"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "jwtStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "jwtStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldJwtStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"jwt"`,
+						},
+
+						{
+							FieldName: "FieldJwtByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"jwt"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `jwt`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `jwt`)},
+					},
+				},
+			},
+			want: `func jwtStructValidate(obj *jwtStruct) []error {
+var errs []error
+if !(obj.FieldJwtStringPointer != nil && types.IsValidJWT(*obj.FieldJwtStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldJwtStringPointer must be a valid JWT"))
+}
+if !(obj.FieldJwtByteSlicePointer != nil && types.IsValidJWT(string(*obj.FieldJwtByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldJwtByteSlicePointer must be a valid JWT"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hexcolorStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hexcolorStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHexcolorStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"hexcolor"`,
+						},
+
+						{
+							FieldName: "FieldHexcolorByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hexcolor"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexcolor`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hexcolor`)},
+					},
+				},
+			},
+			want: `func hexcolorStructValidate(obj *hexcolorStruct) []error {
+var errs []error
+if !(obj.FieldHexcolorStringPointer != nil && types.IsValidHexColor(*obj.FieldHexcolorStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHexcolorStringPointer must be a valid hex color"))
+}
+if !(obj.FieldHexcolorByteSlicePointer != nil && types.IsValidHexColor(string(*obj.FieldHexcolorByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHexcolorByteSlicePointer must be a valid hex color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "rgbStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "rgbStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRgbStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"rgb"`,
+						},
+
+						{
+							FieldName: "FieldRgbByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"rgb"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgb`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgb`)},
+					},
+				},
+			},
+			want: `func rgbStructValidate(obj *rgbStruct) []error {
+var errs []error
+if !(obj.FieldRgbStringPointer != nil && types.IsValidRGB(*obj.FieldRgbStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRgbStringPointer must be a valid RGB color"))
+}
+if !(obj.FieldRgbByteSlicePointer != nil && types.IsValidRGB(string(*obj.FieldRgbByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldRgbByteSlicePointer must be a valid RGB color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "rgbaStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "rgbaStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldRgbaStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"rgba"`,
+						},
+
+						{
+							FieldName: "FieldRgbaByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"rgba"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgba`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `rgba`)},
+					},
+				},
+			},
+			want: `func rgbaStructValidate(obj *rgbaStruct) []error {
+var errs []error
+if !(obj.FieldRgbaStringPointer != nil && types.IsValidRGBA(*obj.FieldRgbaStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRgbaStringPointer must be a valid RGBA color"))
+}
+if !(obj.FieldRgbaByteSlicePointer != nil && types.IsValidRGBA(string(*obj.FieldRgbaByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldRgbaByteSlicePointer must be a valid RGBA color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "hslStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "hslStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldHslStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"hsl"`,
+						},
+
+						{
+							FieldName: "FieldHslByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"hsl"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hsl`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `hsl`)},
+					},
+				},
+			},
+			want: `func hslStructValidate(obj *hslStruct) []error {
+var errs []error
+if !(obj.FieldHslStringPointer != nil && types.IsValidHSL(*obj.FieldHslStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHslStringPointer must be a valid HSL color"))
+}
+if !(obj.FieldHslByteSlicePointer != nil && types.IsValidHSL(string(*obj.FieldHslByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHslByteSlicePointer must be a valid HSL color"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "datauriStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "datauriStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDatauriStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"datauri"`,
+						},
+
+						{
+							FieldName: "FieldDatauriByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"datauri"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datauri`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datauri`)},
+					},
+				},
+			},
+			want: `func datauriStructValidate(obj *datauriStruct) []error {
+var errs []error
+if !(obj.FieldDatauriStringPointer != nil && types.IsValidDataURI(*obj.FieldDatauriStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldDatauriStringPointer must be a valid data URI"))
+}
+if !(obj.FieldDatauriByteSlicePointer != nil && types.IsValidDataURI(string(*obj.FieldDatauriByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldDatauriByteSlicePointer must be a valid data URI"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "mimetypeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "mimetypeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMimetypeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"mimetype"`,
+						},
+
+						{
+							FieldName: "FieldMimetypeByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"mimetype"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mimetype`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `mimetype`)},
+					},
+				},
+			},
+			want: `func mimetypeStructValidate(obj *mimetypeStruct) []error {
+var errs []error
+if !(obj.FieldMimetypeStringPointer != nil && types.IsValidMIMEType(*obj.FieldMimetypeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMimetypeStringPointer must be a valid MIME type"))
+}
+if !(obj.FieldMimetypeByteSlicePointer != nil && types.IsValidMIMEType(string(*obj.FieldMimetypeByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldMimetypeByteSlicePointer must be a valid MIME type"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "datetimeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "datetimeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDatetimeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"datetime=2006-01-02"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `datetime=2006-01-02`)},
+					},
+				},
+			},
+			want: `func datetimeStructValidate(obj *datetimeStruct) []error {
+var errs []error
+if !(obj.FieldDatetimeStringPointer != nil && types.IsValidDatetime(*obj.FieldDatetimeStringPointer, "2006-01-02")) {
+errs = append(errs, types.NewValidationError("FieldDatetimeStringPointer must be a valid datetime with layout '2006-01-02'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "timezoneStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "timezoneStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldTimezoneStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"timezone"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `timezone`)},
+					},
+				},
+			},
+			want: `func timezoneStructValidate(obj *timezoneStruct) []error {
+var errs []error
+if !(obj.FieldTimezoneStringPointer != nil && types.IsValidTimezone(*obj.FieldTimezoneStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldTimezoneStringPointer must be a valid time zone"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "durationStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "durationStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDurationStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"duration"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `duration`)},
+					},
+				},
+			},
+			want: `func durationStructValidate(obj *durationStruct) []error {
+var errs []error
+if !(obj.FieldDurationStringPointer != nil && types.IsValidDuration(*obj.FieldDurationStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldDurationStringPointer must be a valid duration"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpfStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpfStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpfStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf`)},
+					},
+				},
+			},
+			want: `func cpfStructValidate(obj *cpfStruct) []error {
+var errs []error
+if !(obj.FieldCpfStringPointer != nil && types.IsValidCPF(*obj.FieldCpfStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpfStringPointer must be a valid CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_formattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_formatted`)},
+					},
+				},
+			},
+			want: `func cpf_formattedStructValidate(obj *cpf_formattedStruct) []error {
+var errs []error
+if !(obj.FieldCpf_formattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_formattedStringPointer must be a valid formatted CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_unformattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_unformatted`)},
+					},
+				},
+			},
+			want: `func cpf_unformattedStructValidate(obj *cpf_unformattedStruct) []error {
+var errs []error
+if !(obj.FieldCpf_unformattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_unformattedStringPointer must be a valid unformatted CPF"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpjStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpjStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpjStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj`)},
+					},
+				},
+			},
+			want: `func cnpjStructValidate(obj *cnpjStruct) []error {
+var errs []error
+if !(obj.FieldCnpjStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpjStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCnpjStringPointer must be a valid CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpj_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpj_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpj_formattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj_formatted`)},
+					},
+				},
+			},
+			want: `func cnpj_formattedStructValidate(obj *cnpj_formattedStruct) []error {
+var errs []error
+if !(obj.FieldCnpj_formattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_formattedStringPointer must be a valid formatted CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cnpj_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cnpj_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCnpj_unformattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cnpj_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cnpj_unformatted`)},
+					},
+				},
+			},
+			want: `func cnpj_unformattedStructValidate(obj *cnpj_unformattedStruct) []error {
+var errs []error
+if !(obj.FieldCnpj_unformattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_unformattedStringPointer must be a valid unformatted CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cepStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cepStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCepStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep`)},
+					},
+				},
+			},
+			want: `func cepStructValidate(obj *cepStruct) []error {
+var errs []error
+if !(obj.FieldCepStringPointer != nil && types.IsValidCEP(*obj.FieldCepStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCepStringPointer must be a valid CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cep_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cep_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCep_formattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep_formatted`)},
+					},
+				},
+			},
+			want: `func cep_formattedStructValidate(obj *cep_formattedStruct) []error {
+var errs []error
+if !(obj.FieldCep_formattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_formattedStringPointer must be a valid formatted CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cep_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cep_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCep_unformattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cep_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cep_unformatted`)},
+					},
+				},
+			},
+			want: `func cep_unformattedStructValidate(obj *cep_unformattedStruct) []error {
+var errs []error
+if !(obj.FieldCep_unformattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_unformattedStringPointer must be a valid unformatted CEP"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpjStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpjStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpjStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj`)},
+					},
+				},
+			},
+			want: `func cpf_cnpjStructValidate(obj *cpf_cnpjStruct) []error {
+var errs []error
+if !(obj.FieldCpf_cnpjStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpjStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpjStringPointer must be a valid CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_formattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpj_formattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpj_formattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj_formatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj_formatted`)},
+					},
+				},
+			},
+			want: `func cpf_cnpj_formattedStructValidate(obj *cpf_cnpj_formattedStruct) []error {
+var errs []error
+if !(obj.FieldCpf_cnpj_formattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedStringPointer must be a valid formatted CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_unformattedStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cpf_cnpj_unformattedStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCpf_cnpj_unformattedStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cpf_cnpj_unformatted"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cpf_cnpj_unformatted`)},
+					},
+				},
+			},
+			want: `func cpf_cnpj_unformattedStructValidate(obj *cpf_cnpj_unformattedStruct) []error {
+var errs []error
+if !(obj.FieldCpf_cnpj_unformattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedStringPointer must be a valid unformatted CPF or CNPJ"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "luhn_checksumStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "luhn_checksumStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLuhn_checksumStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"luhn_checksum"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `luhn_checksum`)},
+					},
+				},
+			},
+			want: `func luhn_checksumStructValidate(obj *luhn_checksumStruct) []error {
+var errs []error
+if !(obj.FieldLuhn_checksumStringPointer != nil && types.IsValidLuhnChecksum(*obj.FieldLuhn_checksumStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLuhn_checksumStringPointer must have a valid Luhn checksum"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "credit_cardStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "credit_cardStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCredit_cardStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"credit_card"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `credit_card`)},
+					},
+				},
+			},
+			want: `func credit_cardStructValidate(obj *credit_cardStruct) []error {
+var errs []error
+if !(obj.FieldCredit_cardStringPointer != nil && types.IsValidCreditCard(*obj.FieldCredit_cardStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCredit_cardStringPointer must be a valid credit card number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ibanStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ibanStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIbanStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iban"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iban`)},
+					},
+				},
+			},
+			want: `func ibanStructValidate(obj *ibanStruct) []error {
+var errs []error
+if !(obj.FieldIbanStringPointer != nil && types.IsValidIBAN(*obj.FieldIbanStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIbanStringPointer must be a valid IBAN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "bicStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "bicStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBicStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"bic"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `bic`)},
+					},
+				},
+			},
+			want: `func bicStructValidate(obj *bicStruct) []error {
+var errs []error
+if !(obj.FieldBicStringPointer != nil && types.IsValidBIC(*obj.FieldBicStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBicStringPointer must be a valid BIC"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "isbn10Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "isbn10Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIsbn10StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"isbn10"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `isbn10`)},
+					},
+				},
+			},
+			want: `func isbn10StructValidate(obj *isbn10Struct) []error {
+var errs []error
+if !(obj.FieldIsbn10StringPointer != nil && types.IsValidISBN10(*obj.FieldIsbn10StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIsbn10StringPointer must be a valid ISBN-10"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "isbn13Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "isbn13Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIsbn13StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"isbn13"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `isbn13`)},
+					},
+				},
+			},
+			want: `func isbn13StructValidate(obj *isbn13Struct) []error {
+var errs []error
+if !(obj.FieldIsbn13StringPointer != nil && types.IsValidISBN13(*obj.FieldIsbn13StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIsbn13StringPointer must be a valid ISBN-13"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "issnStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "issnStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIssnStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"issn"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `issn`)},
+					},
+				},
+			},
+			want: `func issnStructValidate(obj *issnStruct) []error {
+var errs []error
+if !(obj.FieldIssnStringPointer != nil && types.IsValidISSN(*obj.FieldIssnStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIssnStringPointer must be a valid ISSN"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ean8Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ean8Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEan8StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ean8"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ean8`)},
+					},
+				},
+			},
+			want: `func ean8StructValidate(obj *ean8Struct) []error {
+var errs []error
+if !(obj.FieldEan8StringPointer != nil && types.IsValidEAN8(*obj.FieldEan8StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldEan8StringPointer must be a valid EAN-8"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "ean13Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "ean13Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldEan13StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"ean13"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `ean13`)},
+					},
+				},
+			},
+			want: `func ean13StructValidate(obj *ean13Struct) []error {
+var errs []error
+if !(obj.FieldEan13StringPointer != nil && types.IsValidEAN13(*obj.FieldEan13StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldEan13StringPointer must be a valid EAN-13"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "upcStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "upcStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldUpcStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"upc"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `upc`)},
+					},
+				},
+			},
+			want: `func upcStructValidate(obj *upcStruct) []error {
+var errs []error
+if !(obj.FieldUpcStringPointer != nil && types.IsValidUPC(*obj.FieldUpcStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUpcStringPointer must be a valid UPC"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha2Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_alpha2Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_alpha2StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_alpha2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_alpha2`)},
+					},
+				},
+			},
+			want: `func iso3166_1_alpha2StructValidate(obj *iso3166_1_alpha2Struct) []error {
+var errs []error
+if !(obj.FieldIso3166_1_alpha2StringPointer != nil && types.IsValidISO3166Alpha2(*obj.FieldIso3166_1_alpha2StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2StringPointer must be a valid ISO 3166-1 alpha-2 country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha3Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_alpha3Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_alpha3StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_alpha3"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_alpha3`)},
+					},
+				},
+			},
+			want: `func iso3166_1_alpha3StructValidate(obj *iso3166_1_alpha3Struct) []error {
+var errs []error
+if !(obj.FieldIso3166_1_alpha3StringPointer != nil && types.IsValidISO3166Alpha3(*obj.FieldIso3166_1_alpha3StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3StringPointer must be a valid ISO 3166-1 alpha-3 country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso3166_1_numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso3166_1_numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso3166_1_numericStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericIntPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericInt64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUintPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso3166_1_numericUint64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"iso3166_1_numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso3166_1_numeric`)},
+					},
+				},
+			},
+			want: `func iso3166_1_numericStructValidate(obj *iso3166_1_numericStruct) []error {
+var errs []error
+if !(obj.FieldIso3166_1_numericStringPointer != nil && types.IsValidISO3166NumericString(*obj.FieldIso3166_1_numericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericStringPointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericIntPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericIntPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericIntPointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericInt8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericInt16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericInt32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericInt64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericUintPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUintPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUintPointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericUint8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericUint16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericUint32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+if !(obj.FieldIso3166_1_numericUint64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso4217Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso4217Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso4217StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso4217"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217`)},
+					},
+				},
+			},
+			want: `func iso4217StructValidate(obj *iso4217Struct) []error {
+var errs []error
+if !(obj.FieldIso4217StringPointer != nil && types.IsValidISO4217(*obj.FieldIso4217StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso4217StringPointer must be a valid ISO 4217 currency code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "iso4217_numericStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "iso4217_numericStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldIso4217_numericStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericIntPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericInt64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUintPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+
+						{
+							FieldName: "FieldIso4217_numericUint64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"iso4217_numeric"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `iso4217_numeric`)},
+					},
+				},
+			},
+			want: `func iso4217_numericStructValidate(obj *iso4217_numericStruct) []error {
+var errs []error
+if !(obj.FieldIso4217_numericStringPointer != nil && types.IsValidISO4217NumericString(*obj.FieldIso4217_numericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericStringPointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericIntPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericIntPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericIntPointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericInt8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericInt16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericInt32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericInt64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericUintPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUintPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUintPointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericUint8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericUint16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericUint32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32Pointer must be a valid ISO 4217 numeric currency code"))
+}
+if !(obj.FieldIso4217_numericUint64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64Pointer must be a valid ISO 4217 numeric currency code"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "bcp47_language_tagStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "bcp47_language_tagStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldBcp47_language_tagStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"bcp47_language_tag"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `bcp47_language_tag`)},
+					},
+				},
+			},
+			want: `func bcp47_language_tagStructValidate(obj *bcp47_language_tagStruct) []error {
+var errs []error
+if !(obj.FieldBcp47_language_tagStringPointer != nil && types.IsValidBCP47LanguageTag(*obj.FieldBcp47_language_tagStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBcp47_language_tagStringPointer must be a valid BCP 47 language tag"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "e164Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "e164Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldE164StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"e164"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `e164`)},
+					},
+				},
+			},
+			want: `func e164StructValidate(obj *e164Struct) []error {
+var errs []error
+if !(obj.FieldE164StringPointer != nil && types.IsValidE164(*obj.FieldE164StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldE164StringPointer must be a valid E.164 phone number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "postcode_iso3166_alpha2Struct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "postcode_iso3166_alpha2Struct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPostcode_iso3166_alpha2StringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"postcode_iso3166_alpha2=BR"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `postcode_iso3166_alpha2=BR`)},
+					},
+				},
+			},
+			want: `func postcode_iso3166_alpha2StructValidate(obj *postcode_iso3166_alpha2Struct) []error {
+var errs []error
+if !(obj.FieldPostcode_iso3166_alpha2StringPointer != nil && types.IsValidPostcode(*obj.FieldPostcode_iso3166_alpha2StringPointer, "BR")) {
+errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2StringPointer must be a valid postal code for 'BR'"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "latitudeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "latitudeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLatitudeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+
+						{
+							FieldName: "FieldLatitudeFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+
+						{
+							FieldName: "FieldLatitudeFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"latitude"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `latitude`)},
+					},
+				},
+			},
+			want: `func latitudeStructValidate(obj *latitudeStruct) []error {
+var errs []error
+if !(obj.FieldLatitudeStringPointer != nil && types.IsValidLatitude(*obj.FieldLatitudeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLatitudeStringPointer must be a valid latitude (-90 to 90)"))
+}
+if !(obj.FieldLatitudeFloat32Pointer != nil && *obj.FieldLatitudeFloat32Pointer >= -90 && *obj.FieldLatitudeFloat32Pointer <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat32Pointer must be a valid latitude (-90 to 90)"))
+}
+if !(obj.FieldLatitudeFloat64Pointer != nil && *obj.FieldLatitudeFloat64Pointer >= -90 && *obj.FieldLatitudeFloat64Pointer <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat64Pointer must be a valid latitude (-90 to 90)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "longitudeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "longitudeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldLongitudeStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+
+						{
+							FieldName: "FieldLongitudeFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+
+						{
+							FieldName: "FieldLongitudeFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"longitude"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `longitude`)},
+					},
+				},
+			},
+			want: `func longitudeStructValidate(obj *longitudeStruct) []error {
+var errs []error
+if !(obj.FieldLongitudeStringPointer != nil && types.IsValidLongitude(*obj.FieldLongitudeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLongitudeStringPointer must be a valid longitude (-180 to 180)"))
+}
+if !(obj.FieldLongitudeFloat32Pointer != nil && *obj.FieldLongitudeFloat32Pointer >= -180 && *obj.FieldLongitudeFloat32Pointer <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat32Pointer must be a valid longitude (-180 to 180)"))
+}
+if !(obj.FieldLongitudeFloat64Pointer != nil && *obj.FieldLongitudeFloat64Pointer >= -180 && *obj.FieldLongitudeFloat64Pointer <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat64Pointer must be a valid longitude (-180 to 180)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "semverStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "semverStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldSemverStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"semver"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `semver`)},
+					},
+				},
+			},
+			want: `func semverStructValidate(obj *semverStruct) []error {
+var errs []error
+if !(obj.FieldSemverStringPointer != nil && types.IsValidSemver(*obj.FieldSemverStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldSemverStringPointer must be a valid semantic version (e.g. 1.2.3)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "cronStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "cronStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldCronStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"cron"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `cron`)},
+					},
+				},
+			},
+			want: `func cronStructValidate(obj *cronStruct) []error {
+var errs []error
+if !(obj.FieldCronStringPointer != nil && types.IsValidCron(*obj.FieldCronStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCronStringPointer must be a valid cron expression (5 fields, or 6 with seconds)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "finiteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "finiteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldFiniteFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"finite"`,
+						},
+
+						{
+							FieldName: "FieldFiniteFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"finite"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `finite`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `finite`)},
+					},
+				},
+			},
+			want: `func finiteStructValidate(obj *finiteStruct) []error {
+var errs []error
+if !(obj.FieldFiniteFloat32Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat32Pointer)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat32Pointer must be a finite number"))
+}
+if !(obj.FieldFiniteFloat64Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat64Pointer)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat64Pointer must be a finite number"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "multipleofStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "multipleofStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMultipleofIntPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofInt64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUintPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofUint64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"multipleof=5"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"multipleof=0.25"`,
+						},
+
+						{
+							FieldName: "FieldMultipleofFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"multipleof=0.25"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=5`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=0.25`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `multipleof=0.25`)},
+					},
+				},
+			},
+			want: `func multipleofStructValidate(obj *multipleofStruct) []error {
+var errs []error
+if !(obj.FieldMultipleofIntPointer != nil && *obj.FieldMultipleofIntPointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofIntPointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt8Pointer != nil && *obj.FieldMultipleofInt8Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt8Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt16Pointer != nil && *obj.FieldMultipleofInt16Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt16Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt32Pointer != nil && *obj.FieldMultipleofInt32Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt32Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofInt64Pointer != nil && *obj.FieldMultipleofInt64Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt64Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUintPointer != nil && *obj.FieldMultipleofUintPointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUintPointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint8Pointer != nil && *obj.FieldMultipleofUint8Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint8Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint16Pointer != nil && *obj.FieldMultipleofUint16Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint16Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint32Pointer != nil && *obj.FieldMultipleofUint32Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint32Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofUint64Pointer != nil && *obj.FieldMultipleofUint64Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint64Pointer must be a multiple of 5"))
+}
+if !(obj.FieldMultipleofFloat32Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat32Pointer, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat32Pointer must be a multiple of 0.25"))
+}
+if !(obj.FieldMultipleofFloat64Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat64Pointer, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat64Pointer must be a multiple of 0.25"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "maxdecimalsStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "maxdecimalsStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldMaxdecimalsFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"maxdecimals=2"`,
+						},
+
+						{
+							FieldName: "FieldMaxdecimalsFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"maxdecimals=2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `maxdecimals=2`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `maxdecimals=2`)},
+					},
+				},
+			},
+			want: `func maxdecimalsStructValidate(obj *maxdecimalsStruct) []error {
+var errs []error
+if !(obj.FieldMaxdecimalsFloat32Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat32Pointer, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32Pointer must have at most 2 decimal places"))
+}
+if !(obj.FieldMaxdecimalsFloat64Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat64Pointer, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64Pointer must have at most 2 decimal places"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "positiveStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "positiveStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldPositiveIntPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveInt64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUintPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveUint64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+
+						{
+							FieldName: "FieldPositiveFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"positive"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `positive`)},
+					},
+				},
+			},
+			want: `func positiveStructValidate(obj *positiveStruct) []error {
+var errs []error
+if !(obj.FieldPositiveIntPointer != nil && *obj.FieldPositiveIntPointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveIntPointer must be positive"))
+}
+if !(obj.FieldPositiveInt8Pointer != nil && *obj.FieldPositiveInt8Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt8Pointer must be positive"))
+}
+if !(obj.FieldPositiveInt16Pointer != nil && *obj.FieldPositiveInt16Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt16Pointer must be positive"))
+}
+if !(obj.FieldPositiveInt32Pointer != nil && *obj.FieldPositiveInt32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt32Pointer must be positive"))
+}
+if !(obj.FieldPositiveInt64Pointer != nil && *obj.FieldPositiveInt64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt64Pointer must be positive"))
+}
+if !(obj.FieldPositiveUintPointer != nil && *obj.FieldPositiveUintPointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUintPointer must be positive"))
+}
+if !(obj.FieldPositiveUint8Pointer != nil && *obj.FieldPositiveUint8Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint8Pointer must be positive"))
+}
+if !(obj.FieldPositiveUint16Pointer != nil && *obj.FieldPositiveUint16Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint16Pointer must be positive"))
+}
+if !(obj.FieldPositiveUint32Pointer != nil && *obj.FieldPositiveUint32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint32Pointer must be positive"))
+}
+if !(obj.FieldPositiveUint64Pointer != nil && *obj.FieldPositiveUint64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint64Pointer must be positive"))
+}
+if !(obj.FieldPositiveFloat32Pointer != nil && *obj.FieldPositiveFloat32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat32Pointer must be positive"))
+}
+if !(obj.FieldPositiveFloat64Pointer != nil && *obj.FieldPositiveFloat64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat64Pointer must be positive"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "negativeStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "negativeStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNegativeFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"negative"`,
+						},
+
+						{
+							FieldName: "FieldNegativeFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"negative"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `negative`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `negative`)},
+					},
+				},
+			},
+			want: `func negativeStructValidate(obj *negativeStruct) []error {
+var errs []error
+if !(obj.FieldNegativeFloat32Pointer != nil && *obj.FieldNegativeFloat32Pointer < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat32Pointer must be negative"))
+}
+if !(obj.FieldNegativeFloat64Pointer != nil && *obj.FieldNegativeFloat64Pointer < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat64Pointer must be negative"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "nonzeroStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "nonzeroStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldNonzeroIntPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroInt64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUintPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint8Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint16Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroUint64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroFloat32Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+
+						{
+							FieldName: "FieldNonzeroFloat64Pointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+							Tag:       `validate:"nonzero"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `nonzero`)},
+					},
+				},
+			},
+			want: `func nonzeroStructValidate(obj *nonzeroStruct) []error {
+var errs []error
+if !(obj.FieldNonzeroIntPointer != nil && *obj.FieldNonzeroIntPointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroIntPointer must not be zero"))
+}
+if !(obj.FieldNonzeroInt8Pointer != nil && *obj.FieldNonzeroInt8Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt8Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroInt16Pointer != nil && *obj.FieldNonzeroInt16Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt16Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroInt32Pointer != nil && *obj.FieldNonzeroInt32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt32Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroInt64Pointer != nil && *obj.FieldNonzeroInt64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt64Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroUintPointer != nil && *obj.FieldNonzeroUintPointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUintPointer must not be zero"))
+}
+if !(obj.FieldNonzeroUint8Pointer != nil && *obj.FieldNonzeroUint8Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint8Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroUint16Pointer != nil && *obj.FieldNonzeroUint16Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint16Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroUint32Pointer != nil && *obj.FieldNonzeroUint32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint32Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroUint64Pointer != nil && *obj.FieldNonzeroUint64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint64Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroFloat32Pointer != nil && *obj.FieldNonzeroFloat32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat32Pointer must not be zero"))
+}
+if !(obj.FieldNonzeroFloat64Pointer != nil && *obj.FieldNonzeroFloat64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat64Pointer must not be zero"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimalStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimalStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimalStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal=12,2"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal=12,2`)},
+					},
+				},
+			},
+			want: `func decimalStructValidate(obj *decimalStruct) []error {
+var errs []error
+if !(obj.FieldDecimalStringPointer != nil && types.IsValidDecimal(*obj.FieldDecimalStringPointer, 12,2)) {
+errs = append(errs, types.NewValidationError("FieldDecimalStringPointer must be a decimal(12,2)"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_gtStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_gtStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_gtStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_gt=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_gt=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_gtStructValidate(obj *decimal_gtStruct) []error {
+var errs []error
+if !(obj.FieldDecimal_gtStringPointer != nil && types.IsDecimalGT(*obj.FieldDecimal_gtStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gtStringPointer must be a decimal > 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_gteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_gteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_gteStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_gte=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_gte=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_gteStructValidate(obj *decimal_gteStruct) []error {
+var errs []error
+if !(obj.FieldDecimal_gteStringPointer != nil && types.IsDecimalGTE(*obj.FieldDecimal_gteStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gteStringPointer must be a decimal >= 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_ltStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_ltStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_ltStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_lt=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_lt=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_ltStructValidate(obj *decimal_ltStruct) []error {
+var errs []error
+if !(obj.FieldDecimal_ltStringPointer != nil && types.IsDecimalLT(*obj.FieldDecimal_ltStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_ltStringPointer must be a decimal < 0.01"))
+}
+return errs
+}
+`,
+		},
+		{
+			name: "decimal_lteStruct",
+			structInfo: &analyzer.Struct{
+				Struct: parser.Struct{
+					PackageName: "main",
+					StructName:  "decimal_lteStruct",
+					Fields: []parser.Field{
+
+						{
+							FieldName: "FieldDecimal_lteStringPointer",
+							Type:      common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+							Tag:       `validate:"decimal_lte=0.01"`,
+						},
+					},
+				},
+				FieldsValidations: []analyzer.FieldValidations{
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `decimal_lte=0.01`)},
+					},
+				},
+			},
+			want: `func decimal_lteStructValidate(obj *decimal_lteStruct) []error {
+var errs []error
+if !(obj.FieldDecimal_lteStringPointer != nil && types.IsDecimalLTE(*obj.FieldDecimal_lteStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_lteStringPointer must be a decimal <= 0.01"))
+}
+return errs
+}
 `,
 		},
 		{
@@ -224,6 +4446,12 @@ return errs
 							Tag:       `validate:"required"`,
 						},
 
+						{
+							FieldName: "FieldRequiredByteSlicePointer",
+							Type:      common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+							Tag:       `validate:"required"`,
+						},
+
 						{
 							FieldName: "FieldRequiredStringArrayPointer",
 							Type:      common.FieldType{ComposedType: "*[N]", BaseType: "string", Size: "3"},
@@ -618,6 +4846,10 @@ return errs
 					{
 						Validations: []*analyzer.Validation{AssertParserValidation(t, `required`)},
 					},
+
+					{
+						Validations: []*analyzer.Validation{AssertParserValidation(t, `required`)},
+					},
 				},
 			},
 			want: `func requiredStructValidate(obj *requiredStruct) []error {
@@ -706,6 +4938,9 @@ errs = append(errs, types.NewValidationError("FieldRequiredFloat64SlicePointer m
 if !(obj.FieldRequiredBoolSlicePointer != nil && len(*obj.FieldRequiredBoolSlicePointer) != 0) {
 errs = append(errs, types.NewValidationError("FieldRequiredBoolSlicePointer must not be empty"))
 }
+if !(obj.FieldRequiredByteSlicePointer != nil && len(*obj.FieldRequiredByteSlicePointer) != 0) {
+errs = append(errs, types.NewValidationError("FieldRequiredByteSlicePointer must not be empty"))
+}
 if !(obj.FieldRequiredStringArrayPointer != nil) {
 errs = append(errs, types.NewValidationError("FieldRequiredStringArrayPointer must not be empty"))
 }
@@ -30,6 +30,2142 @@ func TestBuildValidationCode(t *testing.T) {
 			want: `if !(types.IsValidEmail(obj.FieldEmailString)) {
 errs = append(errs, types.NewValidationError("FieldEmailString must be a valid email"))
 }
+`,
+		},
+		{
+			name: "regex_string_regex='^[a-z]{2,5}$'",
+			args: args{
+				fieldName:       "FieldRegexString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "regex='^[a-z]{2,5}$'",
+			},
+			want: `if !(validgenRegex691022c6.MatchString(obj.FieldRegexString)) {
+errs = append(errs, types.NewValidationError("FieldRegexString must match the pattern '^[a-z]{2,5}$'"))
+}
+`,
+		},
+		{
+			name: "url_string_url",
+			args: args{
+				fieldName:       "FieldUrlString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "url",
+			},
+			want: `if !(types.IsValidURL(obj.FieldUrlString)) {
+errs = append(errs, types.NewValidationError("FieldUrlString must be a valid URL"))
+}
+`,
+		},
+		{
+			name: "uri_string_uri",
+			args: args{
+				fieldName:       "FieldUriString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uri",
+			},
+			want: `if !(types.IsValidURI(obj.FieldUriString)) {
+errs = append(errs, types.NewValidationError("FieldUriString must be a valid URI"))
+}
+`,
+		},
+		{
+			name: "http_url_string_http_url",
+			args: args{
+				fieldName:       "FieldHttp_urlString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "http_url",
+			},
+			want: `if !(types.IsValidHTTPURL(obj.FieldHttp_urlString)) {
+errs = append(errs, types.NewValidationError("FieldHttp_urlString must be a valid HTTP URL"))
+}
+`,
+		},
+		{
+			name: "url_scheme_string_url_scheme=https wss",
+			args: args{
+				fieldName:       "FieldUrl_schemeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "url_scheme=https wss",
+			},
+			want: `if !(types.IsValidURLWithScheme(obj.FieldUrl_schemeString, []string{"https", "wss"})) {
+errs = append(errs, types.NewValidationError("FieldUrl_schemeString must be a valid URL with scheme 'https' 'wss'"))
+}
+`,
+		},
+		{
+			name: "ip_string_ip",
+			args: args{
+				fieldName:       "FieldIpString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ip",
+			},
+			want: `if !(types.IsValidIP(obj.FieldIpString)) {
+errs = append(errs, types.NewValidationError("FieldIpString must be a valid IP address"))
+}
+`,
+		},
+		{
+			name: "ipv4_string_ipv4",
+			args: args{
+				fieldName:       "FieldIpv4String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ipv4",
+			},
+			want: `if !(types.IsValidIPv4(obj.FieldIpv4String)) {
+errs = append(errs, types.NewValidationError("FieldIpv4String must be a valid IPv4 address"))
+}
+`,
+		},
+		{
+			name: "ipv6_string_ipv6",
+			args: args{
+				fieldName:       "FieldIpv6String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ipv6",
+			},
+			want: `if !(types.IsValidIPv6(obj.FieldIpv6String)) {
+errs = append(errs, types.NewValidationError("FieldIpv6String must be a valid IPv6 address"))
+}
+`,
+		},
+		{
+			name: "cidr_string_cidr",
+			args: args{
+				fieldName:       "FieldCidrString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cidr",
+			},
+			want: `if !(types.IsValidCIDR(obj.FieldCidrString)) {
+errs = append(errs, types.NewValidationError("FieldCidrString must be a valid CIDR"))
+}
+`,
+		},
+		{
+			name: "cidrv4_string_cidrv4",
+			args: args{
+				fieldName:       "FieldCidrv4String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cidrv4",
+			},
+			want: `if !(types.IsValidCIDRv4(obj.FieldCidrv4String)) {
+errs = append(errs, types.NewValidationError("FieldCidrv4String must be a valid IPv4 CIDR"))
+}
+`,
+		},
+		{
+			name: "cidrv6_string_cidrv6",
+			args: args{
+				fieldName:       "FieldCidrv6String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cidrv6",
+			},
+			want: `if !(types.IsValidCIDRv6(obj.FieldCidrv6String)) {
+errs = append(errs, types.NewValidationError("FieldCidrv6String must be a valid IPv6 CIDR"))
+}
+`,
+		},
+		{
+			name: "private_ip_string_private_ip",
+			args: args{
+				fieldName:       "FieldPrivate_ipString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "private_ip",
+			},
+			want: `if !(types.IsPrivateIP(obj.FieldPrivate_ipString)) {
+errs = append(errs, types.NewValidationError("FieldPrivate_ipString must be a private IP address"))
+}
+`,
+		},
+		{
+			name: "public_ip_string_public_ip",
+			args: args{
+				fieldName:       "FieldPublic_ipString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "public_ip",
+			},
+			want: `if !(types.IsPublicIP(obj.FieldPublic_ipString)) {
+errs = append(errs, types.NewValidationError("FieldPublic_ipString must be a public IP address"))
+}
+`,
+		},
+		{
+			name: "mac_string_mac",
+			args: args{
+				fieldName:       "FieldMacString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "mac",
+			},
+			want: `if !(types.IsValidMAC(obj.FieldMacString)) {
+errs = append(errs, types.NewValidationError("FieldMacString must be a valid MAC address"))
+}
+`,
+		},
+		{
+			name: "hostname_string_hostname",
+			args: args{
+				fieldName:       "FieldHostnameString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "hostname",
+			},
+			want: `if !(types.IsValidHostname(obj.FieldHostnameString)) {
+errs = append(errs, types.NewValidationError("FieldHostnameString must be a valid hostname"))
+}
+`,
+		},
+		{
+			name: "fqdn_string_fqdn",
+			args: args{
+				fieldName:       "FieldFqdnString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "fqdn",
+			},
+			want: `if !(types.IsValidFQDN(obj.FieldFqdnString)) {
+errs = append(errs, types.NewValidationError("FieldFqdnString must be a valid FQDN"))
+}
+`,
+		},
+		{
+			name: "hostname_port_string_hostname_port",
+			args: args{
+				fieldName:       "FieldHostname_portString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "hostname_port",
+			},
+			want: `if !(types.IsValidHostnamePort(obj.FieldHostname_portString)) {
+errs = append(errs, types.NewValidationError("FieldHostname_portString must be a valid hostname and port"))
+}
+`,
+		},
+		{
+			name: "uuid_string_uuid",
+			args: args{
+				fieldName:       "FieldUuidString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uuid",
+			},
+			want: `if !(types.IsValidUUID(obj.FieldUuidString)) {
+errs = append(errs, types.NewValidationError("FieldUuidString must be a valid UUID"))
+}
+`,
+		},
+		{
+			name: "uuid3_string_uuid3",
+			args: args{
+				fieldName:       "FieldUuid3String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uuid3",
+			},
+			want: `if !(types.IsValidUUID3(obj.FieldUuid3String)) {
+errs = append(errs, types.NewValidationError("FieldUuid3String must be a valid UUID v3"))
+}
+`,
+		},
+		{
+			name: "uuid4_string_uuid4",
+			args: args{
+				fieldName:       "FieldUuid4String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uuid4",
+			},
+			want: `if !(types.IsValidUUID4(obj.FieldUuid4String)) {
+errs = append(errs, types.NewValidationError("FieldUuid4String must be a valid UUID v4"))
+}
+`,
+		},
+		{
+			name: "uuid5_string_uuid5",
+			args: args{
+				fieldName:       "FieldUuid5String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uuid5",
+			},
+			want: `if !(types.IsValidUUID5(obj.FieldUuid5String)) {
+errs = append(errs, types.NewValidationError("FieldUuid5String must be a valid UUID v5"))
+}
+`,
+		},
+		{
+			name: "uuid7_string_uuid7",
+			args: args{
+				fieldName:       "FieldUuid7String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uuid7",
+			},
+			want: `if !(types.IsValidUUID7(obj.FieldUuid7String)) {
+errs = append(errs, types.NewValidationError("FieldUuid7String must be a valid UUID v7"))
+}
+`,
+		},
+		{
+			name: "ulid_string_ulid",
+			args: args{
+				fieldName:       "FieldUlidString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ulid",
+			},
+			want: `if !(types.IsValidULID(obj.FieldUlidString)) {
+errs = append(errs, types.NewValidationError("FieldUlidString must be a valid ULID"))
+}
+`,
+		},
+		{
+			name: "mongodb_string_mongodb",
+			args: args{
+				fieldName:       "FieldMongodbString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "mongodb",
+			},
+			want: `if !(types.IsValidMongoDBObjectID(obj.FieldMongodbString)) {
+errs = append(errs, types.NewValidationError("FieldMongodbString must be a valid MongoDB ObjectID"))
+}
+`,
+		},
+		{
+			name: "alpha_string_alpha",
+			args: args{
+				fieldName:       "FieldAlphaString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "alpha",
+			},
+			want: `if !(types.IsAlpha(obj.FieldAlphaString)) {
+errs = append(errs, types.NewValidationError("FieldAlphaString must contain only ASCII letters"))
+}
+`,
+		},
+		{
+			name: "alphanum_string_alphanum",
+			args: args{
+				fieldName:       "FieldAlphanumString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "alphanum",
+			},
+			want: `if !(types.IsAlphanumeric(obj.FieldAlphanumString)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumString must contain only ASCII letters and digits"))
+}
+`,
+		},
+		{
+			name: "alphaunicode_string_alphaunicode",
+			args: args{
+				fieldName:       "FieldAlphaunicodeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "alphaunicode",
+			},
+			want: `if !(types.IsAlphaUnicode(obj.FieldAlphaunicodeString)) {
+errs = append(errs, types.NewValidationError("FieldAlphaunicodeString must contain only letters"))
+}
+`,
+		},
+		{
+			name: "alphanumunicode_string_alphanumunicode",
+			args: args{
+				fieldName:       "FieldAlphanumunicodeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "alphanumunicode",
+			},
+			want: `if !(types.IsAlphanumericUnicode(obj.FieldAlphanumunicodeString)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumunicodeString must contain only letters and numbers"))
+}
+`,
+		},
+		{
+			name: "numeric_string_numeric",
+			args: args{
+				fieldName:       "FieldNumericString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "numeric",
+			},
+			want: `if !(types.IsNumeric(obj.FieldNumericString)) {
+errs = append(errs, types.NewValidationError("FieldNumericString must be a numeric value"))
+}
+`,
+		},
+		{
+			name: "number_string_number",
+			args: args{
+				fieldName:       "FieldNumberString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "number",
+			},
+			want: `if !(types.IsNumber(obj.FieldNumberString)) {
+errs = append(errs, types.NewValidationError("FieldNumberString must contain only digits"))
+}
+`,
+		},
+		{
+			name: "ascii_string_ascii",
+			args: args{
+				fieldName:       "FieldAsciiString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ascii",
+			},
+			want: `if !(types.IsASCII(obj.FieldAsciiString)) {
+errs = append(errs, types.NewValidationError("FieldAsciiString must contain only ASCII characters"))
+}
+`,
+		},
+		{
+			name: "printascii_string_printascii",
+			args: args{
+				fieldName:       "FieldPrintasciiString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "printascii",
+			},
+			want: `if !(types.IsPrintableASCII(obj.FieldPrintasciiString)) {
+errs = append(errs, types.NewValidationError("FieldPrintasciiString must contain only printable ASCII characters"))
+}
+`,
+		},
+		{
+			name: "lowercase_string_lowercase",
+			args: args{
+				fieldName:       "FieldLowercaseString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "lowercase",
+			},
+			want: `if !(types.IsLowercase(obj.FieldLowercaseString)) {
+errs = append(errs, types.NewValidationError("FieldLowercaseString must be lowercase"))
+}
+`,
+		},
+		{
+			name: "uppercase_string_uppercase",
+			args: args{
+				fieldName:       "FieldUppercaseString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "uppercase",
+			},
+			want: `if !(types.IsUppercase(obj.FieldUppercaseString)) {
+errs = append(errs, types.NewValidationError("FieldUppercaseString must be uppercase"))
+}
+`,
+		},
+		{
+			name: "notblank_string_notblank",
+			args: args{
+				fieldName:       "FieldNotblankString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "notblank",
+			},
+			want: `if !(types.IsNotBlank(obj.FieldNotblankString)) {
+errs = append(errs, types.NewValidationError("FieldNotblankString must not be blank"))
+}
+`,
+		},
+		{
+			name: "contains_string_contains=ab",
+			args: args{
+				fieldName:       "FieldContainsString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "contains=ab",
+			},
+			want: `if !(types.Contains(obj.FieldContainsString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldContainsString must contain 'ab'"))
+}
+`,
+		},
+		{
+			name: "containsany_string_containsany=!@#",
+			args: args{
+				fieldName:       "FieldContainsanyString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "containsany=!@#",
+			},
+			want: `if !(types.ContainsAny(obj.FieldContainsanyString, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldContainsanyString must contain any of '!@#'"))
+}
+`,
+		},
+		{
+			name: "containsrune_string_containsrune=@",
+			args: args{
+				fieldName:       "FieldContainsruneString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "containsrune=@",
+			},
+			want: `if !(types.Contains(obj.FieldContainsruneString, "@")) {
+errs = append(errs, types.NewValidationError("FieldContainsruneString must contain '@'"))
+}
+`,
+		},
+		{
+			name: "excludes_string_excludes=ab",
+			args: args{
+				fieldName:       "FieldExcludesString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "excludes=ab",
+			},
+			want: `if !(!types.Contains(obj.FieldExcludesString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldExcludesString must not contain 'ab'"))
+}
+`,
+		},
+		{
+			name: "excludesall_string_excludesall=!@#",
+			args: args{
+				fieldName:       "FieldExcludesallString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "excludesall=!@#",
+			},
+			want: `if !(!types.ContainsAny(obj.FieldExcludesallString, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldExcludesallString must not contain any of '!@#'"))
+}
+`,
+		},
+		{
+			name: "startswith_string_startswith=ab",
+			args: args{
+				fieldName:       "FieldStartswithString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "startswith=ab",
+			},
+			want: `if !(types.HasPrefix(obj.FieldStartswithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartswithString must start with 'ab'"))
+}
+`,
+		},
+		{
+			name: "endswith_string_endswith=ab",
+			args: args{
+				fieldName:       "FieldEndswithString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "endswith=ab",
+			},
+			want: `if !(types.HasSuffix(obj.FieldEndswithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndswithString must end with 'ab'"))
+}
+`,
+		},
+		{
+			name: "startsnotwith_string_startsnotwith=ab",
+			args: args{
+				fieldName:       "FieldStartsnotwithString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "startsnotwith=ab",
+			},
+			want: `if !(!types.HasPrefix(obj.FieldStartsnotwithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartsnotwithString must not start with 'ab'"))
+}
+`,
+		},
+		{
+			name: "endsnotwith_string_endsnotwith=ab",
+			args: args{
+				fieldName:       "FieldEndsnotwithString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "endsnotwith=ab",
+			},
+			want: `if !(!types.HasSuffix(obj.FieldEndsnotwithString, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndsnotwithString must not end with 'ab'"))
+}
+`,
+		},
+		{
+			name: "in_ignore_case_string_in_ignore_case=ab cd ef",
+			args: args{
+				fieldName:       "FieldIn_ignore_caseString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "in_ignore_case=ab cd ef",
+			},
+			want: `if !(types.EqualFold(obj.FieldIn_ignore_caseString, "ab") || types.EqualFold(obj.FieldIn_ignore_caseString, "cd") || types.EqualFold(obj.FieldIn_ignore_caseString, "ef")) {
+errs = append(errs, types.NewValidationError("FieldIn_ignore_caseString must be one of 'ab' 'cd' 'ef'"))
+}
+`,
+		},
+		{
+			name: "nin_ignore_case_string_nin_ignore_case=ab cd ef",
+			args: args{
+				fieldName:       "FieldNin_ignore_caseString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "nin_ignore_case=ab cd ef",
+			},
+			want: `if !(!types.EqualFold(obj.FieldNin_ignore_caseString, "ab") && !types.EqualFold(obj.FieldNin_ignore_caseString, "cd") && !types.EqualFold(obj.FieldNin_ignore_caseString, "ef")) {
+errs = append(errs, types.NewValidationError("FieldNin_ignore_caseString must not be one of 'ab' 'cd' 'ef'"))
+}
+`,
+		},
+		{
+			name: "base64_string_base64",
+			args: args{
+				fieldName:       "FieldBase64String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "base64",
+			},
+			want: `if !(types.IsValidBase64(obj.FieldBase64String)) {
+errs = append(errs, types.NewValidationError("FieldBase64String must be a valid base64 string"))
+}
+`,
+		},
+		{
+			name: "base64_byteslice_base64",
+			args: args{
+				fieldName:       "FieldBase64ByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64",
+			},
+			want: `if !(types.IsValidBase64(string(obj.FieldBase64ByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64ByteSlice must be a valid base64 string"))
+}
+`,
+		},
+		{
+			name: "base64url_string_base64url",
+			args: args{
+				fieldName:       "FieldBase64urlString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "base64url",
+			},
+			want: `if !(types.IsValidBase64URL(obj.FieldBase64urlString)) {
+errs = append(errs, types.NewValidationError("FieldBase64urlString must be a valid base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64url_byteslice_base64url",
+			args: args{
+				fieldName:       "FieldBase64urlByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64url",
+			},
+			want: `if !(types.IsValidBase64URL(string(obj.FieldBase64urlByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64urlByteSlice must be a valid base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64rawurl_string_base64rawurl",
+			args: args{
+				fieldName:       "FieldBase64rawurlString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "base64rawurl",
+			},
+			want: `if !(types.IsValidBase64RawURL(obj.FieldBase64rawurlString)) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlString must be a valid raw base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64rawurl_byteslice_base64rawurl",
+			args: args{
+				fieldName:       "FieldBase64rawurlByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64rawurl",
+			},
+			want: `if !(types.IsValidBase64RawURL(string(obj.FieldBase64rawurlByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlice must be a valid raw base64 URL string"))
+}
+`,
+		},
+		{
+			name: "hexadecimal_string_hexadecimal",
+			args: args{
+				fieldName:       "FieldHexadecimalString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "hexadecimal",
+			},
+			want: `if !(types.IsValidHexadecimal(obj.FieldHexadecimalString)) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalString must be a valid hexadecimal string"))
+}
+`,
+		},
+		{
+			name: "hexadecimal_byteslice_hexadecimal",
+			args: args{
+				fieldName:       "FieldHexadecimalByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hexadecimal",
+			},
+			want: `if !(types.IsValidHexadecimal(string(obj.FieldHexadecimalByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlice must be a valid hexadecimal string"))
+}
+`,
+		},
+		{
+			name: "json_string_json",
+			args: args{
+				fieldName:       "FieldJsonString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "json",
+			},
+			want: `if !(types.IsValidJSON(obj.FieldJsonString)) {
+errs = append(errs, types.NewValidationError("FieldJsonString must be a valid JSON"))
+}
+`,
+		},
+		{
+			name: "json_byteslice_json",
+			args: args{
+				fieldName:       "FieldJsonByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "json",
+			},
+			want: `if !(types.IsValidJSON(string(obj.FieldJsonByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldJsonByteSlice must be a valid JSON"))
+}
+`,
+		},
+		{
+			name: "jwt_string_jwt",
+			args: args{
+				fieldName:       "FieldJwtString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "jwt",
+			},
+			want: `if !(types.IsValidJWT(obj.FieldJwtString)) {
+errs = append(errs, types.NewValidationError("FieldJwtString must be a valid JWT"))
+}
+`,
+		},
+		{
+			name: "jwt_byteslice_jwt",
+			args: args{
+				fieldName:       "FieldJwtByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "jwt",
+			},
+			want: `if !(types.IsValidJWT(string(obj.FieldJwtByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldJwtByteSlice must be a valid JWT"))
+}
+`,
+		},
+		{
+			name: "hexcolor_string_hexcolor",
+			args: args{
+				fieldName:       "FieldHexcolorString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "hexcolor",
+			},
+			want: `if !(types.IsValidHexColor(obj.FieldHexcolorString)) {
+errs = append(errs, types.NewValidationError("FieldHexcolorString must be a valid hex color"))
+}
+`,
+		},
+		{
+			name: "hexcolor_byteslice_hexcolor",
+			args: args{
+				fieldName:       "FieldHexcolorByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hexcolor",
+			},
+			want: `if !(types.IsValidHexColor(string(obj.FieldHexcolorByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHexcolorByteSlice must be a valid hex color"))
+}
+`,
+		},
+		{
+			name: "rgb_string_rgb",
+			args: args{
+				fieldName:       "FieldRgbString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "rgb",
+			},
+			want: `if !(types.IsValidRGB(obj.FieldRgbString)) {
+errs = append(errs, types.NewValidationError("FieldRgbString must be a valid RGB color"))
+}
+`,
+		},
+		{
+			name: "rgb_byteslice_rgb",
+			args: args{
+				fieldName:       "FieldRgbByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "rgb",
+			},
+			want: `if !(types.IsValidRGB(string(obj.FieldRgbByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldRgbByteSlice must be a valid RGB color"))
+}
+`,
+		},
+		{
+			name: "rgba_string_rgba",
+			args: args{
+				fieldName:       "FieldRgbaString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "rgba",
+			},
+			want: `if !(types.IsValidRGBA(obj.FieldRgbaString)) {
+errs = append(errs, types.NewValidationError("FieldRgbaString must be a valid RGBA color"))
+}
+`,
+		},
+		{
+			name: "rgba_byteslice_rgba",
+			args: args{
+				fieldName:       "FieldRgbaByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "rgba",
+			},
+			want: `if !(types.IsValidRGBA(string(obj.FieldRgbaByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldRgbaByteSlice must be a valid RGBA color"))
+}
+`,
+		},
+		{
+			name: "hsl_string_hsl",
+			args: args{
+				fieldName:       "FieldHslString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "hsl",
+			},
+			want: `if !(types.IsValidHSL(obj.FieldHslString)) {
+errs = append(errs, types.NewValidationError("FieldHslString must be a valid HSL color"))
+}
+`,
+		},
+		{
+			name: "hsl_byteslice_hsl",
+			args: args{
+				fieldName:       "FieldHslByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hsl",
+			},
+			want: `if !(types.IsValidHSL(string(obj.FieldHslByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldHslByteSlice must be a valid HSL color"))
+}
+`,
+		},
+		{
+			name: "datauri_string_datauri",
+			args: args{
+				fieldName:       "FieldDatauriString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "datauri",
+			},
+			want: `if !(types.IsValidDataURI(obj.FieldDatauriString)) {
+errs = append(errs, types.NewValidationError("FieldDatauriString must be a valid data URI"))
+}
+`,
+		},
+		{
+			name: "datauri_byteslice_datauri",
+			args: args{
+				fieldName:       "FieldDatauriByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "datauri",
+			},
+			want: `if !(types.IsValidDataURI(string(obj.FieldDatauriByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldDatauriByteSlice must be a valid data URI"))
+}
+`,
+		},
+		{
+			name: "mimetype_string_mimetype",
+			args: args{
+				fieldName:       "FieldMimetypeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "mimetype",
+			},
+			want: `if !(types.IsValidMIMEType(obj.FieldMimetypeString)) {
+errs = append(errs, types.NewValidationError("FieldMimetypeString must be a valid MIME type"))
+}
+`,
+		},
+		{
+			name: "mimetype_byteslice_mimetype",
+			args: args{
+				fieldName:       "FieldMimetypeByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "mimetype",
+			},
+			want: `if !(types.IsValidMIMEType(string(obj.FieldMimetypeByteSlice))) {
+errs = append(errs, types.NewValidationError("FieldMimetypeByteSlice must be a valid MIME type"))
+}
+`,
+		},
+		{
+			name: "datetime_string_datetime=2006-01-02",
+			args: args{
+				fieldName:       "FieldDatetimeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "datetime=2006-01-02",
+			},
+			want: `if !(types.IsValidDatetime(obj.FieldDatetimeString, "2006-01-02")) {
+errs = append(errs, types.NewValidationError("FieldDatetimeString must be a valid datetime with layout '2006-01-02'"))
+}
+`,
+		},
+		{
+			name: "timezone_string_timezone",
+			args: args{
+				fieldName:       "FieldTimezoneString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "timezone",
+			},
+			want: `if !(types.IsValidTimezone(obj.FieldTimezoneString)) {
+errs = append(errs, types.NewValidationError("FieldTimezoneString must be a valid time zone"))
+}
+`,
+		},
+		{
+			name: "duration_string_duration",
+			args: args{
+				fieldName:       "FieldDurationString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "duration",
+			},
+			want: `if !(types.IsValidDuration(obj.FieldDurationString)) {
+errs = append(errs, types.NewValidationError("FieldDurationString must be a valid duration"))
+}
+`,
+		},
+		{
+			name: "cpf_string_cpf",
+			args: args{
+				fieldName:       "FieldCpfString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf",
+			},
+			want: `if !(types.IsValidCPF(obj.FieldCpfString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpfString must be a valid CPF"))
+}
+`,
+		},
+		{
+			name: "cpf_formatted_string_cpf_formatted",
+			args: args{
+				fieldName:       "FieldCpf_formattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_formatted",
+			},
+			want: `if !(types.IsValidCPF(obj.FieldCpf_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_formattedString must be a valid formatted CPF"))
+}
+`,
+		},
+		{
+			name: "cpf_unformatted_string_cpf_unformatted",
+			args: args{
+				fieldName:       "FieldCpf_unformattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_unformatted",
+			},
+			want: `if !(types.IsValidCPF(obj.FieldCpf_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_unformattedString must be a valid unformatted CPF"))
+}
+`,
+		},
+		{
+			name: "cnpj_string_cnpj",
+			args: args{
+				fieldName:       "FieldCnpjString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj",
+			},
+			want: `if !(types.IsValidCNPJ(obj.FieldCnpjString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCnpjString must be a valid CNPJ"))
+}
+`,
+		},
+		{
+			name: "cnpj_formatted_string_cnpj_formatted",
+			args: args{
+				fieldName:       "FieldCnpj_formattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj_formatted",
+			},
+			want: `if !(types.IsValidCNPJ(obj.FieldCnpj_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_formattedString must be a valid formatted CNPJ"))
+}
+`,
+		},
+		{
+			name: "cnpj_unformatted_string_cnpj_unformatted",
+			args: args{
+				fieldName:       "FieldCnpj_unformattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj_unformatted",
+			},
+			want: `if !(types.IsValidCNPJ(obj.FieldCnpj_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_unformattedString must be a valid unformatted CNPJ"))
+}
+`,
+		},
+		{
+			name: "cep_string_cep",
+			args: args{
+				fieldName:       "FieldCepString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cep",
+			},
+			want: `if !(types.IsValidCEP(obj.FieldCepString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCepString must be a valid CEP"))
+}
+`,
+		},
+		{
+			name: "cep_formatted_string_cep_formatted",
+			args: args{
+				fieldName:       "FieldCep_formattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cep_formatted",
+			},
+			want: `if !(types.IsValidCEP(obj.FieldCep_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_formattedString must be a valid formatted CEP"))
+}
+`,
+		},
+		{
+			name: "cep_unformatted_string_cep_unformatted",
+			args: args{
+				fieldName:       "FieldCep_unformattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cep_unformatted",
+			},
+			want: `if !(types.IsValidCEP(obj.FieldCep_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_unformattedString must be a valid unformatted CEP"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_string_cpf_cnpj",
+			args: args{
+				fieldName:       "FieldCpf_cnpjString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj",
+			},
+			want: `if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpjString, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpjString must be a valid CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_formatted_string_cpf_cnpj_formatted",
+			args: args{
+				fieldName:       "FieldCpf_cnpj_formattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj_formatted",
+			},
+			want: `if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_formattedString, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedString must be a valid formatted CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_unformatted_string_cpf_cnpj_unformatted",
+			args: args{
+				fieldName:       "FieldCpf_cnpj_unformattedString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj_unformatted",
+			},
+			want: `if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_unformattedString, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedString must be a valid unformatted CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "luhn_checksum_string_luhn_checksum",
+			args: args{
+				fieldName:       "FieldLuhn_checksumString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "luhn_checksum",
+			},
+			want: `if !(types.IsValidLuhnChecksum(obj.FieldLuhn_checksumString)) {
+errs = append(errs, types.NewValidationError("FieldLuhn_checksumString must have a valid Luhn checksum"))
+}
+`,
+		},
+		{
+			name: "credit_card_string_credit_card",
+			args: args{
+				fieldName:       "FieldCredit_cardString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "credit_card",
+			},
+			want: `if !(types.IsValidCreditCard(obj.FieldCredit_cardString)) {
+errs = append(errs, types.NewValidationError("FieldCredit_cardString must be a valid credit card number"))
+}
+`,
+		},
+		{
+			name: "iban_string_iban",
+			args: args{
+				fieldName:       "FieldIbanString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iban",
+			},
+			want: `if !(types.IsValidIBAN(obj.FieldIbanString)) {
+errs = append(errs, types.NewValidationError("FieldIbanString must be a valid IBAN"))
+}
+`,
+		},
+		{
+			name: "bic_string_bic",
+			args: args{
+				fieldName:       "FieldBicString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "bic",
+			},
+			want: `if !(types.IsValidBIC(obj.FieldBicString)) {
+errs = append(errs, types.NewValidationError("FieldBicString must be a valid BIC"))
+}
+`,
+		},
+		{
+			name: "isbn10_string_isbn10",
+			args: args{
+				fieldName:       "FieldIsbn10String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "isbn10",
+			},
+			want: `if !(types.IsValidISBN10(obj.FieldIsbn10String)) {
+errs = append(errs, types.NewValidationError("FieldIsbn10String must be a valid ISBN-10"))
+}
+`,
+		},
+		{
+			name: "isbn13_string_isbn13",
+			args: args{
+				fieldName:       "FieldIsbn13String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "isbn13",
+			},
+			want: `if !(types.IsValidISBN13(obj.FieldIsbn13String)) {
+errs = append(errs, types.NewValidationError("FieldIsbn13String must be a valid ISBN-13"))
+}
+`,
+		},
+		{
+			name: "issn_string_issn",
+			args: args{
+				fieldName:       "FieldIssnString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "issn",
+			},
+			want: `if !(types.IsValidISSN(obj.FieldIssnString)) {
+errs = append(errs, types.NewValidationError("FieldIssnString must be a valid ISSN"))
+}
+`,
+		},
+		{
+			name: "ean8_string_ean8",
+			args: args{
+				fieldName:       "FieldEan8String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ean8",
+			},
+			want: `if !(types.IsValidEAN8(obj.FieldEan8String)) {
+errs = append(errs, types.NewValidationError("FieldEan8String must be a valid EAN-8"))
+}
+`,
+		},
+		{
+			name: "ean13_string_ean13",
+			args: args{
+				fieldName:       "FieldEan13String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "ean13",
+			},
+			want: `if !(types.IsValidEAN13(obj.FieldEan13String)) {
+errs = append(errs, types.NewValidationError("FieldEan13String must be a valid EAN-13"))
+}
+`,
+		},
+		{
+			name: "upc_string_upc",
+			args: args{
+				fieldName:       "FieldUpcString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "upc",
+			},
+			want: `if !(types.IsValidUPC(obj.FieldUpcString)) {
+errs = append(errs, types.NewValidationError("FieldUpcString must be a valid UPC"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha2_string_iso3166_1_alpha2",
+			args: args{
+				fieldName:       "FieldIso3166_1_alpha2String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_alpha2",
+			},
+			want: `if !(types.IsValidISO3166Alpha2(obj.FieldIso3166_1_alpha2String)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2String must be a valid ISO 3166-1 alpha-2 country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha3_string_iso3166_1_alpha3",
+			args: args{
+				fieldName:       "FieldIso3166_1_alpha3String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_alpha3",
+			},
+			want: `if !(types.IsValidISO3166Alpha3(obj.FieldIso3166_1_alpha3String)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3String must be a valid ISO 3166-1 alpha-3 country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_string_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166NumericString(obj.FieldIso3166_1_numericString)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericString must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int8_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt8))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int16_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt16))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int32_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt32))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int64_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt64))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint8_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint8))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint16_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint16))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint32_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint32))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint64_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint64))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64 must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso4217_string_iso4217",
+			args: args{
+				fieldName:       "FieldIso4217String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iso4217",
+			},
+			want: `if !(types.IsValidISO4217(obj.FieldIso4217String)) {
+errs = append(errs, types.NewValidationError("FieldIso4217String must be a valid ISO 4217 currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_string_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217NumericString(obj.FieldIso4217_numericString)) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericString must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int8_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt8))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int16_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt16))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int32_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt32))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int64_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt64))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint8_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint8))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint16_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint16))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint32_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint32))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint64_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint64))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64 must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "bcp47_language_tag_string_bcp47_language_tag",
+			args: args{
+				fieldName:       "FieldBcp47_language_tagString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "bcp47_language_tag",
+			},
+			want: `if !(types.IsValidBCP47LanguageTag(obj.FieldBcp47_language_tagString)) {
+errs = append(errs, types.NewValidationError("FieldBcp47_language_tagString must be a valid BCP 47 language tag"))
+}
+`,
+		},
+		{
+			name: "e164_string_e164",
+			args: args{
+				fieldName:       "FieldE164String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "e164",
+			},
+			want: `if !(types.IsValidE164(obj.FieldE164String)) {
+errs = append(errs, types.NewValidationError("FieldE164String must be a valid E.164 phone number"))
+}
+`,
+		},
+		{
+			name: "postcode_iso3166_alpha2_string_postcode_iso3166_alpha2=BR",
+			args: args{
+				fieldName:       "FieldPostcode_iso3166_alpha2String",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "postcode_iso3166_alpha2=BR",
+			},
+			want: `if !(types.IsValidPostcode(obj.FieldPostcode_iso3166_alpha2String, "BR")) {
+errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2String must be a valid postal code for 'BR'"))
+}
+`,
+		},
+		{
+			name: "latitude_string_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(types.IsValidLatitude(obj.FieldLatitudeString)) {
+errs = append(errs, types.NewValidationError("FieldLatitudeString must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "latitude_float32_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(obj.FieldLatitudeFloat32 >= -90 && obj.FieldLatitudeFloat32 <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat32 must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "latitude_float64_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(obj.FieldLatitudeFloat64 >= -90 && obj.FieldLatitudeFloat64 <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat64 must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "longitude_string_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(types.IsValidLongitude(obj.FieldLongitudeString)) {
+errs = append(errs, types.NewValidationError("FieldLongitudeString must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "longitude_float32_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(obj.FieldLongitudeFloat32 >= -180 && obj.FieldLongitudeFloat32 <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat32 must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "longitude_float64_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(obj.FieldLongitudeFloat64 >= -180 && obj.FieldLongitudeFloat64 <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat64 must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "semver_string_semver",
+			args: args{
+				fieldName:       "FieldSemverString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "semver",
+			},
+			want: `if !(types.IsValidSemver(obj.FieldSemverString)) {
+errs = append(errs, types.NewValidationError("FieldSemverString must be a valid semantic version (e.g. 1.2.3)"))
+}
+`,
+		},
+		{
+			name: "cron_string_cron",
+			args: args{
+				fieldName:       "FieldCronString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "cron",
+			},
+			want: `if !(types.IsValidCron(obj.FieldCronString)) {
+errs = append(errs, types.NewValidationError("FieldCronString must be a valid cron expression (5 fields, or 6 with seconds)"))
+}
+`,
+		},
+		{
+			name: "finite_float32_finite",
+			args: args{
+				fieldName:       "FieldFiniteFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "finite",
+			},
+			want: `if !(types.IsFinite(obj.FieldFiniteFloat32)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat32 must be a finite number"))
+}
+`,
+		},
+		{
+			name: "finite_float64_finite",
+			args: args{
+				fieldName:       "FieldFiniteFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "finite",
+			},
+			want: `if !(types.IsFinite(obj.FieldFiniteFloat64)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat64 must be a finite number"))
+}
+`,
+		},
+		{
+			name: "multipleof_int_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int8_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt8%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt8 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int16_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt16%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt16 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int32_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt32%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt32 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int64_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt64%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt64 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint8_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint8%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint8 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint16_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint16%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint16 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint32_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint32%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint32 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint64_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint64%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint64 must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_float32_multipleof=0.25",
+			args: args{
+				fieldName:       "FieldMultipleofFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "multipleof=0.25",
+			},
+			want: `if !(types.IsMultipleOf(obj.FieldMultipleofFloat32, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat32 must be a multiple of 0.25"))
+}
+`,
+		},
+		{
+			name: "multipleof_float64_multipleof=0.25",
+			args: args{
+				fieldName:       "FieldMultipleofFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "multipleof=0.25",
+			},
+			want: `if !(types.IsMultipleOf(obj.FieldMultipleofFloat64, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat64 must be a multiple of 0.25"))
+}
+`,
+		},
+		{
+			name: "maxdecimals_float32_maxdecimals=2",
+			args: args{
+				fieldName:       "FieldMaxdecimalsFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "maxdecimals=2",
+			},
+			want: `if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat32, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32 must have at most 2 decimal places"))
+}
+`,
+		},
+		{
+			name: "maxdecimals_float64_maxdecimals=2",
+			args: args{
+				fieldName:       "FieldMaxdecimalsFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "maxdecimals=2",
+			},
+			want: `if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat64, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64 must have at most 2 decimal places"))
+}
+`,
+		},
+		{
+			name: "positive_int_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int8_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt8 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt8 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int16_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt16 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt16 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int32_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt32 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int64_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt64 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint8_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint8 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint8 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint16_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint16 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint16 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint32_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint32 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint64_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint64 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_float32_positive",
+			args: args{
+				fieldName:       "FieldPositiveFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveFloat32 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat32 must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_float64_positive",
+			args: args{
+				fieldName:       "FieldPositiveFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveFloat64 > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat64 must be positive"))
+}
+`,
+		},
+		{
+			name: "negative_float32_negative",
+			args: args{
+				fieldName:       "FieldNegativeFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "negative",
+			},
+			want: `if !(obj.FieldNegativeFloat32 < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat32 must be negative"))
+}
+`,
+		},
+		{
+			name: "negative_float64_negative",
+			args: args{
+				fieldName:       "FieldNegativeFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "negative",
+			},
+			want: `if !(obj.FieldNegativeFloat64 < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat64 must be negative"))
+}
+`,
+		},
+		{
+			name: "nonzero_int_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int8_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int8", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt8 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt8 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int16_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int16", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt16 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt16 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int32_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt32 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int64_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "int64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt64 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint8_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint8",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint8", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint8 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint8 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint16_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint16",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint16", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint16 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint16 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint32_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint32 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint64_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "uint64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint64 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_float32_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroFloat32",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroFloat32 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat32 must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_float64_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroFloat64",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "float64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroFloat64 != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat64 must not be zero"))
+}
+`,
+		},
+		{
+			name: "decimal_string_decimal=12,2",
+			args: args{
+				fieldName:       "FieldDecimalString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "decimal=12,2",
+			},
+			want: `if !(types.IsValidDecimal(obj.FieldDecimalString, 12,2)) {
+errs = append(errs, types.NewValidationError("FieldDecimalString must be a decimal(12,2)"))
+}
+`,
+		},
+		{
+			name: "decimal_gt_string_decimal_gt=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_gtString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_gt=0.01",
+			},
+			want: `if !(types.IsDecimalGT(obj.FieldDecimal_gtString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gtString must be a decimal > 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_gte_string_decimal_gte=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_gteString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_gte=0.01",
+			},
+			want: `if !(types.IsDecimalGTE(obj.FieldDecimal_gteString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gteString must be a decimal >= 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_lt_string_decimal_lt=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_ltString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_lt=0.01",
+			},
+			want: `if !(types.IsDecimalLT(obj.FieldDecimal_ltString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_ltString must be a decimal < 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_lte_string_decimal_lte=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_lteString",
+				fieldType:       common.FieldType{ComposedType: "", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_lte=0.01",
+			},
+			want: `if !(types.IsDecimalLTE(obj.FieldDecimal_lteString, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_lteString must be a decimal <= 0.01"))
+}
 `,
 		},
 		{
@@ -366,6 +2502,18 @@ errs = append(errs, types.NewValidationError("FieldRequiredFloat64Slice must not
 			want: `if !(len(obj.FieldRequiredBoolSlice) != 0) {
 errs = append(errs, types.NewValidationError("FieldRequiredBoolSlice must not be empty"))
 }
+`,
+		},
+		{
+			name: "required_byteslice_required",
+			args: args{
+				fieldName:       "FieldRequiredByteSlice",
+				fieldType:       common.FieldType{ComposedType: "[]", BaseType: "byte", Size: ""},
+				fieldValidation: "required",
+			},
+			want: `if !(len(obj.FieldRequiredByteSlice) != 0) {
+errs = append(errs, types.NewValidationError("FieldRequiredByteSlice must not be empty"))
+}
 `,
 		},
 		{
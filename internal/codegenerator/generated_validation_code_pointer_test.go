@@ -30,6 +30,2142 @@ func TestBuildValidationCodePointer(t *testing.T) {
 			want: `if !(obj.FieldEmailStringPointer != nil && types.IsValidEmail(*obj.FieldEmailStringPointer)) {
 errs = append(errs, types.NewValidationError("FieldEmailStringPointer must be a valid email"))
 }
+`,
+		},
+		{
+			name: "regex_stringpointer_regex='^[a-z]{2,5}$'",
+			args: args{
+				fieldName:       "FieldRegexStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "regex='^[a-z]{2,5}$'",
+			},
+			want: `if !(obj.FieldRegexStringPointer != nil && validgenRegex691022c6.MatchString(*obj.FieldRegexStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRegexStringPointer must match the pattern '^[a-z]{2,5}$'"))
+}
+`,
+		},
+		{
+			name: "url_stringpointer_url",
+			args: args{
+				fieldName:       "FieldUrlStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "url",
+			},
+			want: `if !(obj.FieldUrlStringPointer != nil && types.IsValidURL(*obj.FieldUrlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUrlStringPointer must be a valid URL"))
+}
+`,
+		},
+		{
+			name: "uri_stringpointer_uri",
+			args: args{
+				fieldName:       "FieldUriStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uri",
+			},
+			want: `if !(obj.FieldUriStringPointer != nil && types.IsValidURI(*obj.FieldUriStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUriStringPointer must be a valid URI"))
+}
+`,
+		},
+		{
+			name: "http_url_stringpointer_http_url",
+			args: args{
+				fieldName:       "FieldHttp_urlStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "http_url",
+			},
+			want: `if !(obj.FieldHttp_urlStringPointer != nil && types.IsValidHTTPURL(*obj.FieldHttp_urlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHttp_urlStringPointer must be a valid HTTP URL"))
+}
+`,
+		},
+		{
+			name: "url_scheme_stringpointer_url_scheme=https wss",
+			args: args{
+				fieldName:       "FieldUrl_schemeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "url_scheme=https wss",
+			},
+			want: `if !(obj.FieldUrl_schemeStringPointer != nil && types.IsValidURLWithScheme(*obj.FieldUrl_schemeStringPointer, []string{"https", "wss"})) {
+errs = append(errs, types.NewValidationError("FieldUrl_schemeStringPointer must be a valid URL with scheme 'https' 'wss'"))
+}
+`,
+		},
+		{
+			name: "ip_stringpointer_ip",
+			args: args{
+				fieldName:       "FieldIpStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ip",
+			},
+			want: `if !(obj.FieldIpStringPointer != nil && types.IsValidIP(*obj.FieldIpStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpStringPointer must be a valid IP address"))
+}
+`,
+		},
+		{
+			name: "ipv4_stringpointer_ipv4",
+			args: args{
+				fieldName:       "FieldIpv4StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ipv4",
+			},
+			want: `if !(obj.FieldIpv4StringPointer != nil && types.IsValidIPv4(*obj.FieldIpv4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpv4StringPointer must be a valid IPv4 address"))
+}
+`,
+		},
+		{
+			name: "ipv6_stringpointer_ipv6",
+			args: args{
+				fieldName:       "FieldIpv6StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ipv6",
+			},
+			want: `if !(obj.FieldIpv6StringPointer != nil && types.IsValidIPv6(*obj.FieldIpv6StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIpv6StringPointer must be a valid IPv6 address"))
+}
+`,
+		},
+		{
+			name: "cidr_stringpointer_cidr",
+			args: args{
+				fieldName:       "FieldCidrStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cidr",
+			},
+			want: `if !(obj.FieldCidrStringPointer != nil && types.IsValidCIDR(*obj.FieldCidrStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrStringPointer must be a valid CIDR"))
+}
+`,
+		},
+		{
+			name: "cidrv4_stringpointer_cidrv4",
+			args: args{
+				fieldName:       "FieldCidrv4StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cidrv4",
+			},
+			want: `if !(obj.FieldCidrv4StringPointer != nil && types.IsValidCIDRv4(*obj.FieldCidrv4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrv4StringPointer must be a valid IPv4 CIDR"))
+}
+`,
+		},
+		{
+			name: "cidrv6_stringpointer_cidrv6",
+			args: args{
+				fieldName:       "FieldCidrv6StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cidrv6",
+			},
+			want: `if !(obj.FieldCidrv6StringPointer != nil && types.IsValidCIDRv6(*obj.FieldCidrv6StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCidrv6StringPointer must be a valid IPv6 CIDR"))
+}
+`,
+		},
+		{
+			name: "private_ip_stringpointer_private_ip",
+			args: args{
+				fieldName:       "FieldPrivate_ipStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "private_ip",
+			},
+			want: `if !(obj.FieldPrivate_ipStringPointer != nil && types.IsPrivateIP(*obj.FieldPrivate_ipStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPrivate_ipStringPointer must be a private IP address"))
+}
+`,
+		},
+		{
+			name: "public_ip_stringpointer_public_ip",
+			args: args{
+				fieldName:       "FieldPublic_ipStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "public_ip",
+			},
+			want: `if !(obj.FieldPublic_ipStringPointer != nil && types.IsPublicIP(*obj.FieldPublic_ipStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPublic_ipStringPointer must be a public IP address"))
+}
+`,
+		},
+		{
+			name: "mac_stringpointer_mac",
+			args: args{
+				fieldName:       "FieldMacStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "mac",
+			},
+			want: `if !(obj.FieldMacStringPointer != nil && types.IsValidMAC(*obj.FieldMacStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMacStringPointer must be a valid MAC address"))
+}
+`,
+		},
+		{
+			name: "hostname_stringpointer_hostname",
+			args: args{
+				fieldName:       "FieldHostnameStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "hostname",
+			},
+			want: `if !(obj.FieldHostnameStringPointer != nil && types.IsValidHostname(*obj.FieldHostnameStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHostnameStringPointer must be a valid hostname"))
+}
+`,
+		},
+		{
+			name: "fqdn_stringpointer_fqdn",
+			args: args{
+				fieldName:       "FieldFqdnStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "fqdn",
+			},
+			want: `if !(obj.FieldFqdnStringPointer != nil && types.IsValidFQDN(*obj.FieldFqdnStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldFqdnStringPointer must be a valid FQDN"))
+}
+`,
+		},
+		{
+			name: "hostname_port_stringpointer_hostname_port",
+			args: args{
+				fieldName:       "FieldHostname_portStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "hostname_port",
+			},
+			want: `if !(obj.FieldHostname_portStringPointer != nil && types.IsValidHostnamePort(*obj.FieldHostname_portStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHostname_portStringPointer must be a valid hostname and port"))
+}
+`,
+		},
+		{
+			name: "uuid_stringpointer_uuid",
+			args: args{
+				fieldName:       "FieldUuidStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uuid",
+			},
+			want: `if !(obj.FieldUuidStringPointer != nil && types.IsValidUUID(*obj.FieldUuidStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuidStringPointer must be a valid UUID"))
+}
+`,
+		},
+		{
+			name: "uuid3_stringpointer_uuid3",
+			args: args{
+				fieldName:       "FieldUuid3StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uuid3",
+			},
+			want: `if !(obj.FieldUuid3StringPointer != nil && types.IsValidUUID3(*obj.FieldUuid3StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid3StringPointer must be a valid UUID v3"))
+}
+`,
+		},
+		{
+			name: "uuid4_stringpointer_uuid4",
+			args: args{
+				fieldName:       "FieldUuid4StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uuid4",
+			},
+			want: `if !(obj.FieldUuid4StringPointer != nil && types.IsValidUUID4(*obj.FieldUuid4StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid4StringPointer must be a valid UUID v4"))
+}
+`,
+		},
+		{
+			name: "uuid5_stringpointer_uuid5",
+			args: args{
+				fieldName:       "FieldUuid5StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uuid5",
+			},
+			want: `if !(obj.FieldUuid5StringPointer != nil && types.IsValidUUID5(*obj.FieldUuid5StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid5StringPointer must be a valid UUID v5"))
+}
+`,
+		},
+		{
+			name: "uuid7_stringpointer_uuid7",
+			args: args{
+				fieldName:       "FieldUuid7StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uuid7",
+			},
+			want: `if !(obj.FieldUuid7StringPointer != nil && types.IsValidUUID7(*obj.FieldUuid7StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUuid7StringPointer must be a valid UUID v7"))
+}
+`,
+		},
+		{
+			name: "ulid_stringpointer_ulid",
+			args: args{
+				fieldName:       "FieldUlidStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ulid",
+			},
+			want: `if !(obj.FieldUlidStringPointer != nil && types.IsValidULID(*obj.FieldUlidStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUlidStringPointer must be a valid ULID"))
+}
+`,
+		},
+		{
+			name: "mongodb_stringpointer_mongodb",
+			args: args{
+				fieldName:       "FieldMongodbStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "mongodb",
+			},
+			want: `if !(obj.FieldMongodbStringPointer != nil && types.IsValidMongoDBObjectID(*obj.FieldMongodbStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMongodbStringPointer must be a valid MongoDB ObjectID"))
+}
+`,
+		},
+		{
+			name: "alpha_stringpointer_alpha",
+			args: args{
+				fieldName:       "FieldAlphaStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "alpha",
+			},
+			want: `if !(obj.FieldAlphaStringPointer != nil && types.IsAlpha(*obj.FieldAlphaStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphaStringPointer must contain only ASCII letters"))
+}
+`,
+		},
+		{
+			name: "alphanum_stringpointer_alphanum",
+			args: args{
+				fieldName:       "FieldAlphanumStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "alphanum",
+			},
+			want: `if !(obj.FieldAlphanumStringPointer != nil && types.IsAlphanumeric(*obj.FieldAlphanumStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumStringPointer must contain only ASCII letters and digits"))
+}
+`,
+		},
+		{
+			name: "alphaunicode_stringpointer_alphaunicode",
+			args: args{
+				fieldName:       "FieldAlphaunicodeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "alphaunicode",
+			},
+			want: `if !(obj.FieldAlphaunicodeStringPointer != nil && types.IsAlphaUnicode(*obj.FieldAlphaunicodeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphaunicodeStringPointer must contain only letters"))
+}
+`,
+		},
+		{
+			name: "alphanumunicode_stringpointer_alphanumunicode",
+			args: args{
+				fieldName:       "FieldAlphanumunicodeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "alphanumunicode",
+			},
+			want: `if !(obj.FieldAlphanumunicodeStringPointer != nil && types.IsAlphanumericUnicode(*obj.FieldAlphanumunicodeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAlphanumunicodeStringPointer must contain only letters and numbers"))
+}
+`,
+		},
+		{
+			name: "numeric_stringpointer_numeric",
+			args: args{
+				fieldName:       "FieldNumericStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "numeric",
+			},
+			want: `if !(obj.FieldNumericStringPointer != nil && types.IsNumeric(*obj.FieldNumericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNumericStringPointer must be a numeric value"))
+}
+`,
+		},
+		{
+			name: "number_stringpointer_number",
+			args: args{
+				fieldName:       "FieldNumberStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "number",
+			},
+			want: `if !(obj.FieldNumberStringPointer != nil && types.IsNumber(*obj.FieldNumberStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNumberStringPointer must contain only digits"))
+}
+`,
+		},
+		{
+			name: "ascii_stringpointer_ascii",
+			args: args{
+				fieldName:       "FieldAsciiStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ascii",
+			},
+			want: `if !(obj.FieldAsciiStringPointer != nil && types.IsASCII(*obj.FieldAsciiStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldAsciiStringPointer must contain only ASCII characters"))
+}
+`,
+		},
+		{
+			name: "printascii_stringpointer_printascii",
+			args: args{
+				fieldName:       "FieldPrintasciiStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "printascii",
+			},
+			want: `if !(obj.FieldPrintasciiStringPointer != nil && types.IsPrintableASCII(*obj.FieldPrintasciiStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldPrintasciiStringPointer must contain only printable ASCII characters"))
+}
+`,
+		},
+		{
+			name: "lowercase_stringpointer_lowercase",
+			args: args{
+				fieldName:       "FieldLowercaseStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "lowercase",
+			},
+			want: `if !(obj.FieldLowercaseStringPointer != nil && types.IsLowercase(*obj.FieldLowercaseStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLowercaseStringPointer must be lowercase"))
+}
+`,
+		},
+		{
+			name: "uppercase_stringpointer_uppercase",
+			args: args{
+				fieldName:       "FieldUppercaseStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "uppercase",
+			},
+			want: `if !(obj.FieldUppercaseStringPointer != nil && types.IsUppercase(*obj.FieldUppercaseStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUppercaseStringPointer must be uppercase"))
+}
+`,
+		},
+		{
+			name: "notblank_stringpointer_notblank",
+			args: args{
+				fieldName:       "FieldNotblankStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "notblank",
+			},
+			want: `if !(obj.FieldNotblankStringPointer != nil && types.IsNotBlank(*obj.FieldNotblankStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldNotblankStringPointer must not be blank"))
+}
+`,
+		},
+		{
+			name: "contains_stringpointer_contains=ab",
+			args: args{
+				fieldName:       "FieldContainsStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "contains=ab",
+			},
+			want: `if !(obj.FieldContainsStringPointer != nil && types.Contains(*obj.FieldContainsStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldContainsStringPointer must contain 'ab'"))
+}
+`,
+		},
+		{
+			name: "containsany_stringpointer_containsany=!@#",
+			args: args{
+				fieldName:       "FieldContainsanyStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "containsany=!@#",
+			},
+			want: `if !(obj.FieldContainsanyStringPointer != nil && types.ContainsAny(*obj.FieldContainsanyStringPointer, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldContainsanyStringPointer must contain any of '!@#'"))
+}
+`,
+		},
+		{
+			name: "containsrune_stringpointer_containsrune=@",
+			args: args{
+				fieldName:       "FieldContainsruneStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "containsrune=@",
+			},
+			want: `if !(obj.FieldContainsruneStringPointer != nil && types.Contains(*obj.FieldContainsruneStringPointer, "@")) {
+errs = append(errs, types.NewValidationError("FieldContainsruneStringPointer must contain '@'"))
+}
+`,
+		},
+		{
+			name: "excludes_stringpointer_excludes=ab",
+			args: args{
+				fieldName:       "FieldExcludesStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "excludes=ab",
+			},
+			want: `if !(obj.FieldExcludesStringPointer != nil && !types.Contains(*obj.FieldExcludesStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldExcludesStringPointer must not contain 'ab'"))
+}
+`,
+		},
+		{
+			name: "excludesall_stringpointer_excludesall=!@#",
+			args: args{
+				fieldName:       "FieldExcludesallStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "excludesall=!@#",
+			},
+			want: `if !(obj.FieldExcludesallStringPointer != nil && !types.ContainsAny(*obj.FieldExcludesallStringPointer, "!@#")) {
+errs = append(errs, types.NewValidationError("FieldExcludesallStringPointer must not contain any of '!@#'"))
+}
+`,
+		},
+		{
+			name: "startswith_stringpointer_startswith=ab",
+			args: args{
+				fieldName:       "FieldStartswithStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "startswith=ab",
+			},
+			want: `if !(obj.FieldStartswithStringPointer != nil && types.HasPrefix(*obj.FieldStartswithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartswithStringPointer must start with 'ab'"))
+}
+`,
+		},
+		{
+			name: "endswith_stringpointer_endswith=ab",
+			args: args{
+				fieldName:       "FieldEndswithStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "endswith=ab",
+			},
+			want: `if !(obj.FieldEndswithStringPointer != nil && types.HasSuffix(*obj.FieldEndswithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndswithStringPointer must end with 'ab'"))
+}
+`,
+		},
+		{
+			name: "startsnotwith_stringpointer_startsnotwith=ab",
+			args: args{
+				fieldName:       "FieldStartsnotwithStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "startsnotwith=ab",
+			},
+			want: `if !(obj.FieldStartsnotwithStringPointer != nil && !types.HasPrefix(*obj.FieldStartsnotwithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldStartsnotwithStringPointer must not start with 'ab'"))
+}
+`,
+		},
+		{
+			name: "endsnotwith_stringpointer_endsnotwith=ab",
+			args: args{
+				fieldName:       "FieldEndsnotwithStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "endsnotwith=ab",
+			},
+			want: `if !(obj.FieldEndsnotwithStringPointer != nil && !types.HasSuffix(*obj.FieldEndsnotwithStringPointer, "ab")) {
+errs = append(errs, types.NewValidationError("FieldEndsnotwithStringPointer must not end with 'ab'"))
+}
+`,
+		},
+		{
+			name: "in_ignore_case_stringpointer_in_ignore_case=ab cd ef",
+			args: args{
+				fieldName:       "FieldIn_ignore_caseStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "in_ignore_case=ab cd ef",
+			},
+			want: `if !(obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ab") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "cd") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ef")) {
+errs = append(errs, types.NewValidationError("FieldIn_ignore_caseStringPointer must be one of 'ab' 'cd' 'ef'"))
+}
+`,
+		},
+		{
+			name: "nin_ignore_case_stringpointer_nin_ignore_case=ab cd ef",
+			args: args{
+				fieldName:       "FieldNin_ignore_caseStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "nin_ignore_case=ab cd ef",
+			},
+			want: `if !(obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ab") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "cd") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ef")) {
+errs = append(errs, types.NewValidationError("FieldNin_ignore_caseStringPointer must not be one of 'ab' 'cd' 'ef'"))
+}
+`,
+		},
+		{
+			name: "base64_stringpointer_base64",
+			args: args{
+				fieldName:       "FieldBase64StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "base64",
+			},
+			want: `if !(obj.FieldBase64StringPointer != nil && types.IsValidBase64(*obj.FieldBase64StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64StringPointer must be a valid base64 string"))
+}
+`,
+		},
+		{
+			name: "base64_byteslicepointer_base64",
+			args: args{
+				fieldName:       "FieldBase64ByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64",
+			},
+			want: `if !(obj.FieldBase64ByteSlicePointer != nil && types.IsValidBase64(string(*obj.FieldBase64ByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64ByteSlicePointer must be a valid base64 string"))
+}
+`,
+		},
+		{
+			name: "base64url_stringpointer_base64url",
+			args: args{
+				fieldName:       "FieldBase64urlStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "base64url",
+			},
+			want: `if !(obj.FieldBase64urlStringPointer != nil && types.IsValidBase64URL(*obj.FieldBase64urlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64urlStringPointer must be a valid base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64url_byteslicepointer_base64url",
+			args: args{
+				fieldName:       "FieldBase64urlByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64url",
+			},
+			want: `if !(obj.FieldBase64urlByteSlicePointer != nil && types.IsValidBase64URL(string(*obj.FieldBase64urlByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64urlByteSlicePointer must be a valid base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64rawurl_stringpointer_base64rawurl",
+			args: args{
+				fieldName:       "FieldBase64rawurlStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "base64rawurl",
+			},
+			want: `if !(obj.FieldBase64rawurlStringPointer != nil && types.IsValidBase64RawURL(*obj.FieldBase64rawurlStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlStringPointer must be a valid raw base64 URL string"))
+}
+`,
+		},
+		{
+			name: "base64rawurl_byteslicepointer_base64rawurl",
+			args: args{
+				fieldName:       "FieldBase64rawurlByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "base64rawurl",
+			},
+			want: `if !(obj.FieldBase64rawurlByteSlicePointer != nil && types.IsValidBase64RawURL(string(*obj.FieldBase64rawurlByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlicePointer must be a valid raw base64 URL string"))
+}
+`,
+		},
+		{
+			name: "hexadecimal_stringpointer_hexadecimal",
+			args: args{
+				fieldName:       "FieldHexadecimalStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "hexadecimal",
+			},
+			want: `if !(obj.FieldHexadecimalStringPointer != nil && types.IsValidHexadecimal(*obj.FieldHexadecimalStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalStringPointer must be a valid hexadecimal string"))
+}
+`,
+		},
+		{
+			name: "hexadecimal_byteslicepointer_hexadecimal",
+			args: args{
+				fieldName:       "FieldHexadecimalByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hexadecimal",
+			},
+			want: `if !(obj.FieldHexadecimalByteSlicePointer != nil && types.IsValidHexadecimal(string(*obj.FieldHexadecimalByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlicePointer must be a valid hexadecimal string"))
+}
+`,
+		},
+		{
+			name: "json_stringpointer_json",
+			args: args{
+				fieldName:       "FieldJsonStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "json",
+			},
+			want: `if !(obj.FieldJsonStringPointer != nil && types.IsValidJSON(*obj.FieldJsonStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldJsonStringPointer must be a valid JSON"))
+}
+`,
+		},
+		{
+			name: "json_byteslicepointer_json",
+			args: args{
+				fieldName:       "FieldJsonByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "json",
+			},
+			want: `if !(obj.FieldJsonByteSlicePointer != nil && types.IsValidJSON(string(*obj.FieldJsonByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldJsonByteSlicePointer must be a valid JSON"))
+}
+`,
+		},
+		{
+			name: "jwt_stringpointer_jwt",
+			args: args{
+				fieldName:       "FieldJwtStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "jwt",
+			},
+			want: `if !(obj.FieldJwtStringPointer != nil && types.IsValidJWT(*obj.FieldJwtStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldJwtStringPointer must be a valid JWT"))
+}
+`,
+		},
+		{
+			name: "jwt_byteslicepointer_jwt",
+			args: args{
+				fieldName:       "FieldJwtByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "jwt",
+			},
+			want: `if !(obj.FieldJwtByteSlicePointer != nil && types.IsValidJWT(string(*obj.FieldJwtByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldJwtByteSlicePointer must be a valid JWT"))
+}
+`,
+		},
+		{
+			name: "hexcolor_stringpointer_hexcolor",
+			args: args{
+				fieldName:       "FieldHexcolorStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "hexcolor",
+			},
+			want: `if !(obj.FieldHexcolorStringPointer != nil && types.IsValidHexColor(*obj.FieldHexcolorStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHexcolorStringPointer must be a valid hex color"))
+}
+`,
+		},
+		{
+			name: "hexcolor_byteslicepointer_hexcolor",
+			args: args{
+				fieldName:       "FieldHexcolorByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hexcolor",
+			},
+			want: `if !(obj.FieldHexcolorByteSlicePointer != nil && types.IsValidHexColor(string(*obj.FieldHexcolorByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHexcolorByteSlicePointer must be a valid hex color"))
+}
+`,
+		},
+		{
+			name: "rgb_stringpointer_rgb",
+			args: args{
+				fieldName:       "FieldRgbStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "rgb",
+			},
+			want: `if !(obj.FieldRgbStringPointer != nil && types.IsValidRGB(*obj.FieldRgbStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRgbStringPointer must be a valid RGB color"))
+}
+`,
+		},
+		{
+			name: "rgb_byteslicepointer_rgb",
+			args: args{
+				fieldName:       "FieldRgbByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "rgb",
+			},
+			want: `if !(obj.FieldRgbByteSlicePointer != nil && types.IsValidRGB(string(*obj.FieldRgbByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldRgbByteSlicePointer must be a valid RGB color"))
+}
+`,
+		},
+		{
+			name: "rgba_stringpointer_rgba",
+			args: args{
+				fieldName:       "FieldRgbaStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "rgba",
+			},
+			want: `if !(obj.FieldRgbaStringPointer != nil && types.IsValidRGBA(*obj.FieldRgbaStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldRgbaStringPointer must be a valid RGBA color"))
+}
+`,
+		},
+		{
+			name: "rgba_byteslicepointer_rgba",
+			args: args{
+				fieldName:       "FieldRgbaByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "rgba",
+			},
+			want: `if !(obj.FieldRgbaByteSlicePointer != nil && types.IsValidRGBA(string(*obj.FieldRgbaByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldRgbaByteSlicePointer must be a valid RGBA color"))
+}
+`,
+		},
+		{
+			name: "hsl_stringpointer_hsl",
+			args: args{
+				fieldName:       "FieldHslStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "hsl",
+			},
+			want: `if !(obj.FieldHslStringPointer != nil && types.IsValidHSL(*obj.FieldHslStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldHslStringPointer must be a valid HSL color"))
+}
+`,
+		},
+		{
+			name: "hsl_byteslicepointer_hsl",
+			args: args{
+				fieldName:       "FieldHslByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "hsl",
+			},
+			want: `if !(obj.FieldHslByteSlicePointer != nil && types.IsValidHSL(string(*obj.FieldHslByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldHslByteSlicePointer must be a valid HSL color"))
+}
+`,
+		},
+		{
+			name: "datauri_stringpointer_datauri",
+			args: args{
+				fieldName:       "FieldDatauriStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "datauri",
+			},
+			want: `if !(obj.FieldDatauriStringPointer != nil && types.IsValidDataURI(*obj.FieldDatauriStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldDatauriStringPointer must be a valid data URI"))
+}
+`,
+		},
+		{
+			name: "datauri_byteslicepointer_datauri",
+			args: args{
+				fieldName:       "FieldDatauriByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "datauri",
+			},
+			want: `if !(obj.FieldDatauriByteSlicePointer != nil && types.IsValidDataURI(string(*obj.FieldDatauriByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldDatauriByteSlicePointer must be a valid data URI"))
+}
+`,
+		},
+		{
+			name: "mimetype_stringpointer_mimetype",
+			args: args{
+				fieldName:       "FieldMimetypeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "mimetype",
+			},
+			want: `if !(obj.FieldMimetypeStringPointer != nil && types.IsValidMIMEType(*obj.FieldMimetypeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldMimetypeStringPointer must be a valid MIME type"))
+}
+`,
+		},
+		{
+			name: "mimetype_byteslicepointer_mimetype",
+			args: args{
+				fieldName:       "FieldMimetypeByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "mimetype",
+			},
+			want: `if !(obj.FieldMimetypeByteSlicePointer != nil && types.IsValidMIMEType(string(*obj.FieldMimetypeByteSlicePointer))) {
+errs = append(errs, types.NewValidationError("FieldMimetypeByteSlicePointer must be a valid MIME type"))
+}
+`,
+		},
+		{
+			name: "datetime_stringpointer_datetime=2006-01-02",
+			args: args{
+				fieldName:       "FieldDatetimeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "datetime=2006-01-02",
+			},
+			want: `if !(obj.FieldDatetimeStringPointer != nil && types.IsValidDatetime(*obj.FieldDatetimeStringPointer, "2006-01-02")) {
+errs = append(errs, types.NewValidationError("FieldDatetimeStringPointer must be a valid datetime with layout '2006-01-02'"))
+}
+`,
+		},
+		{
+			name: "timezone_stringpointer_timezone",
+			args: args{
+				fieldName:       "FieldTimezoneStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "timezone",
+			},
+			want: `if !(obj.FieldTimezoneStringPointer != nil && types.IsValidTimezone(*obj.FieldTimezoneStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldTimezoneStringPointer must be a valid time zone"))
+}
+`,
+		},
+		{
+			name: "duration_stringpointer_duration",
+			args: args{
+				fieldName:       "FieldDurationStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "duration",
+			},
+			want: `if !(obj.FieldDurationStringPointer != nil && types.IsValidDuration(*obj.FieldDurationStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldDurationStringPointer must be a valid duration"))
+}
+`,
+		},
+		{
+			name: "cpf_stringpointer_cpf",
+			args: args{
+				fieldName:       "FieldCpfStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf",
+			},
+			want: `if !(obj.FieldCpfStringPointer != nil && types.IsValidCPF(*obj.FieldCpfStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpfStringPointer must be a valid CPF"))
+}
+`,
+		},
+		{
+			name: "cpf_formatted_stringpointer_cpf_formatted",
+			args: args{
+				fieldName:       "FieldCpf_formattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_formatted",
+			},
+			want: `if !(obj.FieldCpf_formattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_formattedStringPointer must be a valid formatted CPF"))
+}
+`,
+		},
+		{
+			name: "cpf_unformatted_stringpointer_cpf_unformatted",
+			args: args{
+				fieldName:       "FieldCpf_unformattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_unformatted",
+			},
+			want: `if !(obj.FieldCpf_unformattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_unformattedStringPointer must be a valid unformatted CPF"))
+}
+`,
+		},
+		{
+			name: "cnpj_stringpointer_cnpj",
+			args: args{
+				fieldName:       "FieldCnpjStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj",
+			},
+			want: `if !(obj.FieldCnpjStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpjStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCnpjStringPointer must be a valid CNPJ"))
+}
+`,
+		},
+		{
+			name: "cnpj_formatted_stringpointer_cnpj_formatted",
+			args: args{
+				fieldName:       "FieldCnpj_formattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj_formatted",
+			},
+			want: `if !(obj.FieldCnpj_formattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_formattedStringPointer must be a valid formatted CNPJ"))
+}
+`,
+		},
+		{
+			name: "cnpj_unformatted_stringpointer_cnpj_unformatted",
+			args: args{
+				fieldName:       "FieldCnpj_unformattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cnpj_unformatted",
+			},
+			want: `if !(obj.FieldCnpj_unformattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCnpj_unformattedStringPointer must be a valid unformatted CNPJ"))
+}
+`,
+		},
+		{
+			name: "cep_stringpointer_cep",
+			args: args{
+				fieldName:       "FieldCepStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cep",
+			},
+			want: `if !(obj.FieldCepStringPointer != nil && types.IsValidCEP(*obj.FieldCepStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCepStringPointer must be a valid CEP"))
+}
+`,
+		},
+		{
+			name: "cep_formatted_stringpointer_cep_formatted",
+			args: args{
+				fieldName:       "FieldCep_formattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cep_formatted",
+			},
+			want: `if !(obj.FieldCep_formattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_formattedStringPointer must be a valid formatted CEP"))
+}
+`,
+		},
+		{
+			name: "cep_unformatted_stringpointer_cep_unformatted",
+			args: args{
+				fieldName:       "FieldCep_unformattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cep_unformatted",
+			},
+			want: `if !(obj.FieldCep_unformattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCep_unformattedStringPointer must be a valid unformatted CEP"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_stringpointer_cpf_cnpj",
+			args: args{
+				fieldName:       "FieldCpf_cnpjStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj",
+			},
+			want: `if !(obj.FieldCpf_cnpjStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpjStringPointer, types.AnyDocumentFormat)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpjStringPointer must be a valid CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_formatted_stringpointer_cpf_cnpj_formatted",
+			args: args{
+				fieldName:       "FieldCpf_cnpj_formattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj_formatted",
+			},
+			want: `if !(obj.FieldCpf_cnpj_formattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_formattedStringPointer, types.FormattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedStringPointer must be a valid formatted CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "cpf_cnpj_unformatted_stringpointer_cpf_cnpj_unformatted",
+			args: args{
+				fieldName:       "FieldCpf_cnpj_unformattedStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cpf_cnpj_unformatted",
+			},
+			want: `if !(obj.FieldCpf_cnpj_unformattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_unformattedStringPointer, types.UnformattedDocument)) {
+errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedStringPointer must be a valid unformatted CPF or CNPJ"))
+}
+`,
+		},
+		{
+			name: "luhn_checksum_stringpointer_luhn_checksum",
+			args: args{
+				fieldName:       "FieldLuhn_checksumStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "luhn_checksum",
+			},
+			want: `if !(obj.FieldLuhn_checksumStringPointer != nil && types.IsValidLuhnChecksum(*obj.FieldLuhn_checksumStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLuhn_checksumStringPointer must have a valid Luhn checksum"))
+}
+`,
+		},
+		{
+			name: "credit_card_stringpointer_credit_card",
+			args: args{
+				fieldName:       "FieldCredit_cardStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "credit_card",
+			},
+			want: `if !(obj.FieldCredit_cardStringPointer != nil && types.IsValidCreditCard(*obj.FieldCredit_cardStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCredit_cardStringPointer must be a valid credit card number"))
+}
+`,
+		},
+		{
+			name: "iban_stringpointer_iban",
+			args: args{
+				fieldName:       "FieldIbanStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iban",
+			},
+			want: `if !(obj.FieldIbanStringPointer != nil && types.IsValidIBAN(*obj.FieldIbanStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIbanStringPointer must be a valid IBAN"))
+}
+`,
+		},
+		{
+			name: "bic_stringpointer_bic",
+			args: args{
+				fieldName:       "FieldBicStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "bic",
+			},
+			want: `if !(obj.FieldBicStringPointer != nil && types.IsValidBIC(*obj.FieldBicStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBicStringPointer must be a valid BIC"))
+}
+`,
+		},
+		{
+			name: "isbn10_stringpointer_isbn10",
+			args: args{
+				fieldName:       "FieldIsbn10StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "isbn10",
+			},
+			want: `if !(obj.FieldIsbn10StringPointer != nil && types.IsValidISBN10(*obj.FieldIsbn10StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIsbn10StringPointer must be a valid ISBN-10"))
+}
+`,
+		},
+		{
+			name: "isbn13_stringpointer_isbn13",
+			args: args{
+				fieldName:       "FieldIsbn13StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "isbn13",
+			},
+			want: `if !(obj.FieldIsbn13StringPointer != nil && types.IsValidISBN13(*obj.FieldIsbn13StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIsbn13StringPointer must be a valid ISBN-13"))
+}
+`,
+		},
+		{
+			name: "issn_stringpointer_issn",
+			args: args{
+				fieldName:       "FieldIssnStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "issn",
+			},
+			want: `if !(obj.FieldIssnStringPointer != nil && types.IsValidISSN(*obj.FieldIssnStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIssnStringPointer must be a valid ISSN"))
+}
+`,
+		},
+		{
+			name: "ean8_stringpointer_ean8",
+			args: args{
+				fieldName:       "FieldEan8StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ean8",
+			},
+			want: `if !(obj.FieldEan8StringPointer != nil && types.IsValidEAN8(*obj.FieldEan8StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldEan8StringPointer must be a valid EAN-8"))
+}
+`,
+		},
+		{
+			name: "ean13_stringpointer_ean13",
+			args: args{
+				fieldName:       "FieldEan13StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "ean13",
+			},
+			want: `if !(obj.FieldEan13StringPointer != nil && types.IsValidEAN13(*obj.FieldEan13StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldEan13StringPointer must be a valid EAN-13"))
+}
+`,
+		},
+		{
+			name: "upc_stringpointer_upc",
+			args: args{
+				fieldName:       "FieldUpcStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "upc",
+			},
+			want: `if !(obj.FieldUpcStringPointer != nil && types.IsValidUPC(*obj.FieldUpcStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldUpcStringPointer must be a valid UPC"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha2_stringpointer_iso3166_1_alpha2",
+			args: args{
+				fieldName:       "FieldIso3166_1_alpha2StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_alpha2",
+			},
+			want: `if !(obj.FieldIso3166_1_alpha2StringPointer != nil && types.IsValidISO3166Alpha2(*obj.FieldIso3166_1_alpha2StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2StringPointer must be a valid ISO 3166-1 alpha-2 country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_alpha3_stringpointer_iso3166_1_alpha3",
+			args: args{
+				fieldName:       "FieldIso3166_1_alpha3StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_alpha3",
+			},
+			want: `if !(obj.FieldIso3166_1_alpha3StringPointer != nil && types.IsValidISO3166Alpha3(*obj.FieldIso3166_1_alpha3StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3StringPointer must be a valid ISO 3166-1 alpha-3 country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_stringpointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericStringPointer != nil && types.IsValidISO3166NumericString(*obj.FieldIso3166_1_numericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericStringPointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_intpointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericIntPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericIntPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericIntPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericIntPointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int8pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericInt8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int16pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericInt16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int32pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericInt32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_int64pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericInt64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericInt64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uintpointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUintPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericUintPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUintPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUintPointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint8pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericUint8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint16pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericUint16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint32pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericUint32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso3166_1_numeric_uint64pointer_iso3166_1_numeric",
+			args: args{
+				fieldName:       "FieldIso3166_1_numericUint64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+				fieldValidation: "iso3166_1_numeric",
+			},
+			want: `if !(obj.FieldIso3166_1_numericUint64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64Pointer must be a valid ISO 3166-1 numeric country code"))
+}
+`,
+		},
+		{
+			name: "iso4217_stringpointer_iso4217",
+			args: args{
+				fieldName:       "FieldIso4217StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iso4217",
+			},
+			want: `if !(obj.FieldIso4217StringPointer != nil && types.IsValidISO4217(*obj.FieldIso4217StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso4217StringPointer must be a valid ISO 4217 currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_stringpointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericStringPointer != nil && types.IsValidISO4217NumericString(*obj.FieldIso4217_numericStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericStringPointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_intpointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericIntPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericIntPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericIntPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericIntPointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int8pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericInt8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int16pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericInt16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int32pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericInt32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_int64pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericInt64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericInt64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uintpointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUintPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericUintPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUintPointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUintPointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint8pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericUint8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint8Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint16pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericUint16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint16Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint32pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericUint32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint32Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "iso4217_numeric_uint64pointer_iso4217_numeric",
+			args: args{
+				fieldName:       "FieldIso4217_numericUint64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+				fieldValidation: "iso4217_numeric",
+			},
+			want: `if !(obj.FieldIso4217_numericUint64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint64Pointer))) {
+errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64Pointer must be a valid ISO 4217 numeric currency code"))
+}
+`,
+		},
+		{
+			name: "bcp47_language_tag_stringpointer_bcp47_language_tag",
+			args: args{
+				fieldName:       "FieldBcp47_language_tagStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "bcp47_language_tag",
+			},
+			want: `if !(obj.FieldBcp47_language_tagStringPointer != nil && types.IsValidBCP47LanguageTag(*obj.FieldBcp47_language_tagStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldBcp47_language_tagStringPointer must be a valid BCP 47 language tag"))
+}
+`,
+		},
+		{
+			name: "e164_stringpointer_e164",
+			args: args{
+				fieldName:       "FieldE164StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "e164",
+			},
+			want: `if !(obj.FieldE164StringPointer != nil && types.IsValidE164(*obj.FieldE164StringPointer)) {
+errs = append(errs, types.NewValidationError("FieldE164StringPointer must be a valid E.164 phone number"))
+}
+`,
+		},
+		{
+			name: "postcode_iso3166_alpha2_stringpointer_postcode_iso3166_alpha2=BR",
+			args: args{
+				fieldName:       "FieldPostcode_iso3166_alpha2StringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "postcode_iso3166_alpha2=BR",
+			},
+			want: `if !(obj.FieldPostcode_iso3166_alpha2StringPointer != nil && types.IsValidPostcode(*obj.FieldPostcode_iso3166_alpha2StringPointer, "BR")) {
+errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2StringPointer must be a valid postal code for 'BR'"))
+}
+`,
+		},
+		{
+			name: "latitude_stringpointer_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(obj.FieldLatitudeStringPointer != nil && types.IsValidLatitude(*obj.FieldLatitudeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLatitudeStringPointer must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "latitude_float32pointer_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(obj.FieldLatitudeFloat32Pointer != nil && *obj.FieldLatitudeFloat32Pointer >= -90 && *obj.FieldLatitudeFloat32Pointer <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat32Pointer must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "latitude_float64pointer_latitude",
+			args: args{
+				fieldName:       "FieldLatitudeFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "latitude",
+			},
+			want: `if !(obj.FieldLatitudeFloat64Pointer != nil && *obj.FieldLatitudeFloat64Pointer >= -90 && *obj.FieldLatitudeFloat64Pointer <= 90) {
+errs = append(errs, types.NewValidationError("FieldLatitudeFloat64Pointer must be a valid latitude (-90 to 90)"))
+}
+`,
+		},
+		{
+			name: "longitude_stringpointer_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(obj.FieldLongitudeStringPointer != nil && types.IsValidLongitude(*obj.FieldLongitudeStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldLongitudeStringPointer must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "longitude_float32pointer_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(obj.FieldLongitudeFloat32Pointer != nil && *obj.FieldLongitudeFloat32Pointer >= -180 && *obj.FieldLongitudeFloat32Pointer <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat32Pointer must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "longitude_float64pointer_longitude",
+			args: args{
+				fieldName:       "FieldLongitudeFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "longitude",
+			},
+			want: `if !(obj.FieldLongitudeFloat64Pointer != nil && *obj.FieldLongitudeFloat64Pointer >= -180 && *obj.FieldLongitudeFloat64Pointer <= 180) {
+errs = append(errs, types.NewValidationError("FieldLongitudeFloat64Pointer must be a valid longitude (-180 to 180)"))
+}
+`,
+		},
+		{
+			name: "semver_stringpointer_semver",
+			args: args{
+				fieldName:       "FieldSemverStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "semver",
+			},
+			want: `if !(obj.FieldSemverStringPointer != nil && types.IsValidSemver(*obj.FieldSemverStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldSemverStringPointer must be a valid semantic version (e.g. 1.2.3)"))
+}
+`,
+		},
+		{
+			name: "cron_stringpointer_cron",
+			args: args{
+				fieldName:       "FieldCronStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "cron",
+			},
+			want: `if !(obj.FieldCronStringPointer != nil && types.IsValidCron(*obj.FieldCronStringPointer)) {
+errs = append(errs, types.NewValidationError("FieldCronStringPointer must be a valid cron expression (5 fields, or 6 with seconds)"))
+}
+`,
+		},
+		{
+			name: "finite_float32pointer_finite",
+			args: args{
+				fieldName:       "FieldFiniteFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "finite",
+			},
+			want: `if !(obj.FieldFiniteFloat32Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat32Pointer)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat32Pointer must be a finite number"))
+}
+`,
+		},
+		{
+			name: "finite_float64pointer_finite",
+			args: args{
+				fieldName:       "FieldFiniteFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "finite",
+			},
+			want: `if !(obj.FieldFiniteFloat64Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat64Pointer)) {
+errs = append(errs, types.NewValidationError("FieldFiniteFloat64Pointer must be a finite number"))
+}
+`,
+		},
+		{
+			name: "multipleof_intpointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofIntPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofIntPointer != nil && *obj.FieldMultipleofIntPointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofIntPointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int8pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt8Pointer != nil && *obj.FieldMultipleofInt8Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt8Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int16pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt16Pointer != nil && *obj.FieldMultipleofInt16Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt16Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int32pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt32Pointer != nil && *obj.FieldMultipleofInt32Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt32Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_int64pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofInt64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofInt64Pointer != nil && *obj.FieldMultipleofInt64Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofInt64Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uintpointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUintPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUintPointer != nil && *obj.FieldMultipleofUintPointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUintPointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint8pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint8Pointer != nil && *obj.FieldMultipleofUint8Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint8Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint16pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint16Pointer != nil && *obj.FieldMultipleofUint16Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint16Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint32pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint32Pointer != nil && *obj.FieldMultipleofUint32Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint32Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_uint64pointer_multipleof=5",
+			args: args{
+				fieldName:       "FieldMultipleofUint64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+				fieldValidation: "multipleof=5",
+			},
+			want: `if !(obj.FieldMultipleofUint64Pointer != nil && *obj.FieldMultipleofUint64Pointer%5 == 0) {
+errs = append(errs, types.NewValidationError("FieldMultipleofUint64Pointer must be a multiple of 5"))
+}
+`,
+		},
+		{
+			name: "multipleof_float32pointer_multipleof=0.25",
+			args: args{
+				fieldName:       "FieldMultipleofFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "multipleof=0.25",
+			},
+			want: `if !(obj.FieldMultipleofFloat32Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat32Pointer, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat32Pointer must be a multiple of 0.25"))
+}
+`,
+		},
+		{
+			name: "multipleof_float64pointer_multipleof=0.25",
+			args: args{
+				fieldName:       "FieldMultipleofFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "multipleof=0.25",
+			},
+			want: `if !(obj.FieldMultipleofFloat64Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat64Pointer, 0.25)) {
+errs = append(errs, types.NewValidationError("FieldMultipleofFloat64Pointer must be a multiple of 0.25"))
+}
+`,
+		},
+		{
+			name: "maxdecimals_float32pointer_maxdecimals=2",
+			args: args{
+				fieldName:       "FieldMaxdecimalsFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "maxdecimals=2",
+			},
+			want: `if !(obj.FieldMaxdecimalsFloat32Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat32Pointer, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32Pointer must have at most 2 decimal places"))
+}
+`,
+		},
+		{
+			name: "maxdecimals_float64pointer_maxdecimals=2",
+			args: args{
+				fieldName:       "FieldMaxdecimalsFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "maxdecimals=2",
+			},
+			want: `if !(obj.FieldMaxdecimalsFloat64Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat64Pointer, 2)) {
+errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64Pointer must have at most 2 decimal places"))
+}
+`,
+		},
+		{
+			name: "positive_intpointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveIntPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveIntPointer != nil && *obj.FieldPositiveIntPointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveIntPointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int8pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt8Pointer != nil && *obj.FieldPositiveInt8Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt8Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int16pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt16Pointer != nil && *obj.FieldPositiveInt16Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt16Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int32pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt32Pointer != nil && *obj.FieldPositiveInt32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt32Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_int64pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveInt64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveInt64Pointer != nil && *obj.FieldPositiveInt64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveInt64Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uintpointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveUintPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUintPointer != nil && *obj.FieldPositiveUintPointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUintPointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint8pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint8Pointer != nil && *obj.FieldPositiveUint8Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint8Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint16pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint16Pointer != nil && *obj.FieldPositiveUint16Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint16Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint32pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint32Pointer != nil && *obj.FieldPositiveUint32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint32Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_uint64pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveUint64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveUint64Pointer != nil && *obj.FieldPositiveUint64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveUint64Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_float32pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveFloat32Pointer != nil && *obj.FieldPositiveFloat32Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat32Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "positive_float64pointer_positive",
+			args: args{
+				fieldName:       "FieldPositiveFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "positive",
+			},
+			want: `if !(obj.FieldPositiveFloat64Pointer != nil && *obj.FieldPositiveFloat64Pointer > 0) {
+errs = append(errs, types.NewValidationError("FieldPositiveFloat64Pointer must be positive"))
+}
+`,
+		},
+		{
+			name: "negative_float32pointer_negative",
+			args: args{
+				fieldName:       "FieldNegativeFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "negative",
+			},
+			want: `if !(obj.FieldNegativeFloat32Pointer != nil && *obj.FieldNegativeFloat32Pointer < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat32Pointer must be negative"))
+}
+`,
+		},
+		{
+			name: "negative_float64pointer_negative",
+			args: args{
+				fieldName:       "FieldNegativeFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "negative",
+			},
+			want: `if !(obj.FieldNegativeFloat64Pointer != nil && *obj.FieldNegativeFloat64Pointer < 0) {
+errs = append(errs, types.NewValidationError("FieldNegativeFloat64Pointer must be negative"))
+}
+`,
+		},
+		{
+			name: "nonzero_intpointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroIntPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroIntPointer != nil && *obj.FieldNonzeroIntPointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroIntPointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int8pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int8", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt8Pointer != nil && *obj.FieldNonzeroInt8Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt8Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int16pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int16", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt16Pointer != nil && *obj.FieldNonzeroInt16Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt16Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int32pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt32Pointer != nil && *obj.FieldNonzeroInt32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt32Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_int64pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroInt64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "int64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroInt64Pointer != nil && *obj.FieldNonzeroInt64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroInt64Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uintpointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUintPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUintPointer != nil && *obj.FieldNonzeroUintPointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUintPointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint8pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint8Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint8", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint8Pointer != nil && *obj.FieldNonzeroUint8Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint8Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint16pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint16Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint16", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint16Pointer != nil && *obj.FieldNonzeroUint16Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint16Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint32pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint32Pointer != nil && *obj.FieldNonzeroUint32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint32Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_uint64pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroUint64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "uint64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroUint64Pointer != nil && *obj.FieldNonzeroUint64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroUint64Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_float32pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroFloat32Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float32", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroFloat32Pointer != nil && *obj.FieldNonzeroFloat32Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat32Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "nonzero_float64pointer_nonzero",
+			args: args{
+				fieldName:       "FieldNonzeroFloat64Pointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "float64", Size: ""},
+				fieldValidation: "nonzero",
+			},
+			want: `if !(obj.FieldNonzeroFloat64Pointer != nil && *obj.FieldNonzeroFloat64Pointer != 0) {
+errs = append(errs, types.NewValidationError("FieldNonzeroFloat64Pointer must not be zero"))
+}
+`,
+		},
+		{
+			name: "decimal_stringpointer_decimal=12,2",
+			args: args{
+				fieldName:       "FieldDecimalStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "decimal=12,2",
+			},
+			want: `if !(obj.FieldDecimalStringPointer != nil && types.IsValidDecimal(*obj.FieldDecimalStringPointer, 12,2)) {
+errs = append(errs, types.NewValidationError("FieldDecimalStringPointer must be a decimal(12,2)"))
+}
+`,
+		},
+		{
+			name: "decimal_gt_stringpointer_decimal_gt=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_gtStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_gt=0.01",
+			},
+			want: `if !(obj.FieldDecimal_gtStringPointer != nil && types.IsDecimalGT(*obj.FieldDecimal_gtStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gtStringPointer must be a decimal > 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_gte_stringpointer_decimal_gte=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_gteStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_gte=0.01",
+			},
+			want: `if !(obj.FieldDecimal_gteStringPointer != nil && types.IsDecimalGTE(*obj.FieldDecimal_gteStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_gteStringPointer must be a decimal >= 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_lt_stringpointer_decimal_lt=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_ltStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_lt=0.01",
+			},
+			want: `if !(obj.FieldDecimal_ltStringPointer != nil && types.IsDecimalLT(*obj.FieldDecimal_ltStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_ltStringPointer must be a decimal < 0.01"))
+}
+`,
+		},
+		{
+			name: "decimal_lte_stringpointer_decimal_lte=0.01",
+			args: args{
+				fieldName:       "FieldDecimal_lteStringPointer",
+				fieldType:       common.FieldType{ComposedType: "*", BaseType: "string", Size: ""},
+				fieldValidation: "decimal_lte=0.01",
+			},
+			want: `if !(obj.FieldDecimal_lteStringPointer != nil && types.IsDecimalLTE(*obj.FieldDecimal_lteStringPointer, "0.01")) {
+errs = append(errs, types.NewValidationError("FieldDecimal_lteStringPointer must be a decimal <= 0.01"))
+}
 `,
 		},
 		{
@@ -366,6 +2502,18 @@ errs = append(errs, types.NewValidationError("FieldRequiredFloat64SlicePointer m
 			want: `if !(obj.FieldRequiredBoolSlicePointer != nil && len(*obj.FieldRequiredBoolSlicePointer) != 0) {
 errs = append(errs, types.NewValidationError("FieldRequiredBoolSlicePointer must not be empty"))
 }
+`,
+		},
+		{
+			name: "required_byteslicepointer_required",
+			args: args{
+				fieldName:       "FieldRequiredByteSlicePointer",
+				fieldType:       common.FieldType{ComposedType: "*[]", BaseType: "byte", Size: ""},
+				fieldValidation: "required",
+			},
+			want: `if !(obj.FieldRequiredByteSlicePointer != nil && len(*obj.FieldRequiredByteSlicePointer) != 0) {
+errs = append(errs, types.NewValidationError("FieldRequiredByteSlicePointer must not be empty"))
+}
 `,
 		},
 		{
@@ -152,6 +152,40 @@ func TestDefineTestElementsWithStringFields(t *testing.T) {
 				errorMessage: "EmailField must be a valid email",
 			},
 		},
+		{
+			name: "Contains with quote and backslash",
+			args: args{
+				fieldName:       "ContainsField",
+				fieldValidation: `contains=a"b\c`,
+			},
+			want: TestElements{
+				conditions:   []string{`types.Contains(obj.ContainsField, "a\"b\\c")`},
+				errorMessage: `ContainsField must contain 'a"b\c'`,
+			},
+		},
+		{
+			name: "Starts with backslash",
+			args: args{
+				fieldName:       "PathField",
+				fieldValidation: `startswith=\`,
+			},
+			want: TestElements{
+				conditions:   []string{`types.HasPrefix(obj.PathField, "\\")`},
+				errorMessage: `PathField must start with '\'`,
+			},
+		},
+		{
+			name: "In ignore case with quotes",
+			args: args{
+				fieldName:       "InField",
+				fieldValidation: `in_ignore_case=a" b\`,
+			},
+			want: TestElements{
+				conditions:     []string{`types.EqualFold(obj.InField, "a\"")`, `types.EqualFold(obj.InField, "b\\")`},
+				concatOperator: "||",
+				errorMessage:   `InField must be one of 'a"' 'b\'`,
+			},
+		},
 	}
 
 	for _, tt := range tests {
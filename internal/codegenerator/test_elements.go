@@ -1,6 +1,7 @@
 package codegenerator
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/opencodeco/validgen/internal/analyzer"
@@ -36,6 +37,8 @@ func DefineTestElements(fieldName string, fieldType common.FieldType, fieldValid
 		for _, value := range values {
 			operation := replaceNameAndTarget(condition.operation, fieldName, value)
 			operation = replaceSlicesTargets(operation, valuesAsStringSlice, valuesAsNumericSlice)
+			operation = strings.ReplaceAll(operation, "{{.RegexVar}}", regexVarName(value))
+			operation = strings.ReplaceAll(operation, "{{.BigVar}}", bigNumberVarName(fieldType, value))
 			roperands = append(roperands, operation)
 			targetValue = value
 			targetValues += "'" + value + "' "
@@ -62,6 +65,7 @@ func DefineTestElements(fieldName string, fieldType common.FieldType, fieldValid
 func replaceNameAndTarget(text, name, target string) string {
 	text = strings.ReplaceAll(text, "{{.Name}}", name)
 	text = strings.ReplaceAll(text, "{{.Target}}", target)
+	text = strings.ReplaceAll(text, "{{.QuotedTarget}}", strconv.Quote(target))
 
 	return text
 }
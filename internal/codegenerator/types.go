@@ -6,10 +6,12 @@ import (
 )
 
 type Pkg struct {
-	Name    string
-	Path    string
-	Imports map[string]parser.Import
-	Structs map[string]*Struct
+	Name       string
+	Path       string
+	Imports    map[string]parser.Import
+	Structs    map[string]*Struct
+	Regexes    map[string]string // package level regular expressions (variable name to pattern)
+	BigNumbers map[string]string // package level math/big values (variable name to initialization code)
 }
 
 type Struct struct {
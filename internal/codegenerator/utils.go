@@ -1,7 +1,13 @@
 package codegenerator
 
 import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
 	"github.com/opencodeco/validgen/internal/analyzer"
+	"github.com/opencodeco/validgen/internal/common"
 )
 
 func StructToTpl(st *analyzer.Struct) *structTpl {
@@ -21,3 +27,53 @@ func StructToTpl(st *analyzer.Struct) *structTpl {
 
 	return stTpl
 }
+
+// quoteValues returns the values as a list of Go string literals (e.g. `"a", "b"`).
+func quoteValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// regexVarName returns the name of the package level variable with the compiled regex.
+// The name depends only on the pattern, so the same pattern uses the same variable.
+func regexVarName(pattern string) string {
+	h := fnv.New32a()
+	h.Write([]byte(pattern))
+
+	return fmt.Sprintf("validgenRegex%08x", h.Sum32())
+}
+
+// bigNumberVarName returns the name of the package level variable with the parsed math/big value.
+// The name depends only on the type and the value, so the same value uses the same variable.
+func bigNumberVarName(fieldType common.FieldType, value string) string {
+	h := fnv.New32a()
+	h.Write([]byte(value))
+
+	return fmt.Sprintf("validgenBig%s%08x", strings.TrimPrefix(fieldType.BaseType, "big."), h.Sum32())
+}
+
+// bigNumberCode returns the code to parse the math/big value (e.g. types.MustParseBigInt("100")).
+func bigNumberCode(fieldType common.FieldType, value string) string {
+	return fmt.Sprintf("types.MustParseBig%s(%q)", strings.TrimPrefix(fieldType.BaseType, "big."), value)
+}
+
+// errorMessageCode returns the error message as a Go string literal.
+// The message is used as a format by types.NewValidationError, so % is escaped.
+func errorMessageCode(message string) string {
+	return strconv.Quote(strings.ReplaceAll(message, "%", "%%"))
+}
+
+// indexMessageCode returns the quoted error message of an element (e.g. "Tags[%d] must not be empty"),
+// keeping the index verb of the element name and escaping the other '%'.
+func indexMessageCode(message, indexName string) string {
+	parts := strings.Split(message, indexName)
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(part, "%", "%%")
+	}
+
+	return strconv.Quote(strings.Join(parts, indexName))
+}
@@ -8,9 +8,10 @@ import (
 )
 
 type FieldType struct {
-	ComposedType string // array ([N]), map (map) or slice ([])
-	BaseType     string // base type (e.g. string, int, etc.)
-	Size         string // for arrays
+	ComposedType string   // array ([N]), map (map) or slice ([])
+	BaseType     string   // base type (e.g. string, int, etc.)
+	Size         string   // for arrays
+	Optional     Optional // optional wrapper of the value (e.g. sql.NullString), if any
 }
 
 func (ft FieldType) IsGoType() bool {
@@ -33,6 +34,18 @@ func (ft FieldType) IsGoType() bool {
 		"uint64":  {},
 		"float32": {},
 		"float64": {},
+		"byte":    {},
+		// Arbitrary-precision numbers of math/big.
+		"big.Int":   {},
+		"big.Float": {},
+		"big.Rat":   {},
+		// Parsed values of net/netip, net/url and net/mail.
+		"netip.Addr":   {},
+		"netip.Prefix": {},
+		"url.URL":      {},
+		"mail.Address": {},
+		// Time of the time package.
+		"time.Time": {},
 	}
 
 	_, ok := goTypes[ft.BaseType]
@@ -40,6 +53,26 @@ func (ft FieldType) IsGoType() bool {
 	return ok
 }
 
+// IsBigNumber reports if the base type is an arbitrary-precision number of math/big (e.g. *big.Int).
+func (ft FieldType) IsBigNumber() bool {
+	switch ft.NormalizeBaseType() {
+	case BigIntType, BigFloatType, BigRatType:
+		return true
+	}
+
+	return false
+}
+
+// IsOptional reports if the field is an optional wrapper of the value (e.g. sql.NullString or sql.Null[int64]).
+func (ft FieldType) IsOptional() bool {
+	return ft.Optional.Type != ""
+}
+
+// ValueType returns the type of the value of an optional field (e.g. string for sql.NullString).
+func (ft FieldType) ValueType() FieldType {
+	return FieldType{BaseType: ft.BaseType}
+}
+
 func (ft FieldType) NormalizeBaseType() NormalizedBaseType {
 	// Base type grouping by type (e.g. string, bool, int and float)
 
@@ -58,6 +91,18 @@ func (ft FieldType) NormalizeBaseType() NormalizedBaseType {
 		"uint64":  IntType,
 		"float32": FloatType,
 		"float64": FloatType,
+		"byte":    ByteType,
+		// Arbitrary-precision numbers of math/big.
+		"big.Int":   BigIntType,
+		"big.Float": BigFloatType,
+		"big.Rat":   BigRatType,
+		// Parsed values of net/netip, net/url and net/mail.
+		"netip.Addr":   IPAddrType,
+		"netip.Prefix": IPPrefixType,
+		"url.URL":      URLType,
+		"mail.Address": MailAddressType,
+		// Time of the time package.
+		"time.Time": TimeType,
 	}
 
 	return normalizedBaseType[ft.BaseType]
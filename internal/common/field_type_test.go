@@ -115,6 +115,27 @@ func TestFieldTypeIsGoType(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "byte slice type",
+			args: args{
+				fieldType: FieldType{BaseType: "byte", ComposedType: "[]", Size: ""},
+			},
+			want: true,
+		},
+		{
+			name: "big int pointer type",
+			args: args{
+				fieldType: FieldType{BaseType: "big.Int", ComposedType: "*", Size: ""},
+			},
+			want: true,
+		},
+		{
+			name: "url pointer type",
+			args: args{
+				fieldType: FieldType{BaseType: "url.URL", ComposedType: "*", Size: ""},
+			},
+			want: true,
+		},
 		{
 			name: "array type",
 			args: args{
@@ -274,6 +295,69 @@ func TestFieldTypeNormalizeBaseType(t *testing.T) {
 			},
 			FloatType,
 		},
+		{
+			"byte type",
+			fields{
+				BaseType: "byte",
+			},
+			ByteType,
+		},
+		{
+			"big int type",
+			fields{
+				BaseType: "big.Int",
+			},
+			BigIntType,
+		},
+		{
+			"big float type",
+			fields{
+				BaseType: "big.Float",
+			},
+			BigFloatType,
+		},
+		{
+			"big rat type",
+			fields{
+				BaseType: "big.Rat",
+			},
+			BigRatType,
+		},
+		{
+			"ip address type",
+			fields{
+				BaseType: "netip.Addr",
+			},
+			IPAddrType,
+		},
+		{
+			"ip prefix type",
+			fields{
+				BaseType: "netip.Prefix",
+			},
+			IPPrefixType,
+		},
+		{
+			"url type",
+			fields{
+				BaseType: "url.URL",
+			},
+			URLType,
+		},
+		{
+			"mail address type",
+			fields{
+				BaseType: "mail.Address",
+			},
+			MailAddressType,
+		},
+		{
+			"time type",
+			fields{
+				BaseType: "time.Time",
+			},
+			TimeType,
+		},
 		{
 			name: "custom type",
 			fields: fields{
@@ -60,6 +60,22 @@ func HelperFromNormalizedToFieldTypes(t string) ([]FieldType, error) {
 			{BaseType: "float32"},
 			{BaseType: "float64"},
 		}
+	case "<BIGINT>":
+		fieldTypes = []FieldType{{BaseType: "big.Int"}}
+	case "<BIGFLOAT>":
+		fieldTypes = []FieldType{{BaseType: "big.Float"}}
+	case "<BIGRAT>":
+		fieldTypes = []FieldType{{BaseType: "big.Rat"}}
+	case "<IPADDR>":
+		fieldTypes = []FieldType{{BaseType: "netip.Addr"}}
+	case "<IPPREFIX>":
+		fieldTypes = []FieldType{{BaseType: "netip.Prefix"}}
+	case "<URL>":
+		fieldTypes = []FieldType{{BaseType: "url.URL"}}
+	case "<MAILADDRESS>":
+		fieldTypes = []FieldType{{BaseType: "mail.Address"}}
+	case "<TIME>":
+		fieldTypes = []FieldType{{BaseType: "time.Time"}}
 	case "map[<STRING>]":
 		fieldTypes = []FieldType{{BaseType: "string", ComposedType: "map"}}
 	case "map[<BOOL>]":
@@ -104,6 +120,8 @@ func HelperFromNormalizedToFieldTypes(t string) ([]FieldType, error) {
 			{BaseType: "float32", ComposedType: "[]"},
 			{BaseType: "float64", ComposedType: "[]"},
 		}
+	case "[]<BYTE>":
+		fieldTypes = []FieldType{{BaseType: "byte", ComposedType: "[]"}}
 	case "[N]<STRING>":
 		fieldTypes = []FieldType{{BaseType: "string", ComposedType: "[N]", Size: "3"}}
 	case "[N]<BOOL>":
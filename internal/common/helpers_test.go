@@ -63,6 +63,31 @@ func TestFromNormalizedToBasicTypes(t *testing.T) {
 			args: args{t: "[]<STRING>"},
 			want: []string{"[]string"},
 		},
+		{
+			name: "slice byte type",
+			args: args{t: "[]<BYTE>"},
+			want: []string{"[]byte"},
+		},
+		{
+			name: "big int pointer type",
+			args: args{t: "*<BIGINT>"},
+			want: []string{"*big.Int"},
+		},
+		{
+			name: "ip address type",
+			args: args{t: "<IPADDR>"},
+			want: []string{"netip.Addr"},
+		},
+		{
+			name: "url pointer type",
+			args: args{t: "*<URL>"},
+			want: []string{"*url.URL"},
+		},
+		{
+			name: "time type",
+			args: args{t: "<TIME>"},
+			want: []string{"time.Time"},
+		},
 		{
 			name: "slice bool type",
 			args: args{t: "[]<BOOL>"},
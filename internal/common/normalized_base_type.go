@@ -8,6 +8,15 @@ const (
 	BoolType
 	IntType
 	FloatType
+	ByteType
+	BigIntType
+	BigFloatType
+	BigRatType
+	IPAddrType
+	IPPrefixType
+	URLType
+	MailAddressType
+	TimeType
 )
 
 func (n NormalizedBaseType) String() string {
@@ -20,6 +29,24 @@ func (n NormalizedBaseType) String() string {
 		return "<INT>"
 	case FloatType:
 		return "<FLOAT>"
+	case ByteType:
+		return "<BYTE>"
+	case BigIntType:
+		return "<BIGINT>"
+	case BigFloatType:
+		return "<BIGFLOAT>"
+	case BigRatType:
+		return "<BIGRAT>"
+	case IPAddrType:
+		return "<IPADDR>"
+	case IPPrefixType:
+		return "<IPPREFIX>"
+	case URLType:
+		return "<URL>"
+	case MailAddressType:
+		return "<MAILADDRESS>"
+	case TimeType:
+		return "<TIME>"
 	}
 
 	return "<INVALID>"
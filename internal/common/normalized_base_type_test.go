@@ -28,6 +28,51 @@ func TestNormalizedBaseTypeString(t *testing.T) {
 			n:    FloatType,
 			want: "<FLOAT>",
 		},
+		{
+			name: "ByteType",
+			n:    ByteType,
+			want: "<BYTE>",
+		},
+		{
+			name: "BigIntType",
+			n:    BigIntType,
+			want: "<BIGINT>",
+		},
+		{
+			name: "BigFloatType",
+			n:    BigFloatType,
+			want: "<BIGFLOAT>",
+		},
+		{
+			name: "BigRatType",
+			n:    BigRatType,
+			want: "<BIGRAT>",
+		},
+		{
+			name: "IPAddrType",
+			n:    IPAddrType,
+			want: "<IPADDR>",
+		},
+		{
+			name: "IPPrefixType",
+			n:    IPPrefixType,
+			want: "<IPPREFIX>",
+		},
+		{
+			name: "URLType",
+			n:    URLType,
+			want: "<URL>",
+		},
+		{
+			name: "MailAddressType",
+			n:    MailAddressType,
+			want: "<MAILADDRESS>",
+		},
+		{
+			name: "TimeType",
+			n:    TimeType,
+			want: "<TIME>",
+		},
 	}
 
 	for _, tt := range tests {
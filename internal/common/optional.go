@@ -0,0 +1,46 @@
+package common
+
+// Optional is a wrapper of a value that can be absent (e.g. sql.NullString).
+// The value is in ValueField and is present only if the Valid field is true.
+type Optional struct {
+	Type       string // wrapper type (e.g. sql.NullString or sql.Null[int64])
+	ValueField string // field with the value (e.g. String or V)
+}
+
+// sqlNullTypes are the null types of database/sql with the field and the type of their values.
+// The generic sql.Null[T] is handled as the other generic optional types.
+var sqlNullTypes = map[string]Optional{
+	"sql.NullString":  {Type: "string", ValueField: "String"},
+	"sql.NullBool":    {Type: "bool", ValueField: "Bool"},
+	"sql.NullByte":    {Type: "byte", ValueField: "Byte"},
+	"sql.NullInt16":   {Type: "int16", ValueField: "Int16"},
+	"sql.NullInt32":   {Type: "int32", ValueField: "Int32"},
+	"sql.NullInt64":   {Type: "int64", ValueField: "Int64"},
+	"sql.NullFloat64": {Type: "float64", ValueField: "Float64"},
+	"sql.NullTime":    {Type: "time.Time", ValueField: "Time"},
+}
+
+// SQLNullType returns the type of an optional field of a database/sql null type (e.g. sql.NullString).
+// The base type is the type of the value (e.g. string).
+func SQLNullType(ft FieldType) (FieldType, bool) {
+	value, ok := sqlNullTypes[ft.BaseType]
+	if !ok {
+		return FieldType{}, false
+	}
+
+	return FieldType{
+		ComposedType: ft.ComposedType,
+		BaseType:     value.Type,
+		Optional:     Optional{Type: ft.BaseType, ValueField: value.ValueField},
+	}, true
+}
+
+// GenericOptionalType returns the type of an optional field of a generic type with the shape of
+// sql.Null[T] (fields V and Valid). The base type is the type of the value (e.g. int64 for sql.Null[int64]).
+func GenericOptionalType(ft FieldType, genericType string, valueType FieldType) FieldType {
+	return FieldType{
+		ComposedType: ft.ComposedType,
+		BaseType:     valueType.BaseType,
+		Optional:     Optional{Type: genericType + "[" + valueType.BaseType + "]", ValueField: "V"},
+	}
+}
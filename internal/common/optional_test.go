@@ -0,0 +1,67 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestSQLNullType(t *testing.T) {
+	tests := []struct {
+		name   string
+		ft     FieldType
+		want   FieldType
+		wantOk bool
+	}{
+		{
+			name: "null string",
+			ft:   FieldType{BaseType: "sql.NullString"},
+			want: FieldType{
+				BaseType: "string",
+				Optional: Optional{Type: "sql.NullString", ValueField: "String"},
+			},
+			wantOk: true,
+		},
+		{
+			name: "null time pointer",
+			ft:   FieldType{BaseType: "sql.NullTime", ComposedType: "*"},
+			want: FieldType{
+				ComposedType: "*",
+				BaseType:     "time.Time",
+				Optional:     Optional{Type: "sql.NullTime", ValueField: "Time"},
+			},
+			wantOk: true,
+		},
+		{
+			name:   "other sql type",
+			ft:     FieldType{BaseType: "sql.RawBytes"},
+			want:   FieldType{},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SQLNullType(tt.ft)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("SQLNullType() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+			if got.IsOptional() != tt.wantOk {
+				t.Errorf("FieldType.IsOptional() = %v, want %v", got.IsOptional(), tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGenericOptionalType(t *testing.T) {
+	got := GenericOptionalType(FieldType{}, "sql.Null", FieldType{BaseType: "int64"})
+	want := FieldType{
+		BaseType: "int64",
+		Optional: Optional{Type: "sql.Null[int64]", ValueField: "V"},
+	}
+	if got != want {
+		t.Errorf("GenericOptionalType() = %v, want %v", got, want)
+	}
+
+	if got.ValueType() != (FieldType{BaseType: "int64"}) {
+		t.Errorf("FieldType.ValueType() = %v, want int64", got.ValueType())
+	}
+}
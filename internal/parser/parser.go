@@ -7,13 +7,21 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/opencodeco/validgen/internal/common"
 )
 
-func ExtractStructs(path string) ([]*Struct, error) {
+// Options controls how the types of the fields are parsed.
+type Options struct {
+	// OptionalTypes are the generic types with the shape of sql.Null[T] (fields V and Valid), as
+	// written in the struct fields (e.g. Optional or opt.Optional). sql.Null[T] is always optional.
+	OptionalTypes []string
+}
+
+func ExtractStructs(path string, opts Options) ([]*Struct, error) {
 	files, err := findFiles(path)
 	if err != nil {
 		return nil, err
@@ -22,7 +30,7 @@ func ExtractStructs(path string) ([]*Struct, error) {
 	structs := []*Struct{}
 
 	for _, file := range files {
-		parsedStructs, err := parseFile(file)
+		parsedStructs, err := parseFile(file, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -59,7 +67,7 @@ func findFiles(path string) ([]string, error) {
 	return files, nil
 }
 
-func parseFile(fullpath string) ([]*Struct, error) {
+func parseFile(fullpath string, opts Options) ([]*Struct, error) {
 	fmt.Printf("Parsing %s\n", fullpath)
 
 	src, err := os.ReadFile(fullpath)
@@ -67,7 +75,7 @@ func parseFile(fullpath string) ([]*Struct, error) {
 		return nil, err
 	}
 
-	structs, err := parseStructs(fullpath, string(src))
+	structs, err := parseStructs(fullpath, string(src), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +83,7 @@ func parseFile(fullpath string) ([]*Struct, error) {
 	return structs, nil
 }
 
-func parseStructs(fullpath, src string) ([]*Struct, error) {
+func parseStructs(fullpath, src string, opts Options) ([]*Struct, error) {
 
 	var err error
 	structs := []*Struct{}
@@ -99,7 +107,7 @@ func parseStructs(fullpath, src string) ([]*Struct, error) {
 		case (*ast.TypeSpec):
 			currentStruct = extractStructDefinition(v.Name.Name, fullpath, packageName, imports)
 		case (*ast.StructType):
-			err = extractAndAppendStructFields(v, packageName, currentStruct)
+			err = extractAndAppendStructFields(v, packageName, currentStruct, opts)
 			if err != nil {
 				return false
 			}
@@ -145,7 +153,7 @@ func extractStructDefinition(name, fullpath, packageName string, imports map[str
 	}
 }
 
-func extractAndAppendStructFields(structType *ast.StructType, packageName string, cstruct *Struct) error {
+func extractAndAppendStructFields(structType *ast.StructType, packageName string, cstruct *Struct, opts Options) error {
 	if structType.Fields == nil {
 		return nil
 	}
@@ -161,7 +169,7 @@ func extractAndAppendStructFields(structType *ast.StructType, packageName string
 			return err
 		}
 
-		fieldType, err := extractCompleteType(common.FieldType{}, field.Type, packageName)
+		fieldType, err := extractCompleteType(common.FieldType{}, field.Type, packageName, opts)
 		if err != nil {
 			return err
 		}
@@ -180,7 +188,7 @@ func extractAndAppendStructFields(structType *ast.StructType, packageName string
 	return nil
 }
 
-func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName string) (common.FieldType, error) {
+func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName string, opts Options) (common.FieldType, error) {
 	var err error
 
 	switch v := expr.(type) {
@@ -193,7 +201,7 @@ func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName stri
 		return fType, nil
 	case *ast.ArrayType:
 		// Slice or array type
-		fType, err = extractCompleteType(fType, v.Elt, packageName)
+		fType, err = extractCompleteType(fType, v.Elt, packageName, opts)
 		if err != nil {
 			return common.FieldType{}, err
 		}
@@ -219,14 +227,37 @@ func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName stri
 			return common.FieldType{}, fmt.Errorf("cannot find the type id: %T", v.X)
 		}
 
+		// The composed type is kept (e.g. *big.Int is a pointer).
 		nestedPkgName := typeID.Name
-		fType = extractNestedFieldType(nestedPkgName, v.Sel.Name)
+		fType.BaseType = common.KeyPath(nestedPkgName, v.Sel.Name)
+
+		// The null types of database/sql are optional values (e.g. sql.NullString).
+		if optionalType, ok := common.SQLNullType(fType); ok {
+			return optionalType, nil
+		}
 		return fType, nil
+	case *ast.IndexExpr:
+		// Generic type (only optional types are supported, e.g. sql.Null[int64])
+		genericType := genericTypeName(v.X)
+		if genericType != "sql.Null" && !slices.Contains(opts.OptionalTypes, genericType) {
+			return common.FieldType{}, nil
+		}
+
+		valueType, err := extractCompleteType(common.FieldType{}, v.Index, packageName, opts)
+		if err != nil {
+			return common.FieldType{}, err
+		}
+
+		if valueType.ComposedType != "" || valueType.IsOptional() {
+			return common.FieldType{}, fmt.Errorf("unsupported value type %s of optional type %s", valueType.ToType(), genericType)
+		}
+
+		return common.GenericOptionalType(fType, genericType, valueType), nil
 
 	case *ast.MapType:
 		// Map type
 		fType.ComposedType += "map"
-		fType, err = extractCompleteType(fType, v.Key, packageName)
+		fType, err = extractCompleteType(fType, v.Key, packageName, opts)
 		if err != nil {
 			return common.FieldType{}, err
 		}
@@ -235,7 +266,7 @@ func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName stri
 	case *ast.StarExpr:
 		// Pointer type
 		fType.ComposedType += "*"
-		fType, err = extractCompleteType(fType, v.X, packageName)
+		fType, err = extractCompleteType(fType, v.X, packageName, opts)
 		if err != nil {
 			return common.FieldType{}, err
 		}
@@ -246,6 +277,20 @@ func extractCompleteType(fType common.FieldType, expr ast.Expr, packageName stri
 	return common.FieldType{}, nil
 }
 
+// genericTypeName returns the name of a generic type as written in the code (e.g. Optional or sql.Null).
+func genericTypeName(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.SelectorExpr:
+		if typeID, ok := v.X.(*ast.Ident); ok {
+			return common.KeyPath(typeID.Name, v.Sel.Name)
+		}
+	}
+
+	return ""
+}
+
 func extractTag(fieldTag string) (string, error) {
 
 	if fieldTag == "" {
@@ -259,12 +304,3 @@ func extractTag(fieldTag string) (string, error) {
 
 	return rFieldTag, nil
 }
-
-func extractNestedFieldType(nestedPkgName, baseType string) common.FieldType {
-
-	return common.FieldType{
-		BaseType:     common.KeyPath(nestedPkgName, baseType),
-		ComposedType: "",
-		Size:         "",
-	}
-}
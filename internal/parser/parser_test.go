@@ -249,6 +249,39 @@ func TestParseStructsOk(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Pointers to types from other packages",
+			args: args{
+				fullpath: "example/main.go",
+				src: "package main\n" +
+					"import \"math/big\"\n" +
+					"type Ledger struct {\n" +
+					"	Balance *big.Int `valid:\"required\"`\n" +
+					"	Rate    big.Rat\n" +
+					"}\n",
+			},
+			want: []*Struct{
+				{
+					StructName:  "Ledger",
+					Path:        "./example",
+					PackageName: "main",
+					Fields: []Field{
+						{
+							FieldName: "Balance",
+							Type:      common.FieldType{BaseType: "big.Int", ComposedType: "*", Size: ""},
+							Tag:       "valid:\"required\"",
+						},
+						{
+							FieldName: "Rate",
+							Type:      common.FieldType{BaseType: "big.Rat", ComposedType: "", Size: ""},
+							Tag:       "",
+						},
+					},
+					Imports: map[string]Import{"big": {Name: "big", Path: "math/big"}},
+				},
+			},
+		},
+
 		{
 			name: "Slice of strings",
 			args: args{
@@ -568,7 +601,7 @@ func TestParseStructsOk(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseStructs(tt.args.fullpath, tt.args.src)
+			got, err := parseStructs(tt.args.fullpath, tt.args.src, Options{})
 			var wantErr error = nil
 			if err != wantErr {
 				t.Errorf("parseStructs() error = %v, wantErr %v", err, wantErr)
@@ -587,6 +620,69 @@ func TestParseStructsOk(t *testing.T) {
 	}
 }
 
+func TestParseStructsWithOptionalTypes(t *testing.T) {
+	src := "package main\n" +
+		"import \"database/sql\"\n" +
+		"type Optional[T any] struct {\n" +
+		"	V     T\n" +
+		"	Valid bool\n" +
+		"}\n" +
+		"type Maybe[T any] struct {\n" +
+		"	V     T\n" +
+		"	Valid bool\n" +
+		"}\n" +
+		"type User struct {\n" +
+		"	Name     sql.NullString `valid:\"required\"`\n" +
+		"	Birthday *sql.NullTime\n" +
+		"	Age      sql.Null[int64]\n" +
+		"	Nickname Optional[string]\n" +
+		"	Alias    Maybe[string]\n" +
+		"}\n"
+
+	got, err := parseStructs("example/main.go", src, Options{OptionalTypes: []string{"Optional"}})
+	if err != nil {
+		t.Fatalf("parseStructs() error = %v, wantErr %v", err, nil)
+	}
+
+	want := []Field{
+		{
+			FieldName: "Name",
+			Type:      common.FieldType{BaseType: "string", Optional: common.Optional{Type: "sql.NullString", ValueField: "String"}},
+			Tag:       "valid:\"required\"",
+		},
+		{
+			FieldName: "Birthday",
+			Type:      common.FieldType{BaseType: "time.Time", ComposedType: "*", Optional: common.Optional{Type: "sql.NullTime", ValueField: "Time"}},
+		},
+		{
+			FieldName: "Age",
+			Type:      common.FieldType{BaseType: "int64", Optional: common.Optional{Type: "sql.Null[int64]", ValueField: "V"}},
+		},
+		{
+			FieldName: "Nickname",
+			Type:      common.FieldType{BaseType: "string", Optional: common.Optional{Type: "Optional[string]", ValueField: "V"}},
+		},
+	}
+
+	// Maybe isn't an optional type, so Alias is ignored as the other unknown generic types.
+	if len(got) != 3 || !reflect.DeepEqual(got[2].Fields, want) {
+		t.Errorf("parseStructs() fields = %+v, want %+v", got[len(got)-1].Fields, want)
+	}
+}
+
+func TestParseStructsWithInvalidOptionalType(t *testing.T) {
+	src := "package main\n" +
+		"import \"database/sql\"\n" +
+		"type User struct {\n" +
+		"	Tags sql.Null[[]string]\n" +
+		"}\n"
+
+	_, err := parseStructs("example/main.go", src, Options{})
+	if err == nil || err.Error() != "unsupported value type []string of optional type sql.Null" {
+		t.Errorf("parseStructs() error = %v, want unsupported value type", err)
+	}
+}
+
 func structsToString(structs []*Struct) string {
 	var result string
 	for _, s := range structs {
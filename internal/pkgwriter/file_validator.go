@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/opencodeco/validgen/internal/codegenerator"
@@ -18,7 +22,7 @@ package {{.Name}}
 import (
 {{buildImportPath .Imports}}
 )
-{{range .Structs}}{{.ValidatorFuncCode}}{{end}}`
+{{buildRegexVars .Regexes}}{{buildBigNumberVars .BigNumbers}}{{range .Structs}}{{.ValidatorFuncCode}}{{end}}`
 
 func Writer(pkgs map[string]*codegenerator.Pkg) error {
 	for _, pkg := range pkgs {
@@ -39,16 +43,52 @@ func buildImportPath(imports map[string]parser.Import) (string, error) {
 	code := "\t\"github.com/opencodeco/validgen/types\""
 
 	for _, imp := range imports {
+		if imp.Name == "_" {
+			code += fmt.Sprintf("\n\t_ \"%s\"", imp.Path)
+			continue
+		}
+
 		code += fmt.Sprintf("\n\t\"%s\"", imp.Path)
 	}
 
 	return code, nil
 }
 
+// buildRegexVars declares the package level regular expressions (compiled once).
+func buildRegexVars(regexes map[string]string) (string, error) {
+	varNames := slices.Sorted(maps.Keys(regexes))
+
+	code := ""
+	for _, varName := range varNames {
+		pattern := regexes[varName]
+		quotedPattern := "`" + pattern + "`"
+		if strings.ContainsAny(pattern, "`\r") {
+			quotedPattern = strconv.Quote(pattern)
+		}
+		code += fmt.Sprintf("\nvar %s = regexp.MustCompile(%s)\n", varName, quotedPattern)
+	}
+
+	return code, nil
+}
+
+// buildBigNumberVars declares the package level math/big values (parsed once).
+func buildBigNumberVars(bigNumbers map[string]string) (string, error) {
+	varNames := slices.Sorted(maps.Keys(bigNumbers))
+
+	code := ""
+	for _, varName := range varNames {
+		code += fmt.Sprintf("\nvar %s = %s\n", varName, bigNumbers[varName])
+	}
+
+	return code, nil
+}
+
 func BuildFileValidatorCode(pkg *codegenerator.Pkg) (string, error) {
 
 	funcMap := template.FuncMap{
-		"buildImportPath": buildImportPath,
+		"buildImportPath":    buildImportPath,
+		"buildRegexVars":     buildRegexVars,
+		"buildBigNumberVars": buildBigNumberVars,
 	}
 
 	tmpl, err := template.New("FileValidator").Funcs(funcMap).Parse(fileValidatorTpl)
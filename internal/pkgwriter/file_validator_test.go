@@ -147,3 +147,202 @@ func UserValidate(obj *User) []error {
 		})
 	}
 }
+
+func TestBuildFileValidatorWithRegexes(t *testing.T) {
+	pkg := &codegenerator.Pkg{
+		Name: "main",
+		Imports: map[string]parser.Import{
+			"regexp": {Name: "regexp", Path: "regexp"},
+		},
+		Structs: map[string]*codegenerator.Struct{
+			"User": {
+				Struct: &analyzer.Struct{
+					Struct: parser.Struct{
+						PackageName: "main",
+						StructName:  "User",
+					},
+				},
+				ValidatorFuncCode: `
+func UserValidate(obj *User) []error {
+var errs []error
+if !(validgenRegex2.MatchString(obj.Code)) {
+errs = append(errs, types.NewValidationError("Code must match the pattern '^a` + "`" + `b$'"))
+}
+if !(validgenRegex1.MatchString(obj.Name)) {
+errs = append(errs, types.NewValidationError("Name must match the pattern '^[a-z]{2,5}$'"))
+}
+return errs
+}`,
+			},
+		},
+		Regexes: map[string]string{
+			"validgenRegex2": "^a`b$",
+			"validgenRegex1": "^[a-z]{2,5}$",
+		},
+	}
+
+	want := `// Code generated by ValidGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/opencodeco/validgen/types"
+	"regexp"
+)
+
+var validgenRegex1 = regexp.MustCompile(` + "`^[a-z]{2,5}$`" + `)
+
+var validgenRegex2 = regexp.MustCompile("^a` + "`" + `b$")
+
+func UserValidate(obj *User) []error {
+	var errs []error
+	if !(validgenRegex2.MatchString(obj.Code)) {
+		errs = append(errs, types.NewValidationError("Code must match the pattern '^a` + "`" + `b$'"))
+	}
+	if !(validgenRegex1.MatchString(obj.Name)) {
+		errs = append(errs, types.NewValidationError("Name must match the pattern '^[a-z]{2,5}$'"))
+	}
+	return errs
+}
+`
+
+	got, err := BuildFileValidatorCode(pkg)
+	if err != nil {
+		t.Errorf("FileValidator.BuildFileValidatorCode() error = %v, wantErr %v", err, nil)
+		return
+	}
+
+	if got != want {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(want, got, false)
+		t.Errorf("FileValidator.BuildFileValidatorCode() diff = \n%v", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestBuildFileValidatorWithBigNumbers(t *testing.T) {
+	pkg := &codegenerator.Pkg{
+		Name:    "main",
+		Imports: map[string]parser.Import{},
+		Structs: map[string]*codegenerator.Struct{
+			"Ledger": {
+				Struct: &analyzer.Struct{
+					Struct: parser.Struct{
+						PackageName: "main",
+						StructName:  "Ledger",
+					},
+				},
+				ValidatorFuncCode: `
+func LedgerValidate(obj *Ledger) []error {
+var errs []error
+if !(obj.Balance != nil && obj.Balance.Cmp(validgenBigInt2) >= 0) {
+errs = append(errs, types.NewValidationError("Balance must be >= 0"))
+}
+if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat1) < 0) {
+errs = append(errs, types.NewValidationError("Rate must be < 1/3"))
+}
+return errs
+}`,
+			},
+		},
+		Regexes: map[string]string{},
+		BigNumbers: map[string]string{
+			"validgenBigInt2": `types.MustParseBigInt("0")`,
+			"validgenBigRat1": `types.MustParseBigRat("1/3")`,
+		},
+	}
+
+	want := `// Code generated by ValidGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/opencodeco/validgen/types"
+)
+
+var validgenBigInt2 = types.MustParseBigInt("0")
+
+var validgenBigRat1 = types.MustParseBigRat("1/3")
+
+func LedgerValidate(obj *Ledger) []error {
+	var errs []error
+	if !(obj.Balance != nil && obj.Balance.Cmp(validgenBigInt2) >= 0) {
+		errs = append(errs, types.NewValidationError("Balance must be >= 0"))
+	}
+	if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat1) < 0) {
+		errs = append(errs, types.NewValidationError("Rate must be < 1/3"))
+	}
+	return errs
+}
+`
+
+	got, err := BuildFileValidatorCode(pkg)
+	if err != nil {
+		t.Errorf("FileValidator.BuildFileValidatorCode() error = %v, wantErr %v", err, nil)
+		return
+	}
+
+	if got != want {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(want, got, false)
+		t.Errorf("FileValidator.BuildFileValidatorCode() diff = \n%v", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestBuildFileValidatorWithTimezoneDatabase(t *testing.T) {
+	pkg := &codegenerator.Pkg{
+		Name: "main",
+		Imports: map[string]parser.Import{
+			"time/tzdata": {Name: "_", Path: "time/tzdata"},
+		},
+		Structs: map[string]*codegenerator.Struct{
+			"Event": {
+				Struct: &analyzer.Struct{
+					Struct: parser.Struct{
+						PackageName: "main",
+						StructName:  "Event",
+					},
+				},
+				ValidatorFuncCode: `
+func EventValidate(obj *Event) []error {
+var errs []error
+if !(types.IsValidTimezone(obj.Zone)) {
+errs = append(errs, types.NewValidationError("Zone must be a valid time zone"))
+}
+return errs
+}`,
+			},
+		},
+		Regexes:    map[string]string{},
+		BigNumbers: map[string]string{},
+	}
+
+	want := `// Code generated by ValidGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/opencodeco/validgen/types"
+	_ "time/tzdata"
+)
+
+func EventValidate(obj *Event) []error {
+	var errs []error
+	if !(types.IsValidTimezone(obj.Zone)) {
+		errs = append(errs, types.NewValidationError("Zone must be a valid time zone"))
+	}
+	return errs
+}
+`
+
+	got, err := BuildFileValidatorCode(pkg)
+	if err != nil {
+		t.Errorf("FileValidator.BuildFileValidatorCode() error = %v, wantErr %v", err, nil)
+		return
+	}
+
+	if got != want {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(want, got, false)
+		t.Errorf("FileValidator.BuildFileValidatorCode() diff = \n%v", dmp.DiffPrettyText(diffs))
+	}
+}
@@ -1,8 +1,8 @@
 package main
 
 import (
+	"flag"
 	"log"
-	"os"
 
 	"github.com/opencodeco/validgen/internal/analyzer"
 	"github.com/opencodeco/validgen/internal/codegenerator"
@@ -11,12 +11,21 @@ import (
 )
 
 func main() {
-	argsWithoutCmd := os.Args[1:]
-	if len(argsWithoutCmd) != 1 {
-		log.Fatal("Invalid parameters:\n\tvalidgen <path>\n")
+	opts := codegenerator.Options{}
+	flag.BoolVar(&opts.WithContext, "context", false, "generate context-aware validators (<Struct>ValidateContext)")
+	flag.BoolVar(&opts.Partial, "partial", false, "generate partial validators (<Struct>ValidateFields and <Struct>ValidateExcept)")
+	parserOpts := parser.Options{}
+	flag.Func("optional", "generic type with the fields V and Valid, like sql.Null[T] (e.g. Optional or opt.Optional), can be repeated", func(s string) error {
+		parserOpts.OptionalTypes = append(parserOpts.OptionalTypes, s)
+		return nil
+	})
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("Invalid parameters:\n\tvalidgen [-context] [-partial] [-optional <type>] <path>\n")
 	}
 
-	parsedStructs, err := parser.ExtractStructs(argsWithoutCmd[0])
+	parsedStructs, err := parser.ExtractStructs(flag.Arg(0), parserOpts)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -30,7 +39,7 @@ func main() {
 		st.PrintInfo()
 	}
 
-	pkgs, err := codegenerator.GenerateCode(analyzedStructs)
+	pkgs, err := codegenerator.GenerateCode(analyzedStructs, opts)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,64 @@
+package benchtests
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type StructIDsValidator struct {
+	RequestID  string `validate:"uuid4"`
+	EventID    string `validate:"ulid"`
+	DocumentID string `validate:"mongodb"`
+}
+
+func TestIDsValidGen(t *testing.T) {
+	data := &StructIDsValidGen{
+		RequestID:  "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		EventID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		DocumentID: "507f1f77bcf86cd799439011",
+	}
+
+	errors := StructIDsValidGenValidate(data)
+	assert.Equal(t, 0, len(errors))
+}
+
+func TestIDsValidator(t *testing.T) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	data := &StructIDsValidator{
+		RequestID:  "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		EventID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		DocumentID: "507f1f77bcf86cd799439011",
+	}
+
+	err := validate.Struct(data)
+	assert.NoError(t, err)
+}
+
+func BenchmarkIDsValidGen(b *testing.B) {
+	for b.Loop() {
+		data := &StructIDsValidGen{
+			RequestID:  "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			EventID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			DocumentID: "507f1f77bcf86cd799439011",
+		}
+
+		StructIDsValidGenValidate(data)
+	}
+}
+
+func BenchmarkIDsValidator(b *testing.B) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	for b.Loop() {
+		data := &StructIDsValidator{
+			RequestID:  "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			EventID:    "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			DocumentID: "507f1f77bcf86cd799439011",
+		}
+
+		validate.Struct(data)
+	}
+}
@@ -0,0 +1,54 @@
+package benchtests
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type StructTimezoneValidator struct {
+	Timezone string `validate:"timezone"`
+}
+
+func TestTimezoneValidGen(t *testing.T) {
+	data := &StructTimezoneValidGen{
+		Timezone: "America/Sao_Paulo",
+	}
+
+	errors := StructTimezoneValidGenValidate(data)
+	assert.Equal(t, 0, len(errors))
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	data := &StructTimezoneValidator{
+		Timezone: "America/Sao_Paulo",
+	}
+
+	err := validate.Struct(data)
+	assert.NoError(t, err)
+}
+
+func BenchmarkTimezoneValidGen(b *testing.B) {
+	for b.Loop() {
+		data := &StructTimezoneValidGen{
+			Timezone: "America/Sao_Paulo",
+		}
+
+		StructTimezoneValidGenValidate(data)
+	}
+}
+
+func BenchmarkTimezoneValidator(b *testing.B) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+
+	for b.Loop() {
+		data := &StructTimezoneValidator{
+			Timezone: "America/Sao_Paulo",
+		}
+
+		validate.Struct(data)
+	}
+}
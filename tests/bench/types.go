@@ -15,3 +15,13 @@ type StructManualCoding struct {
 	UserName  string
 	Optional  string
 }
+
+type StructIDsValidGen struct {
+	RequestID  string `valid:"uuid4"`
+	EventID    string `valid:"ulid"`
+	DocumentID string `valid:"mongodb"`
+}
+
+type StructTimezoneValidGen struct {
+	Timezone string `valid:"timezone"`
+}
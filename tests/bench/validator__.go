@@ -4,8 +4,29 @@ package benchtests
 
 import (
 	"github.com/opencodeco/validgen/types"
+	_ "time/tzdata"
 )
 
+func StructIDsValidGenValidate(obj *StructIDsValidGen) []error {
+	var errs []error
+	if !(types.IsValidUUID4(obj.RequestID)) {
+		errs = append(errs, types.NewValidationError("RequestID must be a valid UUID v4"))
+	}
+	if !(types.IsValidULID(obj.EventID)) {
+		errs = append(errs, types.NewValidationError("EventID must be a valid ULID"))
+	}
+	if !(types.IsValidMongoDBObjectID(obj.DocumentID)) {
+		errs = append(errs, types.NewValidationError("DocumentID must be a valid MongoDB ObjectID"))
+	}
+	return errs
+}
+func StructTimezoneValidGenValidate(obj *StructTimezoneValidGen) []error {
+	var errs []error
+	if !(types.IsValidTimezone(obj.Timezone)) {
+		errs = append(errs, types.NewValidationError("Timezone must be a valid time zone"))
+	}
+	return errs
+}
 func StructValidGenValidate(obj *StructValidGen) []error {
 	var errs []error
 	if !(obj.FirstName != "") {
@@ -4,8 +4,303 @@ package benchtests
 
 import (
 	"github.com/opencodeco/validgen/types"
+	_ "time/tzdata"
 )
 
+func ValidGenAlphaStringPointerStructValidate(obj *ValidGenAlphaStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsAlpha(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII letters"))
+	}
+	return errs
+}
+func ValidGenAlphaStringStructValidate(obj *ValidGenAlphaStringStruct) []error {
+	var errs []error
+	if !(types.IsAlpha(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII letters"))
+	}
+	return errs
+}
+func ValidGenAlphanumStringPointerStructValidate(obj *ValidGenAlphanumStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsAlphanumeric(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII letters and digits"))
+	}
+	return errs
+}
+func ValidGenAlphanumStringStructValidate(obj *ValidGenAlphanumStringStruct) []error {
+	var errs []error
+	if !(types.IsAlphanumeric(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII letters and digits"))
+	}
+	return errs
+}
+func ValidGenAlphanumunicodeStringPointerStructValidate(obj *ValidGenAlphanumunicodeStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsAlphanumericUnicode(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only letters and numbers"))
+	}
+	return errs
+}
+func ValidGenAlphanumunicodeStringStructValidate(obj *ValidGenAlphanumunicodeStringStruct) []error {
+	var errs []error
+	if !(types.IsAlphanumericUnicode(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only letters and numbers"))
+	}
+	return errs
+}
+func ValidGenAlphaunicodeStringPointerStructValidate(obj *ValidGenAlphaunicodeStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsAlphaUnicode(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only letters"))
+	}
+	return errs
+}
+func ValidGenAlphaunicodeStringStructValidate(obj *ValidGenAlphaunicodeStringStruct) []error {
+	var errs []error
+	if !(types.IsAlphaUnicode(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only letters"))
+	}
+	return errs
+}
+func ValidGenAsciiStringPointerStructValidate(obj *ValidGenAsciiStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsASCII(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII characters"))
+	}
+	return errs
+}
+func ValidGenAsciiStringStructValidate(obj *ValidGenAsciiStringStruct) []error {
+	var errs []error
+	if !(types.IsASCII(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only ASCII characters"))
+	}
+	return errs
+}
+func ValidGenBase64StringPointerStructValidate(obj *ValidGenBase64StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidBase64(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid base64 string"))
+	}
+	return errs
+}
+func ValidGenBase64StringStructValidate(obj *ValidGenBase64StringStruct) []error {
+	var errs []error
+	if !(types.IsValidBase64(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid base64 string"))
+	}
+	return errs
+}
+func ValidGenBase64rawurlStringPointerStructValidate(obj *ValidGenBase64rawurlStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidBase64RawURL(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid raw base64 URL string"))
+	}
+	return errs
+}
+func ValidGenBase64rawurlStringStructValidate(obj *ValidGenBase64rawurlStringStruct) []error {
+	var errs []error
+	if !(types.IsValidBase64RawURL(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid raw base64 URL string"))
+	}
+	return errs
+}
+func ValidGenBase64urlStringPointerStructValidate(obj *ValidGenBase64urlStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidBase64URL(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid base64 URL string"))
+	}
+	return errs
+}
+func ValidGenBase64urlStringStructValidate(obj *ValidGenBase64urlStringStruct) []error {
+	var errs []error
+	if !(types.IsValidBase64URL(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid base64 URL string"))
+	}
+	return errs
+}
+func ValidGenBcp47_language_tagStringPointerStructValidate(obj *ValidGenBcp47_language_tagStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidBCP47LanguageTag(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid BCP 47 language tag"))
+	}
+	return errs
+}
+func ValidGenBcp47_language_tagStringStructValidate(obj *ValidGenBcp47_language_tagStringStruct) []error {
+	var errs []error
+	if !(types.IsValidBCP47LanguageTag(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid BCP 47 language tag"))
+	}
+	return errs
+}
+func ValidGenBicStringPointerStructValidate(obj *ValidGenBicStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidBIC(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid BIC"))
+	}
+	return errs
+}
+func ValidGenBicStringStructValidate(obj *ValidGenBicStringStruct) []error {
+	var errs []error
+	if !(types.IsValidBIC(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid BIC"))
+	}
+	return errs
+}
+func ValidGenCidrStringPointerStructValidate(obj *ValidGenCidrStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidCIDR(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid CIDR"))
+	}
+	return errs
+}
+func ValidGenCidrStringStructValidate(obj *ValidGenCidrStringStruct) []error {
+	var errs []error
+	if !(types.IsValidCIDR(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid CIDR"))
+	}
+	return errs
+}
+func ValidGenCidrv4StringPointerStructValidate(obj *ValidGenCidrv4StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidCIDRv4(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv4 CIDR"))
+	}
+	return errs
+}
+func ValidGenCidrv4StringStructValidate(obj *ValidGenCidrv4StringStruct) []error {
+	var errs []error
+	if !(types.IsValidCIDRv4(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv4 CIDR"))
+	}
+	return errs
+}
+func ValidGenCidrv6StringPointerStructValidate(obj *ValidGenCidrv6StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidCIDRv6(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv6 CIDR"))
+	}
+	return errs
+}
+func ValidGenCidrv6StringStructValidate(obj *ValidGenCidrv6StringStruct) []error {
+	var errs []error
+	if !(types.IsValidCIDRv6(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv6 CIDR"))
+	}
+	return errs
+}
+func ValidGenContainsStringPointerStructValidate(obj *ValidGenContainsStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.Contains(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must contain 'ab'"))
+	}
+	return errs
+}
+func ValidGenContainsStringStructValidate(obj *ValidGenContainsStringStruct) []error {
+	var errs []error
+	if !(types.Contains(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must contain 'ab'"))
+	}
+	return errs
+}
+func ValidGenContainsanyStringPointerStructValidate(obj *ValidGenContainsanyStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.ContainsAny(*obj.Field, "!@#")) {
+		errs = append(errs, types.NewValidationError("Field must contain any of '!@#'"))
+	}
+	return errs
+}
+func ValidGenContainsanyStringStructValidate(obj *ValidGenContainsanyStringStruct) []error {
+	var errs []error
+	if !(types.ContainsAny(obj.Field, "!@#")) {
+		errs = append(errs, types.NewValidationError("Field must contain any of '!@#'"))
+	}
+	return errs
+}
+func ValidGenContainsruneStringPointerStructValidate(obj *ValidGenContainsruneStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.Contains(*obj.Field, "@")) {
+		errs = append(errs, types.NewValidationError("Field must contain '@'"))
+	}
+	return errs
+}
+func ValidGenContainsruneStringStructValidate(obj *ValidGenContainsruneStringStruct) []error {
+	var errs []error
+	if !(types.Contains(obj.Field, "@")) {
+		errs = append(errs, types.NewValidationError("Field must contain '@'"))
+	}
+	return errs
+}
+func ValidGenCredit_cardStringPointerStructValidate(obj *ValidGenCredit_cardStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidCreditCard(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid credit card number"))
+	}
+	return errs
+}
+func ValidGenCredit_cardStringStructValidate(obj *ValidGenCredit_cardStringStruct) []error {
+	var errs []error
+	if !(types.IsValidCreditCard(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid credit card number"))
+	}
+	return errs
+}
+func ValidGenCronStringPointerStructValidate(obj *ValidGenCronStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidCron(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid cron expression (5 fields, or 6 with seconds)"))
+	}
+	return errs
+}
+func ValidGenCronStringStructValidate(obj *ValidGenCronStringStruct) []error {
+	var errs []error
+	if !(types.IsValidCron(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid cron expression (5 fields, or 6 with seconds)"))
+	}
+	return errs
+}
+func ValidGenDatauriStringPointerStructValidate(obj *ValidGenDatauriStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidDataURI(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid data URI"))
+	}
+	return errs
+}
+func ValidGenDatauriStringStructValidate(obj *ValidGenDatauriStringStruct) []error {
+	var errs []error
+	if !(types.IsValidDataURI(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid data URI"))
+	}
+	return errs
+}
+func ValidGenDatetimeStringPointerStructValidate(obj *ValidGenDatetimeStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidDatetime(*obj.Field, "2006-01-02")) {
+		errs = append(errs, types.NewValidationError("Field must be a valid datetime with layout '2006-01-02'"))
+	}
+	return errs
+}
+func ValidGenDatetimeStringStructValidate(obj *ValidGenDatetimeStringStruct) []error {
+	var errs []error
+	if !(types.IsValidDatetime(obj.Field, "2006-01-02")) {
+		errs = append(errs, types.NewValidationError("Field must be a valid datetime with layout '2006-01-02'"))
+	}
+	return errs
+}
+func ValidGenE164StringPointerStructValidate(obj *ValidGenE164StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidE164(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid E.164 phone number"))
+	}
+	return errs
+}
+func ValidGenE164StringStructValidate(obj *ValidGenE164StringStruct) []error {
+	var errs []error
+	if !(types.IsValidE164(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid E.164 phone number"))
+	}
+	return errs
+}
 func ValidGenEmailStringPointerStructValidate(obj *ValidGenEmailStringPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && types.IsValidEmail(*obj.Field)) {
@@ -20,6 +315,34 @@ func ValidGenEmailStringStructValidate(obj *ValidGenEmailStringStruct) []error {
 	}
 	return errs
 }
+func ValidGenEndsnotwithStringPointerStructValidate(obj *ValidGenEndsnotwithStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && !types.HasSuffix(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not end with 'ab'"))
+	}
+	return errs
+}
+func ValidGenEndsnotwithStringStructValidate(obj *ValidGenEndsnotwithStringStruct) []error {
+	var errs []error
+	if !(!types.HasSuffix(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not end with 'ab'"))
+	}
+	return errs
+}
+func ValidGenEndswithStringPointerStructValidate(obj *ValidGenEndswithStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.HasSuffix(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must end with 'ab'"))
+	}
+	return errs
+}
+func ValidGenEndswithStringStructValidate(obj *ValidGenEndswithStringStruct) []error {
+	var errs []error
+	if !(types.HasSuffix(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must end with 'ab'"))
+	}
+	return errs
+}
 func ValidGenEqBoolPointerStructValidate(obj *ValidGenEqBoolPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && *obj.Field == true) {
@@ -230,6 +553,48 @@ func ValidGenEq_ignore_caseStringStructValidate(obj *ValidGenEq_ignore_caseStrin
 	}
 	return errs
 }
+func ValidGenExcludesStringPointerStructValidate(obj *ValidGenExcludesStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && !types.Contains(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not contain 'ab'"))
+	}
+	return errs
+}
+func ValidGenExcludesStringStructValidate(obj *ValidGenExcludesStringStruct) []error {
+	var errs []error
+	if !(!types.Contains(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not contain 'ab'"))
+	}
+	return errs
+}
+func ValidGenExcludesallStringPointerStructValidate(obj *ValidGenExcludesallStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && !types.ContainsAny(*obj.Field, "!@#")) {
+		errs = append(errs, types.NewValidationError("Field must not contain any of '!@#'"))
+	}
+	return errs
+}
+func ValidGenExcludesallStringStructValidate(obj *ValidGenExcludesallStringStruct) []error {
+	var errs []error
+	if !(!types.ContainsAny(obj.Field, "!@#")) {
+		errs = append(errs, types.NewValidationError("Field must not contain any of '!@#'"))
+	}
+	return errs
+}
+func ValidGenFqdnStringPointerStructValidate(obj *ValidGenFqdnStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidFQDN(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid FQDN"))
+	}
+	return errs
+}
+func ValidGenFqdnStringStructValidate(obj *ValidGenFqdnStringStruct) []error {
+	var errs []error
+	if !(types.IsValidFQDN(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid FQDN"))
+	}
+	return errs
+}
 func ValidGenGtFloat32PointerStructValidate(obj *ValidGenGtFloat32PointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && *obj.Field > 12.34) {
@@ -566,6 +931,90 @@ func ValidGenGteUintStructValidate(obj *ValidGenGteUintStruct) []error {
 	}
 	return errs
 }
+func ValidGenHexadecimalStringPointerStructValidate(obj *ValidGenHexadecimalStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHexadecimal(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hexadecimal string"))
+	}
+	return errs
+}
+func ValidGenHexadecimalStringStructValidate(obj *ValidGenHexadecimalStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHexadecimal(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hexadecimal string"))
+	}
+	return errs
+}
+func ValidGenHexcolorStringPointerStructValidate(obj *ValidGenHexcolorStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHexColor(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hex color"))
+	}
+	return errs
+}
+func ValidGenHexcolorStringStructValidate(obj *ValidGenHexcolorStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHexColor(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hex color"))
+	}
+	return errs
+}
+func ValidGenHostnameStringPointerStructValidate(obj *ValidGenHostnameStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHostname(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hostname"))
+	}
+	return errs
+}
+func ValidGenHostnameStringStructValidate(obj *ValidGenHostnameStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHostname(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hostname"))
+	}
+	return errs
+}
+func ValidGenHostname_portStringPointerStructValidate(obj *ValidGenHostname_portStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHostnamePort(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hostname and port"))
+	}
+	return errs
+}
+func ValidGenHostname_portStringStructValidate(obj *ValidGenHostname_portStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHostnamePort(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid hostname and port"))
+	}
+	return errs
+}
+func ValidGenHslStringPointerStructValidate(obj *ValidGenHslStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHSL(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid HSL color"))
+	}
+	return errs
+}
+func ValidGenHslStringStructValidate(obj *ValidGenHslStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHSL(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid HSL color"))
+	}
+	return errs
+}
+func ValidGenHttp_urlStringPointerStructValidate(obj *ValidGenHttp_urlStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidHTTPURL(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid HTTP URL"))
+	}
+	return errs
+}
+func ValidGenHttp_urlStringStructValidate(obj *ValidGenHttp_urlStringStruct) []error {
+	var errs []error
+	if !(types.IsValidHTTPURL(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid HTTP URL"))
+	}
+	return errs
+}
 func ValidGenInInt16PointerStructValidate(obj *ValidGenInInt16PointerStruct) []error {
 	var errs []error
 	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
@@ -580,143 +1029,325 @@ func ValidGenInInt16StructValidate(obj *ValidGenInInt16Struct) []error {
 	}
 	return errs
 }
-func ValidGenInInt32PointerStructValidate(obj *ValidGenInInt32PointerStruct) []error {
+func ValidGenInInt32PointerStructValidate(obj *ValidGenInInt32PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInInt32StructValidate(obj *ValidGenInInt32Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInInt64PointerStructValidate(obj *ValidGenInInt64PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInInt64StructValidate(obj *ValidGenInInt64Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInInt8PointerStructValidate(obj *ValidGenInInt8PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInInt8StructValidate(obj *ValidGenInInt8Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInIntPointerStructValidate(obj *ValidGenInIntPointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInIntStructValidate(obj *ValidGenInIntStruct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInStringPointerStructValidate(obj *ValidGenInStringPointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == "ab") || (obj.Field != nil && *obj.Field == "cd") || (obj.Field != nil && *obj.Field == "ef")) {
+		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+func ValidGenInStringStructValidate(obj *ValidGenInStringStruct) []error {
+	var errs []error
+	if !(obj.Field == "ab" || obj.Field == "cd" || obj.Field == "ef") {
+		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+func ValidGenInUint16PointerStructValidate(obj *ValidGenInUint16PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint16StructValidate(obj *ValidGenInUint16Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint32PointerStructValidate(obj *ValidGenInUint32PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint32StructValidate(obj *ValidGenInUint32Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint64PointerStructValidate(obj *ValidGenInUint64PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint64StructValidate(obj *ValidGenInUint64Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint8PointerStructValidate(obj *ValidGenInUint8PointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUint8StructValidate(obj *ValidGenInUint8Struct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUintPointerStructValidate(obj *ValidGenInUintPointerStruct) []error {
+	var errs []error
+	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenInUintStructValidate(obj *ValidGenInUintStruct) []error {
+	var errs []error
+	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
+		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	}
+	return errs
+}
+func ValidGenIn_ignore_caseStringPointerStructValidate(obj *ValidGenIn_ignore_caseStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.EqualFold(*obj.Field, "ab") || obj.Field != nil && types.EqualFold(*obj.Field, "cd") || obj.Field != nil && types.EqualFold(*obj.Field, "ef")) {
+		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+func ValidGenIn_ignore_caseStringStructValidate(obj *ValidGenIn_ignore_caseStringStruct) []error {
+	var errs []error
+	if !(types.EqualFold(obj.Field, "ab") || types.EqualFold(obj.Field, "cd") || types.EqualFold(obj.Field, "ef")) {
+		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+func ValidGenIpStringPointerStructValidate(obj *ValidGenIpStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidIP(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IP address"))
+	}
+	return errs
+}
+func ValidGenIpStringStructValidate(obj *ValidGenIpStringStruct) []error {
+	var errs []error
+	if !(types.IsValidIP(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IP address"))
+	}
+	return errs
+}
+func ValidGenIpv4StringPointerStructValidate(obj *ValidGenIpv4StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidIPv4(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv4 address"))
+	}
+	return errs
+}
+func ValidGenIpv4StringStructValidate(obj *ValidGenIpv4StringStruct) []error {
+	var errs []error
+	if !(types.IsValidIPv4(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv4 address"))
+	}
+	return errs
+}
+func ValidGenIpv6StringPointerStructValidate(obj *ValidGenIpv6StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidIPv6(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv6 address"))
 	}
 	return errs
 }
-func ValidGenInInt32StructValidate(obj *ValidGenInInt32Struct) []error {
+func ValidGenIpv6StringStructValidate(obj *ValidGenIpv6StringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidIPv6(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid IPv6 address"))
 	}
 	return errs
 }
-func ValidGenInInt64PointerStructValidate(obj *ValidGenInInt64PointerStruct) []error {
+func ValidGenIsbn10StringPointerStructValidate(obj *ValidGenIsbn10StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidISBN10(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISBN-10"))
 	}
 	return errs
 }
-func ValidGenInInt64StructValidate(obj *ValidGenInInt64Struct) []error {
+func ValidGenIsbn10StringStructValidate(obj *ValidGenIsbn10StringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidISBN10(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISBN-10"))
 	}
 	return errs
 }
-func ValidGenInInt8PointerStructValidate(obj *ValidGenInInt8PointerStruct) []error {
+func ValidGenIsbn13StringPointerStructValidate(obj *ValidGenIsbn13StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidISBN13(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISBN-13"))
 	}
 	return errs
 }
-func ValidGenInInt8StructValidate(obj *ValidGenInInt8Struct) []error {
+func ValidGenIsbn13StringStructValidate(obj *ValidGenIsbn13StringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidISBN13(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISBN-13"))
 	}
 	return errs
 }
-func ValidGenInIntPointerStructValidate(obj *ValidGenInIntPointerStruct) []error {
+func ValidGenIso3166_1_alpha2StringPointerStructValidate(obj *ValidGenIso3166_1_alpha2StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidISO3166Alpha2(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 3166-1 alpha-2 country code"))
 	}
 	return errs
 }
-func ValidGenInIntStructValidate(obj *ValidGenInIntStruct) []error {
+func ValidGenIso3166_1_alpha2StringStructValidate(obj *ValidGenIso3166_1_alpha2StringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidISO3166Alpha2(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 3166-1 alpha-2 country code"))
 	}
 	return errs
 }
-func ValidGenInStringPointerStructValidate(obj *ValidGenInStringPointerStruct) []error {
+func ValidGenIso3166_1_alpha3StringPointerStructValidate(obj *ValidGenIso3166_1_alpha3StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == "ab") || (obj.Field != nil && *obj.Field == "cd") || (obj.Field != nil && *obj.Field == "ef")) {
-		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	if !(obj.Field != nil && types.IsValidISO3166Alpha3(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 3166-1 alpha-3 country code"))
 	}
 	return errs
 }
-func ValidGenInStringStructValidate(obj *ValidGenInStringStruct) []error {
+func ValidGenIso3166_1_alpha3StringStructValidate(obj *ValidGenIso3166_1_alpha3StringStruct) []error {
 	var errs []error
-	if !(obj.Field == "ab" || obj.Field == "cd" || obj.Field == "ef") {
-		errs = append(errs, types.NewValidationError("Field must be one of 'ab' 'cd' 'ef'"))
+	if !(types.IsValidISO3166Alpha3(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 3166-1 alpha-3 country code"))
 	}
 	return errs
 }
-func ValidGenInUint16PointerStructValidate(obj *ValidGenInUint16PointerStruct) []error {
+func ValidGenIso4217StringPointerStructValidate(obj *ValidGenIso4217StringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidISO4217(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 4217 currency code"))
 	}
 	return errs
 }
-func ValidGenInUint16StructValidate(obj *ValidGenInUint16Struct) []error {
+func ValidGenIso4217StringStructValidate(obj *ValidGenIso4217StringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidISO4217(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISO 4217 currency code"))
 	}
 	return errs
 }
-func ValidGenInUint32PointerStructValidate(obj *ValidGenInUint32PointerStruct) []error {
+func ValidGenIssnStringPointerStructValidate(obj *ValidGenIssnStringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidISSN(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISSN"))
 	}
 	return errs
 }
-func ValidGenInUint32StructValidate(obj *ValidGenInUint32Struct) []error {
+func ValidGenIssnStringStructValidate(obj *ValidGenIssnStringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidISSN(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ISSN"))
 	}
 	return errs
 }
-func ValidGenInUint64PointerStructValidate(obj *ValidGenInUint64PointerStruct) []error {
+func ValidGenJsonStringPointerStructValidate(obj *ValidGenJsonStringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidJSON(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid JSON"))
 	}
 	return errs
 }
-func ValidGenInUint64StructValidate(obj *ValidGenInUint64Struct) []error {
+func ValidGenJsonStringStructValidate(obj *ValidGenJsonStringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidJSON(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid JSON"))
 	}
 	return errs
 }
-func ValidGenInUint8PointerStructValidate(obj *ValidGenInUint8PointerStruct) []error {
+func ValidGenJwtStringPointerStructValidate(obj *ValidGenJwtStringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidJWT(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid JWT"))
 	}
 	return errs
 }
-func ValidGenInUint8StructValidate(obj *ValidGenInUint8Struct) []error {
+func ValidGenJwtStringStructValidate(obj *ValidGenJwtStringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidJWT(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid JWT"))
 	}
 	return errs
 }
-func ValidGenInUintPointerStructValidate(obj *ValidGenInUintPointerStruct) []error {
+func ValidGenLatitudeStringPointerStructValidate(obj *ValidGenLatitudeStringPointerStruct) []error {
 	var errs []error
-	if !((obj.Field != nil && *obj.Field == 12) || (obj.Field != nil && *obj.Field == 34) || (obj.Field != nil && *obj.Field == 56)) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(obj.Field != nil && types.IsValidLatitude(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid latitude (-90 to 90)"))
 	}
 	return errs
 }
-func ValidGenInUintStructValidate(obj *ValidGenInUintStruct) []error {
+func ValidGenLatitudeStringStructValidate(obj *ValidGenLatitudeStringStruct) []error {
 	var errs []error
-	if !(obj.Field == 12 || obj.Field == 34 || obj.Field == 56) {
-		errs = append(errs, types.NewValidationError("Field must be one of '12' '34' '56'"))
+	if !(types.IsValidLatitude(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid latitude (-90 to 90)"))
 	}
 	return errs
 }
@@ -1126,6 +1757,34 @@ func ValidGenLenUintSliceStructValidate(obj *ValidGenLenUintSliceStruct) []error
 	}
 	return errs
 }
+func ValidGenLongitudeStringPointerStructValidate(obj *ValidGenLongitudeStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidLongitude(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid longitude (-180 to 180)"))
+	}
+	return errs
+}
+func ValidGenLongitudeStringStructValidate(obj *ValidGenLongitudeStringStruct) []error {
+	var errs []error
+	if !(types.IsValidLongitude(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid longitude (-180 to 180)"))
+	}
+	return errs
+}
+func ValidGenLowercaseStringPointerStructValidate(obj *ValidGenLowercaseStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsLowercase(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be lowercase"))
+	}
+	return errs
+}
+func ValidGenLowercaseStringStructValidate(obj *ValidGenLowercaseStringStruct) []error {
+	var errs []error
+	if !(types.IsLowercase(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be lowercase"))
+	}
+	return errs
+}
 func ValidGenLtFloat32PointerStructValidate(obj *ValidGenLtFloat32PointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && *obj.Field < 12.34) {
@@ -1462,6 +2121,34 @@ func ValidGenLteUintStructValidate(obj *ValidGenLteUintStruct) []error {
 	}
 	return errs
 }
+func ValidGenLuhn_checksumStringPointerStructValidate(obj *ValidGenLuhn_checksumStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidLuhnChecksum(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must have a valid Luhn checksum"))
+	}
+	return errs
+}
+func ValidGenLuhn_checksumStringStructValidate(obj *ValidGenLuhn_checksumStringStruct) []error {
+	var errs []error
+	if !(types.IsValidLuhnChecksum(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must have a valid Luhn checksum"))
+	}
+	return errs
+}
+func ValidGenMacStringPointerStructValidate(obj *ValidGenMacStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidMAC(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid MAC address"))
+	}
+	return errs
+}
+func ValidGenMacStringStructValidate(obj *ValidGenMacStringStruct) []error {
+	var errs []error
+	if !(types.IsValidMAC(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid MAC address"))
+	}
+	return errs
+}
 func ValidGenMaxBoolMapPointerStructValidate(obj *ValidGenMaxBoolMapPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && len(*obj.Field) <= 1) {
@@ -2274,6 +2961,20 @@ func ValidGenMinUintSliceStructValidate(obj *ValidGenMinUintSliceStruct) []error
 	}
 	return errs
 }
+func ValidGenMongodbStringPointerStructValidate(obj *ValidGenMongodbStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidMongoDBObjectID(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid MongoDB ObjectID"))
+	}
+	return errs
+}
+func ValidGenMongodbStringStructValidate(obj *ValidGenMongodbStringStruct) []error {
+	var errs []error
+	if !(types.IsValidMongoDBObjectID(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid MongoDB ObjectID"))
+	}
+	return errs
+}
 func ValidGenNeqBoolPointerStructValidate(obj *ValidGenNeqBoolPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil && *obj.Field != true) {
@@ -2484,6 +3185,62 @@ func ValidGenNeq_ignore_caseStringStructValidate(obj *ValidGenNeq_ignore_caseStr
 	}
 	return errs
 }
+func ValidGenNumberStringPointerStructValidate(obj *ValidGenNumberStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsNumber(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only digits"))
+	}
+	return errs
+}
+func ValidGenNumberStringStructValidate(obj *ValidGenNumberStringStruct) []error {
+	var errs []error
+	if !(types.IsNumber(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only digits"))
+	}
+	return errs
+}
+func ValidGenNumericStringPointerStructValidate(obj *ValidGenNumericStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsNumeric(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a numeric value"))
+	}
+	return errs
+}
+func ValidGenNumericStringStructValidate(obj *ValidGenNumericStringStruct) []error {
+	var errs []error
+	if !(types.IsNumeric(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a numeric value"))
+	}
+	return errs
+}
+func ValidGenPostcode_iso3166_alpha2StringPointerStructValidate(obj *ValidGenPostcode_iso3166_alpha2StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidPostcode(*obj.Field, "BR")) {
+		errs = append(errs, types.NewValidationError("Field must be a valid postal code for 'BR'"))
+	}
+	return errs
+}
+func ValidGenPostcode_iso3166_alpha2StringStructValidate(obj *ValidGenPostcode_iso3166_alpha2StringStruct) []error {
+	var errs []error
+	if !(types.IsValidPostcode(obj.Field, "BR")) {
+		errs = append(errs, types.NewValidationError("Field must be a valid postal code for 'BR'"))
+	}
+	return errs
+}
+func ValidGenPrintasciiStringPointerStructValidate(obj *ValidGenPrintasciiStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsPrintableASCII(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only printable ASCII characters"))
+	}
+	return errs
+}
+func ValidGenPrintasciiStringStructValidate(obj *ValidGenPrintasciiStringStruct) []error {
+	var errs []error
+	if !(types.IsPrintableASCII(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must contain only printable ASCII characters"))
+	}
+	return errs
+}
 func ValidGenRequiredBoolArrayPointerStructValidate(obj *ValidGenRequiredBoolArrayPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil) {
@@ -2533,6 +3290,20 @@ func ValidGenRequiredBoolStructValidate(obj *ValidGenRequiredBoolStruct) []error
 	}
 	return errs
 }
+func ValidGenRequiredByteSlicePointerStructValidate(obj *ValidGenRequiredByteSlicePointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && len(*obj.Field) != 0) {
+		errs = append(errs, types.NewValidationError("Field must not be empty"))
+	}
+	return errs
+}
+func ValidGenRequiredByteSliceStructValidate(obj *ValidGenRequiredByteSliceStruct) []error {
+	var errs []error
+	if !(len(obj.Field) != 0) {
+		errs = append(errs, types.NewValidationError("Field must not be empty"))
+	}
+	return errs
+}
 func ValidGenRequiredFloat32ArrayPointerStructValidate(obj *ValidGenRequiredFloat32ArrayPointerStruct) []error {
 	var errs []error
 	if !(obj.Field != nil) {
@@ -3170,3 +3941,199 @@ func ValidGenRequiredUintStructValidate(obj *ValidGenRequiredUintStruct) []error
 	}
 	return errs
 }
+func ValidGenRgbStringPointerStructValidate(obj *ValidGenRgbStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidRGB(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid RGB color"))
+	}
+	return errs
+}
+func ValidGenRgbStringStructValidate(obj *ValidGenRgbStringStruct) []error {
+	var errs []error
+	if !(types.IsValidRGB(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid RGB color"))
+	}
+	return errs
+}
+func ValidGenRgbaStringPointerStructValidate(obj *ValidGenRgbaStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidRGBA(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid RGBA color"))
+	}
+	return errs
+}
+func ValidGenRgbaStringStructValidate(obj *ValidGenRgbaStringStruct) []error {
+	var errs []error
+	if !(types.IsValidRGBA(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid RGBA color"))
+	}
+	return errs
+}
+func ValidGenSemverStringPointerStructValidate(obj *ValidGenSemverStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidSemver(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid semantic version (e.g. 1.2.3)"))
+	}
+	return errs
+}
+func ValidGenSemverStringStructValidate(obj *ValidGenSemverStringStruct) []error {
+	var errs []error
+	if !(types.IsValidSemver(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid semantic version (e.g. 1.2.3)"))
+	}
+	return errs
+}
+func ValidGenStartsnotwithStringPointerStructValidate(obj *ValidGenStartsnotwithStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && !types.HasPrefix(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not start with 'ab'"))
+	}
+	return errs
+}
+func ValidGenStartsnotwithStringStructValidate(obj *ValidGenStartsnotwithStringStruct) []error {
+	var errs []error
+	if !(!types.HasPrefix(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must not start with 'ab'"))
+	}
+	return errs
+}
+func ValidGenStartswithStringPointerStructValidate(obj *ValidGenStartswithStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.HasPrefix(*obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must start with 'ab'"))
+	}
+	return errs
+}
+func ValidGenStartswithStringStructValidate(obj *ValidGenStartswithStringStruct) []error {
+	var errs []error
+	if !(types.HasPrefix(obj.Field, "ab")) {
+		errs = append(errs, types.NewValidationError("Field must start with 'ab'"))
+	}
+	return errs
+}
+func ValidGenTimezoneStringPointerStructValidate(obj *ValidGenTimezoneStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidTimezone(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid time zone"))
+	}
+	return errs
+}
+func ValidGenTimezoneStringStructValidate(obj *ValidGenTimezoneStringStruct) []error {
+	var errs []error
+	if !(types.IsValidTimezone(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid time zone"))
+	}
+	return errs
+}
+func ValidGenUlidStringPointerStructValidate(obj *ValidGenUlidStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidULID(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ULID"))
+	}
+	return errs
+}
+func ValidGenUlidStringStructValidate(obj *ValidGenUlidStringStruct) []error {
+	var errs []error
+	if !(types.IsValidULID(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid ULID"))
+	}
+	return errs
+}
+func ValidGenUppercaseStringPointerStructValidate(obj *ValidGenUppercaseStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsUppercase(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be uppercase"))
+	}
+	return errs
+}
+func ValidGenUppercaseStringStructValidate(obj *ValidGenUppercaseStringStruct) []error {
+	var errs []error
+	if !(types.IsUppercase(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be uppercase"))
+	}
+	return errs
+}
+func ValidGenUriStringPointerStructValidate(obj *ValidGenUriStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidURI(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid URI"))
+	}
+	return errs
+}
+func ValidGenUriStringStructValidate(obj *ValidGenUriStringStruct) []error {
+	var errs []error
+	if !(types.IsValidURI(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid URI"))
+	}
+	return errs
+}
+func ValidGenUrlStringPointerStructValidate(obj *ValidGenUrlStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidURL(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid URL"))
+	}
+	return errs
+}
+func ValidGenUrlStringStructValidate(obj *ValidGenUrlStringStruct) []error {
+	var errs []error
+	if !(types.IsValidURL(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid URL"))
+	}
+	return errs
+}
+func ValidGenUuid3StringPointerStructValidate(obj *ValidGenUuid3StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidUUID3(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v3"))
+	}
+	return errs
+}
+func ValidGenUuid3StringStructValidate(obj *ValidGenUuid3StringStruct) []error {
+	var errs []error
+	if !(types.IsValidUUID3(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v3"))
+	}
+	return errs
+}
+func ValidGenUuid4StringPointerStructValidate(obj *ValidGenUuid4StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidUUID4(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v4"))
+	}
+	return errs
+}
+func ValidGenUuid4StringStructValidate(obj *ValidGenUuid4StringStruct) []error {
+	var errs []error
+	if !(types.IsValidUUID4(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v4"))
+	}
+	return errs
+}
+func ValidGenUuid5StringPointerStructValidate(obj *ValidGenUuid5StringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidUUID5(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v5"))
+	}
+	return errs
+}
+func ValidGenUuid5StringStructValidate(obj *ValidGenUuid5StringStruct) []error {
+	var errs []error
+	if !(types.IsValidUUID5(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID v5"))
+	}
+	return errs
+}
+func ValidGenUuidStringPointerStructValidate(obj *ValidGenUuidStringPointerStruct) []error {
+	var errs []error
+	if !(obj.Field != nil && types.IsValidUUID(*obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID"))
+	}
+	return errs
+}
+func ValidGenUuidStringStructValidate(obj *ValidGenUuidStringStruct) []error {
+	var errs []error
+	if !(types.IsValidUUID(obj.Field)) {
+		errs = append(errs, types.NewValidationError("Field must be a valid UUID"))
+	}
+	return errs
+}
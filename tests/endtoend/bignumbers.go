@@ -0,0 +1,82 @@
+package main
+
+import (
+	"log"
+	"math/big"
+)
+
+type LedgerType struct {
+	Balance  *big.Int   `valid:"required,gte=0"`
+	Limit    *big.Int   `valid:"omitempty,gtefield=Balance"`
+	Total    *big.Int   `valid:"lte=1000000000000000000000"`
+	Fee      *big.Float `valid:"gt=0,lt=0.01"`
+	Rate     *big.Rat   `valid:"neq=0,lte=1/3"`
+	Discount big.Rat    `valid:"gte=0"`
+}
+
+func bigNumbersTests() {
+	log.Println("starting math/big tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	v := &LedgerType{
+		Balance:  big.NewInt(10),
+		Limit:    big.NewInt(5),
+		Total:    new(big.Int).Add(bigTen21(), big.NewInt(1)),
+		Fee:      big.NewFloat(0.01),
+		Rate:     big.NewRat(1, 2),
+		Discount: *big.NewRat(-1, 10),
+	}
+	expectedMsgErrors = []string{
+		"Limit must be >= Balance",
+		"Total must be <= 1000000000000000000000",
+		"Fee must be < 0.01",
+		"Rate must be <= 1/3",
+		"Discount must be >= 0",
+	}
+	errs = LedgerTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Nil and zero values
+	v = &LedgerType{
+		Balance: big.NewInt(0),
+		Rate:    new(big.Rat),
+	}
+	expectedMsgErrors = []string{
+		"Balance is required",
+		"Total must be <= 1000000000000000000000",
+		"Fee must be > 0",
+		"Fee must be < 0.01",
+		"Rate must not be equal to 0",
+	}
+	errs = LedgerTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	v = &LedgerType{
+		Balance:  big.NewInt(10),
+		Limit:    big.NewInt(10),
+		Total:    bigTen21(),
+		Fee:      big.NewFloat(0.005),
+		Rate:     big.NewRat(1, 3),
+		Discount: *big.NewRat(1, 10),
+	}
+	expectedMsgErrors = nil
+	errs = LedgerTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("math/big tests ok")
+}
+
+// bigTen21 returns 10^21, greater than the int64 limit.
+func bigTen21() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(21), nil)
+}
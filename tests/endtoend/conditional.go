@@ -0,0 +1,137 @@
+package main
+
+import "log"
+
+type ConditionalUser struct {
+	Country       string
+	Age           uint8
+	Email         *string
+	Phone         string   `valid:"required_unless=Country US"`
+	TaxID         string   `valid:"required_if=Country BR Age 18"`
+	Mobile        string   `valid:"required_with=Email"`
+	Document      []string `valid:"required_with_all=Email TaxID"`
+	Fax           *string  `valid:"required_without=Email"`
+	Address       string   `valid:"required_without_all=Email Phone"`
+	Nickname      string   `valid:"excluded_if=Country BR"`
+	Promo         int      `valid:"excluded_unless=Age 18"`
+	Coupon        string   `valid:"excluded_with=Email"`
+	Voucher       string   `valid:"excluded_with_all=Email Phone"`
+	GuestPassword string   `valid:"excluded_without=Email"`
+	Token         *int     `valid:"excluded_without_all=Email Phone"`
+}
+
+type ConditionalPayment struct {
+	Method string
+	Card   *PaymentCard `valid:"required_if=Method card"`
+	Pix    *PaymentPix  `valid:"excluded_unless=Method pix"`
+}
+
+func conditionalTests() {
+	log.Println("starting conditional tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	email := "user@example.com"
+	token := 1
+	v := &ConditionalUser{
+		Country:  "BR",
+		Age:      18,
+		Email:    &email,
+		Phone:    "",
+		Nickname: "nick",
+		Coupon:   "coupon",
+		Token:    &token,
+	}
+	expectedMsgErrors = []string{
+		"Phone is required unless Country is 'US'",
+		"TaxID is required when Country is 'BR' and Age is '18'",
+		"Mobile is required when any of 'Email' is present",
+		"Nickname must be empty when Country is 'BR'",
+		"Coupon must be empty when any of 'Email' is present",
+	}
+	errs = ConditionalUserValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: All failure scenarios without email and phone
+	v = &ConditionalUser{
+		Country:       "US",
+		Age:           20,
+		Promo:         10,
+		GuestPassword: "",
+		Voucher:       "voucher",
+		Token:         &token,
+	}
+	expectedMsgErrors = []string{
+		"Fax is required when any of 'Email' is missing",
+		"Address is required when all of 'Email' 'Phone' are missing",
+		"Promo must be empty unless Age is '18'",
+		"Token must be empty when all of 'Email' 'Phone' are missing",
+	}
+	errs = ConditionalUserValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	fax := "5555-5555"
+	v = &ConditionalUser{
+		Country:  "BR",
+		Age:      18,
+		Email:    &email,
+		Phone:    "5555-5555",
+		TaxID:    "123",
+		Mobile:   "5555-5555",
+		Document: []string{"doc"},
+		Fax:      &fax,
+		Promo:    10,
+	}
+	expectedMsgErrors = nil
+	errs = ConditionalUserValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 4: Pointers to nested structs
+	payment := &ConditionalPayment{
+		Method: "card",
+		Pix:    &PaymentPix{Key: "key"},
+	}
+	expectedMsgErrors = []string{
+		"Card is required when Method is 'card'",
+		"Pix must be empty unless Method is 'pix'",
+	}
+	errs = ConditionalPaymentValidate(payment)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 5: Nested struct validated after the conditional operation
+	payment = &ConditionalPayment{
+		Method: "card",
+		Card:   &PaymentCard{},
+	}
+	expectedMsgErrors = []string{
+		"Number is required",
+	}
+	errs = ConditionalPaymentValidate(payment)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 6: All valid input with pointers to nested structs
+	payment = &ConditionalPayment{
+		Method: "pix",
+		Pix:    &PaymentPix{Key: "key"},
+	}
+	expectedMsgErrors = nil
+	errs = ConditionalPaymentValidate(payment)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("conditional tests ok")
+}
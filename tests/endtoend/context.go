@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/opencodeco/validgen/tests/endtoend/structsinpkg"
+)
+
+type ContextUser struct {
+	FirstName string                 `valid:"required"`
+	Address   structsinpkg.Address   `valid:"required"`
+	Previous  []structsinpkg.Address `valid:"dive"`
+}
+
+// canceledAfterFirstCheck is canceled after its first check, so only the validators of the nested
+// structs see it canceled.
+type canceledAfterFirstCheck struct {
+	context.Context
+	checks int
+}
+
+func (c *canceledAfterFirstCheck) Err() error {
+	c.checks++
+	if c.checks > 1 {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+func contextTests() {
+	log.Println("starting context tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios with a live context
+	v := &ContextUser{
+		Previous: []structsinpkg.Address{{Street: "av 1"}},
+	}
+	expectedMsgErrors = []string{
+		"FirstName is required",
+		"Street is required",
+		"City is required",
+		"City is required",
+	}
+	errs = ContextUserValidateContext(context.Background(), v)
+	assertExpectedErrorMsgs("testcase 1", errs, expectedMsgErrors)
+
+	// Test case 2: All valid input with a live context
+	v = &ContextUser{
+		FirstName: "Myname",
+		Address: structsinpkg.Address{
+			Street: "av 123",
+			City:   "city 123",
+		},
+		Previous: []structsinpkg.Address{{Street: "av 1", City: "city 1"}},
+	}
+	expectedMsgErrors = nil
+	errs = ContextUserValidateContext(context.Background(), v)
+	assertExpectedErrorMsgs("testcase 2", errs, expectedMsgErrors)
+
+	// Test case 3: Canceled context stops the validation
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = ContextUserValidateContext(ctx, &ContextUser{})
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		log.Fatalf("testcase 3 error = %v, wantErr %v", errs, context.Canceled)
+	}
+
+	// Test case 4: The context is passed to the nested structs and to the elements of the slices
+	errs = ContextUserValidateContext(&canceledAfterFirstCheck{Context: context.Background()}, v)
+	if len(errs) != 2 || !errors.Is(errs[0], context.Canceled) || !errors.Is(errs[1], context.Canceled) {
+		log.Fatalf("testcase 4 error = %v, wantErr %v", errs, context.Canceled)
+	}
+
+	log.Println("context tests ok")
+}
@@ -0,0 +1,83 @@
+package main
+
+import "log"
+
+type PaymentCard struct {
+	Number string `valid:"required"`
+}
+
+type PaymentPix struct {
+	Key string
+}
+
+type Payment struct {
+	Card      *PaymentCard `valid:"exactly_one_of=payment"`
+	Pix       *PaymentPix  `valid:"exactly_one_of=payment"`
+	Boleto    *string      `valid:"exactly_one_of=payment"`
+	Email     string       `valid:"at_least_one_of=contact"`
+	Phone     string       `valid:"at_least_one_of=contact"`
+	Coupon    string       `valid:"at_most_one_of=discount"`
+	Voucher   *int         `valid:"at_most_one_of=discount"`
+	Latitude  *float64     `valid:"all_or_none_of=location"`
+	Longitude *float64     `valid:"all_or_none_of=location"`
+}
+
+func fieldGroupsTests() {
+	log.Println("starting field groups tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	boleto := "123"
+	voucher := 10
+	latitude := -23.5
+	v := &Payment{
+		Card:     &PaymentCard{},
+		Boleto:   &boleto,
+		Coupon:   "PROMO",
+		Voucher:  &voucher,
+		Latitude: &latitude,
+	}
+	expectedMsgErrors = []string{
+		"payment must have exactly one of 'Card' 'Pix' 'Boleto'",
+		"Number is required",
+		"contact must have at least one of 'Email' 'Phone'",
+		"discount must have at most one of 'Coupon' 'Voucher'",
+		"location must have all or none of 'Latitude' 'Longitude'",
+	}
+	errs = PaymentValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: None of the payment fields
+	v = &Payment{
+		Email: "user@example.com",
+	}
+	expectedMsgErrors = []string{
+		"payment must have exactly one of 'Card' 'Pix' 'Boleto'",
+	}
+	errs = PaymentValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	longitude := -46.6
+	v = &Payment{
+		Pix:       &PaymentPix{Key: "key"},
+		Email:     "user@example.com",
+		Phone:     "5555-5555",
+		Voucher:   &voucher,
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}
+	expectedMsgErrors = nil
+	errs = PaymentValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("field groups tests ok")
+}
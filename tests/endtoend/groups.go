@@ -0,0 +1,79 @@
+package main
+
+import (
+	"log"
+)
+
+type GroupsUser struct {
+	ID       uint32        `valid:"required@update@patch"`
+	UserName string        `valid:"required@create,min=5"`
+	Email    string        `valid:"required@create,email@create@update"`
+	Address  GroupsAddress `valid:"required"`
+}
+
+type GroupsAddress struct {
+	Street string `valid:"required@create"`
+	City   string `valid:"required"`
+}
+
+func groupsTests() {
+	log.Println("starting groups tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: Without groups, only the rules without groups are checked
+	v := &GroupsUser{
+		UserName: "myname",
+		Address: GroupsAddress{
+			City: "city 123",
+		},
+	}
+	expectedMsgErrors = nil
+	errs = GroupsUserValidate(v)
+	assertExpectedErrorMsgs("testcase 1", errs, expectedMsgErrors)
+
+	// Test case 2: Create group
+	v = &GroupsUser{}
+	expectedMsgErrors = []string{
+		"UserName is required",
+		"UserName length must be >= 5",
+		"Email is required",
+		"Email must be a valid email",
+		"Street is required",
+		"City is required",
+	}
+	errs = GroupsUserValidateGroups(v, "create")
+	assertExpectedErrorMsgs("testcase 2", errs, expectedMsgErrors)
+
+	// Test case 3: Update group
+	v = &GroupsUser{
+		UserName: "myname",
+		Email:    "invalid",
+		Address: GroupsAddress{
+			City: "city 123",
+		},
+	}
+	expectedMsgErrors = []string{
+		"ID is required",
+		"Email must be a valid email",
+	}
+	errs = GroupsUserValidateGroups(v, "update")
+	assertExpectedErrorMsgs("testcase 3", errs, expectedMsgErrors)
+
+	// Test case 4: Many active groups
+	v = &GroupsUser{
+		ID:       1,
+		UserName: "myname",
+		Email:    "abcde@example.com",
+		Address: GroupsAddress{
+			Street: "av 123",
+			City:   "city 123",
+		},
+	}
+	expectedMsgErrors = nil
+	errs = GroupsUserValidateGroups(v, "create", "update")
+	assertExpectedErrorMsgs("testcase 4", errs, expectedMsgErrors)
+
+	log.Println("groups tests ok")
+}
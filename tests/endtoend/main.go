@@ -38,6 +38,17 @@ func main() {
 	cmpBetweenInnerFieldsTests()
 	cmpBetweenNestedFieldsTests()
 	boolTests()
+	contextTests()
+	groupsTests()
+	partialTests()
+	omitEmptyTests()
+	conditionalTests()
+	fieldGroupsTests()
+	regexTests()
+	postcodeTests()
+	bigNumbersTests()
+	stdTypesTests()
+	optionalTests()
 	pointerTests()
 	noPointerTests()
 
@@ -23,11 +23,17 @@ type UserWithStructInPkg struct {
 	Address   structsinpkg.Address `valid:"required"`
 }
 
+type UserWithAddressPointer struct {
+	FirstName string   `valid:"required"`
+	Address   *Address `valid:"required"`
+}
+
 func nestedStructTests() {
 	log.Println("starting nested struct tests")
 
 	nestedStructTests1()
 	nestedStructTests2()
+	nestedStructTests3()
 
 	log.Println("nested struct tests ok")
 }
@@ -113,3 +119,52 @@ func nestedStructTests2() {
 
 	log.Println("nested struct tests 2 ok")
 }
+
+func nestedStructTests3() {
+	log.Println("starting nested struct tests 3")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: Nil pointer
+	v := &UserWithAddressPointer{
+		FirstName: "Myname",
+	}
+	expectedMsgErrors = []string{
+		"Address is required",
+	}
+	errs = UserWithAddressPointerValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Invalid nested struct
+	v = &UserWithAddressPointer{
+		FirstName: "Myname",
+		Address:   &Address{},
+	}
+	expectedMsgErrors = []string{
+		"Street is required",
+		"City is required",
+	}
+	errs = UserWithAddressPointerValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	v = &UserWithAddressPointer{
+		FirstName: "Myname",
+		Address: &Address{
+			Street: "av 123",
+			City:   "city 123",
+		},
+	}
+	expectedMsgErrors = nil
+	errs = UserWithAddressPointerValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("nested struct tests 3 ok")
+}
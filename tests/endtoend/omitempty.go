@@ -0,0 +1,84 @@
+package main
+
+import "log"
+
+type OmitEmptyType struct {
+	FieldString    string            `valid:"omitempty,min=3"`
+	FieldInt       int               `valid:"omitempty,gte=10"`
+	FieldFloat     float64           `valid:"omitempty,lte=1.5"`
+	FieldBool      bool              `valid:"omitempty,eq=true"`
+	FieldSlice     []string          `valid:"omitempty,min=2"`
+	FieldMap       map[string]string `valid:"omitnil,len=1"`
+	FieldStringPtr *string           `valid:"omitempty,in=a b"`
+	FieldIntPtr    *int              `valid:"omitnil,gt=0"`
+	FieldBefore    string            `valid:"max=5,omitempty,min=3"`
+	FieldArray     [3]string         `valid:"omitempty,in=a b"`
+}
+
+func omitEmptyTests() {
+	log.Println("starting omitempty tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	invalidString := "c"
+	invalidInt := 0
+	v := &OmitEmptyType{
+		FieldString:    "ab",
+		FieldInt:       5,
+		FieldFloat:     2.5,
+		FieldSlice:     []string{"a"},
+		FieldMap:       map[string]string{},
+		FieldStringPtr: &invalidString,
+		FieldIntPtr:    &invalidInt,
+		FieldBefore:    "abcdefgh",
+		FieldArray:     [3]string{"a", "c"},
+	}
+	expectedMsgErrors = []string{
+		"FieldString length must be >= 3",
+		"FieldInt must be >= 10",
+		"FieldFloat must be <= 1.5",
+		"FieldSlice must have at least 2 elements",
+		"FieldMap must have exactly 1 elements",
+		"FieldStringPtr must be one of 'a' 'b'",
+		"FieldIntPtr must be > 0",
+		"FieldBefore length must be <= 5",
+		"FieldArray elements must be one of 'a' 'b'",
+	}
+	errs = OmitEmptyTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Empty values skip the rules after omitempty/omitnil
+	v = &OmitEmptyType{}
+	expectedMsgErrors = nil
+	errs = OmitEmptyTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	validString := "a"
+	validInt := 1
+	v = &OmitEmptyType{
+		FieldString:    "abc",
+		FieldInt:       10,
+		FieldFloat:     1.5,
+		FieldBool:      true,
+		FieldSlice:     []string{"a", "b"},
+		FieldMap:       map[string]string{"a": "b"},
+		FieldStringPtr: &validString,
+		FieldIntPtr:    &validInt,
+		FieldBefore:    "abcd",
+		FieldArray:     [3]string{"a", "b", "a"},
+	}
+	expectedMsgErrors = nil
+	errs = OmitEmptyTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("omitempty tests ok")
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+	"time"
+)
+
+// Optional is a user-defined optional value with the shape of sql.Null[T] (validgen -optional Optional).
+type Optional[T any] struct {
+	V     T
+	Valid bool
+}
+
+type CustomerRecordType struct {
+	Name      sql.NullString   `valid:"required,min=3"`
+	Email     sql.NullString   `valid:"email"`
+	Age       sql.NullInt64    `valid:"gte=18,lte=130"`
+	Score     sql.NullFloat64  `valid:"omitempty,gt=0"`
+	Deleted   *sql.NullBool    `valid:"required"`
+	CreatedAt sql.NullTime     `valid:"required"`
+	Level     sql.Null[int32]  `valid:"in=1 2 3"`
+	Nickname  Optional[string] `valid:"omitempty,alpha"`
+	Country   Optional[string] `valid:"required,len=2"`
+}
+
+func optionalTests() {
+	log.Println("starting optional types tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios with valid values
+	v := &CustomerRecordType{
+		Name:      sql.NullString{String: "Jo", Valid: true},
+		Email:     sql.NullString{String: "john", Valid: true},
+		Age:       sql.NullInt64{Int64: 15, Valid: true},
+		Score:     sql.NullFloat64{Float64: -1, Valid: true},
+		Deleted:   &sql.NullBool{Bool: false, Valid: true},
+		CreatedAt: sql.NullTime{Valid: true},
+		Level:     sql.Null[int32]{V: 4, Valid: true},
+		Nickname:  Optional[string]{V: "john1", Valid: true},
+		Country:   Optional[string]{V: "BRA", Valid: true},
+	}
+	expectedMsgErrors = []string{
+		"Name length must be >= 3",
+		"Email must be a valid email",
+		"Age must be >= 18",
+		"Score must be > 0",
+		"Deleted is required",
+		"CreatedAt is required",
+		"Level must be one of '1' '2' '3'",
+		"Nickname must contain only ASCII letters",
+		"Country length must be 2",
+	}
+	errs = CustomerRecordTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Invalid values (only required is checked)
+	v = &CustomerRecordType{
+		Name:     sql.NullString{String: "John"},
+		Email:    sql.NullString{String: "john"},
+		Age:      sql.NullInt64{Int64: 15},
+		Level:    sql.Null[int32]{V: 4},
+		Nickname: Optional[string]{V: "john1"},
+	}
+	expectedMsgErrors = []string{
+		"Name is required",
+		"Deleted is required",
+		"CreatedAt is required",
+		"Country is required",
+	}
+	errs = CustomerRecordTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	v = &CustomerRecordType{
+		Name:      sql.NullString{String: "John", Valid: true},
+		Email:     sql.NullString{String: "john@example.com", Valid: true},
+		Age:       sql.NullInt64{Int64: 18, Valid: true},
+		Score:     sql.NullFloat64{Valid: true},
+		Deleted:   &sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		Level:     sql.Null[int32]{V: 2, Valid: true},
+		Nickname:  Optional[string]{V: "", Valid: true},
+		Country:   Optional[string]{V: "BR", Valid: true},
+	}
+	expectedMsgErrors = nil
+	errs = CustomerRecordTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("optional types tests ok")
+}
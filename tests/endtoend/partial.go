@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/opencodeco/validgen/types"
+)
+
+type PartialUser struct {
+	UserName        string         `valid:"required,min=5"`
+	Password        string         `valid:"required"`
+	ConfirmPassword string         `valid:"eqfield=Password"`
+	Address         PartialAddress `valid:"required"`
+}
+
+type PartialAddress struct {
+	Street string `valid:"required"`
+	City   string `valid:"required"`
+}
+
+func partialTests() {
+	log.Println("starting partial tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	v := &PartialUser{
+		UserName:        "abc",
+		ConfirmPassword: "secret",
+	}
+
+	// Test case 1: Only the selected fields
+	expectedMsgErrors = []string{
+		"UserName length must be >= 5",
+		"City is required",
+	}
+	errs = PartialUserValidateFields(v, "UserName", "Address.City")
+	assertExpectedErrorMsgs("testcase 1", errs, expectedMsgErrors)
+
+	// Test case 2: Operations between fields run only if both fields are selected
+	expectedMsgErrors = nil
+	errs = PartialUserValidateFields(v, "ConfirmPassword")
+	assertExpectedErrorMsgs("testcase 2", errs, expectedMsgErrors)
+
+	expectedMsgErrors = []string{
+		"Password is required",
+		"ConfirmPassword must be equal to Password",
+	}
+	errs = PartialUserValidateFields(v, "Password", "ConfirmPassword")
+	assertExpectedErrorMsgs("testcase 2", errs, expectedMsgErrors)
+
+	// Test case 3: All fields except the excluded ones
+	expectedMsgErrors = []string{
+		"UserName length must be >= 5",
+		"Street is required",
+	}
+	errs = PartialUserValidateExcept(v, "Password", "Address.City")
+	assertExpectedErrorMsgs("testcase 3", errs, expectedMsgErrors)
+
+	// Test case 4: Whole nested struct selected
+	expectedMsgErrors = []string{
+		"Street is required",
+		"City is required",
+	}
+	errs = PartialUserValidateFields(v, "Address")
+	assertExpectedErrorMsgs("testcase 4", errs, expectedMsgErrors)
+
+	// Test case 5: Context-aware partial validator
+	expectedMsgErrors = []string{
+		"UserName length must be >= 5",
+	}
+	errs = PartialUserValidatePartialContext(context.Background(), v, types.SelectFields("UserName"))
+	assertExpectedErrorMsgs("testcase 5", errs, expectedMsgErrors)
+
+	log.Println("partial tests ok")
+}
@@ -0,0 +1,64 @@
+package main
+
+import "log"
+
+type AddressType struct {
+	Country  string `valid:"iso3166_1_alpha2"`
+	Postcode string `valid:"postcode_iso3166_alpha2_field=Country"`
+	Phone    string `valid:"e164"`
+	Zipcode  string `valid:"postcode_iso3166_alpha2=US"`
+}
+
+func postcodeTests() {
+	log.Println("starting postcode tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	v := &AddressType{
+		Country:  "BR",
+		Postcode: "94105",
+		Phone:    "11987654321",
+		Zipcode:  "01310-100",
+	}
+	expectedMsgErrors = []string{
+		"Postcode must be a valid postal code for the country in Country",
+		"Phone must be a valid E.164 phone number",
+		"Zipcode must be a valid postal code for 'US'",
+	}
+	errs = AddressTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Unsupported country
+	v = &AddressType{
+		Country:  "AQ",
+		Postcode: "01310-100",
+		Phone:    "+5511987654321",
+		Zipcode:  "94105",
+	}
+	expectedMsgErrors = []string{
+		"Postcode must be a valid postal code for the country in Country",
+	}
+	errs = AddressTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	v = &AddressType{
+		Country:  "BR",
+		Postcode: "01310-100",
+		Phone:    "+5511987654321",
+		Zipcode:  "94105-1234",
+	}
+	expectedMsgErrors = nil
+	errs = AddressTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("postcode tests ok")
+}
@@ -0,0 +1,61 @@
+package main
+
+import "log"
+
+type RegexType struct {
+	SKU   string   `valid:"regex='^[A-Z]{3}-\\d{4,6}$'"`
+	Slug  *string  `valid:"regex=^[a-z0-9]+(-[a-z0-9]+)*$"`
+	Tags  []string `valid:"min=1,dive,regex='^[a-z]{2,10}$'"`
+	Codes [2]int   `valid:"dive,gte=10"`
+	Alias string   `valid:"regex='^[a-z]{2,10}$'"`
+	Quote string   `valid:"regex='^[a-z]+\\',[a-z]+$',required"`
+}
+
+func regexTests() {
+	log.Println("starting regex tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	slug := "Invalid Slug"
+	v := &RegexType{
+		SKU:   "ABC-12",
+		Slug:  &slug,
+		Tags:  []string{"go", "X", "validgen", "1"},
+		Codes: [2]int{10, 9},
+		Alias: "a",
+		Quote: "rock,roll",
+	}
+	expectedMsgErrors = []string{
+		"SKU must match the pattern '^[A-Z]{3}-\\d{4,6}$'",
+		"Slug must match the pattern '^[a-z0-9]+(-[a-z0-9]+)*$'",
+		"Tags[1] must match the pattern '^[a-z]{2,10}$'",
+		"Tags[3] must match the pattern '^[a-z]{2,10}$'",
+		"Codes[1] must be >= 10",
+		"Alias must match the pattern '^[a-z]{2,10}$'",
+		"Quote must match the pattern '^[a-z]+',[a-z]+$'",
+	}
+	errs = RegexTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: All valid input
+	slug = "valid-slug-2"
+	v = &RegexType{
+		SKU:   "ABC-1234",
+		Slug:  &slug,
+		Tags:  []string{"go", "validgen"},
+		Codes: [2]int{10, 20},
+		Alias: "alias",
+		Quote: "rock',roll",
+	}
+	expectedMsgErrors = nil
+	errs = RegexTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("regex tests ok")
+}
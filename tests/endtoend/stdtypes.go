@@ -0,0 +1,99 @@
+package main
+
+import (
+	"log"
+	"net/mail"
+	"net/netip"
+	"net/url"
+)
+
+type EndpointType struct {
+	Addr      netip.Addr    `valid:"required,ipv4,private_ip"`
+	Peer      *netip.Addr   `valid:"omitnil,public_ip"`
+	Network   netip.Prefix  `valid:"required,cidrv6"`
+	Callback  *url.URL      `valid:"required,url_scheme=https"`
+	Homepage  url.URL       `valid:"omitempty,http_url"`
+	Contact   mail.Address  `valid:"required,email"`
+	RequestID [16]byte      `valid:"required,uuid4"`
+	TraceID   *[16]byte     `valid:"omitnil,uuid7"`
+	Fallback  *netip.Prefix `valid:"omitempty,cidr"`
+}
+
+func stdTypesTests() {
+	log.Println("starting standard library types tests")
+
+	var expectedMsgErrors []string
+	var errs []error
+
+	// Test case 1: All failure scenarios
+	peer := netip.MustParseAddr("10.0.0.1")
+	traceID := [16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+	v := &EndpointType{
+		Addr:      netip.MustParseAddr("2001:4860::8888"),
+		Peer:      &peer,
+		Network:   netip.MustParsePrefix("10.0.0.0/8"),
+		Callback:  &url.URL{Scheme: "http", Host: "example.com"},
+		Homepage:  url.URL{Scheme: "ftp", Host: "example.com"},
+		Contact:   mail.Address{Name: "John", Address: "john"},
+		RequestID: [16]byte{1},
+		TraceID:   &traceID,
+	}
+	expectedMsgErrors = []string{
+		"Addr must be a valid IPv4 address",
+		"Addr must be a private IP address",
+		"Peer must be a public IP address",
+		"Network must be a valid IPv6 CIDR",
+		"Callback must be a valid URL with scheme 'https'",
+		"Homepage must be a valid HTTP URL",
+		"Contact must be a valid email",
+		"RequestID must be a valid UUID v4",
+		"TraceID must be a valid UUID v7",
+	}
+	errs = EndpointTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 2: Zero values
+	v = &EndpointType{}
+	expectedMsgErrors = []string{
+		"Addr is required",
+		"Addr must be a valid IPv4 address",
+		"Addr must be a private IP address",
+		"Network is required",
+		"Network must be a valid IPv6 CIDR",
+		"Callback is required",
+		"Callback must be a valid URL with scheme 'https'",
+		"Contact is required",
+		"Contact must be a valid email",
+		"RequestID is required",
+		"RequestID must be a valid UUID v4",
+	}
+	errs = EndpointTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	// Test case 3: All valid input
+	peer = netip.MustParseAddr("8.8.8.8")
+	traceID = [16]byte{0x01, 0x7f, 0x22, 0xe2, 0x79, 0xb0, 0x7c, 0xc3, 0x98, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f}
+	fallback := netip.MustParsePrefix("10.0.0.0/8")
+	v = &EndpointType{
+		Addr:      netip.MustParseAddr("::ffff:192.168.0.1"),
+		Peer:      &peer,
+		Network:   netip.MustParsePrefix("fd00::/8"),
+		Callback:  &url.URL{Scheme: "HTTPS", Host: "example.com", Path: "/hook"},
+		Homepage:  url.URL{Scheme: "http", Host: "example.com"},
+		Contact:   mail.Address{Name: "John", Address: "john@example.com"},
+		RequestID: [16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79},
+		TraceID:   &traceID,
+		Fallback:  &fallback,
+	}
+	expectedMsgErrors = nil
+	errs = EndpointTypeValidate(v)
+	if !expectedMsgErrorsOk(errs, expectedMsgErrors) {
+		log.Fatalf("error = %v, wantErr %v", errs, expectedMsgErrors)
+	}
+
+	log.Println("standard library types tests ok")
+}
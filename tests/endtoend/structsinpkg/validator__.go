@@ -3,10 +3,18 @@
 package structsinpkg
 
 import (
+	"context"
 	"github.com/opencodeco/validgen/types"
 )
 
 func AddressValidate(obj *Address) []error {
+	return AddressValidateContext(context.Background(), obj)
+}
+
+func AddressValidateContext(ctx context.Context, obj *Address) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Street != "") {
 		errs = append(errs, types.NewValidationError("Street is required"))
@@ -16,7 +24,40 @@ func AddressValidate(obj *Address) []error {
 	}
 	return errs
 }
+
+func AddressValidateFields(obj *Address, fields ...string) []error {
+	return AddressValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func AddressValidateExcept(obj *Address, fields ...string) []error {
+	return AddressValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func AddressValidatePartialContext(ctx context.Context, obj *Address, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Street") {
+		if !(obj.Street != "") {
+			errs = append(errs, types.NewValidationError("Street is required"))
+		}
+	}
+	if selection.Has("City") {
+		if !(obj.City != "") {
+			errs = append(errs, types.NewValidationError("City is required"))
+		}
+	}
+	return errs
+}
 func Type1Validate(obj *Type1) []error {
+	return Type1ValidateContext(context.Background(), obj)
+}
+
+func Type1ValidateContext(ctx context.Context, obj *Type1) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.FirstName != "") {
 		errs = append(errs, types.NewValidationError("FirstName is required"))
@@ -29,3 +70,34 @@ func Type1Validate(obj *Type1) []error {
 	}
 	return errs
 }
+
+func Type1ValidateFields(obj *Type1, fields ...string) []error {
+	return Type1ValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func Type1ValidateExcept(obj *Type1, fields ...string) []error {
+	return Type1ValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func Type1ValidatePartialContext(ctx context.Context, obj *Type1, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("LastName") {
+		if !(obj.LastName != "") {
+			errs = append(errs, types.NewValidationError("LastName is required"))
+		}
+	}
+	if selection.Has("Age") {
+		if !(obj.Age != 0) {
+			errs = append(errs, types.NewValidationError("Age is required"))
+		}
+	}
+	return errs
+}
@@ -3,11 +3,43 @@
 package main
 
 import (
+	"context"
 	"github.com/opencodeco/validgen/tests/endtoend/structsinpkg"
 	"github.com/opencodeco/validgen/types"
+	"regexp"
+	_ "time/tzdata"
 )
 
+var validgenRegex36f71598 = regexp.MustCompile(`^[a-z]{2,10}$`)
+
+var validgenRegex5b8f7731 = regexp.MustCompile(`^[A-Z]{3}-\d{4,6}$`)
+
+var validgenRegex691022c6 = regexp.MustCompile(`^[a-z]{2,5}$`)
+
+var validgenRegex9a5da842 = regexp.MustCompile(`^[a-z]+',[a-z]+$`)
+
+var validgenRegexdea5200b = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var validgenBigFloat350ca8af = types.MustParseBigFloat("0")
+
+var validgenBigFloat75954ef8 = types.MustParseBigFloat("0.01")
+
+var validgenBigInt350ca8af = types.MustParseBigInt("0")
+
+var validgenBigIntce399344 = types.MustParseBigInt("1000000000000000000000")
+
+var validgenBigRat350ca8af = types.MustParseBigRat("0")
+
+var validgenBigRat72452f0e = types.MustParseBigRat("1/3")
+
 func AddressValidate(obj *Address) []error {
+	return AddressValidateContext(context.Background(), obj)
+}
+
+func AddressValidateContext(ctx context.Context, obj *Address) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Street != "") {
 		errs = append(errs, types.NewValidationError("Street is required"))
@@ -17,7 +49,99 @@ func AddressValidate(obj *Address) []error {
 	}
 	return errs
 }
+
+func AddressValidateFields(obj *Address, fields ...string) []error {
+	return AddressValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func AddressValidateExcept(obj *Address, fields ...string) []error {
+	return AddressValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func AddressValidatePartialContext(ctx context.Context, obj *Address, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Street") {
+		if !(obj.Street != "") {
+			errs = append(errs, types.NewValidationError("Street is required"))
+		}
+	}
+	if selection.Has("City") {
+		if !(obj.City != "") {
+			errs = append(errs, types.NewValidationError("City is required"))
+		}
+	}
+	return errs
+}
+func AddressTypeValidate(obj *AddressType) []error {
+	return AddressTypeValidateContext(context.Background(), obj)
+}
+
+func AddressTypeValidateContext(ctx context.Context, obj *AddressType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO3166Alpha2(obj.Country)) {
+		errs = append(errs, types.NewValidationError("Country must be a valid ISO 3166-1 alpha-2 country code"))
+	}
+	if !(types.IsValidPostcode(obj.Postcode, obj.Country)) {
+		errs = append(errs, types.NewValidationError("Postcode must be a valid postal code for the country in Country"))
+	}
+	if !(types.IsValidE164(obj.Phone)) {
+		errs = append(errs, types.NewValidationError("Phone must be a valid E.164 phone number"))
+	}
+	if !(types.IsValidPostcode(obj.Zipcode, "US")) {
+		errs = append(errs, types.NewValidationError("Zipcode must be a valid postal code for 'US'"))
+	}
+	return errs
+}
+
+func AddressTypeValidateFields(obj *AddressType, fields ...string) []error {
+	return AddressTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func AddressTypeValidateExcept(obj *AddressType, fields ...string) []error {
+	return AddressTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func AddressTypeValidatePartialContext(ctx context.Context, obj *AddressType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Country") {
+		if !(types.IsValidISO3166Alpha2(obj.Country)) {
+			errs = append(errs, types.NewValidationError("Country must be a valid ISO 3166-1 alpha-2 country code"))
+		}
+	}
+	if selection.Has("Postcode") {
+		if selection.Has("Country") && !(types.IsValidPostcode(obj.Postcode, obj.Country)) {
+			errs = append(errs, types.NewValidationError("Postcode must be a valid postal code for the country in Country"))
+		}
+	}
+	if selection.Has("Phone") {
+		if !(types.IsValidE164(obj.Phone)) {
+			errs = append(errs, types.NewValidationError("Phone must be a valid E.164 phone number"))
+		}
+	}
+	if selection.Has("Zipcode") {
+		if !(types.IsValidPostcode(obj.Zipcode, "US")) {
+			errs = append(errs, types.NewValidationError("Zipcode must be a valid postal code for 'US'"))
+		}
+	}
+	return errs
+}
 func AllTypes1Validate(obj *AllTypes1) []error {
+	return AllTypes1ValidateContext(context.Background(), obj)
+}
+
+func AllTypes1ValidateContext(ctx context.Context, obj *AllTypes1) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.FirstName != "") {
 		errs = append(errs, types.NewValidationError("FirstName is required"))
@@ -30,7 +154,45 @@ func AllTypes1Validate(obj *AllTypes1) []error {
 	}
 	return errs
 }
+
+func AllTypes1ValidateFields(obj *AllTypes1, fields ...string) []error {
+	return AllTypes1ValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func AllTypes1ValidateExcept(obj *AllTypes1, fields ...string) []error {
+	return AllTypes1ValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func AllTypes1ValidatePartialContext(ctx context.Context, obj *AllTypes1, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("LastName") {
+		if !(obj.LastName != "") {
+			errs = append(errs, types.NewValidationError("LastName is required"))
+		}
+	}
+	if selection.Has("Age") {
+		if !(obj.Age != 0) {
+			errs = append(errs, types.NewValidationError("Age is required"))
+		}
+	}
+	return errs
+}
 func AllTypes2Validate(obj *AllTypes2) []error {
+	return AllTypes2ValidateContext(context.Background(), obj)
+}
+
+func AllTypes2ValidateContext(ctx context.Context, obj *AllTypes2) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.FirstName != "") {
 		errs = append(errs, types.NewValidationError("FirstName is required"))
@@ -52,7 +214,56 @@ func AllTypes2Validate(obj *AllTypes2) []error {
 	}
 	return errs
 }
+
+func AllTypes2ValidateFields(obj *AllTypes2, fields ...string) []error {
+	return AllTypes2ValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func AllTypes2ValidateExcept(obj *AllTypes2, fields ...string) []error {
+	return AllTypes2ValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func AllTypes2ValidatePartialContext(ctx context.Context, obj *AllTypes2, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("LastName") {
+		if !(obj.LastName != "") {
+			errs = append(errs, types.NewValidationError("LastName is required"))
+		}
+	}
+	if selection.Has("Age") {
+		if !(obj.Age >= 18) {
+			errs = append(errs, types.NewValidationError("Age must be >= 18"))
+		}
+		if !(obj.Age <= 130) {
+			errs = append(errs, types.NewValidationError("Age must be <= 130"))
+		}
+	}
+	if selection.Has("UserName") {
+		if !(len(obj.UserName) >= 5) {
+			errs = append(errs, types.NewValidationError("UserName length must be >= 5"))
+		}
+		if !(len(obj.UserName) <= 10) {
+			errs = append(errs, types.NewValidationError("UserName length must be <= 10"))
+		}
+	}
+	return errs
+}
 func BoolTypeValidate(obj *BoolType) []error {
+	return BoolTypeValidateContext(context.Background(), obj)
+}
+
+func BoolTypeValidateContext(ctx context.Context, obj *BoolType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.FieldEqTrue == true) {
 		errs = append(errs, types.NewValidationError("FieldEqTrue must be equal to true"))
@@ -68,7 +279,50 @@ func BoolTypeValidate(obj *BoolType) []error {
 	}
 	return errs
 }
+
+func BoolTypeValidateFields(obj *BoolType, fields ...string) []error {
+	return BoolTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func BoolTypeValidateExcept(obj *BoolType, fields ...string) []error {
+	return BoolTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func BoolTypeValidatePartialContext(ctx context.Context, obj *BoolType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEqTrue") {
+		if !(obj.FieldEqTrue == true) {
+			errs = append(errs, types.NewValidationError("FieldEqTrue must be equal to true"))
+		}
+	}
+	if selection.Has("FieldNeqFalse") {
+		if !(obj.FieldNeqFalse != false) {
+			errs = append(errs, types.NewValidationError("FieldNeqFalse must not be equal to false"))
+		}
+	}
+	if selection.Has("FieldEqFieldEqTrue") {
+		if selection.Has("FieldEqTrue") && !(obj.FieldEqFieldEqTrue == obj.FieldEqTrue) {
+			errs = append(errs, types.NewValidationError("FieldEqFieldEqTrue must be equal to FieldEqTrue"))
+		}
+	}
+	if selection.Has("FieldNeqFieldEqTrue") {
+		if selection.Has("FieldEqTrue") && !(obj.FieldNeqFieldEqTrue != obj.FieldEqTrue) {
+			errs = append(errs, types.NewValidationError("FieldNeqFieldEqTrue must not be equal to FieldEqTrue"))
+		}
+	}
+	return errs
+}
 func CmpInnerBoolFieldsValidate(obj *CmpInnerBoolFields) []error {
+	return CmpInnerBoolFieldsValidateContext(context.Background(), obj)
+}
+
+func CmpInnerBoolFieldsValidateContext(ctx context.Context, obj *CmpInnerBoolFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Field2eq1 == obj.Field1) {
 		errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
@@ -78,7 +332,40 @@ func CmpInnerBoolFieldsValidate(obj *CmpInnerBoolFields) []error {
 	}
 	return errs
 }
+
+func CmpInnerBoolFieldsValidateFields(obj *CmpInnerBoolFields, fields ...string) []error {
+	return CmpInnerBoolFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CmpInnerBoolFieldsValidateExcept(obj *CmpInnerBoolFields, fields ...string) []error {
+	return CmpInnerBoolFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CmpInnerBoolFieldsValidatePartialContext(ctx context.Context, obj *CmpInnerBoolFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Field2eq1") {
+		if selection.Has("Field1") && !(obj.Field2eq1 == obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
+		}
+	}
+	if selection.Has("Field3neq1") {
+		if selection.Has("Field1") && !(obj.Field3neq1 != obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field3neq1 must not be equal to Field1"))
+		}
+	}
+	return errs
+}
 func CmpInnerStringFieldsValidate(obj *CmpInnerStringFields) []error {
+	return CmpInnerStringFieldsValidateContext(context.Background(), obj)
+}
+
+func CmpInnerStringFieldsValidateContext(ctx context.Context, obj *CmpInnerStringFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Field2eq1 == obj.Field1) {
 		errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
@@ -88,7 +375,40 @@ func CmpInnerStringFieldsValidate(obj *CmpInnerStringFields) []error {
 	}
 	return errs
 }
+
+func CmpInnerStringFieldsValidateFields(obj *CmpInnerStringFields, fields ...string) []error {
+	return CmpInnerStringFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CmpInnerStringFieldsValidateExcept(obj *CmpInnerStringFields, fields ...string) []error {
+	return CmpInnerStringFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CmpInnerStringFieldsValidatePartialContext(ctx context.Context, obj *CmpInnerStringFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Field2eq1") {
+		if selection.Has("Field1") && !(obj.Field2eq1 == obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
+		}
+	}
+	if selection.Has("Field3neq1") {
+		if selection.Has("Field1") && !(obj.Field3neq1 != obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field3neq1 must not be equal to Field1"))
+		}
+	}
+	return errs
+}
 func CmpInnerUint8FieldsValidate(obj *CmpInnerUint8Fields) []error {
+	return CmpInnerUint8FieldsValidateContext(context.Background(), obj)
+}
+
+func CmpInnerUint8FieldsValidateContext(ctx context.Context, obj *CmpInnerUint8Fields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Field2eq1 == obj.Field1) {
 		errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
@@ -110,7 +430,60 @@ func CmpInnerUint8FieldsValidate(obj *CmpInnerUint8Fields) []error {
 	}
 	return errs
 }
+
+func CmpInnerUint8FieldsValidateFields(obj *CmpInnerUint8Fields, fields ...string) []error {
+	return CmpInnerUint8FieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CmpInnerUint8FieldsValidateExcept(obj *CmpInnerUint8Fields, fields ...string) []error {
+	return CmpInnerUint8FieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CmpInnerUint8FieldsValidatePartialContext(ctx context.Context, obj *CmpInnerUint8Fields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Field2eq1") {
+		if selection.Has("Field1") && !(obj.Field2eq1 == obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field2eq1 must be equal to Field1"))
+		}
+	}
+	if selection.Has("Field3neq1") {
+		if selection.Has("Field1") && !(obj.Field3neq1 != obj.Field1) {
+			errs = append(errs, types.NewValidationError("Field3neq1 must not be equal to Field1"))
+		}
+	}
+	if selection.Has("Field5gte4") {
+		if selection.Has("Field4") && !(obj.Field5gte4 >= obj.Field4) {
+			errs = append(errs, types.NewValidationError("Field5gte4 must be >= Field4"))
+		}
+	}
+	if selection.Has("Field6gt4") {
+		if selection.Has("Field4") && !(obj.Field6gt4 > obj.Field4) {
+			errs = append(errs, types.NewValidationError("Field6gt4 must be > Field4"))
+		}
+	}
+	if selection.Has("Field7lte4") {
+		if selection.Has("Field4") && !(obj.Field7lte4 <= obj.Field4) {
+			errs = append(errs, types.NewValidationError("Field7lte4 must be <= Field4"))
+		}
+	}
+	if selection.Has("Field8lt4") {
+		if selection.Has("Field4") && !(obj.Field8lt4 < obj.Field4) {
+			errs = append(errs, types.NewValidationError("Field8lt4 must be < Field4"))
+		}
+	}
+	return errs
+}
 func CmpNestedStringFieldsValidate(obj *CmpNestedStringFields) []error {
+	return CmpNestedStringFieldsValidateContext(context.Background(), obj)
+}
+
+func CmpNestedStringFieldsValidateContext(ctx context.Context, obj *CmpNestedStringFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Field1eqNestedField1 == obj.Nested.Field1) {
 		errs = append(errs, types.NewValidationError("Field1eqNestedField1 must be equal to Nested.Field1"))
@@ -120,7 +493,40 @@ func CmpNestedStringFieldsValidate(obj *CmpNestedStringFields) []error {
 	}
 	return errs
 }
+
+func CmpNestedStringFieldsValidateFields(obj *CmpNestedStringFields, fields ...string) []error {
+	return CmpNestedStringFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CmpNestedStringFieldsValidateExcept(obj *CmpNestedStringFields, fields ...string) []error {
+	return CmpNestedStringFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CmpNestedStringFieldsValidatePartialContext(ctx context.Context, obj *CmpNestedStringFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("Field1eqNestedField1") {
+		if selection.Has("Nested.Field1") && !(obj.Field1eqNestedField1 == obj.Nested.Field1) {
+			errs = append(errs, types.NewValidationError("Field1eqNestedField1 must be equal to Nested.Field1"))
+		}
+	}
+	if selection.Has("Field2neqNestedField1") {
+		if selection.Has("Nested.Field1") && !(obj.Field2neqNestedField1 != obj.Nested.Field1) {
+			errs = append(errs, types.NewValidationError("Field2neqNestedField1 must not be equal to Nested.Field1"))
+		}
+	}
+	return errs
+}
 func CmpNestedUint8FieldsValidate(obj *CmpNestedUint8Fields) []error {
+	return CmpNestedUint8FieldsValidateContext(context.Background(), obj)
+}
+
+func CmpNestedUint8FieldsValidateContext(ctx context.Context, obj *CmpNestedUint8Fields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
 	if !(obj.Field1eqNestedField1 == obj.Nested.Field1) {
 		errs = append(errs, types.NewValidationError("Field1eqNestedField1 must be equal to Nested.Field1"))
@@ -142,2113 +548,16024 @@ func CmpNestedUint8FieldsValidate(obj *CmpNestedUint8Fields) []error {
 	}
 	return errs
 }
-func UserValidate(obj *User) []error {
-	var errs []error
-	if !(obj.FirstName != "") {
-		errs = append(errs, types.NewValidationError("FirstName is required"))
-	}
-	if !(obj.Age >= 18) {
-		errs = append(errs, types.NewValidationError("Age must be >= 18"))
+
+func CmpNestedUint8FieldsValidateFields(obj *CmpNestedUint8Fields, fields ...string) []error {
+	return CmpNestedUint8FieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CmpNestedUint8FieldsValidateExcept(obj *CmpNestedUint8Fields, fields ...string) []error {
+	return CmpNestedUint8FieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CmpNestedUint8FieldsValidatePartialContext(ctx context.Context, obj *CmpNestedUint8Fields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.Age <= 130) {
-		errs = append(errs, types.NewValidationError("Age must be <= 130"))
+	var errs []error
+	if selection.Has("Field1eqNestedField1") {
+		if selection.Has("Nested.Field1") && !(obj.Field1eqNestedField1 == obj.Nested.Field1) {
+			errs = append(errs, types.NewValidationError("Field1eqNestedField1 must be equal to Nested.Field1"))
+		}
+	}
+	if selection.Has("Field2neqNestedField1") {
+		if selection.Has("Nested.Field1") && !(obj.Field2neqNestedField1 != obj.Nested.Field1) {
+			errs = append(errs, types.NewValidationError("Field2neqNestedField1 must not be equal to Nested.Field1"))
+		}
+	}
+	if selection.Has("Field3gteNestedField2") {
+		if selection.Has("Nested.Field2") && !(obj.Field3gteNestedField2 >= obj.Nested.Field2) {
+			errs = append(errs, types.NewValidationError("Field3gteNestedField2 must be >= Nested.Field2"))
+		}
+	}
+	if selection.Has("Field4gtNestedField2") {
+		if selection.Has("Nested.Field2") && !(obj.Field4gtNestedField2 > obj.Nested.Field2) {
+			errs = append(errs, types.NewValidationError("Field4gtNestedField2 must be > Nested.Field2"))
+		}
+	}
+	if selection.Has("Field5lteNestedField2") {
+		if selection.Has("Nested.Field2") && !(obj.Field5lteNestedField2 <= obj.Nested.Field2) {
+			errs = append(errs, types.NewValidationError("Field5lteNestedField2 must be <= Nested.Field2"))
+		}
+	}
+	if selection.Has("Field6ltNestedField2") {
+		if selection.Has("Nested.Field2") && !(obj.Field6ltNestedField2 < obj.Nested.Field2) {
+			errs = append(errs, types.NewValidationError("Field6ltNestedField2 must be < Nested.Field2"))
+		}
 	}
-	errs = append(errs, AddressValidate(&obj.Address)...)
 	return errs
 }
-func UserWithStructInPkgValidate(obj *UserWithStructInPkg) []error {
+func ConditionalPaymentValidate(obj *ConditionalPayment) []error {
+	return ConditionalPaymentValidateContext(context.Background(), obj)
+}
+
+func ConditionalPaymentValidateContext(ctx context.Context, obj *ConditionalPayment) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FirstName != "") {
-		errs = append(errs, types.NewValidationError("FirstName is required"))
+	if obj.Method == "card" && !(obj.Card != nil) {
+		errs = append(errs, types.NewValidationError("Card is required when Method is 'card'"))
 	}
-	if !(obj.Age >= 18) {
-		errs = append(errs, types.NewValidationError("Age must be >= 18"))
+	if obj.Card != nil {
+		errs = append(errs, PaymentCardValidateContext(ctx, obj.Card)...)
 	}
-	if !(obj.Age <= 130) {
-		errs = append(errs, types.NewValidationError("Age must be <= 130"))
+	if !(obj.Method == "pix") && obj.Pix != nil {
+		errs = append(errs, types.NewValidationError("Pix must be empty unless Method is 'pix'"))
 	}
-	errs = append(errs, structsinpkg.AddressValidate(&obj.Address)...)
 	return errs
 }
-func emailStructFieldsValidate(obj *emailStructFields) []error {
-	var errs []error
-	if !(types.IsValidEmail(obj.FieldEmailString)) {
-		errs = append(errs, types.NewValidationError("FieldEmailString must be a valid email"))
-	}
-	return errs
+
+func ConditionalPaymentValidateFields(obj *ConditionalPayment, fields ...string) []error {
+	return ConditionalPaymentValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
 }
-func emailStructFieldsPointerValidate(obj *emailStructFieldsPointer) []error {
+
+func ConditionalPaymentValidateExcept(obj *ConditionalPayment, fields ...string) []error {
+	return ConditionalPaymentValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ConditionalPaymentValidatePartialContext(ctx context.Context, obj *ConditionalPayment, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldEmailStringPointer != nil && types.IsValidEmail(*obj.FieldEmailStringPointer)) {
-		errs = append(errs, types.NewValidationError("FieldEmailStringPointer must be a valid email"))
+	if selection.Has("Card") {
+		if selection.Has("Method") && obj.Method == "card" && !(obj.Card != nil) {
+			errs = append(errs, types.NewValidationError("Card is required when Method is 'card'"))
+		}
+		if obj.Card != nil {
+			errs = append(errs, PaymentCardValidatePartialContext(ctx, obj.Card, selection.Nested("Card"))...)
+		}
+	}
+	if selection.Has("Pix") {
+		if selection.Has("Method") && !(obj.Method == "pix") && obj.Pix != nil {
+			errs = append(errs, types.NewValidationError("Pix must be empty unless Method is 'pix'"))
+		}
 	}
 	return errs
 }
-func eqStructFieldsValidate(obj *eqStructFields) []error {
+func ConditionalUserValidate(obj *ConditionalUser) []error {
+	return ConditionalUserValidateContext(context.Background(), obj)
+}
+
+func ConditionalUserValidateContext(ctx context.Context, obj *ConditionalUser) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldEqString == "abcde") {
-		errs = append(errs, types.NewValidationError("FieldEqString must be equal to 'abcde'"))
+	if !(obj.Country == "US") && !(obj.Phone != "") {
+		errs = append(errs, types.NewValidationError("Phone is required unless Country is 'US'"))
 	}
-	if !(obj.FieldEqInt == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt must be equal to 32"))
+	if (obj.Country == "BR" && obj.Age == 18) && !(obj.TaxID != "") {
+		errs = append(errs, types.NewValidationError("TaxID is required when Country is 'BR' and Age is '18'"))
 	}
-	if !(obj.FieldEqInt8 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt8 must be equal to 32"))
+	if obj.Email != nil && *obj.Email != "" && !(obj.Mobile != "") {
+		errs = append(errs, types.NewValidationError("Mobile is required when any of 'Email' is present"))
 	}
-	if !(obj.FieldEqInt16 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt16 must be equal to 32"))
+	if (obj.Email != nil && *obj.Email != "" && obj.TaxID != "") && !(len(obj.Document) != 0) {
+		errs = append(errs, types.NewValidationError("Document is required when all of 'Email' 'TaxID' are present"))
 	}
-	if !(obj.FieldEqInt32 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt32 must be equal to 32"))
+	if !(obj.Email != nil && *obj.Email != "") && !(obj.Fax != nil && *obj.Fax != "") {
+		errs = append(errs, types.NewValidationError("Fax is required when any of 'Email' is missing"))
 	}
-	if !(obj.FieldEqInt64 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt64 must be equal to 32"))
+	if (!(obj.Email != nil && *obj.Email != "") && !(obj.Phone != "")) && !(obj.Address != "") {
+		errs = append(errs, types.NewValidationError("Address is required when all of 'Email' 'Phone' are missing"))
 	}
-	if !(obj.FieldEqUint == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint must be equal to 32"))
+	if obj.Country == "BR" && obj.Nickname != "" {
+		errs = append(errs, types.NewValidationError("Nickname must be empty when Country is 'BR'"))
 	}
-	if !(obj.FieldEqUint8 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint8 must be equal to 32"))
+	if !(obj.Age == 18) && obj.Promo != 0 {
+		errs = append(errs, types.NewValidationError("Promo must be empty unless Age is '18'"))
 	}
-	if !(obj.FieldEqUint16 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint16 must be equal to 32"))
+	if obj.Email != nil && *obj.Email != "" && obj.Coupon != "" {
+		errs = append(errs, types.NewValidationError("Coupon must be empty when any of 'Email' is present"))
 	}
-	if !(obj.FieldEqUint32 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint32 must be equal to 32"))
+	if (obj.Email != nil && *obj.Email != "" && obj.Phone != "") && obj.Voucher != "" {
+		errs = append(errs, types.NewValidationError("Voucher must be empty when all of 'Email' 'Phone' are present"))
 	}
-	if !(obj.FieldEqUint64 == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint64 must be equal to 32"))
+	if !(obj.Email != nil && *obj.Email != "") && obj.GuestPassword != "" {
+		errs = append(errs, types.NewValidationError("GuestPassword must be empty when any of 'Email' is missing"))
 	}
-	if !(obj.FieldEqFloat32 == 12.34) {
-		errs = append(errs, types.NewValidationError("FieldEqFloat32 must be equal to 12.34"))
+	if (!(obj.Email != nil && *obj.Email != "") && !(obj.Phone != "")) && obj.Token != nil && *obj.Token != 0 {
+		errs = append(errs, types.NewValidationError("Token must be empty when all of 'Email' 'Phone' are missing"))
 	}
-	if !(obj.FieldEqFloat64 == 12.34) {
-		errs = append(errs, types.NewValidationError("FieldEqFloat64 must be equal to 12.34"))
+	return errs
+}
+
+func ConditionalUserValidateFields(obj *ConditionalUser, fields ...string) []error {
+	return ConditionalUserValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ConditionalUserValidateExcept(obj *ConditionalUser, fields ...string) []error {
+	return ConditionalUserValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ConditionalUserValidatePartialContext(ctx context.Context, obj *ConditionalUser, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldEqBool == true) {
-		errs = append(errs, types.NewValidationError("FieldEqBool must be equal to true"))
+	var errs []error
+	if selection.Has("Phone") {
+		if selection.Has("Country") && !(obj.Country == "US") && !(obj.Phone != "") {
+			errs = append(errs, types.NewValidationError("Phone is required unless Country is 'US'"))
+		}
+	}
+	if selection.Has("TaxID") {
+		if selection.Has("Country") && selection.Has("Age") && (obj.Country == "BR" && obj.Age == 18) && !(obj.TaxID != "") {
+			errs = append(errs, types.NewValidationError("TaxID is required when Country is 'BR' and Age is '18'"))
+		}
+	}
+	if selection.Has("Mobile") {
+		if selection.Has("Email") && obj.Email != nil && *obj.Email != "" && !(obj.Mobile != "") {
+			errs = append(errs, types.NewValidationError("Mobile is required when any of 'Email' is present"))
+		}
+	}
+	if selection.Has("Document") {
+		if selection.Has("Email") && selection.Has("TaxID") && (obj.Email != nil && *obj.Email != "" && obj.TaxID != "") && !(len(obj.Document) != 0) {
+			errs = append(errs, types.NewValidationError("Document is required when all of 'Email' 'TaxID' are present"))
+		}
+	}
+	if selection.Has("Fax") {
+		if selection.Has("Email") && !(obj.Email != nil && *obj.Email != "") && !(obj.Fax != nil && *obj.Fax != "") {
+			errs = append(errs, types.NewValidationError("Fax is required when any of 'Email' is missing"))
+		}
+	}
+	if selection.Has("Address") {
+		if selection.Has("Email") && selection.Has("Phone") && (!(obj.Email != nil && *obj.Email != "") && !(obj.Phone != "")) && !(obj.Address != "") {
+			errs = append(errs, types.NewValidationError("Address is required when all of 'Email' 'Phone' are missing"))
+		}
+	}
+	if selection.Has("Nickname") {
+		if selection.Has("Country") && obj.Country == "BR" && obj.Nickname != "" {
+			errs = append(errs, types.NewValidationError("Nickname must be empty when Country is 'BR'"))
+		}
+	}
+	if selection.Has("Promo") {
+		if selection.Has("Age") && !(obj.Age == 18) && obj.Promo != 0 {
+			errs = append(errs, types.NewValidationError("Promo must be empty unless Age is '18'"))
+		}
+	}
+	if selection.Has("Coupon") {
+		if selection.Has("Email") && obj.Email != nil && *obj.Email != "" && obj.Coupon != "" {
+			errs = append(errs, types.NewValidationError("Coupon must be empty when any of 'Email' is present"))
+		}
+	}
+	if selection.Has("Voucher") {
+		if selection.Has("Email") && selection.Has("Phone") && (obj.Email != nil && *obj.Email != "" && obj.Phone != "") && obj.Voucher != "" {
+			errs = append(errs, types.NewValidationError("Voucher must be empty when all of 'Email' 'Phone' are present"))
+		}
+	}
+	if selection.Has("GuestPassword") {
+		if selection.Has("Email") && !(obj.Email != nil && *obj.Email != "") && obj.GuestPassword != "" {
+			errs = append(errs, types.NewValidationError("GuestPassword must be empty when any of 'Email' is missing"))
+		}
+	}
+	if selection.Has("Token") {
+		if selection.Has("Email") && selection.Has("Phone") && (!(obj.Email != nil && *obj.Email != "") && !(obj.Phone != "")) && obj.Token != nil && *obj.Token != 0 {
+			errs = append(errs, types.NewValidationError("Token must be empty when all of 'Email' 'Phone' are missing"))
+		}
 	}
 	return errs
 }
-func eqStructFieldsPointerValidate(obj *eqStructFieldsPointer) []error {
+func ContextUserValidate(obj *ContextUser) []error {
+	return ContextUserValidateContext(context.Background(), obj)
+}
+
+func ContextUserValidateContext(ctx context.Context, obj *ContextUser) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldEqStringPointer != nil && *obj.FieldEqStringPointer == "abcde") {
-		errs = append(errs, types.NewValidationError("FieldEqStringPointer must be equal to 'abcde'"))
+	if !(obj.FirstName != "") {
+		errs = append(errs, types.NewValidationError("FirstName is required"))
 	}
-	if !(obj.FieldEqIntPointer != nil && *obj.FieldEqIntPointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqIntPointer must be equal to 32"))
+	errs = append(errs, structsinpkg.AddressValidateContext(ctx, &obj.Address)...)
+	for i := range obj.Previous {
+		errs = append(errs, structsinpkg.AddressValidateContext(ctx, &obj.Previous[i])...)
 	}
-	if !(obj.FieldEqInt8Pointer != nil && *obj.FieldEqInt8Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt8Pointer must be equal to 32"))
+	return errs
+}
+
+func ContextUserValidateFields(obj *ContextUser, fields ...string) []error {
+	return ContextUserValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ContextUserValidateExcept(obj *ContextUser, fields ...string) []error {
+	return ContextUserValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ContextUserValidatePartialContext(ctx context.Context, obj *ContextUser, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldEqInt16Pointer != nil && *obj.FieldEqInt16Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt16Pointer must be equal to 32"))
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
 	}
-	if !(obj.FieldEqInt32Pointer != nil && *obj.FieldEqInt32Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt32Pointer must be equal to 32"))
+	if selection.Has("Address") {
+		errs = append(errs, structsinpkg.AddressValidatePartialContext(ctx, &obj.Address, selection.Nested("Address"))...)
 	}
-	if !(obj.FieldEqInt64Pointer != nil && *obj.FieldEqInt64Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqInt64Pointer must be equal to 32"))
+	if selection.Has("Previous") {
+		for i := range obj.Previous {
+			errs = append(errs, structsinpkg.AddressValidatePartialContext(ctx, &obj.Previous[i], selection.Nested("Previous"))...)
+		}
 	}
-	if !(obj.FieldEqUintPointer != nil && *obj.FieldEqUintPointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUintPointer must be equal to 32"))
+	return errs
+}
+func CustomerRecordTypeValidate(obj *CustomerRecordType) []error {
+	return CustomerRecordTypeValidateContext(context.Background(), obj)
+}
+
+func CustomerRecordTypeValidateContext(ctx context.Context, obj *CustomerRecordType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldEqUint8Pointer != nil && *obj.FieldEqUint8Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint8Pointer must be equal to 32"))
-	}
-	if !(obj.FieldEqUint16Pointer != nil && *obj.FieldEqUint16Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint16Pointer must be equal to 32"))
-	}
-	if !(obj.FieldEqUint32Pointer != nil && *obj.FieldEqUint32Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint32Pointer must be equal to 32"))
-	}
-	if !(obj.FieldEqUint64Pointer != nil && *obj.FieldEqUint64Pointer == 32) {
-		errs = append(errs, types.NewValidationError("FieldEqUint64Pointer must be equal to 32"))
-	}
-	if !(obj.FieldEqFloat32Pointer != nil && *obj.FieldEqFloat32Pointer == 12.34) {
-		errs = append(errs, types.NewValidationError("FieldEqFloat32Pointer must be equal to 12.34"))
-	}
-	if !(obj.FieldEqFloat64Pointer != nil && *obj.FieldEqFloat64Pointer == 12.34) {
-		errs = append(errs, types.NewValidationError("FieldEqFloat64Pointer must be equal to 12.34"))
-	}
-	if !(obj.FieldEqBoolPointer != nil && *obj.FieldEqBoolPointer == true) {
-		errs = append(errs, types.NewValidationError("FieldEqBoolPointer must be equal to true"))
-	}
-	return errs
-}
-func eq_ignore_caseStructFieldsValidate(obj *eq_ignore_caseStructFields) []error {
 	var errs []error
-	if !(types.EqualFold(obj.FieldEq_ignore_caseString, "abcde")) {
-		errs = append(errs, types.NewValidationError("FieldEq_ignore_caseString must be equal to 'abcde'"))
+	if !(obj.Name.Valid && obj.Name.String != "") {
+		errs = append(errs, types.NewValidationError("Name is required"))
+	}
+	if obj.Name.Valid {
+		if !(len(obj.Name.String) >= 3) {
+			errs = append(errs, types.NewValidationError("Name length must be >= 3"))
+		}
+	}
+	if obj.Email.Valid {
+		if !(types.IsValidEmail(obj.Email.String)) {
+			errs = append(errs, types.NewValidationError("Email must be a valid email"))
+		}
+	}
+	if obj.Age.Valid {
+		if !(obj.Age.Int64 >= 18) {
+			errs = append(errs, types.NewValidationError("Age must be >= 18"))
+		}
+		if !(obj.Age.Int64 <= 130) {
+			errs = append(errs, types.NewValidationError("Age must be <= 130"))
+		}
+	}
+	if obj.Score.Valid && obj.Score.Float64 != 0 {
+		if !(obj.Score.Float64 > 0) {
+			errs = append(errs, types.NewValidationError("Score must be > 0"))
+		}
+	}
+	if !(obj.Deleted != nil && obj.Deleted.Valid && obj.Deleted.Bool != false) {
+		errs = append(errs, types.NewValidationError("Deleted is required"))
+	}
+	if !(obj.CreatedAt.Valid && !obj.CreatedAt.Time.IsZero()) {
+		errs = append(errs, types.NewValidationError("CreatedAt is required"))
+	}
+	if obj.Level.Valid {
+		if !(obj.Level.V == 1 || obj.Level.V == 2 || obj.Level.V == 3) {
+			errs = append(errs, types.NewValidationError("Level must be one of '1' '2' '3'"))
+		}
+	}
+	if obj.Nickname.Valid && obj.Nickname.V != "" {
+		if !(types.IsAlpha(obj.Nickname.V)) {
+			errs = append(errs, types.NewValidationError("Nickname must contain only ASCII letters"))
+		}
+	}
+	if !(obj.Country.Valid && obj.Country.V != "") {
+		errs = append(errs, types.NewValidationError("Country is required"))
+	}
+	if obj.Country.Valid {
+		if !(len(obj.Country.V) == 2) {
+			errs = append(errs, types.NewValidationError("Country length must be 2"))
+		}
 	}
 	return errs
 }
-func eq_ignore_caseStructFieldsPointerValidate(obj *eq_ignore_caseStructFieldsPointer) []error {
+
+func CustomerRecordTypeValidateFields(obj *CustomerRecordType, fields ...string) []error {
+	return CustomerRecordTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func CustomerRecordTypeValidateExcept(obj *CustomerRecordType, fields ...string) []error {
+	return CustomerRecordTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func CustomerRecordTypeValidatePartialContext(ctx context.Context, obj *CustomerRecordType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldEq_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldEq_ignore_caseStringPointer, "abcde")) {
-		errs = append(errs, types.NewValidationError("FieldEq_ignore_caseStringPointer must be equal to 'abcde'"))
+	if selection.Has("Name") {
+		if !(obj.Name.Valid && obj.Name.String != "") {
+			errs = append(errs, types.NewValidationError("Name is required"))
+		}
+		if obj.Name.Valid {
+			if !(len(obj.Name.String) >= 3) {
+				errs = append(errs, types.NewValidationError("Name length must be >= 3"))
+			}
+		}
+	}
+	if selection.Has("Email") {
+		if obj.Email.Valid {
+			if !(types.IsValidEmail(obj.Email.String)) {
+				errs = append(errs, types.NewValidationError("Email must be a valid email"))
+			}
+		}
+	}
+	if selection.Has("Age") {
+		if obj.Age.Valid {
+			if !(obj.Age.Int64 >= 18) {
+				errs = append(errs, types.NewValidationError("Age must be >= 18"))
+			}
+			if !(obj.Age.Int64 <= 130) {
+				errs = append(errs, types.NewValidationError("Age must be <= 130"))
+			}
+		}
+	}
+	if selection.Has("Score") {
+		if obj.Score.Valid && obj.Score.Float64 != 0 {
+			if !(obj.Score.Float64 > 0) {
+				errs = append(errs, types.NewValidationError("Score must be > 0"))
+			}
+		}
+	}
+	if selection.Has("Deleted") {
+		if !(obj.Deleted != nil && obj.Deleted.Valid && obj.Deleted.Bool != false) {
+			errs = append(errs, types.NewValidationError("Deleted is required"))
+		}
+	}
+	if selection.Has("CreatedAt") {
+		if !(obj.CreatedAt.Valid && !obj.CreatedAt.Time.IsZero()) {
+			errs = append(errs, types.NewValidationError("CreatedAt is required"))
+		}
+	}
+	if selection.Has("Level") {
+		if obj.Level.Valid {
+			if !(obj.Level.V == 1 || obj.Level.V == 2 || obj.Level.V == 3) {
+				errs = append(errs, types.NewValidationError("Level must be one of '1' '2' '3'"))
+			}
+		}
+	}
+	if selection.Has("Nickname") {
+		if obj.Nickname.Valid && obj.Nickname.V != "" {
+			if !(types.IsAlpha(obj.Nickname.V)) {
+				errs = append(errs, types.NewValidationError("Nickname must contain only ASCII letters"))
+			}
+		}
+	}
+	if selection.Has("Country") {
+		if !(obj.Country.Valid && obj.Country.V != "") {
+			errs = append(errs, types.NewValidationError("Country is required"))
+		}
+		if obj.Country.Valid {
+			if !(len(obj.Country.V) == 2) {
+				errs = append(errs, types.NewValidationError("Country length must be 2"))
+			}
+		}
 	}
 	return errs
 }
-func gtStructFieldsValidate(obj *gtStructFields) []error {
+func EndpointTypeValidate(obj *EndpointType) []error {
+	return EndpointTypeValidateContext(context.Background(), obj)
+}
+
+func EndpointTypeValidateContext(ctx context.Context, obj *EndpointType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldGtInt > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt must be > 32"))
+	if !(obj.Addr.IsValid()) {
+		errs = append(errs, types.NewValidationError("Addr is required"))
 	}
-	if !(obj.FieldGtInt8 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt8 must be > 32"))
+	if !(obj.Addr.Zone() == "" && obj.Addr.Unmap().Is4()) {
+		errs = append(errs, types.NewValidationError("Addr must be a valid IPv4 address"))
 	}
-	if !(obj.FieldGtInt16 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt16 must be > 32"))
+	if !(obj.Addr.IsPrivate()) {
+		errs = append(errs, types.NewValidationError("Addr must be a private IP address"))
 	}
-	if !(obj.FieldGtInt32 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt32 must be > 32"))
+	if obj.Peer != nil {
+		if !(obj.Peer != nil && obj.Peer.IsGlobalUnicast() && !obj.Peer.IsPrivate()) {
+			errs = append(errs, types.NewValidationError("Peer must be a public IP address"))
+		}
 	}
-	if !(obj.FieldGtInt64 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt64 must be > 32"))
+	if !(obj.Network.IsValid()) {
+		errs = append(errs, types.NewValidationError("Network is required"))
 	}
-	if !(obj.FieldGtUint > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint must be > 32"))
+	if !(obj.Network.IsValid() && obj.Network.Addr().Is6() && !obj.Network.Addr().Is4In6()) {
+		errs = append(errs, types.NewValidationError("Network must be a valid IPv6 CIDR"))
 	}
-	if !(obj.FieldGtUint8 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint8 must be > 32"))
+	if !(obj.Callback != nil && !types.IsEmptyURL(obj.Callback)) {
+		errs = append(errs, types.NewValidationError("Callback is required"))
 	}
-	if !(obj.FieldGtUint16 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint16 must be > 32"))
+	if !(obj.Callback != nil && types.IsValidParsedURLWithScheme(obj.Callback, []string{"https"})) {
+		errs = append(errs, types.NewValidationError("Callback must be a valid URL with scheme 'https'"))
 	}
-	if !(obj.FieldGtUint32 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint32 must be > 32"))
+	if !types.IsEmptyURL(&obj.Homepage) {
+		if !(types.IsValidParsedHTTPURL(&obj.Homepage)) {
+			errs = append(errs, types.NewValidationError("Homepage must be a valid HTTP URL"))
+		}
 	}
-	if !(obj.FieldGtUint64 > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint64 must be > 32"))
+	if !(obj.Contact.Address != "") {
+		errs = append(errs, types.NewValidationError("Contact is required"))
 	}
-	if !(obj.FieldGtFloat32 > 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGtFloat32 must be > 12.34"))
+	if !(types.IsValidEmail(obj.Contact.Address)) {
+		errs = append(errs, types.NewValidationError("Contact must be a valid email"))
 	}
-	if !(obj.FieldGtFloat64 > 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGtFloat64 must be > 12.34"))
+	if !(!types.IsZeroBytes(obj.RequestID[:])) {
+		errs = append(errs, types.NewValidationError("RequestID is required"))
+	}
+	if !(types.IsValidUUIDBytes(obj.RequestID[:], 4)) {
+		errs = append(errs, types.NewValidationError("RequestID must be a valid UUID v4"))
+	}
+	if obj.TraceID != nil {
+		if !(obj.TraceID != nil && types.IsValidUUIDBytes(obj.TraceID[:], 7)) {
+			errs = append(errs, types.NewValidationError("TraceID must be a valid UUID v7"))
+		}
+	}
+	if obj.Fallback != nil {
+		if !(obj.Fallback != nil && obj.Fallback.IsValid()) {
+			errs = append(errs, types.NewValidationError("Fallback must be a valid CIDR"))
+		}
 	}
 	return errs
 }
-func gtStructFieldsPointerValidate(obj *gtStructFieldsPointer) []error {
+
+func EndpointTypeValidateFields(obj *EndpointType, fields ...string) []error {
+	return EndpointTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func EndpointTypeValidateExcept(obj *EndpointType, fields ...string) []error {
+	return EndpointTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func EndpointTypeValidatePartialContext(ctx context.Context, obj *EndpointType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldGtIntPointer != nil && *obj.FieldGtIntPointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtIntPointer must be > 32"))
+	if selection.Has("Addr") {
+		if !(obj.Addr.IsValid()) {
+			errs = append(errs, types.NewValidationError("Addr is required"))
+		}
+		if !(obj.Addr.Zone() == "" && obj.Addr.Unmap().Is4()) {
+			errs = append(errs, types.NewValidationError("Addr must be a valid IPv4 address"))
+		}
+		if !(obj.Addr.IsPrivate()) {
+			errs = append(errs, types.NewValidationError("Addr must be a private IP address"))
+		}
+	}
+	if selection.Has("Peer") {
+		if obj.Peer != nil {
+			if !(obj.Peer != nil && obj.Peer.IsGlobalUnicast() && !obj.Peer.IsPrivate()) {
+				errs = append(errs, types.NewValidationError("Peer must be a public IP address"))
+			}
+		}
+	}
+	if selection.Has("Network") {
+		if !(obj.Network.IsValid()) {
+			errs = append(errs, types.NewValidationError("Network is required"))
+		}
+		if !(obj.Network.IsValid() && obj.Network.Addr().Is6() && !obj.Network.Addr().Is4In6()) {
+			errs = append(errs, types.NewValidationError("Network must be a valid IPv6 CIDR"))
+		}
+	}
+	if selection.Has("Callback") {
+		if !(obj.Callback != nil && !types.IsEmptyURL(obj.Callback)) {
+			errs = append(errs, types.NewValidationError("Callback is required"))
+		}
+		if !(obj.Callback != nil && types.IsValidParsedURLWithScheme(obj.Callback, []string{"https"})) {
+			errs = append(errs, types.NewValidationError("Callback must be a valid URL with scheme 'https'"))
+		}
+	}
+	if selection.Has("Homepage") {
+		if !types.IsEmptyURL(&obj.Homepage) {
+			if !(types.IsValidParsedHTTPURL(&obj.Homepage)) {
+				errs = append(errs, types.NewValidationError("Homepage must be a valid HTTP URL"))
+			}
+		}
+	}
+	if selection.Has("Contact") {
+		if !(obj.Contact.Address != "") {
+			errs = append(errs, types.NewValidationError("Contact is required"))
+		}
+		if !(types.IsValidEmail(obj.Contact.Address)) {
+			errs = append(errs, types.NewValidationError("Contact must be a valid email"))
+		}
+	}
+	if selection.Has("RequestID") {
+		if !(!types.IsZeroBytes(obj.RequestID[:])) {
+			errs = append(errs, types.NewValidationError("RequestID is required"))
+		}
+		if !(types.IsValidUUIDBytes(obj.RequestID[:], 4)) {
+			errs = append(errs, types.NewValidationError("RequestID must be a valid UUID v4"))
+		}
+	}
+	if selection.Has("TraceID") {
+		if obj.TraceID != nil {
+			if !(obj.TraceID != nil && types.IsValidUUIDBytes(obj.TraceID[:], 7)) {
+				errs = append(errs, types.NewValidationError("TraceID must be a valid UUID v7"))
+			}
+		}
+	}
+	if selection.Has("Fallback") {
+		if obj.Fallback != nil {
+			if !(obj.Fallback != nil && obj.Fallback.IsValid()) {
+				errs = append(errs, types.NewValidationError("Fallback must be a valid CIDR"))
+			}
+		}
 	}
-	if !(obj.FieldGtInt8Pointer != nil && *obj.FieldGtInt8Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt8Pointer must be > 32"))
+	return errs
+}
+func GroupsAddressValidate(obj *GroupsAddress) []error {
+	return GroupsAddressValidateGroupsContext(context.Background(), obj)
+}
+
+func GroupsAddressValidateContext(ctx context.Context, obj *GroupsAddress) []error {
+	return GroupsAddressValidateGroupsContext(ctx, obj)
+}
+
+func GroupsAddressValidateGroups(obj *GroupsAddress, groups ...string) []error {
+	return GroupsAddressValidateGroupsContext(context.Background(), obj, groups...)
+}
+
+func GroupsAddressValidateGroupsContext(ctx context.Context, obj *GroupsAddress, groups ...string) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGtInt16Pointer != nil && *obj.FieldGtInt16Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt16Pointer must be > 32"))
+	var errs []error
+	if types.InGroups(groups, "create") && !(obj.Street != "") {
+		errs = append(errs, types.NewValidationError("Street is required"))
 	}
-	if !(obj.FieldGtInt32Pointer != nil && *obj.FieldGtInt32Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt32Pointer must be > 32"))
+	if !(obj.City != "") {
+		errs = append(errs, types.NewValidationError("City is required"))
 	}
-	if !(obj.FieldGtInt64Pointer != nil && *obj.FieldGtInt64Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtInt64Pointer must be > 32"))
+	return errs
+}
+
+func GroupsAddressValidateFields(obj *GroupsAddress, fields ...string) []error {
+	return GroupsAddressValidatePartialGroupsContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func GroupsAddressValidateExcept(obj *GroupsAddress, fields ...string) []error {
+	return GroupsAddressValidatePartialGroupsContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func GroupsAddressValidatePartialGroupsContext(ctx context.Context, obj *GroupsAddress, selection types.FieldSelection, groups ...string) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGtUintPointer != nil && *obj.FieldGtUintPointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUintPointer must be > 32"))
+	var errs []error
+	if selection.Has("Street") {
+		if types.InGroups(groups, "create") && !(obj.Street != "") {
+			errs = append(errs, types.NewValidationError("Street is required"))
+		}
 	}
-	if !(obj.FieldGtUint8Pointer != nil && *obj.FieldGtUint8Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint8Pointer must be > 32"))
+	if selection.Has("City") {
+		if !(obj.City != "") {
+			errs = append(errs, types.NewValidationError("City is required"))
+		}
 	}
-	if !(obj.FieldGtUint16Pointer != nil && *obj.FieldGtUint16Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint16Pointer must be > 32"))
+	return errs
+}
+func GroupsUserValidate(obj *GroupsUser) []error {
+	return GroupsUserValidateGroupsContext(context.Background(), obj)
+}
+
+func GroupsUserValidateContext(ctx context.Context, obj *GroupsUser) []error {
+	return GroupsUserValidateGroupsContext(ctx, obj)
+}
+
+func GroupsUserValidateGroups(obj *GroupsUser, groups ...string) []error {
+	return GroupsUserValidateGroupsContext(context.Background(), obj, groups...)
+}
+
+func GroupsUserValidateGroupsContext(ctx context.Context, obj *GroupsUser, groups ...string) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGtUint32Pointer != nil && *obj.FieldGtUint32Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint32Pointer must be > 32"))
+	var errs []error
+	if types.InGroups(groups, "update", "patch") && !(obj.ID != 0) {
+		errs = append(errs, types.NewValidationError("ID is required"))
 	}
-	if !(obj.FieldGtUint64Pointer != nil && *obj.FieldGtUint64Pointer > 32) {
-		errs = append(errs, types.NewValidationError("FieldGtUint64Pointer must be > 32"))
+	if types.InGroups(groups, "create") && !(obj.UserName != "") {
+		errs = append(errs, types.NewValidationError("UserName is required"))
 	}
-	if !(obj.FieldGtFloat32Pointer != nil && *obj.FieldGtFloat32Pointer > 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGtFloat32Pointer must be > 12.34"))
+	if !(len(obj.UserName) >= 5) {
+		errs = append(errs, types.NewValidationError("UserName length must be >= 5"))
 	}
-	if !(obj.FieldGtFloat64Pointer != nil && *obj.FieldGtFloat64Pointer > 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGtFloat64Pointer must be > 12.34"))
+	if types.InGroups(groups, "create") && !(obj.Email != "") {
+		errs = append(errs, types.NewValidationError("Email is required"))
+	}
+	if types.InGroups(groups, "create", "update") && !(types.IsValidEmail(obj.Email)) {
+		errs = append(errs, types.NewValidationError("Email must be a valid email"))
 	}
+	errs = append(errs, GroupsAddressValidateGroupsContext(ctx, &obj.Address, groups...)...)
 	return errs
 }
-func gteStructFieldsValidate(obj *gteStructFields) []error {
-	var errs []error
-	if !(obj.FieldGteInt >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt must be >= 32"))
+
+func GroupsUserValidateFields(obj *GroupsUser, fields ...string) []error {
+	return GroupsUserValidatePartialGroupsContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func GroupsUserValidateExcept(obj *GroupsUser, fields ...string) []error {
+	return GroupsUserValidatePartialGroupsContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func GroupsUserValidatePartialGroupsContext(ctx context.Context, obj *GroupsUser, selection types.FieldSelection, groups ...string) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteInt8 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt8 must be >= 32"))
+	var errs []error
+	if selection.Has("ID") {
+		if types.InGroups(groups, "update", "patch") && !(obj.ID != 0) {
+			errs = append(errs, types.NewValidationError("ID is required"))
+		}
+	}
+	if selection.Has("UserName") {
+		if types.InGroups(groups, "create") && !(obj.UserName != "") {
+			errs = append(errs, types.NewValidationError("UserName is required"))
+		}
+		if !(len(obj.UserName) >= 5) {
+			errs = append(errs, types.NewValidationError("UserName length must be >= 5"))
+		}
+	}
+	if selection.Has("Email") {
+		if types.InGroups(groups, "create") && !(obj.Email != "") {
+			errs = append(errs, types.NewValidationError("Email is required"))
+		}
+		if types.InGroups(groups, "create", "update") && !(types.IsValidEmail(obj.Email)) {
+			errs = append(errs, types.NewValidationError("Email must be a valid email"))
+		}
+	}
+	if selection.Has("Address") {
+		errs = append(errs, GroupsAddressValidatePartialGroupsContext(ctx, &obj.Address, selection.Nested("Address"), groups...)...)
 	}
-	if !(obj.FieldGteInt16 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt16 must be >= 32"))
+	return errs
+}
+func LedgerTypeValidate(obj *LedgerType) []error {
+	return LedgerTypeValidateContext(context.Background(), obj)
+}
+
+func LedgerTypeValidateContext(ctx context.Context, obj *LedgerType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteInt32 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt32 must be >= 32"))
+	var errs []error
+	if !(obj.Balance != nil && obj.Balance.Sign() != 0) {
+		errs = append(errs, types.NewValidationError("Balance is required"))
 	}
-	if !(obj.FieldGteInt64 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt64 must be >= 32"))
+	if !(obj.Balance != nil && obj.Balance.Cmp(validgenBigInt350ca8af) >= 0) {
+		errs = append(errs, types.NewValidationError("Balance must be >= 0"))
 	}
-	if !(obj.FieldGteUint >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint must be >= 32"))
+	if obj.Limit != nil {
+		if !(obj.Limit != nil && obj.Balance != nil && obj.Limit.Cmp(obj.Balance) >= 0) {
+			errs = append(errs, types.NewValidationError("Limit must be >= Balance"))
+		}
 	}
-	if !(obj.FieldGteUint8 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint8 must be >= 32"))
+	if !(obj.Total != nil && obj.Total.Cmp(validgenBigIntce399344) <= 0) {
+		errs = append(errs, types.NewValidationError("Total must be <= 1000000000000000000000"))
 	}
-	if !(obj.FieldGteUint16 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint16 must be >= 32"))
+	if !(obj.Fee != nil && obj.Fee.Cmp(validgenBigFloat350ca8af) > 0) {
+		errs = append(errs, types.NewValidationError("Fee must be > 0"))
 	}
-	if !(obj.FieldGteUint32 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint32 must be >= 32"))
+	if !(obj.Fee != nil && obj.Fee.Cmp(validgenBigFloat75954ef8) < 0) {
+		errs = append(errs, types.NewValidationError("Fee must be < 0.01"))
 	}
-	if !(obj.FieldGteUint64 >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint64 must be >= 32"))
+	if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat350ca8af) != 0) {
+		errs = append(errs, types.NewValidationError("Rate must not be equal to 0"))
 	}
-	if !(obj.FieldGteFloat32 >= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGteFloat32 must be >= 12.34"))
+	if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat72452f0e) <= 0) {
+		errs = append(errs, types.NewValidationError("Rate must be <= 1/3"))
 	}
-	if !(obj.FieldGteFloat64 >= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGteFloat64 must be >= 12.34"))
+	if !(obj.Discount.Cmp(validgenBigRat350ca8af) >= 0) {
+		errs = append(errs, types.NewValidationError("Discount must be >= 0"))
 	}
 	return errs
 }
-func gteStructFieldsPointerValidate(obj *gteStructFieldsPointer) []error {
-	var errs []error
-	if !(obj.FieldGteIntPointer != nil && *obj.FieldGteIntPointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteIntPointer must be >= 32"))
+
+func LedgerTypeValidateFields(obj *LedgerType, fields ...string) []error {
+	return LedgerTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func LedgerTypeValidateExcept(obj *LedgerType, fields ...string) []error {
+	return LedgerTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func LedgerTypeValidatePartialContext(ctx context.Context, obj *LedgerType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteInt8Pointer != nil && *obj.FieldGteInt8Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt8Pointer must be >= 32"))
+	var errs []error
+	if selection.Has("Balance") {
+		if !(obj.Balance != nil && obj.Balance.Sign() != 0) {
+			errs = append(errs, types.NewValidationError("Balance is required"))
+		}
+		if !(obj.Balance != nil && obj.Balance.Cmp(validgenBigInt350ca8af) >= 0) {
+			errs = append(errs, types.NewValidationError("Balance must be >= 0"))
+		}
+	}
+	if selection.Has("Limit") {
+		if obj.Limit != nil {
+			if selection.Has("Balance") && !(obj.Limit != nil && obj.Balance != nil && obj.Limit.Cmp(obj.Balance) >= 0) {
+				errs = append(errs, types.NewValidationError("Limit must be >= Balance"))
+			}
+		}
+	}
+	if selection.Has("Total") {
+		if !(obj.Total != nil && obj.Total.Cmp(validgenBigIntce399344) <= 0) {
+			errs = append(errs, types.NewValidationError("Total must be <= 1000000000000000000000"))
+		}
+	}
+	if selection.Has("Fee") {
+		if !(obj.Fee != nil && obj.Fee.Cmp(validgenBigFloat350ca8af) > 0) {
+			errs = append(errs, types.NewValidationError("Fee must be > 0"))
+		}
+		if !(obj.Fee != nil && obj.Fee.Cmp(validgenBigFloat75954ef8) < 0) {
+			errs = append(errs, types.NewValidationError("Fee must be < 0.01"))
+		}
+	}
+	if selection.Has("Rate") {
+		if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat350ca8af) != 0) {
+			errs = append(errs, types.NewValidationError("Rate must not be equal to 0"))
+		}
+		if !(obj.Rate != nil && obj.Rate.Cmp(validgenBigRat72452f0e) <= 0) {
+			errs = append(errs, types.NewValidationError("Rate must be <= 1/3"))
+		}
+	}
+	if selection.Has("Discount") {
+		if !(obj.Discount.Cmp(validgenBigRat350ca8af) >= 0) {
+			errs = append(errs, types.NewValidationError("Discount must be >= 0"))
+		}
 	}
-	if !(obj.FieldGteInt16Pointer != nil && *obj.FieldGteInt16Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt16Pointer must be >= 32"))
+	return errs
+}
+func OmitEmptyTypeValidate(obj *OmitEmptyType) []error {
+	return OmitEmptyTypeValidateContext(context.Background(), obj)
+}
+
+func OmitEmptyTypeValidateContext(ctx context.Context, obj *OmitEmptyType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteInt32Pointer != nil && *obj.FieldGteInt32Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt32Pointer must be >= 32"))
+	var errs []error
+	if obj.FieldString != "" {
+		if !(len(obj.FieldString) >= 3) {
+			errs = append(errs, types.NewValidationError("FieldString length must be >= 3"))
+		}
+	}
+	if obj.FieldInt != 0 {
+		if !(obj.FieldInt >= 10) {
+			errs = append(errs, types.NewValidationError("FieldInt must be >= 10"))
+		}
+	}
+	if obj.FieldFloat != 0 {
+		if !(obj.FieldFloat <= 1.5) {
+			errs = append(errs, types.NewValidationError("FieldFloat must be <= 1.5"))
+		}
+	}
+	if obj.FieldBool != false {
+		if !(obj.FieldBool == true) {
+			errs = append(errs, types.NewValidationError("FieldBool must be equal to true"))
+		}
+	}
+	if len(obj.FieldSlice) != 0 {
+		if !(len(obj.FieldSlice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldSlice must have at least 2 elements"))
+		}
+	}
+	if obj.FieldMap != nil {
+		if !(len(obj.FieldMap) == 1) {
+			errs = append(errs, types.NewValidationError("FieldMap must have exactly 1 elements"))
+		}
+	}
+	if obj.FieldStringPtr != nil {
+		if !((obj.FieldStringPtr != nil && *obj.FieldStringPtr == "a") || (obj.FieldStringPtr != nil && *obj.FieldStringPtr == "b")) {
+			errs = append(errs, types.NewValidationError("FieldStringPtr must be one of 'a' 'b'"))
+		}
+	}
+	if obj.FieldIntPtr != nil {
+		if !(obj.FieldIntPtr != nil && *obj.FieldIntPtr > 0) {
+			errs = append(errs, types.NewValidationError("FieldIntPtr must be > 0"))
+		}
+	}
+	if !(len(obj.FieldBefore) <= 5) {
+		errs = append(errs, types.NewValidationError("FieldBefore length must be <= 5"))
+	}
+	if obj.FieldBefore != "" {
+		if !(len(obj.FieldBefore) >= 3) {
+			errs = append(errs, types.NewValidationError("FieldBefore length must be >= 3"))
+		}
+	}
+	if !types.IsZeroSlice(obj.FieldArray[:]) {
+		if !(types.SliceOnlyContains(obj.FieldArray[:], []string{"a", "b"})) {
+			errs = append(errs, types.NewValidationError("FieldArray elements must be one of 'a' 'b'"))
+		}
 	}
-	if !(obj.FieldGteInt64Pointer != nil && *obj.FieldGteInt64Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteInt64Pointer must be >= 32"))
+	return errs
+}
+
+func OmitEmptyTypeValidateFields(obj *OmitEmptyType, fields ...string) []error {
+	return OmitEmptyTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func OmitEmptyTypeValidateExcept(obj *OmitEmptyType, fields ...string) []error {
+	return OmitEmptyTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func OmitEmptyTypeValidatePartialContext(ctx context.Context, obj *OmitEmptyType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteUintPointer != nil && *obj.FieldGteUintPointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUintPointer must be >= 32"))
+	var errs []error
+	if selection.Has("FieldString") {
+		if obj.FieldString != "" {
+			if !(len(obj.FieldString) >= 3) {
+				errs = append(errs, types.NewValidationError("FieldString length must be >= 3"))
+			}
+		}
+	}
+	if selection.Has("FieldInt") {
+		if obj.FieldInt != 0 {
+			if !(obj.FieldInt >= 10) {
+				errs = append(errs, types.NewValidationError("FieldInt must be >= 10"))
+			}
+		}
+	}
+	if selection.Has("FieldFloat") {
+		if obj.FieldFloat != 0 {
+			if !(obj.FieldFloat <= 1.5) {
+				errs = append(errs, types.NewValidationError("FieldFloat must be <= 1.5"))
+			}
+		}
+	}
+	if selection.Has("FieldBool") {
+		if obj.FieldBool != false {
+			if !(obj.FieldBool == true) {
+				errs = append(errs, types.NewValidationError("FieldBool must be equal to true"))
+			}
+		}
+	}
+	if selection.Has("FieldSlice") {
+		if len(obj.FieldSlice) != 0 {
+			if !(len(obj.FieldSlice) >= 2) {
+				errs = append(errs, types.NewValidationError("FieldSlice must have at least 2 elements"))
+			}
+		}
+	}
+	if selection.Has("FieldMap") {
+		if obj.FieldMap != nil {
+			if !(len(obj.FieldMap) == 1) {
+				errs = append(errs, types.NewValidationError("FieldMap must have exactly 1 elements"))
+			}
+		}
+	}
+	if selection.Has("FieldStringPtr") {
+		if obj.FieldStringPtr != nil {
+			if !((obj.FieldStringPtr != nil && *obj.FieldStringPtr == "a") || (obj.FieldStringPtr != nil && *obj.FieldStringPtr == "b")) {
+				errs = append(errs, types.NewValidationError("FieldStringPtr must be one of 'a' 'b'"))
+			}
+		}
+	}
+	if selection.Has("FieldIntPtr") {
+		if obj.FieldIntPtr != nil {
+			if !(obj.FieldIntPtr != nil && *obj.FieldIntPtr > 0) {
+				errs = append(errs, types.NewValidationError("FieldIntPtr must be > 0"))
+			}
+		}
+	}
+	if selection.Has("FieldBefore") {
+		if !(len(obj.FieldBefore) <= 5) {
+			errs = append(errs, types.NewValidationError("FieldBefore length must be <= 5"))
+		}
+		if obj.FieldBefore != "" {
+			if !(len(obj.FieldBefore) >= 3) {
+				errs = append(errs, types.NewValidationError("FieldBefore length must be >= 3"))
+			}
+		}
+	}
+	if selection.Has("FieldArray") {
+		if !types.IsZeroSlice(obj.FieldArray[:]) {
+			if !(types.SliceOnlyContains(obj.FieldArray[:], []string{"a", "b"})) {
+				errs = append(errs, types.NewValidationError("FieldArray elements must be one of 'a' 'b'"))
+			}
+		}
 	}
-	if !(obj.FieldGteUint8Pointer != nil && *obj.FieldGteUint8Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint8Pointer must be >= 32"))
+	return errs
+}
+func PartialAddressValidate(obj *PartialAddress) []error {
+	return PartialAddressValidateContext(context.Background(), obj)
+}
+
+func PartialAddressValidateContext(ctx context.Context, obj *PartialAddress) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteUint16Pointer != nil && *obj.FieldGteUint16Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint16Pointer must be >= 32"))
+	var errs []error
+	if !(obj.Street != "") {
+		errs = append(errs, types.NewValidationError("Street is required"))
 	}
-	if !(obj.FieldGteUint32Pointer != nil && *obj.FieldGteUint32Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint32Pointer must be >= 32"))
+	if !(obj.City != "") {
+		errs = append(errs, types.NewValidationError("City is required"))
 	}
-	if !(obj.FieldGteUint64Pointer != nil && *obj.FieldGteUint64Pointer >= 32) {
-		errs = append(errs, types.NewValidationError("FieldGteUint64Pointer must be >= 32"))
+	return errs
+}
+
+func PartialAddressValidateFields(obj *PartialAddress, fields ...string) []error {
+	return PartialAddressValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func PartialAddressValidateExcept(obj *PartialAddress, fields ...string) []error {
+	return PartialAddressValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func PartialAddressValidatePartialContext(ctx context.Context, obj *PartialAddress, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldGteFloat32Pointer != nil && *obj.FieldGteFloat32Pointer >= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGteFloat32Pointer must be >= 12.34"))
+	var errs []error
+	if selection.Has("Street") {
+		if !(obj.Street != "") {
+			errs = append(errs, types.NewValidationError("Street is required"))
+		}
 	}
-	if !(obj.FieldGteFloat64Pointer != nil && *obj.FieldGteFloat64Pointer >= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldGteFloat64Pointer must be >= 12.34"))
+	if selection.Has("City") {
+		if !(obj.City != "") {
+			errs = append(errs, types.NewValidationError("City is required"))
+		}
 	}
 	return errs
 }
-func inStructFieldsValidate(obj *inStructFields) []error {
-	var errs []error
-	if !(obj.FieldInString == "ab" || obj.FieldInString == "cd" || obj.FieldInString == "ef") {
-		errs = append(errs, types.NewValidationError("FieldInString must be one of 'ab' 'cd' 'ef'"))
+func PartialUserValidate(obj *PartialUser) []error {
+	return PartialUserValidateContext(context.Background(), obj)
+}
+
+func PartialUserValidateContext(ctx context.Context, obj *PartialUser) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt == 12 || obj.FieldInInt == 34 || obj.FieldInInt == 56) {
-		errs = append(errs, types.NewValidationError("FieldInInt must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.UserName != "") {
+		errs = append(errs, types.NewValidationError("UserName is required"))
 	}
-	if !(obj.FieldInInt8 == 12 || obj.FieldInInt8 == 34 || obj.FieldInInt8 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInInt8 must be one of '12' '34' '56'"))
+	if !(len(obj.UserName) >= 5) {
+		errs = append(errs, types.NewValidationError("UserName length must be >= 5"))
 	}
-	if !(obj.FieldInInt16 == 12 || obj.FieldInInt16 == 34 || obj.FieldInInt16 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInInt16 must be one of '12' '34' '56'"))
+	if !(obj.Password != "") {
+		errs = append(errs, types.NewValidationError("Password is required"))
 	}
-	if !(obj.FieldInInt32 == 12 || obj.FieldInInt32 == 34 || obj.FieldInInt32 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInInt32 must be one of '12' '34' '56'"))
+	if !(obj.ConfirmPassword == obj.Password) {
+		errs = append(errs, types.NewValidationError("ConfirmPassword must be equal to Password"))
 	}
-	if !(obj.FieldInInt64 == 12 || obj.FieldInInt64 == 34 || obj.FieldInInt64 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInInt64 must be one of '12' '34' '56'"))
+	errs = append(errs, PartialAddressValidateContext(ctx, &obj.Address)...)
+	return errs
+}
+
+func PartialUserValidateFields(obj *PartialUser, fields ...string) []error {
+	return PartialUserValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func PartialUserValidateExcept(obj *PartialUser, fields ...string) []error {
+	return PartialUserValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func PartialUserValidatePartialContext(ctx context.Context, obj *PartialUser, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint == 12 || obj.FieldInUint == 34 || obj.FieldInUint == 56) {
-		errs = append(errs, types.NewValidationError("FieldInUint must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("UserName") {
+		if !(obj.UserName != "") {
+			errs = append(errs, types.NewValidationError("UserName is required"))
+		}
+		if !(len(obj.UserName) >= 5) {
+			errs = append(errs, types.NewValidationError("UserName length must be >= 5"))
+		}
+	}
+	if selection.Has("Password") {
+		if !(obj.Password != "") {
+			errs = append(errs, types.NewValidationError("Password is required"))
+		}
+	}
+	if selection.Has("ConfirmPassword") {
+		if selection.Has("Password") && !(obj.ConfirmPassword == obj.Password) {
+			errs = append(errs, types.NewValidationError("ConfirmPassword must be equal to Password"))
+		}
+	}
+	if selection.Has("Address") {
+		errs = append(errs, PartialAddressValidatePartialContext(ctx, &obj.Address, selection.Nested("Address"))...)
 	}
-	if !(obj.FieldInUint8 == 12 || obj.FieldInUint8 == 34 || obj.FieldInUint8 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInUint8 must be one of '12' '34' '56'"))
+	return errs
+}
+func PaymentValidate(obj *Payment) []error {
+	return PaymentValidateContext(context.Background(), obj)
+}
+
+func PaymentValidateContext(ctx context.Context, obj *Payment) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint16 == 12 || obj.FieldInUint16 == 34 || obj.FieldInUint16 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInUint16 must be one of '12' '34' '56'"))
+	var errs []error
+	if types.CountTrue(obj.Card != nil, obj.Pix != nil, obj.Boleto != nil) != 1 {
+		errs = append(errs, types.NewValidationError("payment must have exactly one of 'Card' 'Pix' 'Boleto'"))
 	}
-	if !(obj.FieldInUint32 == 12 || obj.FieldInUint32 == 34 || obj.FieldInUint32 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInUint32 must be one of '12' '34' '56'"))
+	if obj.Card != nil {
+		errs = append(errs, PaymentCardValidateContext(ctx, obj.Card)...)
 	}
-	if !(obj.FieldInUint64 == 12 || obj.FieldInUint64 == 34 || obj.FieldInUint64 == 56) {
-		errs = append(errs, types.NewValidationError("FieldInUint64 must be one of '12' '34' '56'"))
+	if types.CountTrue(obj.Email != "", obj.Phone != "") == 0 {
+		errs = append(errs, types.NewValidationError("contact must have at least one of 'Email' 'Phone'"))
 	}
-	if !(obj.FieldInFloat32 == 11.11 || obj.FieldInFloat32 == 22.22 || obj.FieldInFloat32 == 33.33) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32 must be one of '11.11' '22.22' '33.33'"))
+	if types.CountTrue(obj.Coupon != "", obj.Voucher != nil) > 1 {
+		errs = append(errs, types.NewValidationError("discount must have at most one of 'Coupon' 'Voucher'"))
 	}
-	if !(obj.FieldInFloat64 == 11.11 || obj.FieldInFloat64 == 22.22 || obj.FieldInFloat64 == 33.33) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64 must be one of '11.11' '22.22' '33.33'"))
+	if types.CountTrue(obj.Latitude != nil, obj.Longitude != nil)%2 != 0 {
+		errs = append(errs, types.NewValidationError("location must have all or none of 'Latitude' 'Longitude'"))
 	}
-	if !(obj.FieldInBool == true) {
-		errs = append(errs, types.NewValidationError("FieldInBool must be one of 'true'"))
+	return errs
+}
+
+func PaymentValidateFields(obj *Payment, fields ...string) []error {
+	return PaymentValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func PaymentValidateExcept(obj *Payment, fields ...string) []error {
+	return PaymentValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func PaymentValidatePartialContext(ctx context.Context, obj *Payment, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInStringSlice, []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringSlice elements must be one of 'ab' 'cd' 'ef'"))
+	var errs []error
+	if selection.Has("Card") {
+		if selection.Has("Pix") && selection.Has("Boleto") && types.CountTrue(obj.Card != nil, obj.Pix != nil, obj.Boleto != nil) != 1 {
+			errs = append(errs, types.NewValidationError("payment must have exactly one of 'Card' 'Pix' 'Boleto'"))
+		}
+		if obj.Card != nil {
+			errs = append(errs, PaymentCardValidatePartialContext(ctx, obj.Card, selection.Nested("Card"))...)
+		}
+	}
+	if selection.Has("Email") {
+		if selection.Has("Phone") && types.CountTrue(obj.Email != "", obj.Phone != "") == 0 {
+			errs = append(errs, types.NewValidationError("contact must have at least one of 'Email' 'Phone'"))
+		}
+	}
+	if selection.Has("Coupon") {
+		if selection.Has("Voucher") && types.CountTrue(obj.Coupon != "", obj.Voucher != nil) > 1 {
+			errs = append(errs, types.NewValidationError("discount must have at most one of 'Coupon' 'Voucher'"))
+		}
+	}
+	if selection.Has("Latitude") {
+		if selection.Has("Longitude") && types.CountTrue(obj.Latitude != nil, obj.Longitude != nil)%2 != 0 {
+			errs = append(errs, types.NewValidationError("location must have all or none of 'Latitude' 'Longitude'"))
+		}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInIntSlice, []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInIntSlice elements must be one of '12' '34' '56'"))
+	return errs
+}
+func PaymentCardValidate(obj *PaymentCard) []error {
+	return PaymentCardValidateContext(context.Background(), obj)
+}
+
+func PaymentCardValidateContext(ctx context.Context, obj *PaymentCard) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt8Slice, []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8Slice elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.Number != "") {
+		errs = append(errs, types.NewValidationError("Number is required"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt16Slice, []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16Slice elements must be one of '12' '34' '56'"))
+	return errs
+}
+
+func PaymentCardValidateFields(obj *PaymentCard, fields ...string) []error {
+	return PaymentCardValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func PaymentCardValidateExcept(obj *PaymentCard, fields ...string) []error {
+	return PaymentCardValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func PaymentCardValidatePartialContext(ctx context.Context, obj *PaymentCard, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt32Slice, []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32Slice elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("Number") {
+		if !(obj.Number != "") {
+			errs = append(errs, types.NewValidationError("Number is required"))
+		}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt64Slice, []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64Slice elements must be one of '12' '34' '56'"))
+	return errs
+}
+func RegexTypeValidate(obj *RegexType) []error {
+	return RegexTypeValidateContext(context.Background(), obj)
+}
+
+func RegexTypeValidateContext(ctx context.Context, obj *RegexType) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUintSlice, []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUintSlice elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(validgenRegex5b8f7731.MatchString(obj.SKU)) {
+		errs = append(errs, types.NewValidationError("SKU must match the pattern '^[A-Z]{3}-\\d{4,6}$'"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint8Slice, []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8Slice elements must be one of '12' '34' '56'"))
+	if !(obj.Slug != nil && validgenRegexdea5200b.MatchString(*obj.Slug)) {
+		errs = append(errs, types.NewValidationError("Slug must match the pattern '^[a-z0-9]+(-[a-z0-9]+)*$'"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint16Slice, []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16Slice elements must be one of '12' '34' '56'"))
+	if !(len(obj.Tags) >= 1) {
+		errs = append(errs, types.NewValidationError("Tags must have at least 1 elements"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint32Slice, []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32Slice elements must be one of '12' '34' '56'"))
+	for i := range obj.Tags {
+		if !(validgenRegex36f71598.MatchString(obj.Tags[i])) {
+			errs = append(errs, types.NewValidationError("Tags[%d] must match the pattern '^[a-z]{2,10}$'", i))
+		}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint64Slice, []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64Slice elements must be one of '12' '34' '56'"))
+	for i := range obj.Codes {
+		if !(obj.Codes[i] >= 10) {
+			errs = append(errs, types.NewValidationError("Codes[%d] must be >= 10", i))
+		}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInFloat32Slice, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32Slice elements must be one of '11.11' '22.22' '33.33'"))
+	if !(validgenRegex36f71598.MatchString(obj.Alias)) {
+		errs = append(errs, types.NewValidationError("Alias must match the pattern '^[a-z]{2,10}$'"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInFloat64Slice, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64Slice elements must be one of '11.11' '22.22' '33.33'"))
+	if !(validgenRegex9a5da842.MatchString(obj.Quote)) {
+		errs = append(errs, types.NewValidationError("Quote must match the pattern '^[a-z]+',[a-z]+$'"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInBoolSlice, []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolSlice elements must be one of 'true'"))
+	if !(obj.Quote != "") {
+		errs = append(errs, types.NewValidationError("Quote is required"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInStringArray[:], []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringArray elements must be one of 'ab' 'cd' 'ef'"))
+	return errs
+}
+
+func RegexTypeValidateFields(obj *RegexType, fields ...string) []error {
+	return RegexTypeValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func RegexTypeValidateExcept(obj *RegexType, fields ...string) []error {
+	return RegexTypeValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func RegexTypeValidatePartialContext(ctx context.Context, obj *RegexType, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInIntArray[:], []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInIntArray elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("SKU") {
+		if !(validgenRegex5b8f7731.MatchString(obj.SKU)) {
+			errs = append(errs, types.NewValidationError("SKU must match the pattern '^[A-Z]{3}-\\d{4,6}$'"))
+		}
+	}
+	if selection.Has("Slug") {
+		if !(obj.Slug != nil && validgenRegexdea5200b.MatchString(*obj.Slug)) {
+			errs = append(errs, types.NewValidationError("Slug must match the pattern '^[a-z0-9]+(-[a-z0-9]+)*$'"))
+		}
+	}
+	if selection.Has("Tags") {
+		if !(len(obj.Tags) >= 1) {
+			errs = append(errs, types.NewValidationError("Tags must have at least 1 elements"))
+		}
+		for i := range obj.Tags {
+			if !(validgenRegex36f71598.MatchString(obj.Tags[i])) {
+				errs = append(errs, types.NewValidationError("Tags[%d] must match the pattern '^[a-z]{2,10}$'", i))
+			}
+		}
+	}
+	if selection.Has("Codes") {
+		for i := range obj.Codes {
+			if !(obj.Codes[i] >= 10) {
+				errs = append(errs, types.NewValidationError("Codes[%d] must be >= 10", i))
+			}
+		}
+	}
+	if selection.Has("Alias") {
+		if !(validgenRegex36f71598.MatchString(obj.Alias)) {
+			errs = append(errs, types.NewValidationError("Alias must match the pattern '^[a-z]{2,10}$'"))
+		}
+	}
+	if selection.Has("Quote") {
+		if !(validgenRegex9a5da842.MatchString(obj.Quote)) {
+			errs = append(errs, types.NewValidationError("Quote must match the pattern '^[a-z]+',[a-z]+$'"))
+		}
+		if !(obj.Quote != "") {
+			errs = append(errs, types.NewValidationError("Quote is required"))
+		}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt8Array[:], []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8Array elements must be one of '12' '34' '56'"))
+	return errs
+}
+func UserValidate(obj *User) []error {
+	return UserValidateContext(context.Background(), obj)
+}
+
+func UserValidateContext(ctx context.Context, obj *User) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt16Array[:], []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16Array elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FirstName != "") {
+		errs = append(errs, types.NewValidationError("FirstName is required"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt32Array[:], []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32Array elements must be one of '12' '34' '56'"))
+	if !(obj.Age >= 18) {
+		errs = append(errs, types.NewValidationError("Age must be >= 18"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInInt64Array[:], []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64Array elements must be one of '12' '34' '56'"))
+	if !(obj.Age <= 130) {
+		errs = append(errs, types.NewValidationError("Age must be <= 130"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUintArray[:], []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUintArray elements must be one of '12' '34' '56'"))
+	errs = append(errs, AddressValidateContext(ctx, &obj.Address)...)
+	return errs
+}
+
+func UserValidateFields(obj *User, fields ...string) []error {
+	return UserValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func UserValidateExcept(obj *User, fields ...string) []error {
+	return UserValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func UserValidatePartialContext(ctx context.Context, obj *User, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint8Array[:], []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8Array elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("Age") {
+		if !(obj.Age >= 18) {
+			errs = append(errs, types.NewValidationError("Age must be >= 18"))
+		}
+		if !(obj.Age <= 130) {
+			errs = append(errs, types.NewValidationError("Age must be <= 130"))
+		}
+	}
+	if selection.Has("Address") {
+		errs = append(errs, AddressValidatePartialContext(ctx, &obj.Address, selection.Nested("Address"))...)
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint16Array[:], []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16Array elements must be one of '12' '34' '56'"))
+	return errs
+}
+func UserWithAddressPointerValidate(obj *UserWithAddressPointer) []error {
+	return UserWithAddressPointerValidateContext(context.Background(), obj)
+}
+
+func UserWithAddressPointerValidateContext(ctx context.Context, obj *UserWithAddressPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint32Array[:], []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32Array elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FirstName != "") {
+		errs = append(errs, types.NewValidationError("FirstName is required"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInUint64Array[:], []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64Array elements must be one of '12' '34' '56'"))
+	if !(obj.Address != nil) {
+		errs = append(errs, types.NewValidationError("Address is required"))
 	}
-	if !(types.SliceOnlyContains(obj.FieldInFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32Array elements must be one of '11.11' '22.22' '33.33'"))
+	if obj.Address != nil {
+		errs = append(errs, AddressValidateContext(ctx, obj.Address)...)
 	}
-	if !(types.SliceOnlyContains(obj.FieldInFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64Array elements must be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func UserWithAddressPointerValidateFields(obj *UserWithAddressPointer, fields ...string) []error {
+	return UserWithAddressPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func UserWithAddressPointerValidateExcept(obj *UserWithAddressPointer, fields ...string) []error {
+	return UserWithAddressPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func UserWithAddressPointerValidatePartialContext(ctx context.Context, obj *UserWithAddressPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceOnlyContains(obj.FieldInBoolArray[:], []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolArray elements must be one of 'true'"))
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("Address") {
+		if !(obj.Address != nil) {
+			errs = append(errs, types.NewValidationError("Address is required"))
+		}
+		if obj.Address != nil {
+			errs = append(errs, AddressValidatePartialContext(ctx, obj.Address, selection.Nested("Address"))...)
+		}
 	}
-	if !(types.MapOnlyContains(obj.FieldInStringMap, []string{"a", "b", "c"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringMap elements must be one of 'a' 'b' 'c'"))
+	return errs
+}
+func UserWithStructInPkgValidate(obj *UserWithStructInPkg) []error {
+	return UserWithStructInPkgValidateContext(context.Background(), obj)
+}
+
+func UserWithStructInPkgValidateContext(ctx context.Context, obj *UserWithStructInPkg) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInIntMap, []int{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInIntMap elements must be one of '1' '2' '3'"))
+	var errs []error
+	if !(obj.FirstName != "") {
+		errs = append(errs, types.NewValidationError("FirstName is required"))
 	}
-	if !(types.MapOnlyContains(obj.FieldInInt8Map, []int8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8Map elements must be one of '1' '2' '3'"))
+	if !(obj.Age >= 18) {
+		errs = append(errs, types.NewValidationError("Age must be >= 18"))
 	}
-	if !(types.MapOnlyContains(obj.FieldInInt16Map, []int16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16Map elements must be one of '1' '2' '3'"))
+	if !(obj.Age <= 130) {
+		errs = append(errs, types.NewValidationError("Age must be <= 130"))
 	}
-	if !(types.MapOnlyContains(obj.FieldInInt32Map, []int32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32Map elements must be one of '1' '2' '3'"))
+	errs = append(errs, structsinpkg.AddressValidateContext(ctx, &obj.Address)...)
+	return errs
+}
+
+func UserWithStructInPkgValidateFields(obj *UserWithStructInPkg, fields ...string) []error {
+	return UserWithStructInPkgValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func UserWithStructInPkgValidateExcept(obj *UserWithStructInPkg, fields ...string) []error {
+	return UserWithStructInPkgValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func UserWithStructInPkgValidatePartialContext(ctx context.Context, obj *UserWithStructInPkg, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInInt64Map, []int64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64Map elements must be one of '1' '2' '3'"))
+	var errs []error
+	if selection.Has("FirstName") {
+		if !(obj.FirstName != "") {
+			errs = append(errs, types.NewValidationError("FirstName is required"))
+		}
+	}
+	if selection.Has("Age") {
+		if !(obj.Age >= 18) {
+			errs = append(errs, types.NewValidationError("Age must be >= 18"))
+		}
+		if !(obj.Age <= 130) {
+			errs = append(errs, types.NewValidationError("Age must be <= 130"))
+		}
+	}
+	if selection.Has("Address") {
+		errs = append(errs, structsinpkg.AddressValidatePartialContext(ctx, &obj.Address, selection.Nested("Address"))...)
 	}
-	if !(types.MapOnlyContains(obj.FieldInUintMap, []uint{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUintMap elements must be one of '1' '2' '3'"))
+	return errs
+}
+func alphaStructFieldsValidate(obj *alphaStructFields) []error {
+	return alphaStructFieldsValidateContext(context.Background(), obj)
+}
+
+func alphaStructFieldsValidateContext(ctx context.Context, obj *alphaStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInUint8Map, []uint8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8Map elements must be one of '1' '2' '3'"))
+	var errs []error
+	if !(types.IsAlpha(obj.FieldAlphaString)) {
+		errs = append(errs, types.NewValidationError("FieldAlphaString must contain only ASCII letters"))
 	}
-	if !(types.MapOnlyContains(obj.FieldInUint16Map, []uint16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16Map elements must be one of '1' '2' '3'"))
+	return errs
+}
+
+func alphaStructFieldsValidateFields(obj *alphaStructFields, fields ...string) []error {
+	return alphaStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphaStructFieldsValidateExcept(obj *alphaStructFields, fields ...string) []error {
+	return alphaStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphaStructFieldsValidatePartialContext(ctx context.Context, obj *alphaStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInUint32Map, []uint32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32Map elements must be one of '1' '2' '3'"))
+	var errs []error
+	if selection.Has("FieldAlphaString") {
+		if !(types.IsAlpha(obj.FieldAlphaString)) {
+			errs = append(errs, types.NewValidationError("FieldAlphaString must contain only ASCII letters"))
+		}
 	}
-	if !(types.MapOnlyContains(obj.FieldInUint64Map, []uint64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64Map elements must be one of '1' '2' '3'"))
+	return errs
+}
+func alphaStructFieldsPointerValidate(obj *alphaStructFieldsPointer) []error {
+	return alphaStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func alphaStructFieldsPointerValidateContext(ctx context.Context, obj *alphaStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInFloat32Map, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32Map elements must be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if !(obj.FieldAlphaStringPointer != nil && types.IsAlpha(*obj.FieldAlphaStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldAlphaStringPointer must contain only ASCII letters"))
 	}
-	if !(types.MapOnlyContains(obj.FieldInFloat64Map, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64Map elements must be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func alphaStructFieldsPointerValidateFields(obj *alphaStructFieldsPointer, fields ...string) []error {
+	return alphaStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphaStructFieldsPointerValidateExcept(obj *alphaStructFieldsPointer, fields ...string) []error {
+	return alphaStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphaStructFieldsPointerValidatePartialContext(ctx context.Context, obj *alphaStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.MapOnlyContains(obj.FieldInBoolMap, []bool{false})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolMap elements must be one of 'false'"))
+	var errs []error
+	if selection.Has("FieldAlphaStringPointer") {
+		if !(obj.FieldAlphaStringPointer != nil && types.IsAlpha(*obj.FieldAlphaStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldAlphaStringPointer must contain only ASCII letters"))
+		}
 	}
 	return errs
 }
-func inStructFieldsPointerValidate(obj *inStructFieldsPointer) []error {
+func alphanumStructFieldsValidate(obj *alphanumStructFields) []error {
+	return alphanumStructFieldsValidateContext(context.Background(), obj)
+}
+
+func alphanumStructFieldsValidateContext(ctx context.Context, obj *alphanumStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !((obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ab") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "cd") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ef")) {
-		errs = append(errs, types.NewValidationError("FieldInStringPointer must be one of 'ab' 'cd' 'ef'"))
+	if !(types.IsAlphanumeric(obj.FieldAlphanumString)) {
+		errs = append(errs, types.NewValidationError("FieldAlphanumString must contain only ASCII letters and digits"))
 	}
-	if !((obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 12) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 34) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInIntPointer must be one of '12' '34' '56'"))
+	return errs
+}
+
+func alphanumStructFieldsValidateFields(obj *alphanumStructFields, fields ...string) []error {
+	return alphanumStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphanumStructFieldsValidateExcept(obj *alphanumStructFields, fields ...string) []error {
+	return alphanumStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphanumStructFieldsValidatePartialContext(ctx context.Context, obj *alphanumStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 12) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 34) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInInt8Pointer must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAlphanumString") {
+		if !(types.IsAlphanumeric(obj.FieldAlphanumString)) {
+			errs = append(errs, types.NewValidationError("FieldAlphanumString must contain only ASCII letters and digits"))
+		}
 	}
-	if !((obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 12) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 34) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInInt16Pointer must be one of '12' '34' '56'"))
+	return errs
+}
+func alphanumStructFieldsPointerValidate(obj *alphanumStructFieldsPointer) []error {
+	return alphanumStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func alphanumStructFieldsPointerValidateContext(ctx context.Context, obj *alphanumStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 12) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 34) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInInt32Pointer must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldAlphanumStringPointer != nil && types.IsAlphanumeric(*obj.FieldAlphanumStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldAlphanumStringPointer must contain only ASCII letters and digits"))
 	}
-	if !((obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 12) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 34) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInInt64Pointer must be one of '12' '34' '56'"))
+	return errs
+}
+
+func alphanumStructFieldsPointerValidateFields(obj *alphanumStructFieldsPointer, fields ...string) []error {
+	return alphanumStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphanumStructFieldsPointerValidateExcept(obj *alphanumStructFieldsPointer, fields ...string) []error {
+	return alphanumStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphanumStructFieldsPointerValidatePartialContext(ctx context.Context, obj *alphanumStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 12) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 34) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInUintPointer must be one of '12' '34' '56'"))
-	}
-	if !((obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 12) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 34) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInUint8Pointer must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAlphanumStringPointer") {
+		if !(obj.FieldAlphanumStringPointer != nil && types.IsAlphanumeric(*obj.FieldAlphanumStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldAlphanumStringPointer must contain only ASCII letters and digits"))
+		}
 	}
-	if !((obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 12) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 34) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInUint16Pointer must be one of '12' '34' '56'"))
+	return errs
+}
+func alphanumunicodeStructFieldsValidate(obj *alphanumunicodeStructFields) []error {
+	return alphanumunicodeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func alphanumunicodeStructFieldsValidateContext(ctx context.Context, obj *alphanumunicodeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 12) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 34) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInUint32Pointer must be one of '12' '34' '56'"))
+	var errs []error
+	if !(types.IsAlphanumericUnicode(obj.FieldAlphanumunicodeString)) {
+		errs = append(errs, types.NewValidationError("FieldAlphanumunicodeString must contain only letters and numbers"))
 	}
-	if !((obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 12) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 34) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 56)) {
-		errs = append(errs, types.NewValidationError("FieldInUint64Pointer must be one of '12' '34' '56'"))
+	return errs
+}
+
+func alphanumunicodeStructFieldsValidateFields(obj *alphanumunicodeStructFields, fields ...string) []error {
+	return alphanumunicodeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphanumunicodeStructFieldsValidateExcept(obj *alphanumunicodeStructFields, fields ...string) []error {
+	return alphanumunicodeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphanumunicodeStructFieldsValidatePartialContext(ctx context.Context, obj *alphanumunicodeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 11.11) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 22.22) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 33.33)) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32Pointer must be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if selection.Has("FieldAlphanumunicodeString") {
+		if !(types.IsAlphanumericUnicode(obj.FieldAlphanumunicodeString)) {
+			errs = append(errs, types.NewValidationError("FieldAlphanumunicodeString must contain only letters and numbers"))
+		}
 	}
-	if !((obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 11.11) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 22.22) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 33.33)) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64Pointer must be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+func alphanumunicodeStructFieldsPointerValidate(obj *alphanumunicodeStructFieldsPointer) []error {
+	return alphanumunicodeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func alphanumunicodeStructFieldsPointerValidateContext(ctx context.Context, obj *alphanumunicodeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInBoolPointer != nil && *obj.FieldInBoolPointer == true) {
-		errs = append(errs, types.NewValidationError("FieldInBoolPointer must be one of 'true'"))
+	var errs []error
+	if !(obj.FieldAlphanumunicodeStringPointer != nil && types.IsAlphanumericUnicode(*obj.FieldAlphanumunicodeStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldAlphanumunicodeStringPointer must contain only letters and numbers"))
 	}
-	if !(obj.FieldInStringSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInStringSlicePointer, []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringSlicePointer elements must be one of 'ab' 'cd' 'ef'"))
+	return errs
+}
+
+func alphanumunicodeStructFieldsPointerValidateFields(obj *alphanumunicodeStructFieldsPointer, fields ...string) []error {
+	return alphanumunicodeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphanumunicodeStructFieldsPointerValidateExcept(obj *alphanumunicodeStructFieldsPointer, fields ...string) []error {
+	return alphanumunicodeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphanumunicodeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *alphanumunicodeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInIntSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInIntSlicePointer, []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInIntSlicePointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAlphanumunicodeStringPointer") {
+		if !(obj.FieldAlphanumunicodeStringPointer != nil && types.IsAlphanumericUnicode(*obj.FieldAlphanumunicodeStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldAlphanumunicodeStringPointer must contain only letters and numbers"))
+		}
 	}
-	if !(obj.FieldInInt8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt8SlicePointer, []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8SlicePointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+func alphaunicodeStructFieldsValidate(obj *alphaunicodeStructFields) []error {
+	return alphaunicodeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func alphaunicodeStructFieldsValidateContext(ctx context.Context, obj *alphaunicodeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt16SlicePointer, []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16SlicePointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(types.IsAlphaUnicode(obj.FieldAlphaunicodeString)) {
+		errs = append(errs, types.NewValidationError("FieldAlphaunicodeString must contain only letters"))
 	}
-	if !(obj.FieldInInt32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt32SlicePointer, []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32SlicePointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+
+func alphaunicodeStructFieldsValidateFields(obj *alphaunicodeStructFields, fields ...string) []error {
+	return alphaunicodeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphaunicodeStructFieldsValidateExcept(obj *alphaunicodeStructFields, fields ...string) []error {
+	return alphaunicodeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphaunicodeStructFieldsValidatePartialContext(ctx context.Context, obj *alphaunicodeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt64SlicePointer, []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64SlicePointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAlphaunicodeString") {
+		if !(types.IsAlphaUnicode(obj.FieldAlphaunicodeString)) {
+			errs = append(errs, types.NewValidationError("FieldAlphaunicodeString must contain only letters"))
+		}
 	}
-	if !(obj.FieldInUintSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUintSlicePointer, []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUintSlicePointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+func alphaunicodeStructFieldsPointerValidate(obj *alphaunicodeStructFieldsPointer) []error {
+	return alphaunicodeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func alphaunicodeStructFieldsPointerValidateContext(ctx context.Context, obj *alphaunicodeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint8SlicePointer, []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8SlicePointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldAlphaunicodeStringPointer != nil && types.IsAlphaUnicode(*obj.FieldAlphaunicodeStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldAlphaunicodeStringPointer must contain only letters"))
 	}
-	if !(obj.FieldInUint16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint16SlicePointer, []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16SlicePointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+
+func alphaunicodeStructFieldsPointerValidateFields(obj *alphaunicodeStructFieldsPointer, fields ...string) []error {
+	return alphaunicodeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func alphaunicodeStructFieldsPointerValidateExcept(obj *alphaunicodeStructFieldsPointer, fields ...string) []error {
+	return alphaunicodeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func alphaunicodeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *alphaunicodeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint32SlicePointer, []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32SlicePointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAlphaunicodeStringPointer") {
+		if !(obj.FieldAlphaunicodeStringPointer != nil && types.IsAlphaUnicode(*obj.FieldAlphaunicodeStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldAlphaunicodeStringPointer must contain only letters"))
+		}
 	}
-	if !(obj.FieldInUint64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint64SlicePointer, []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64SlicePointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+func asciiStructFieldsValidate(obj *asciiStructFields) []error {
+	return asciiStructFieldsValidateContext(context.Background(), obj)
+}
+
+func asciiStructFieldsValidateContext(ctx context.Context, obj *asciiStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInFloat32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if !(types.IsASCII(obj.FieldAsciiString)) {
+		errs = append(errs, types.NewValidationError("FieldAsciiString must contain only ASCII characters"))
 	}
-	if !(obj.FieldInFloat64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func asciiStructFieldsValidateFields(obj *asciiStructFields, fields ...string) []error {
+	return asciiStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func asciiStructFieldsValidateExcept(obj *asciiStructFields, fields ...string) []error {
+	return asciiStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func asciiStructFieldsValidatePartialContext(ctx context.Context, obj *asciiStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInBoolSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInBoolSlicePointer, []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolSlicePointer elements must be one of 'true'"))
+	var errs []error
+	if selection.Has("FieldAsciiString") {
+		if !(types.IsASCII(obj.FieldAsciiString)) {
+			errs = append(errs, types.NewValidationError("FieldAsciiString must contain only ASCII characters"))
+		}
 	}
-	if !(obj.FieldInStringArrayPointer != nil && types.SliceOnlyContains(obj.FieldInStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringArrayPointer elements must be one of 'ab' 'cd' 'ef'"))
+	return errs
+}
+func asciiStructFieldsPointerValidate(obj *asciiStructFieldsPointer) []error {
+	return asciiStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func asciiStructFieldsPointerValidateContext(ctx context.Context, obj *asciiStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInIntArrayPointer != nil && types.SliceOnlyContains(obj.FieldInIntArrayPointer[:], []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInIntArrayPointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldAsciiStringPointer != nil && types.IsASCII(*obj.FieldAsciiStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldAsciiStringPointer must contain only ASCII characters"))
 	}
-	if !(obj.FieldInInt8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt8ArrayPointer[:], []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8ArrayPointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+
+func asciiStructFieldsPointerValidateFields(obj *asciiStructFieldsPointer, fields ...string) []error {
+	return asciiStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func asciiStructFieldsPointerValidateExcept(obj *asciiStructFieldsPointer, fields ...string) []error {
+	return asciiStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func asciiStructFieldsPointerValidatePartialContext(ctx context.Context, obj *asciiStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt16ArrayPointer[:], []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16ArrayPointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldAsciiStringPointer") {
+		if !(obj.FieldAsciiStringPointer != nil && types.IsASCII(*obj.FieldAsciiStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldAsciiStringPointer must contain only ASCII characters"))
+		}
 	}
-	if !(obj.FieldInInt32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt32ArrayPointer[:], []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32ArrayPointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+func base64StructFieldsValidate(obj *base64StructFields) []error {
+	return base64StructFieldsValidateContext(context.Background(), obj)
+}
+
+func base64StructFieldsValidateContext(ctx context.Context, obj *base64StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt64ArrayPointer[:], []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64ArrayPointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if !(types.IsValidBase64(obj.FieldBase64String)) {
+		errs = append(errs, types.NewValidationError("FieldBase64String must be a valid base64 string"))
 	}
-	if !(obj.FieldInUintArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUintArrayPointer[:], []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUintArrayPointer elements must be one of '12' '34' '56'"))
+	if !(types.IsValidBase64(string(obj.FieldBase64ByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldBase64ByteSlice must be a valid base64 string"))
 	}
-	if !(obj.FieldInUint8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint8ArrayPointer[:], []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8ArrayPointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+
+func base64StructFieldsValidateFields(obj *base64StructFields, fields ...string) []error {
+	return base64StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64StructFieldsValidateExcept(obj *base64StructFields, fields ...string) []error {
+	return base64StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64StructFieldsValidatePartialContext(ctx context.Context, obj *base64StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint16ArrayPointer[:], []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16ArrayPointer elements must be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldBase64String") {
+		if !(types.IsValidBase64(obj.FieldBase64String)) {
+			errs = append(errs, types.NewValidationError("FieldBase64String must be a valid base64 string"))
+		}
 	}
-	if !(obj.FieldInUint32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint32ArrayPointer[:], []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32ArrayPointer elements must be one of '12' '34' '56'"))
+	if selection.Has("FieldBase64ByteSlice") {
+		if !(types.IsValidBase64(string(obj.FieldBase64ByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldBase64ByteSlice must be a valid base64 string"))
+		}
 	}
-	if !(obj.FieldInUint64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint64ArrayPointer[:], []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64ArrayPointer elements must be one of '12' '34' '56'"))
+	return errs
+}
+func base64StructFieldsPointerValidate(obj *base64StructFieldsPointer) []error {
+	return base64StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func base64StructFieldsPointerValidateContext(ctx context.Context, obj *base64StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInFloat32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if !(obj.FieldBase64StringPointer != nil && types.IsValidBase64(*obj.FieldBase64StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldBase64StringPointer must be a valid base64 string"))
 	}
-	if !(obj.FieldInFloat64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldBase64ByteSlicePointer != nil && types.IsValidBase64(string(*obj.FieldBase64ByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldBase64ByteSlicePointer must be a valid base64 string"))
 	}
-	if !(obj.FieldInBoolArrayPointer != nil && types.SliceOnlyContains(obj.FieldInBoolArrayPointer[:], []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolArrayPointer elements must be one of 'true'"))
+	return errs
+}
+
+func base64StructFieldsPointerValidateFields(obj *base64StructFieldsPointer, fields ...string) []error {
+	return base64StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64StructFieldsPointerValidateExcept(obj *base64StructFieldsPointer, fields ...string) []error {
+	return base64StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64StructFieldsPointerValidatePartialContext(ctx context.Context, obj *base64StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInStringMapPointer != nil && types.MapOnlyContains(*obj.FieldInStringMapPointer, []string{"a", "b", "c"})) {
-		errs = append(errs, types.NewValidationError("FieldInStringMapPointer elements must be one of 'a' 'b' 'c'"))
+	var errs []error
+	if selection.Has("FieldBase64StringPointer") {
+		if !(obj.FieldBase64StringPointer != nil && types.IsValidBase64(*obj.FieldBase64StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldBase64StringPointer must be a valid base64 string"))
+		}
 	}
-	if !(obj.FieldInIntMapPointer != nil && types.MapOnlyContains(*obj.FieldInIntMapPointer, []int{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInIntMapPointer elements must be one of '1' '2' '3'"))
+	if selection.Has("FieldBase64ByteSlicePointer") {
+		if !(obj.FieldBase64ByteSlicePointer != nil && types.IsValidBase64(string(*obj.FieldBase64ByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldBase64ByteSlicePointer must be a valid base64 string"))
+		}
 	}
-	if !(obj.FieldInInt8MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt8MapPointer, []int8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt8MapPointer elements must be one of '1' '2' '3'"))
+	return errs
+}
+func base64rawurlStructFieldsValidate(obj *base64rawurlStructFields) []error {
+	return base64rawurlStructFieldsValidateContext(context.Background(), obj)
+}
+
+func base64rawurlStructFieldsValidateContext(ctx context.Context, obj *base64rawurlStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInInt16MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt16MapPointer, []int16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt16MapPointer elements must be one of '1' '2' '3'"))
+	var errs []error
+	if !(types.IsValidBase64RawURL(obj.FieldBase64rawurlString)) {
+		errs = append(errs, types.NewValidationError("FieldBase64rawurlString must be a valid raw base64 URL string"))
 	}
-	if !(obj.FieldInInt32MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt32MapPointer, []int32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt32MapPointer elements must be one of '1' '2' '3'"))
+	if !(types.IsValidBase64RawURL(string(obj.FieldBase64rawurlByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlice must be a valid raw base64 URL string"))
 	}
-	if !(obj.FieldInInt64MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt64MapPointer, []int64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInInt64MapPointer elements must be one of '1' '2' '3'"))
+	return errs
+}
+
+func base64rawurlStructFieldsValidateFields(obj *base64rawurlStructFields, fields ...string) []error {
+	return base64rawurlStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64rawurlStructFieldsValidateExcept(obj *base64rawurlStructFields, fields ...string) []error {
+	return base64rawurlStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64rawurlStructFieldsValidatePartialContext(ctx context.Context, obj *base64rawurlStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUintMapPointer != nil && types.MapOnlyContains(*obj.FieldInUintMapPointer, []uint{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUintMapPointer elements must be one of '1' '2' '3'"))
+	var errs []error
+	if selection.Has("FieldBase64rawurlString") {
+		if !(types.IsValidBase64RawURL(obj.FieldBase64rawurlString)) {
+			errs = append(errs, types.NewValidationError("FieldBase64rawurlString must be a valid raw base64 URL string"))
+		}
 	}
-	if !(obj.FieldInUint8MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint8MapPointer, []uint8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint8MapPointer elements must be one of '1' '2' '3'"))
+	if selection.Has("FieldBase64rawurlByteSlice") {
+		if !(types.IsValidBase64RawURL(string(obj.FieldBase64rawurlByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlice must be a valid raw base64 URL string"))
+		}
 	}
-	if !(obj.FieldInUint16MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint16MapPointer, []uint16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint16MapPointer elements must be one of '1' '2' '3'"))
+	return errs
+}
+func base64rawurlStructFieldsPointerValidate(obj *base64rawurlStructFieldsPointer) []error {
+	return base64rawurlStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func base64rawurlStructFieldsPointerValidateContext(ctx context.Context, obj *base64rawurlStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInUint32MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint32MapPointer, []uint32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint32MapPointer elements must be one of '1' '2' '3'"))
+	var errs []error
+	if !(obj.FieldBase64rawurlStringPointer != nil && types.IsValidBase64RawURL(*obj.FieldBase64rawurlStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldBase64rawurlStringPointer must be a valid raw base64 URL string"))
 	}
-	if !(obj.FieldInUint64MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint64MapPointer, []uint64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldInUint64MapPointer elements must be one of '1' '2' '3'"))
+	if !(obj.FieldBase64rawurlByteSlicePointer != nil && types.IsValidBase64RawURL(string(*obj.FieldBase64rawurlByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlicePointer must be a valid raw base64 URL string"))
 	}
-	if !(obj.FieldInFloat32MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat32MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func base64rawurlStructFieldsPointerValidateFields(obj *base64rawurlStructFieldsPointer, fields ...string) []error {
+	return base64rawurlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64rawurlStructFieldsPointerValidateExcept(obj *base64rawurlStructFieldsPointer, fields ...string) []error {
+	return base64rawurlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64rawurlStructFieldsPointerValidatePartialContext(ctx context.Context, obj *base64rawurlStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldInFloat64MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldInFloat64MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if selection.Has("FieldBase64rawurlStringPointer") {
+		if !(obj.FieldBase64rawurlStringPointer != nil && types.IsValidBase64RawURL(*obj.FieldBase64rawurlStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldBase64rawurlStringPointer must be a valid raw base64 URL string"))
+		}
 	}
-	if !(obj.FieldInBoolMapPointer != nil && types.MapOnlyContains(*obj.FieldInBoolMapPointer, []bool{false})) {
-		errs = append(errs, types.NewValidationError("FieldInBoolMapPointer elements must be one of 'false'"))
+	if selection.Has("FieldBase64rawurlByteSlicePointer") {
+		if !(obj.FieldBase64rawurlByteSlicePointer != nil && types.IsValidBase64RawURL(string(*obj.FieldBase64rawurlByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldBase64rawurlByteSlicePointer must be a valid raw base64 URL string"))
+		}
 	}
 	return errs
 }
-func lenStructFieldsValidate(obj *lenStructFields) []error {
-	var errs []error
-	if !(len(obj.FieldLenString) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenString length must be 2"))
+func base64urlStructFieldsValidate(obj *base64urlStructFields) []error {
+	return base64urlStructFieldsValidateContext(context.Background(), obj)
+}
+
+func base64urlStructFieldsValidateContext(ctx context.Context, obj *base64urlStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenStringSlice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenStringSlice must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidBase64URL(obj.FieldBase64urlString)) {
+		errs = append(errs, types.NewValidationError("FieldBase64urlString must be a valid base64 URL string"))
 	}
-	if !(len(obj.FieldLenIntSlice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenIntSlice must have exactly 2 elements"))
+	if !(types.IsValidBase64URL(string(obj.FieldBase64urlByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldBase64urlByteSlice must be a valid base64 URL string"))
 	}
-	if !(len(obj.FieldLenInt8Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt8Slice must have exactly 2 elements"))
+	return errs
+}
+
+func base64urlStructFieldsValidateFields(obj *base64urlStructFields, fields ...string) []error {
+	return base64urlStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64urlStructFieldsValidateExcept(obj *base64urlStructFields, fields ...string) []error {
+	return base64urlStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64urlStructFieldsValidatePartialContext(ctx context.Context, obj *base64urlStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenInt16Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt16Slice must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBase64urlString") {
+		if !(types.IsValidBase64URL(obj.FieldBase64urlString)) {
+			errs = append(errs, types.NewValidationError("FieldBase64urlString must be a valid base64 URL string"))
+		}
 	}
-	if !(len(obj.FieldLenInt32Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt32Slice must have exactly 2 elements"))
+	if selection.Has("FieldBase64urlByteSlice") {
+		if !(types.IsValidBase64URL(string(obj.FieldBase64urlByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldBase64urlByteSlice must be a valid base64 URL string"))
+		}
 	}
-	if !(len(obj.FieldLenInt64Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt64Slice must have exactly 2 elements"))
-	}
-	if !(len(obj.FieldLenUintSlice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUintSlice must have exactly 2 elements"))
-	}
-	if !(len(obj.FieldLenUint8Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint8Slice must have exactly 2 elements"))
-	}
-	if !(len(obj.FieldLenUint16Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint16Slice must have exactly 2 elements"))
-	}
-	if !(len(obj.FieldLenUint32Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint32Slice must have exactly 2 elements"))
-	}
-	if !(len(obj.FieldLenUint64Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint64Slice must have exactly 2 elements"))
+	return errs
+}
+func base64urlStructFieldsPointerValidate(obj *base64urlStructFieldsPointer) []error {
+	return base64urlStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func base64urlStructFieldsPointerValidateContext(ctx context.Context, obj *base64urlStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenFloat32Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat32Slice must have exactly 2 elements"))
+	var errs []error
+	if !(obj.FieldBase64urlStringPointer != nil && types.IsValidBase64URL(*obj.FieldBase64urlStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldBase64urlStringPointer must be a valid base64 URL string"))
 	}
-	if !(len(obj.FieldLenFloat64Slice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat64Slice must have exactly 2 elements"))
+	if !(obj.FieldBase64urlByteSlicePointer != nil && types.IsValidBase64URL(string(*obj.FieldBase64urlByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldBase64urlByteSlicePointer must be a valid base64 URL string"))
 	}
-	if !(len(obj.FieldLenBoolSlice) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenBoolSlice must have exactly 2 elements"))
+	return errs
+}
+
+func base64urlStructFieldsPointerValidateFields(obj *base64urlStructFieldsPointer, fields ...string) []error {
+	return base64urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func base64urlStructFieldsPointerValidateExcept(obj *base64urlStructFieldsPointer, fields ...string) []error {
+	return base64urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func base64urlStructFieldsPointerValidatePartialContext(ctx context.Context, obj *base64urlStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenStringMap) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenStringMap must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBase64urlStringPointer") {
+		if !(obj.FieldBase64urlStringPointer != nil && types.IsValidBase64URL(*obj.FieldBase64urlStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldBase64urlStringPointer must be a valid base64 URL string"))
+		}
 	}
-	if !(len(obj.FieldLenIntMap) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenIntMap must have exactly 2 elements"))
+	if selection.Has("FieldBase64urlByteSlicePointer") {
+		if !(obj.FieldBase64urlByteSlicePointer != nil && types.IsValidBase64URL(string(*obj.FieldBase64urlByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldBase64urlByteSlicePointer must be a valid base64 URL string"))
+		}
 	}
-	if !(len(obj.FieldLenInt8Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt8Map must have exactly 2 elements"))
+	return errs
+}
+func bcp47_language_tagStructFieldsValidate(obj *bcp47_language_tagStructFields) []error {
+	return bcp47_language_tagStructFieldsValidateContext(context.Background(), obj)
+}
+
+func bcp47_language_tagStructFieldsValidateContext(ctx context.Context, obj *bcp47_language_tagStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenInt16Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt16Map must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidBCP47LanguageTag(obj.FieldBcp47_language_tagString)) {
+		errs = append(errs, types.NewValidationError("FieldBcp47_language_tagString must be a valid BCP 47 language tag"))
 	}
-	if !(len(obj.FieldLenInt32Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt32Map must have exactly 2 elements"))
+	return errs
+}
+
+func bcp47_language_tagStructFieldsValidateFields(obj *bcp47_language_tagStructFields, fields ...string) []error {
+	return bcp47_language_tagStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func bcp47_language_tagStructFieldsValidateExcept(obj *bcp47_language_tagStructFields, fields ...string) []error {
+	return bcp47_language_tagStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func bcp47_language_tagStructFieldsValidatePartialContext(ctx context.Context, obj *bcp47_language_tagStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenInt64Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt64Map must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBcp47_language_tagString") {
+		if !(types.IsValidBCP47LanguageTag(obj.FieldBcp47_language_tagString)) {
+			errs = append(errs, types.NewValidationError("FieldBcp47_language_tagString must be a valid BCP 47 language tag"))
+		}
 	}
-	if !(len(obj.FieldLenUintMap) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUintMap must have exactly 2 elements"))
+	return errs
+}
+func bcp47_language_tagStructFieldsPointerValidate(obj *bcp47_language_tagStructFieldsPointer) []error {
+	return bcp47_language_tagStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func bcp47_language_tagStructFieldsPointerValidateContext(ctx context.Context, obj *bcp47_language_tagStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenUint8Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint8Map must have exactly 2 elements"))
+	var errs []error
+	if !(obj.FieldBcp47_language_tagStringPointer != nil && types.IsValidBCP47LanguageTag(*obj.FieldBcp47_language_tagStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldBcp47_language_tagStringPointer must be a valid BCP 47 language tag"))
 	}
-	if !(len(obj.FieldLenUint16Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint16Map must have exactly 2 elements"))
+	return errs
+}
+
+func bcp47_language_tagStructFieldsPointerValidateFields(obj *bcp47_language_tagStructFieldsPointer, fields ...string) []error {
+	return bcp47_language_tagStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func bcp47_language_tagStructFieldsPointerValidateExcept(obj *bcp47_language_tagStructFieldsPointer, fields ...string) []error {
+	return bcp47_language_tagStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func bcp47_language_tagStructFieldsPointerValidatePartialContext(ctx context.Context, obj *bcp47_language_tagStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenUint32Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint32Map must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBcp47_language_tagStringPointer") {
+		if !(obj.FieldBcp47_language_tagStringPointer != nil && types.IsValidBCP47LanguageTag(*obj.FieldBcp47_language_tagStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldBcp47_language_tagStringPointer must be a valid BCP 47 language tag"))
+		}
 	}
-	if !(len(obj.FieldLenUint64Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint64Map must have exactly 2 elements"))
+	return errs
+}
+func bicStructFieldsValidate(obj *bicStructFields) []error {
+	return bicStructFieldsValidateContext(context.Background(), obj)
+}
+
+func bicStructFieldsValidateContext(ctx context.Context, obj *bicStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenFloat32Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat32Map must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidBIC(obj.FieldBicString)) {
+		errs = append(errs, types.NewValidationError("FieldBicString must be a valid BIC"))
 	}
-	if !(len(obj.FieldLenFloat64Map) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat64Map must have exactly 2 elements"))
+	return errs
+}
+
+func bicStructFieldsValidateFields(obj *bicStructFields, fields ...string) []error {
+	return bicStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func bicStructFieldsValidateExcept(obj *bicStructFields, fields ...string) []error {
+	return bicStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func bicStructFieldsValidatePartialContext(ctx context.Context, obj *bicStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldLenBoolMap) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenBoolMap must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBicString") {
+		if !(types.IsValidBIC(obj.FieldBicString)) {
+			errs = append(errs, types.NewValidationError("FieldBicString must be a valid BIC"))
+		}
 	}
 	return errs
 }
-func lenStructFieldsPointerValidate(obj *lenStructFieldsPointer) []error {
+func bicStructFieldsPointerValidate(obj *bicStructFieldsPointer) []error {
+	return bicStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func bicStructFieldsPointerValidateContext(ctx context.Context, obj *bicStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldLenStringPointer != nil && len(*obj.FieldLenStringPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenStringPointer length must be 2"))
+	if !(obj.FieldBicStringPointer != nil && types.IsValidBIC(*obj.FieldBicStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldBicStringPointer must be a valid BIC"))
 	}
-	if !(obj.FieldLenStringSlicePointer != nil && len(*obj.FieldLenStringSlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenStringSlicePointer must have exactly 2 elements"))
+	return errs
+}
+
+func bicStructFieldsPointerValidateFields(obj *bicStructFieldsPointer, fields ...string) []error {
+	return bicStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func bicStructFieldsPointerValidateExcept(obj *bicStructFieldsPointer, fields ...string) []error {
+	return bicStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func bicStructFieldsPointerValidatePartialContext(ctx context.Context, obj *bicStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenIntSlicePointer != nil && len(*obj.FieldLenIntSlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenIntSlicePointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldBicStringPointer") {
+		if !(obj.FieldBicStringPointer != nil && types.IsValidBIC(*obj.FieldBicStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldBicStringPointer must be a valid BIC"))
+		}
 	}
-	if !(obj.FieldLenInt8SlicePointer != nil && len(*obj.FieldLenInt8SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt8SlicePointer must have exactly 2 elements"))
+	return errs
+}
+func cepStructFieldsValidate(obj *cepStructFields) []error {
+	return cepStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cepStructFieldsValidateContext(ctx context.Context, obj *cepStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenInt16SlicePointer != nil && len(*obj.FieldLenInt16SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt16SlicePointer must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidCEP(obj.FieldCepString, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCepString must be a valid CEP"))
 	}
-	if !(obj.FieldLenInt32SlicePointer != nil && len(*obj.FieldLenInt32SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt32SlicePointer must have exactly 2 elements"))
+	return errs
+}
+
+func cepStructFieldsValidateFields(obj *cepStructFields, fields ...string) []error {
+	return cepStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cepStructFieldsValidateExcept(obj *cepStructFields, fields ...string) []error {
+	return cepStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cepStructFieldsValidatePartialContext(ctx context.Context, obj *cepStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenInt64SlicePointer != nil && len(*obj.FieldLenInt64SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt64SlicePointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCepString") {
+		if !(types.IsValidCEP(obj.FieldCepString, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCepString must be a valid CEP"))
+		}
 	}
-	if !(obj.FieldLenUintSlicePointer != nil && len(*obj.FieldLenUintSlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUintSlicePointer must have exactly 2 elements"))
+	return errs
+}
+func cepStructFieldsPointerValidate(obj *cepStructFieldsPointer) []error {
+	return cepStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cepStructFieldsPointerValidateContext(ctx context.Context, obj *cepStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenUint8SlicePointer != nil && len(*obj.FieldLenUint8SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint8SlicePointer must have exactly 2 elements"))
+	var errs []error
+	if !(obj.FieldCepStringPointer != nil && types.IsValidCEP(*obj.FieldCepStringPointer, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCepStringPointer must be a valid CEP"))
 	}
-	if !(obj.FieldLenUint16SlicePointer != nil && len(*obj.FieldLenUint16SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint16SlicePointer must have exactly 2 elements"))
+	return errs
+}
+
+func cepStructFieldsPointerValidateFields(obj *cepStructFieldsPointer, fields ...string) []error {
+	return cepStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cepStructFieldsPointerValidateExcept(obj *cepStructFieldsPointer, fields ...string) []error {
+	return cepStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cepStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cepStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenUint32SlicePointer != nil && len(*obj.FieldLenUint32SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint32SlicePointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCepStringPointer") {
+		if !(obj.FieldCepStringPointer != nil && types.IsValidCEP(*obj.FieldCepStringPointer, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCepStringPointer must be a valid CEP"))
+		}
 	}
-	if !(obj.FieldLenUint64SlicePointer != nil && len(*obj.FieldLenUint64SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint64SlicePointer must have exactly 2 elements"))
+	return errs
+}
+func cep_formattedStructFieldsValidate(obj *cep_formattedStructFields) []error {
+	return cep_formattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cep_formattedStructFieldsValidateContext(ctx context.Context, obj *cep_formattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenFloat32SlicePointer != nil && len(*obj.FieldLenFloat32SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat32SlicePointer must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidCEP(obj.FieldCep_formattedString, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCep_formattedString must be a valid formatted CEP"))
 	}
-	if !(obj.FieldLenFloat64SlicePointer != nil && len(*obj.FieldLenFloat64SlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat64SlicePointer must have exactly 2 elements"))
+	return errs
+}
+
+func cep_formattedStructFieldsValidateFields(obj *cep_formattedStructFields, fields ...string) []error {
+	return cep_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cep_formattedStructFieldsValidateExcept(obj *cep_formattedStructFields, fields ...string) []error {
+	return cep_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cep_formattedStructFieldsValidatePartialContext(ctx context.Context, obj *cep_formattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenBoolSlicePointer != nil && len(*obj.FieldLenBoolSlicePointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenBoolSlicePointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCep_formattedString") {
+		if !(types.IsValidCEP(obj.FieldCep_formattedString, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCep_formattedString must be a valid formatted CEP"))
+		}
 	}
-	if !(obj.FieldLenStringMapPointer != nil && len(*obj.FieldLenStringMapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenStringMapPointer must have exactly 2 elements"))
+	return errs
+}
+func cep_formattedStructFieldsPointerValidate(obj *cep_formattedStructFieldsPointer) []error {
+	return cep_formattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cep_formattedStructFieldsPointerValidateContext(ctx context.Context, obj *cep_formattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenIntMapPointer != nil && len(*obj.FieldLenIntMapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenIntMapPointer must have exactly 2 elements"))
+	var errs []error
+	if !(obj.FieldCep_formattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_formattedStringPointer, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCep_formattedStringPointer must be a valid formatted CEP"))
 	}
-	if !(obj.FieldLenInt8MapPointer != nil && len(*obj.FieldLenInt8MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt8MapPointer must have exactly 2 elements"))
+	return errs
+}
+
+func cep_formattedStructFieldsPointerValidateFields(obj *cep_formattedStructFieldsPointer, fields ...string) []error {
+	return cep_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cep_formattedStructFieldsPointerValidateExcept(obj *cep_formattedStructFieldsPointer, fields ...string) []error {
+	return cep_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cep_formattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cep_formattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenInt16MapPointer != nil && len(*obj.FieldLenInt16MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt16MapPointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCep_formattedStringPointer") {
+		if !(obj.FieldCep_formattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_formattedStringPointer, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCep_formattedStringPointer must be a valid formatted CEP"))
+		}
 	}
-	if !(obj.FieldLenInt32MapPointer != nil && len(*obj.FieldLenInt32MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt32MapPointer must have exactly 2 elements"))
+	return errs
+}
+func cep_unformattedStructFieldsValidate(obj *cep_unformattedStructFields) []error {
+	return cep_unformattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cep_unformattedStructFieldsValidateContext(ctx context.Context, obj *cep_unformattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenInt64MapPointer != nil && len(*obj.FieldLenInt64MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenInt64MapPointer must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidCEP(obj.FieldCep_unformattedString, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCep_unformattedString must be a valid unformatted CEP"))
 	}
-	if !(obj.FieldLenUintMapPointer != nil && len(*obj.FieldLenUintMapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUintMapPointer must have exactly 2 elements"))
+	return errs
+}
+
+func cep_unformattedStructFieldsValidateFields(obj *cep_unformattedStructFields, fields ...string) []error {
+	return cep_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cep_unformattedStructFieldsValidateExcept(obj *cep_unformattedStructFields, fields ...string) []error {
+	return cep_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cep_unformattedStructFieldsValidatePartialContext(ctx context.Context, obj *cep_unformattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenUint8MapPointer != nil && len(*obj.FieldLenUint8MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint8MapPointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCep_unformattedString") {
+		if !(types.IsValidCEP(obj.FieldCep_unformattedString, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCep_unformattedString must be a valid unformatted CEP"))
+		}
 	}
-	if !(obj.FieldLenUint16MapPointer != nil && len(*obj.FieldLenUint16MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint16MapPointer must have exactly 2 elements"))
+	return errs
+}
+func cep_unformattedStructFieldsPointerValidate(obj *cep_unformattedStructFieldsPointer) []error {
+	return cep_unformattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cep_unformattedStructFieldsPointerValidateContext(ctx context.Context, obj *cep_unformattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenUint32MapPointer != nil && len(*obj.FieldLenUint32MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint32MapPointer must have exactly 2 elements"))
+	var errs []error
+	if !(obj.FieldCep_unformattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_unformattedStringPointer, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCep_unformattedStringPointer must be a valid unformatted CEP"))
 	}
-	if !(obj.FieldLenUint64MapPointer != nil && len(*obj.FieldLenUint64MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenUint64MapPointer must have exactly 2 elements"))
+	return errs
+}
+
+func cep_unformattedStructFieldsPointerValidateFields(obj *cep_unformattedStructFieldsPointer, fields ...string) []error {
+	return cep_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cep_unformattedStructFieldsPointerValidateExcept(obj *cep_unformattedStructFieldsPointer, fields ...string) []error {
+	return cep_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cep_unformattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cep_unformattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenFloat32MapPointer != nil && len(*obj.FieldLenFloat32MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat32MapPointer must have exactly 2 elements"))
+	var errs []error
+	if selection.Has("FieldCep_unformattedStringPointer") {
+		if !(obj.FieldCep_unformattedStringPointer != nil && types.IsValidCEP(*obj.FieldCep_unformattedStringPointer, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCep_unformattedStringPointer must be a valid unformatted CEP"))
+		}
 	}
-	if !(obj.FieldLenFloat64MapPointer != nil && len(*obj.FieldLenFloat64MapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenFloat64MapPointer must have exactly 2 elements"))
+	return errs
+}
+func cidrStructFieldsValidate(obj *cidrStructFields) []error {
+	return cidrStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cidrStructFieldsValidateContext(ctx context.Context, obj *cidrStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLenBoolMapPointer != nil && len(*obj.FieldLenBoolMapPointer) == 2) {
-		errs = append(errs, types.NewValidationError("FieldLenBoolMapPointer must have exactly 2 elements"))
+	var errs []error
+	if !(types.IsValidCIDR(obj.FieldCidrString)) {
+		errs = append(errs, types.NewValidationError("FieldCidrString must be a valid CIDR"))
 	}
 	return errs
 }
-func ltStructFieldsValidate(obj *ltStructFields) []error {
-	var errs []error
-	if !(obj.FieldLtInt < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt must be < 32"))
-	}
-	if !(obj.FieldLtInt8 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt8 must be < 32"))
-	}
-	if !(obj.FieldLtInt16 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt16 must be < 32"))
+
+func cidrStructFieldsValidateFields(obj *cidrStructFields, fields ...string) []error {
+	return cidrStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrStructFieldsValidateExcept(obj *cidrStructFields, fields ...string) []error {
+	return cidrStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrStructFieldsValidatePartialContext(ctx context.Context, obj *cidrStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtInt32 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt32 must be < 32"))
+	var errs []error
+	if selection.Has("FieldCidrString") {
+		if !(types.IsValidCIDR(obj.FieldCidrString)) {
+			errs = append(errs, types.NewValidationError("FieldCidrString must be a valid CIDR"))
+		}
 	}
-	if !(obj.FieldLtInt64 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt64 must be < 32"))
+	return errs
+}
+func cidrStructFieldsPointerValidate(obj *cidrStructFieldsPointer) []error {
+	return cidrStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cidrStructFieldsPointerValidateContext(ctx context.Context, obj *cidrStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUint < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint must be < 32"))
+	var errs []error
+	if !(obj.FieldCidrStringPointer != nil && types.IsValidCIDR(*obj.FieldCidrStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldCidrStringPointer must be a valid CIDR"))
 	}
-	if !(obj.FieldLtUint8 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint8 must be < 32"))
+	return errs
+}
+
+func cidrStructFieldsPointerValidateFields(obj *cidrStructFieldsPointer, fields ...string) []error {
+	return cidrStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrStructFieldsPointerValidateExcept(obj *cidrStructFieldsPointer, fields ...string) []error {
+	return cidrStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cidrStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUint16 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint16 must be < 32"))
+	var errs []error
+	if selection.Has("FieldCidrStringPointer") {
+		if !(obj.FieldCidrStringPointer != nil && types.IsValidCIDR(*obj.FieldCidrStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldCidrStringPointer must be a valid CIDR"))
+		}
 	}
-	if !(obj.FieldLtUint32 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint32 must be < 32"))
+	return errs
+}
+func cidrv4StructFieldsValidate(obj *cidrv4StructFields) []error {
+	return cidrv4StructFieldsValidateContext(context.Background(), obj)
+}
+
+func cidrv4StructFieldsValidateContext(ctx context.Context, obj *cidrv4StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUint64 < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint64 must be < 32"))
+	var errs []error
+	if !(types.IsValidCIDRv4(obj.FieldCidrv4String)) {
+		errs = append(errs, types.NewValidationError("FieldCidrv4String must be a valid IPv4 CIDR"))
 	}
-	if !(obj.FieldLtFloat32 < 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLtFloat32 must be < 12.34"))
+	return errs
+}
+
+func cidrv4StructFieldsValidateFields(obj *cidrv4StructFields, fields ...string) []error {
+	return cidrv4StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrv4StructFieldsValidateExcept(obj *cidrv4StructFields, fields ...string) []error {
+	return cidrv4StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrv4StructFieldsValidatePartialContext(ctx context.Context, obj *cidrv4StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtFloat64 < 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLtFloat64 must be < 12.34"))
+	var errs []error
+	if selection.Has("FieldCidrv4String") {
+		if !(types.IsValidCIDRv4(obj.FieldCidrv4String)) {
+			errs = append(errs, types.NewValidationError("FieldCidrv4String must be a valid IPv4 CIDR"))
+		}
 	}
 	return errs
 }
-func ltStructFieldsPointerValidate(obj *ltStructFieldsPointer) []error {
-	var errs []error
-	if !(obj.FieldLtIntPointer != nil && *obj.FieldLtIntPointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtIntPointer must be < 32"))
+func cidrv4StructFieldsPointerValidate(obj *cidrv4StructFieldsPointer) []error {
+	return cidrv4StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cidrv4StructFieldsPointerValidateContext(ctx context.Context, obj *cidrv4StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtInt8Pointer != nil && *obj.FieldLtInt8Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt8Pointer must be < 32"))
+	var errs []error
+	if !(obj.FieldCidrv4StringPointer != nil && types.IsValidCIDRv4(*obj.FieldCidrv4StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldCidrv4StringPointer must be a valid IPv4 CIDR"))
 	}
-	if !(obj.FieldLtInt16Pointer != nil && *obj.FieldLtInt16Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt16Pointer must be < 32"))
+	return errs
+}
+
+func cidrv4StructFieldsPointerValidateFields(obj *cidrv4StructFieldsPointer, fields ...string) []error {
+	return cidrv4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrv4StructFieldsPointerValidateExcept(obj *cidrv4StructFieldsPointer, fields ...string) []error {
+	return cidrv4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrv4StructFieldsPointerValidatePartialContext(ctx context.Context, obj *cidrv4StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtInt32Pointer != nil && *obj.FieldLtInt32Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt32Pointer must be < 32"))
+	var errs []error
+	if selection.Has("FieldCidrv4StringPointer") {
+		if !(obj.FieldCidrv4StringPointer != nil && types.IsValidCIDRv4(*obj.FieldCidrv4StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldCidrv4StringPointer must be a valid IPv4 CIDR"))
+		}
 	}
-	if !(obj.FieldLtInt64Pointer != nil && *obj.FieldLtInt64Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtInt64Pointer must be < 32"))
+	return errs
+}
+func cidrv6StructFieldsValidate(obj *cidrv6StructFields) []error {
+	return cidrv6StructFieldsValidateContext(context.Background(), obj)
+}
+
+func cidrv6StructFieldsValidateContext(ctx context.Context, obj *cidrv6StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUintPointer != nil && *obj.FieldLtUintPointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUintPointer must be < 32"))
+	var errs []error
+	if !(types.IsValidCIDRv6(obj.FieldCidrv6String)) {
+		errs = append(errs, types.NewValidationError("FieldCidrv6String must be a valid IPv6 CIDR"))
 	}
-	if !(obj.FieldLtUint8Pointer != nil && *obj.FieldLtUint8Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint8Pointer must be < 32"))
+	return errs
+}
+
+func cidrv6StructFieldsValidateFields(obj *cidrv6StructFields, fields ...string) []error {
+	return cidrv6StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrv6StructFieldsValidateExcept(obj *cidrv6StructFields, fields ...string) []error {
+	return cidrv6StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrv6StructFieldsValidatePartialContext(ctx context.Context, obj *cidrv6StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUint16Pointer != nil && *obj.FieldLtUint16Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint16Pointer must be < 32"))
+	var errs []error
+	if selection.Has("FieldCidrv6String") {
+		if !(types.IsValidCIDRv6(obj.FieldCidrv6String)) {
+			errs = append(errs, types.NewValidationError("FieldCidrv6String must be a valid IPv6 CIDR"))
+		}
 	}
-	if !(obj.FieldLtUint32Pointer != nil && *obj.FieldLtUint32Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint32Pointer must be < 32"))
+	return errs
+}
+func cidrv6StructFieldsPointerValidate(obj *cidrv6StructFieldsPointer) []error {
+	return cidrv6StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cidrv6StructFieldsPointerValidateContext(ctx context.Context, obj *cidrv6StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtUint64Pointer != nil && *obj.FieldLtUint64Pointer < 32) {
-		errs = append(errs, types.NewValidationError("FieldLtUint64Pointer must be < 32"))
+	var errs []error
+	if !(obj.FieldCidrv6StringPointer != nil && types.IsValidCIDRv6(*obj.FieldCidrv6StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldCidrv6StringPointer must be a valid IPv6 CIDR"))
 	}
-	if !(obj.FieldLtFloat32Pointer != nil && *obj.FieldLtFloat32Pointer < 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLtFloat32Pointer must be < 12.34"))
+	return errs
+}
+
+func cidrv6StructFieldsPointerValidateFields(obj *cidrv6StructFieldsPointer, fields ...string) []error {
+	return cidrv6StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cidrv6StructFieldsPointerValidateExcept(obj *cidrv6StructFieldsPointer, fields ...string) []error {
+	return cidrv6StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cidrv6StructFieldsPointerValidatePartialContext(ctx context.Context, obj *cidrv6StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLtFloat64Pointer != nil && *obj.FieldLtFloat64Pointer < 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLtFloat64Pointer must be < 12.34"))
+	var errs []error
+	if selection.Has("FieldCidrv6StringPointer") {
+		if !(obj.FieldCidrv6StringPointer != nil && types.IsValidCIDRv6(*obj.FieldCidrv6StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldCidrv6StringPointer must be a valid IPv6 CIDR"))
+		}
 	}
 	return errs
 }
-func lteStructFieldsValidate(obj *lteStructFields) []error {
-	var errs []error
-	if !(obj.FieldLteInt <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt must be <= 32"))
+func cnpjStructFieldsValidate(obj *cnpjStructFields) []error {
+	return cnpjStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cnpjStructFieldsValidateContext(ctx context.Context, obj *cnpjStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteInt8 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt8 must be <= 32"))
+	var errs []error
+	if !(types.IsValidCNPJ(obj.FieldCnpjString, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCnpjString must be a valid CNPJ"))
 	}
-	if !(obj.FieldLteInt16 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt16 must be <= 32"))
+	return errs
+}
+
+func cnpjStructFieldsValidateFields(obj *cnpjStructFields, fields ...string) []error {
+	return cnpjStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpjStructFieldsValidateExcept(obj *cnpjStructFields, fields ...string) []error {
+	return cnpjStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpjStructFieldsValidatePartialContext(ctx context.Context, obj *cnpjStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteInt32 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt32 must be <= 32"))
+	var errs []error
+	if selection.Has("FieldCnpjString") {
+		if !(types.IsValidCNPJ(obj.FieldCnpjString, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCnpjString must be a valid CNPJ"))
+		}
 	}
-	if !(obj.FieldLteInt64 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt64 must be <= 32"))
+	return errs
+}
+func cnpjStructFieldsPointerValidate(obj *cnpjStructFieldsPointer) []error {
+	return cnpjStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cnpjStructFieldsPointerValidateContext(ctx context.Context, obj *cnpjStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUint <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint must be <= 32"))
+	var errs []error
+	if !(obj.FieldCnpjStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpjStringPointer, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCnpjStringPointer must be a valid CNPJ"))
 	}
-	if !(obj.FieldLteUint8 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint8 must be <= 32"))
+	return errs
+}
+
+func cnpjStructFieldsPointerValidateFields(obj *cnpjStructFieldsPointer, fields ...string) []error {
+	return cnpjStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpjStructFieldsPointerValidateExcept(obj *cnpjStructFieldsPointer, fields ...string) []error {
+	return cnpjStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpjStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cnpjStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUint16 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint16 must be <= 32"))
+	var errs []error
+	if selection.Has("FieldCnpjStringPointer") {
+		if !(obj.FieldCnpjStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpjStringPointer, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCnpjStringPointer must be a valid CNPJ"))
+		}
 	}
-	if !(obj.FieldLteUint32 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint32 must be <= 32"))
+	return errs
+}
+func cnpj_formattedStructFieldsValidate(obj *cnpj_formattedStructFields) []error {
+	return cnpj_formattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cnpj_formattedStructFieldsValidateContext(ctx context.Context, obj *cnpj_formattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUint64 <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint64 must be <= 32"))
+	var errs []error
+	if !(types.IsValidCNPJ(obj.FieldCnpj_formattedString, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCnpj_formattedString must be a valid formatted CNPJ"))
 	}
-	if !(obj.FieldLteFloat32 <= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLteFloat32 must be <= 12.34"))
+	return errs
+}
+
+func cnpj_formattedStructFieldsValidateFields(obj *cnpj_formattedStructFields, fields ...string) []error {
+	return cnpj_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpj_formattedStructFieldsValidateExcept(obj *cnpj_formattedStructFields, fields ...string) []error {
+	return cnpj_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpj_formattedStructFieldsValidatePartialContext(ctx context.Context, obj *cnpj_formattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteFloat64 <= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLteFloat64 must be <= 12.34"))
+	var errs []error
+	if selection.Has("FieldCnpj_formattedString") {
+		if !(types.IsValidCNPJ(obj.FieldCnpj_formattedString, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCnpj_formattedString must be a valid formatted CNPJ"))
+		}
 	}
 	return errs
 }
-func lteStructFieldsPointerValidate(obj *lteStructFieldsPointer) []error {
-	var errs []error
-	if !(obj.FieldLteIntPointer != nil && *obj.FieldLteIntPointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteIntPointer must be <= 32"))
+func cnpj_formattedStructFieldsPointerValidate(obj *cnpj_formattedStructFieldsPointer) []error {
+	return cnpj_formattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cnpj_formattedStructFieldsPointerValidateContext(ctx context.Context, obj *cnpj_formattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteInt8Pointer != nil && *obj.FieldLteInt8Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt8Pointer must be <= 32"))
+	var errs []error
+	if !(obj.FieldCnpj_formattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_formattedStringPointer, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCnpj_formattedStringPointer must be a valid formatted CNPJ"))
 	}
-	if !(obj.FieldLteInt16Pointer != nil && *obj.FieldLteInt16Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt16Pointer must be <= 32"))
+	return errs
+}
+
+func cnpj_formattedStructFieldsPointerValidateFields(obj *cnpj_formattedStructFieldsPointer, fields ...string) []error {
+	return cnpj_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpj_formattedStructFieldsPointerValidateExcept(obj *cnpj_formattedStructFieldsPointer, fields ...string) []error {
+	return cnpj_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpj_formattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cnpj_formattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteInt32Pointer != nil && *obj.FieldLteInt32Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt32Pointer must be <= 32"))
+	var errs []error
+	if selection.Has("FieldCnpj_formattedStringPointer") {
+		if !(obj.FieldCnpj_formattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_formattedStringPointer, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCnpj_formattedStringPointer must be a valid formatted CNPJ"))
+		}
 	}
-	if !(obj.FieldLteInt64Pointer != nil && *obj.FieldLteInt64Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteInt64Pointer must be <= 32"))
+	return errs
+}
+func cnpj_unformattedStructFieldsValidate(obj *cnpj_unformattedStructFields) []error {
+	return cnpj_unformattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cnpj_unformattedStructFieldsValidateContext(ctx context.Context, obj *cnpj_unformattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUintPointer != nil && *obj.FieldLteUintPointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUintPointer must be <= 32"))
+	var errs []error
+	if !(types.IsValidCNPJ(obj.FieldCnpj_unformattedString, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCnpj_unformattedString must be a valid unformatted CNPJ"))
 	}
-	if !(obj.FieldLteUint8Pointer != nil && *obj.FieldLteUint8Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint8Pointer must be <= 32"))
+	return errs
+}
+
+func cnpj_unformattedStructFieldsValidateFields(obj *cnpj_unformattedStructFields, fields ...string) []error {
+	return cnpj_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpj_unformattedStructFieldsValidateExcept(obj *cnpj_unformattedStructFields, fields ...string) []error {
+	return cnpj_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpj_unformattedStructFieldsValidatePartialContext(ctx context.Context, obj *cnpj_unformattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUint16Pointer != nil && *obj.FieldLteUint16Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint16Pointer must be <= 32"))
+	var errs []error
+	if selection.Has("FieldCnpj_unformattedString") {
+		if !(types.IsValidCNPJ(obj.FieldCnpj_unformattedString, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCnpj_unformattedString must be a valid unformatted CNPJ"))
+		}
 	}
-	if !(obj.FieldLteUint32Pointer != nil && *obj.FieldLteUint32Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint32Pointer must be <= 32"))
+	return errs
+}
+func cnpj_unformattedStructFieldsPointerValidate(obj *cnpj_unformattedStructFieldsPointer) []error {
+	return cnpj_unformattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cnpj_unformattedStructFieldsPointerValidateContext(ctx context.Context, obj *cnpj_unformattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteUint64Pointer != nil && *obj.FieldLteUint64Pointer <= 32) {
-		errs = append(errs, types.NewValidationError("FieldLteUint64Pointer must be <= 32"))
+	var errs []error
+	if !(obj.FieldCnpj_unformattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_unformattedStringPointer, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCnpj_unformattedStringPointer must be a valid unformatted CNPJ"))
 	}
-	if !(obj.FieldLteFloat32Pointer != nil && *obj.FieldLteFloat32Pointer <= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLteFloat32Pointer must be <= 12.34"))
+	return errs
+}
+
+func cnpj_unformattedStructFieldsPointerValidateFields(obj *cnpj_unformattedStructFieldsPointer, fields ...string) []error {
+	return cnpj_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cnpj_unformattedStructFieldsPointerValidateExcept(obj *cnpj_unformattedStructFieldsPointer, fields ...string) []error {
+	return cnpj_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cnpj_unformattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cnpj_unformattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldLteFloat64Pointer != nil && *obj.FieldLteFloat64Pointer <= 12.34) {
-		errs = append(errs, types.NewValidationError("FieldLteFloat64Pointer must be <= 12.34"))
+	var errs []error
+	if selection.Has("FieldCnpj_unformattedStringPointer") {
+		if !(obj.FieldCnpj_unformattedStringPointer != nil && types.IsValidCNPJ(*obj.FieldCnpj_unformattedStringPointer, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCnpj_unformattedStringPointer must be a valid unformatted CNPJ"))
+		}
 	}
 	return errs
 }
-func maxStructFieldsValidate(obj *maxStructFields) []error {
+func containsStructFieldsValidate(obj *containsStructFields) []error {
+	return containsStructFieldsValidateContext(context.Background(), obj)
+}
+
+func containsStructFieldsValidateContext(ctx context.Context, obj *containsStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(len(obj.FieldMaxString) <= 3) {
-		errs = append(errs, types.NewValidationError("FieldMaxString length must be <= 3"))
+	if !(types.Contains(obj.FieldContainsString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldContainsString must contain 'ab'"))
 	}
-	if !(len(obj.FieldMaxStringSlice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxStringSlice must have at most 2 elements"))
+	return errs
+}
+
+func containsStructFieldsValidateFields(obj *containsStructFields, fields ...string) []error {
+	return containsStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsStructFieldsValidateExcept(obj *containsStructFields, fields ...string) []error {
+	return containsStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsStructFieldsValidatePartialContext(ctx context.Context, obj *containsStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxIntSlice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxIntSlice must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsString") {
+		if !(types.Contains(obj.FieldContainsString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldContainsString must contain 'ab'"))
+		}
 	}
-	if !(len(obj.FieldMaxInt8Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt8Slice must have at most 2 elements"))
+	return errs
+}
+func containsStructFieldsPointerValidate(obj *containsStructFieldsPointer) []error {
+	return containsStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func containsStructFieldsPointerValidateContext(ctx context.Context, obj *containsStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxInt16Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt16Slice must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldContainsStringPointer != nil && types.Contains(*obj.FieldContainsStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldContainsStringPointer must contain 'ab'"))
 	}
-	if !(len(obj.FieldMaxInt32Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt32Slice must have at most 2 elements"))
+	return errs
+}
+
+func containsStructFieldsPointerValidateFields(obj *containsStructFieldsPointer, fields ...string) []error {
+	return containsStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsStructFieldsPointerValidateExcept(obj *containsStructFieldsPointer, fields ...string) []error {
+	return containsStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsStructFieldsPointerValidatePartialContext(ctx context.Context, obj *containsStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxInt64Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt64Slice must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsStringPointer") {
+		if !(obj.FieldContainsStringPointer != nil && types.Contains(*obj.FieldContainsStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldContainsStringPointer must contain 'ab'"))
+		}
 	}
-	if !(len(obj.FieldMaxUintSlice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUintSlice must have at most 2 elements"))
+	return errs
+}
+func containsanyStructFieldsValidate(obj *containsanyStructFields) []error {
+	return containsanyStructFieldsValidateContext(context.Background(), obj)
+}
+
+func containsanyStructFieldsValidateContext(ctx context.Context, obj *containsanyStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxUint8Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint8Slice must have at most 2 elements"))
+	var errs []error
+	if !(types.ContainsAny(obj.FieldContainsanyString, "!@#")) {
+		errs = append(errs, types.NewValidationError("FieldContainsanyString must contain any of '!@#'"))
 	}
-	if !(len(obj.FieldMaxUint16Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint16Slice must have at most 2 elements"))
+	return errs
+}
+
+func containsanyStructFieldsValidateFields(obj *containsanyStructFields, fields ...string) []error {
+	return containsanyStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsanyStructFieldsValidateExcept(obj *containsanyStructFields, fields ...string) []error {
+	return containsanyStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsanyStructFieldsValidatePartialContext(ctx context.Context, obj *containsanyStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxUint32Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint32Slice must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsanyString") {
+		if !(types.ContainsAny(obj.FieldContainsanyString, "!@#")) {
+			errs = append(errs, types.NewValidationError("FieldContainsanyString must contain any of '!@#'"))
+		}
 	}
-	if !(len(obj.FieldMaxUint64Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint64Slice must have at most 2 elements"))
+	return errs
+}
+func containsanyStructFieldsPointerValidate(obj *containsanyStructFieldsPointer) []error {
+	return containsanyStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func containsanyStructFieldsPointerValidateContext(ctx context.Context, obj *containsanyStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxFloat32Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat32Slice must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldContainsanyStringPointer != nil && types.ContainsAny(*obj.FieldContainsanyStringPointer, "!@#")) {
+		errs = append(errs, types.NewValidationError("FieldContainsanyStringPointer must contain any of '!@#'"))
 	}
-	if !(len(obj.FieldMaxFloat64Slice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat64Slice must have at most 2 elements"))
+	return errs
+}
+
+func containsanyStructFieldsPointerValidateFields(obj *containsanyStructFieldsPointer, fields ...string) []error {
+	return containsanyStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsanyStructFieldsPointerValidateExcept(obj *containsanyStructFieldsPointer, fields ...string) []error {
+	return containsanyStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsanyStructFieldsPointerValidatePartialContext(ctx context.Context, obj *containsanyStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxBoolSlice) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxBoolSlice must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsanyStringPointer") {
+		if !(obj.FieldContainsanyStringPointer != nil && types.ContainsAny(*obj.FieldContainsanyStringPointer, "!@#")) {
+			errs = append(errs, types.NewValidationError("FieldContainsanyStringPointer must contain any of '!@#'"))
+		}
 	}
-	if !(len(obj.FieldMaxStringMap) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxStringMap must have at most 2 elements"))
+	return errs
+}
+func containsruneStructFieldsValidate(obj *containsruneStructFields) []error {
+	return containsruneStructFieldsValidateContext(context.Background(), obj)
+}
+
+func containsruneStructFieldsValidateContext(ctx context.Context, obj *containsruneStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxIntMap) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxIntMap must have at most 2 elements"))
+	var errs []error
+	if !(types.Contains(obj.FieldContainsruneString, "@")) {
+		errs = append(errs, types.NewValidationError("FieldContainsruneString must contain '@'"))
 	}
-	if !(len(obj.FieldMaxInt8Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt8Map must have at most 2 elements"))
+	return errs
+}
+
+func containsruneStructFieldsValidateFields(obj *containsruneStructFields, fields ...string) []error {
+	return containsruneStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsruneStructFieldsValidateExcept(obj *containsruneStructFields, fields ...string) []error {
+	return containsruneStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsruneStructFieldsValidatePartialContext(ctx context.Context, obj *containsruneStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxInt16Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt16Map must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsruneString") {
+		if !(types.Contains(obj.FieldContainsruneString, "@")) {
+			errs = append(errs, types.NewValidationError("FieldContainsruneString must contain '@'"))
+		}
 	}
-	if !(len(obj.FieldMaxInt32Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt32Map must have at most 2 elements"))
+	return errs
+}
+func containsruneStructFieldsPointerValidate(obj *containsruneStructFieldsPointer) []error {
+	return containsruneStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func containsruneStructFieldsPointerValidateContext(ctx context.Context, obj *containsruneStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxInt64Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt64Map must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldContainsruneStringPointer != nil && types.Contains(*obj.FieldContainsruneStringPointer, "@")) {
+		errs = append(errs, types.NewValidationError("FieldContainsruneStringPointer must contain '@'"))
 	}
-	if !(len(obj.FieldMaxUintMap) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUintMap must have at most 2 elements"))
+	return errs
+}
+
+func containsruneStructFieldsPointerValidateFields(obj *containsruneStructFieldsPointer, fields ...string) []error {
+	return containsruneStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func containsruneStructFieldsPointerValidateExcept(obj *containsruneStructFieldsPointer, fields ...string) []error {
+	return containsruneStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func containsruneStructFieldsPointerValidatePartialContext(ctx context.Context, obj *containsruneStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxUint8Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint8Map must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldContainsruneStringPointer") {
+		if !(obj.FieldContainsruneStringPointer != nil && types.Contains(*obj.FieldContainsruneStringPointer, "@")) {
+			errs = append(errs, types.NewValidationError("FieldContainsruneStringPointer must contain '@'"))
+		}
 	}
-	if !(len(obj.FieldMaxUint16Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint16Map must have at most 2 elements"))
+	return errs
+}
+func cpfStructFieldsValidate(obj *cpfStructFields) []error {
+	return cpfStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpfStructFieldsValidateContext(ctx context.Context, obj *cpfStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxUint32Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint32Map must have at most 2 elements"))
+	var errs []error
+	if !(types.IsValidCPF(obj.FieldCpfString, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCpfString must be a valid CPF"))
 	}
-	if !(len(obj.FieldMaxUint64Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint64Map must have at most 2 elements"))
+	return errs
+}
+
+func cpfStructFieldsValidateFields(obj *cpfStructFields, fields ...string) []error {
+	return cpfStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpfStructFieldsValidateExcept(obj *cpfStructFields, fields ...string) []error {
+	return cpfStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpfStructFieldsValidatePartialContext(ctx context.Context, obj *cpfStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxFloat32Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat32Map must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpfString") {
+		if !(types.IsValidCPF(obj.FieldCpfString, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCpfString must be a valid CPF"))
+		}
 	}
-	if !(len(obj.FieldMaxFloat64Map) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat64Map must have at most 2 elements"))
+	return errs
+}
+func cpfStructFieldsPointerValidate(obj *cpfStructFieldsPointer) []error {
+	return cpfStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpfStructFieldsPointerValidateContext(ctx context.Context, obj *cpfStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMaxBoolMap) <= 1) {
-		errs = append(errs, types.NewValidationError("FieldMaxBoolMap must have at most 1 elements"))
+	var errs []error
+	if !(obj.FieldCpfStringPointer != nil && types.IsValidCPF(*obj.FieldCpfStringPointer, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCpfStringPointer must be a valid CPF"))
 	}
 	return errs
 }
-func maxStructFieldsPointerValidate(obj *maxStructFieldsPointer) []error {
+
+func cpfStructFieldsPointerValidateFields(obj *cpfStructFieldsPointer, fields ...string) []error {
+	return cpfStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpfStructFieldsPointerValidateExcept(obj *cpfStructFieldsPointer, fields ...string) []error {
+	return cpfStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpfStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpfStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldMaxStringPointer != nil && len(*obj.FieldMaxStringPointer) <= 3) {
-		errs = append(errs, types.NewValidationError("FieldMaxStringPointer length must be <= 3"))
+	if selection.Has("FieldCpfStringPointer") {
+		if !(obj.FieldCpfStringPointer != nil && types.IsValidCPF(*obj.FieldCpfStringPointer, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCpfStringPointer must be a valid CPF"))
+		}
 	}
-	if !(obj.FieldMaxStringSlicePointer != nil && len(*obj.FieldMaxStringSlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxStringSlicePointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpjStructFieldsValidate(obj *cpf_cnpjStructFields) []error {
+	return cpf_cnpjStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpjStructFieldsValidateContext(ctx context.Context, obj *cpf_cnpjStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxIntSlicePointer != nil && len(*obj.FieldMaxIntSlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxIntSlicePointer must have at most 2 elements"))
+	var errs []error
+	if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpjString, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpjString must be a valid CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxInt8SlicePointer != nil && len(*obj.FieldMaxInt8SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt8SlicePointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpjStructFieldsValidateFields(obj *cpf_cnpjStructFields, fields ...string) []error {
+	return cpf_cnpjStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpjStructFieldsValidateExcept(obj *cpf_cnpjStructFields, fields ...string) []error {
+	return cpf_cnpjStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpjStructFieldsValidatePartialContext(ctx context.Context, obj *cpf_cnpjStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxInt16SlicePointer != nil && len(*obj.FieldMaxInt16SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt16SlicePointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpjString") {
+		if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpjString, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpjString must be a valid CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxInt32SlicePointer != nil && len(*obj.FieldMaxInt32SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt32SlicePointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpjStructFieldsPointerValidate(obj *cpf_cnpjStructFieldsPointer) []error {
+	return cpf_cnpjStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpjStructFieldsPointerValidateContext(ctx context.Context, obj *cpf_cnpjStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxInt64SlicePointer != nil && len(*obj.FieldMaxInt64SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt64SlicePointer must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldCpf_cnpjStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpjStringPointer, types.AnyDocumentFormat)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpjStringPointer must be a valid CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxUintSlicePointer != nil && len(*obj.FieldMaxUintSlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUintSlicePointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpjStructFieldsPointerValidateFields(obj *cpf_cnpjStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpjStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpjStructFieldsPointerValidateExcept(obj *cpf_cnpjStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpjStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpjStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpf_cnpjStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxUint8SlicePointer != nil && len(*obj.FieldMaxUint8SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint8SlicePointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpjStringPointer") {
+		if !(obj.FieldCpf_cnpjStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpjStringPointer, types.AnyDocumentFormat)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpjStringPointer must be a valid CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxUint16SlicePointer != nil && len(*obj.FieldMaxUint16SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint16SlicePointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpj_formattedStructFieldsValidate(obj *cpf_cnpj_formattedStructFields) []error {
+	return cpf_cnpj_formattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpj_formattedStructFieldsValidateContext(ctx context.Context, obj *cpf_cnpj_formattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxUint32SlicePointer != nil && len(*obj.FieldMaxUint32SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint32SlicePointer must have at most 2 elements"))
+	var errs []error
+	if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_formattedString, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedString must be a valid formatted CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxUint64SlicePointer != nil && len(*obj.FieldMaxUint64SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint64SlicePointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpj_formattedStructFieldsValidateFields(obj *cpf_cnpj_formattedStructFields, fields ...string) []error {
+	return cpf_cnpj_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpj_formattedStructFieldsValidateExcept(obj *cpf_cnpj_formattedStructFields, fields ...string) []error {
+	return cpf_cnpj_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpj_formattedStructFieldsValidatePartialContext(ctx context.Context, obj *cpf_cnpj_formattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxFloat32SlicePointer != nil && len(*obj.FieldMaxFloat32SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat32SlicePointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpj_formattedString") {
+		if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_formattedString, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedString must be a valid formatted CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxFloat64SlicePointer != nil && len(*obj.FieldMaxFloat64SlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat64SlicePointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpj_formattedStructFieldsPointerValidate(obj *cpf_cnpj_formattedStructFieldsPointer) []error {
+	return cpf_cnpj_formattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpj_formattedStructFieldsPointerValidateContext(ctx context.Context, obj *cpf_cnpj_formattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxBoolSlicePointer != nil && len(*obj.FieldMaxBoolSlicePointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxBoolSlicePointer must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldCpf_cnpj_formattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_formattedStringPointer, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedStringPointer must be a valid formatted CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxStringMapPointer != nil && len(*obj.FieldMaxStringMapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxStringMapPointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpj_formattedStructFieldsPointerValidateFields(obj *cpf_cnpj_formattedStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpj_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpj_formattedStructFieldsPointerValidateExcept(obj *cpf_cnpj_formattedStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpj_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpj_formattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpf_cnpj_formattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxIntMapPointer != nil && len(*obj.FieldMaxIntMapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxIntMapPointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpj_formattedStringPointer") {
+		if !(obj.FieldCpf_cnpj_formattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_formattedStringPointer, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpj_formattedStringPointer must be a valid formatted CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxInt8MapPointer != nil && len(*obj.FieldMaxInt8MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt8MapPointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpj_unformattedStructFieldsValidate(obj *cpf_cnpj_unformattedStructFields) []error {
+	return cpf_cnpj_unformattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpj_unformattedStructFieldsValidateContext(ctx context.Context, obj *cpf_cnpj_unformattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxInt16MapPointer != nil && len(*obj.FieldMaxInt16MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt16MapPointer must have at most 2 elements"))
+	var errs []error
+	if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_unformattedString, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedString must be a valid unformatted CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxInt32MapPointer != nil && len(*obj.FieldMaxInt32MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt32MapPointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpj_unformattedStructFieldsValidateFields(obj *cpf_cnpj_unformattedStructFields, fields ...string) []error {
+	return cpf_cnpj_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpj_unformattedStructFieldsValidateExcept(obj *cpf_cnpj_unformattedStructFields, fields ...string) []error {
+	return cpf_cnpj_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpj_unformattedStructFieldsValidatePartialContext(ctx context.Context, obj *cpf_cnpj_unformattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxInt64MapPointer != nil && len(*obj.FieldMaxInt64MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxInt64MapPointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpj_unformattedString") {
+		if !(types.IsValidCPFOrCNPJ(obj.FieldCpf_cnpj_unformattedString, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedString must be a valid unformatted CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxUintMapPointer != nil && len(*obj.FieldMaxUintMapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUintMapPointer must have at most 2 elements"))
+	return errs
+}
+func cpf_cnpj_unformattedStructFieldsPointerValidate(obj *cpf_cnpj_unformattedStructFieldsPointer) []error {
+	return cpf_cnpj_unformattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpf_cnpj_unformattedStructFieldsPointerValidateContext(ctx context.Context, obj *cpf_cnpj_unformattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxUint8MapPointer != nil && len(*obj.FieldMaxUint8MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint8MapPointer must have at most 2 elements"))
+	var errs []error
+	if !(obj.FieldCpf_cnpj_unformattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_unformattedStringPointer, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedStringPointer must be a valid unformatted CPF or CNPJ"))
 	}
-	if !(obj.FieldMaxUint16MapPointer != nil && len(*obj.FieldMaxUint16MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint16MapPointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_cnpj_unformattedStructFieldsPointerValidateFields(obj *cpf_cnpj_unformattedStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpj_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_cnpj_unformattedStructFieldsPointerValidateExcept(obj *cpf_cnpj_unformattedStructFieldsPointer, fields ...string) []error {
+	return cpf_cnpj_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_cnpj_unformattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpf_cnpj_unformattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxUint32MapPointer != nil && len(*obj.FieldMaxUint32MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint32MapPointer must have at most 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_cnpj_unformattedStringPointer") {
+		if !(obj.FieldCpf_cnpj_unformattedStringPointer != nil && types.IsValidCPFOrCNPJ(*obj.FieldCpf_cnpj_unformattedStringPointer, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_cnpj_unformattedStringPointer must be a valid unformatted CPF or CNPJ"))
+		}
 	}
-	if !(obj.FieldMaxUint64MapPointer != nil && len(*obj.FieldMaxUint64MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxUint64MapPointer must have at most 2 elements"))
+	return errs
+}
+func cpf_formattedStructFieldsValidate(obj *cpf_formattedStructFields) []error {
+	return cpf_formattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpf_formattedStructFieldsValidateContext(ctx context.Context, obj *cpf_formattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxFloat32MapPointer != nil && len(*obj.FieldMaxFloat32MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat32MapPointer must have at most 2 elements"))
+	var errs []error
+	if !(types.IsValidCPF(obj.FieldCpf_formattedString, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_formattedString must be a valid formatted CPF"))
 	}
-	if !(obj.FieldMaxFloat64MapPointer != nil && len(*obj.FieldMaxFloat64MapPointer) <= 2) {
-		errs = append(errs, types.NewValidationError("FieldMaxFloat64MapPointer must have at most 2 elements"))
+	return errs
+}
+
+func cpf_formattedStructFieldsValidateFields(obj *cpf_formattedStructFields, fields ...string) []error {
+	return cpf_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_formattedStructFieldsValidateExcept(obj *cpf_formattedStructFields, fields ...string) []error {
+	return cpf_formattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_formattedStructFieldsValidatePartialContext(ctx context.Context, obj *cpf_formattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMaxBoolMapPointer != nil && len(*obj.FieldMaxBoolMapPointer) <= 1) {
-		errs = append(errs, types.NewValidationError("FieldMaxBoolMapPointer must have at most 1 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_formattedString") {
+		if !(types.IsValidCPF(obj.FieldCpf_formattedString, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_formattedString must be a valid formatted CPF"))
+		}
 	}
 	return errs
 }
-func minStructFieldsValidate(obj *minStructFields) []error {
+func cpf_formattedStructFieldsPointerValidate(obj *cpf_formattedStructFieldsPointer) []error {
+	return cpf_formattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpf_formattedStructFieldsPointerValidateContext(ctx context.Context, obj *cpf_formattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(len(obj.FieldMinString) >= 5) {
-		errs = append(errs, types.NewValidationError("FieldMinString length must be >= 5"))
+	if !(obj.FieldCpf_formattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_formattedStringPointer, types.FormattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_formattedStringPointer must be a valid formatted CPF"))
 	}
-	if !(len(obj.FieldMinStringSlice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinStringSlice must have at least 2 elements"))
+	return errs
+}
+
+func cpf_formattedStructFieldsPointerValidateFields(obj *cpf_formattedStructFieldsPointer, fields ...string) []error {
+	return cpf_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_formattedStructFieldsPointerValidateExcept(obj *cpf_formattedStructFieldsPointer, fields ...string) []error {
+	return cpf_formattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_formattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpf_formattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinIntSlice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinIntSlice must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_formattedStringPointer") {
+		if !(obj.FieldCpf_formattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_formattedStringPointer, types.FormattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_formattedStringPointer must be a valid formatted CPF"))
+		}
 	}
-	if !(len(obj.FieldMinInt8Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt8Slice must have at least 2 elements"))
+	return errs
+}
+func cpf_unformattedStructFieldsValidate(obj *cpf_unformattedStructFields) []error {
+	return cpf_unformattedStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cpf_unformattedStructFieldsValidateContext(ctx context.Context, obj *cpf_unformattedStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinInt16Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt16Slice must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidCPF(obj.FieldCpf_unformattedString, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_unformattedString must be a valid unformatted CPF"))
 	}
-	if !(len(obj.FieldMinInt32Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt32Slice must have at least 2 elements"))
+	return errs
+}
+
+func cpf_unformattedStructFieldsValidateFields(obj *cpf_unformattedStructFields, fields ...string) []error {
+	return cpf_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_unformattedStructFieldsValidateExcept(obj *cpf_unformattedStructFields, fields ...string) []error {
+	return cpf_unformattedStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_unformattedStructFieldsValidatePartialContext(ctx context.Context, obj *cpf_unformattedStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinInt64Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt64Slice must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_unformattedString") {
+		if !(types.IsValidCPF(obj.FieldCpf_unformattedString, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_unformattedString must be a valid unformatted CPF"))
+		}
 	}
-	if !(len(obj.FieldMinUintSlice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUintSlice must have at least 2 elements"))
+	return errs
+}
+func cpf_unformattedStructFieldsPointerValidate(obj *cpf_unformattedStructFieldsPointer) []error {
+	return cpf_unformattedStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cpf_unformattedStructFieldsPointerValidateContext(ctx context.Context, obj *cpf_unformattedStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinUint8Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint8Slice must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldCpf_unformattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_unformattedStringPointer, types.UnformattedDocument)) {
+		errs = append(errs, types.NewValidationError("FieldCpf_unformattedStringPointer must be a valid unformatted CPF"))
 	}
-	if !(len(obj.FieldMinUint16Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint16Slice must have at least 2 elements"))
+	return errs
+}
+
+func cpf_unformattedStructFieldsPointerValidateFields(obj *cpf_unformattedStructFieldsPointer, fields ...string) []error {
+	return cpf_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cpf_unformattedStructFieldsPointerValidateExcept(obj *cpf_unformattedStructFieldsPointer, fields ...string) []error {
+	return cpf_unformattedStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cpf_unformattedStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cpf_unformattedStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinUint32Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint32Slice must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCpf_unformattedStringPointer") {
+		if !(obj.FieldCpf_unformattedStringPointer != nil && types.IsValidCPF(*obj.FieldCpf_unformattedStringPointer, types.UnformattedDocument)) {
+			errs = append(errs, types.NewValidationError("FieldCpf_unformattedStringPointer must be a valid unformatted CPF"))
+		}
 	}
-	if !(len(obj.FieldMinUint64Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint64Slice must have at least 2 elements"))
+	return errs
+}
+func credit_cardStructFieldsValidate(obj *credit_cardStructFields) []error {
+	return credit_cardStructFieldsValidateContext(context.Background(), obj)
+}
+
+func credit_cardStructFieldsValidateContext(ctx context.Context, obj *credit_cardStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinFloat32Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat32Slice must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidCreditCard(obj.FieldCredit_cardString)) {
+		errs = append(errs, types.NewValidationError("FieldCredit_cardString must be a valid credit card number"))
 	}
-	if !(len(obj.FieldMinFloat64Slice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat64Slice must have at least 2 elements"))
+	return errs
+}
+
+func credit_cardStructFieldsValidateFields(obj *credit_cardStructFields, fields ...string) []error {
+	return credit_cardStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func credit_cardStructFieldsValidateExcept(obj *credit_cardStructFields, fields ...string) []error {
+	return credit_cardStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func credit_cardStructFieldsValidatePartialContext(ctx context.Context, obj *credit_cardStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinBoolSlice) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinBoolSlice must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCredit_cardString") {
+		if !(types.IsValidCreditCard(obj.FieldCredit_cardString)) {
+			errs = append(errs, types.NewValidationError("FieldCredit_cardString must be a valid credit card number"))
+		}
 	}
-	if !(len(obj.FieldMinStringMap) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinStringMap must have at least 2 elements"))
+	return errs
+}
+func credit_cardStructFieldsPointerValidate(obj *credit_cardStructFieldsPointer) []error {
+	return credit_cardStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func credit_cardStructFieldsPointerValidateContext(ctx context.Context, obj *credit_cardStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinIntMap) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinIntMap must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldCredit_cardStringPointer != nil && types.IsValidCreditCard(*obj.FieldCredit_cardStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldCredit_cardStringPointer must be a valid credit card number"))
 	}
-	if !(len(obj.FieldMinInt8Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt8Map must have at least 2 elements"))
+	return errs
+}
+
+func credit_cardStructFieldsPointerValidateFields(obj *credit_cardStructFieldsPointer, fields ...string) []error {
+	return credit_cardStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func credit_cardStructFieldsPointerValidateExcept(obj *credit_cardStructFieldsPointer, fields ...string) []error {
+	return credit_cardStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func credit_cardStructFieldsPointerValidatePartialContext(ctx context.Context, obj *credit_cardStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinInt16Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt16Map must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCredit_cardStringPointer") {
+		if !(obj.FieldCredit_cardStringPointer != nil && types.IsValidCreditCard(*obj.FieldCredit_cardStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldCredit_cardStringPointer must be a valid credit card number"))
+		}
 	}
-	if !(len(obj.FieldMinInt32Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt32Map must have at least 2 elements"))
+	return errs
+}
+func cronStructFieldsValidate(obj *cronStructFields) []error {
+	return cronStructFieldsValidateContext(context.Background(), obj)
+}
+
+func cronStructFieldsValidateContext(ctx context.Context, obj *cronStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinInt64Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt64Map must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidCron(obj.FieldCronString)) {
+		errs = append(errs, types.NewValidationError("FieldCronString must be a valid cron expression (5 fields, or 6 with seconds)"))
 	}
-	if !(len(obj.FieldMinUintMap) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUintMap must have at least 2 elements"))
+	return errs
+}
+
+func cronStructFieldsValidateFields(obj *cronStructFields, fields ...string) []error {
+	return cronStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cronStructFieldsValidateExcept(obj *cronStructFields, fields ...string) []error {
+	return cronStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cronStructFieldsValidatePartialContext(ctx context.Context, obj *cronStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinUint8Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint8Map must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCronString") {
+		if !(types.IsValidCron(obj.FieldCronString)) {
+			errs = append(errs, types.NewValidationError("FieldCronString must be a valid cron expression (5 fields, or 6 with seconds)"))
+		}
 	}
-	if !(len(obj.FieldMinUint16Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint16Map must have at least 2 elements"))
+	return errs
+}
+func cronStructFieldsPointerValidate(obj *cronStructFieldsPointer) []error {
+	return cronStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func cronStructFieldsPointerValidateContext(ctx context.Context, obj *cronStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinUint32Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint32Map must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldCronStringPointer != nil && types.IsValidCron(*obj.FieldCronStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldCronStringPointer must be a valid cron expression (5 fields, or 6 with seconds)"))
 	}
-	if !(len(obj.FieldMinUint64Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint64Map must have at least 2 elements"))
+	return errs
+}
+
+func cronStructFieldsPointerValidateFields(obj *cronStructFieldsPointer, fields ...string) []error {
+	return cronStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func cronStructFieldsPointerValidateExcept(obj *cronStructFieldsPointer, fields ...string) []error {
+	return cronStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func cronStructFieldsPointerValidatePartialContext(ctx context.Context, obj *cronStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinFloat32Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat32Map must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldCronStringPointer") {
+		if !(obj.FieldCronStringPointer != nil && types.IsValidCron(*obj.FieldCronStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldCronStringPointer must be a valid cron expression (5 fields, or 6 with seconds)"))
+		}
 	}
-	if !(len(obj.FieldMinFloat64Map) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat64Map must have at least 2 elements"))
+	return errs
+}
+func datauriStructFieldsValidate(obj *datauriStructFields) []error {
+	return datauriStructFieldsValidateContext(context.Background(), obj)
+}
+
+func datauriStructFieldsValidateContext(ctx context.Context, obj *datauriStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldMinBoolMap) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinBoolMap must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidDataURI(obj.FieldDatauriString)) {
+		errs = append(errs, types.NewValidationError("FieldDatauriString must be a valid data URI"))
+	}
+	if !(types.IsValidDataURI(string(obj.FieldDatauriByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldDatauriByteSlice must be a valid data URI"))
 	}
 	return errs
 }
-func minStructFieldsPointerValidate(obj *minStructFieldsPointer) []error {
+
+func datauriStructFieldsValidateFields(obj *datauriStructFields, fields ...string) []error {
+	return datauriStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func datauriStructFieldsValidateExcept(obj *datauriStructFields, fields ...string) []error {
+	return datauriStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func datauriStructFieldsValidatePartialContext(ctx context.Context, obj *datauriStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldMinStringPointer != nil && len(*obj.FieldMinStringPointer) >= 5) {
-		errs = append(errs, types.NewValidationError("FieldMinStringPointer length must be >= 5"))
+	if selection.Has("FieldDatauriString") {
+		if !(types.IsValidDataURI(obj.FieldDatauriString)) {
+			errs = append(errs, types.NewValidationError("FieldDatauriString must be a valid data URI"))
+		}
 	}
-	if !(obj.FieldMinStringSlicePointer != nil && len(*obj.FieldMinStringSlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinStringSlicePointer must have at least 2 elements"))
+	if selection.Has("FieldDatauriByteSlice") {
+		if !(types.IsValidDataURI(string(obj.FieldDatauriByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldDatauriByteSlice must be a valid data URI"))
+		}
 	}
-	if !(obj.FieldMinIntSlicePointer != nil && len(*obj.FieldMinIntSlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinIntSlicePointer must have at least 2 elements"))
+	return errs
+}
+func datauriStructFieldsPointerValidate(obj *datauriStructFieldsPointer) []error {
+	return datauriStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func datauriStructFieldsPointerValidateContext(ctx context.Context, obj *datauriStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinInt8SlicePointer != nil && len(*obj.FieldMinInt8SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt8SlicePointer must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldDatauriStringPointer != nil && types.IsValidDataURI(*obj.FieldDatauriStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldDatauriStringPointer must be a valid data URI"))
 	}
-	if !(obj.FieldMinInt16SlicePointer != nil && len(*obj.FieldMinInt16SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt16SlicePointer must have at least 2 elements"))
+	if !(obj.FieldDatauriByteSlicePointer != nil && types.IsValidDataURI(string(*obj.FieldDatauriByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldDatauriByteSlicePointer must be a valid data URI"))
 	}
-	if !(obj.FieldMinInt32SlicePointer != nil && len(*obj.FieldMinInt32SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt32SlicePointer must have at least 2 elements"))
+	return errs
+}
+
+func datauriStructFieldsPointerValidateFields(obj *datauriStructFieldsPointer, fields ...string) []error {
+	return datauriStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func datauriStructFieldsPointerValidateExcept(obj *datauriStructFieldsPointer, fields ...string) []error {
+	return datauriStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func datauriStructFieldsPointerValidatePartialContext(ctx context.Context, obj *datauriStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinInt64SlicePointer != nil && len(*obj.FieldMinInt64SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt64SlicePointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDatauriStringPointer") {
+		if !(obj.FieldDatauriStringPointer != nil && types.IsValidDataURI(*obj.FieldDatauriStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldDatauriStringPointer must be a valid data URI"))
+		}
 	}
-	if !(obj.FieldMinUintSlicePointer != nil && len(*obj.FieldMinUintSlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUintSlicePointer must have at least 2 elements"))
+	if selection.Has("FieldDatauriByteSlicePointer") {
+		if !(obj.FieldDatauriByteSlicePointer != nil && types.IsValidDataURI(string(*obj.FieldDatauriByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldDatauriByteSlicePointer must be a valid data URI"))
+		}
 	}
-	if !(obj.FieldMinUint8SlicePointer != nil && len(*obj.FieldMinUint8SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint8SlicePointer must have at least 2 elements"))
+	return errs
+}
+func datetimeStructFieldsValidate(obj *datetimeStructFields) []error {
+	return datetimeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func datetimeStructFieldsValidateContext(ctx context.Context, obj *datetimeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinUint16SlicePointer != nil && len(*obj.FieldMinUint16SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint16SlicePointer must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidDatetime(obj.FieldDatetimeString, "2006-01-02")) {
+		errs = append(errs, types.NewValidationError("FieldDatetimeString must be a valid datetime with layout '2006-01-02'"))
 	}
-	if !(obj.FieldMinUint32SlicePointer != nil && len(*obj.FieldMinUint32SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint32SlicePointer must have at least 2 elements"))
+	return errs
+}
+
+func datetimeStructFieldsValidateFields(obj *datetimeStructFields, fields ...string) []error {
+	return datetimeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func datetimeStructFieldsValidateExcept(obj *datetimeStructFields, fields ...string) []error {
+	return datetimeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func datetimeStructFieldsValidatePartialContext(ctx context.Context, obj *datetimeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinUint64SlicePointer != nil && len(*obj.FieldMinUint64SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint64SlicePointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDatetimeString") {
+		if !(types.IsValidDatetime(obj.FieldDatetimeString, "2006-01-02")) {
+			errs = append(errs, types.NewValidationError("FieldDatetimeString must be a valid datetime with layout '2006-01-02'"))
+		}
 	}
-	if !(obj.FieldMinFloat32SlicePointer != nil && len(*obj.FieldMinFloat32SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat32SlicePointer must have at least 2 elements"))
-	}
-	if !(obj.FieldMinFloat64SlicePointer != nil && len(*obj.FieldMinFloat64SlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat64SlicePointer must have at least 2 elements"))
+	return errs
+}
+func datetimeStructFieldsPointerValidate(obj *datetimeStructFieldsPointer) []error {
+	return datetimeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func datetimeStructFieldsPointerValidateContext(ctx context.Context, obj *datetimeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinBoolSlicePointer != nil && len(*obj.FieldMinBoolSlicePointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinBoolSlicePointer must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldDatetimeStringPointer != nil && types.IsValidDatetime(*obj.FieldDatetimeStringPointer, "2006-01-02")) {
+		errs = append(errs, types.NewValidationError("FieldDatetimeStringPointer must be a valid datetime with layout '2006-01-02'"))
 	}
-	if !(obj.FieldMinStringMapPointer != nil && len(*obj.FieldMinStringMapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinStringMapPointer must have at least 2 elements"))
+	return errs
+}
+
+func datetimeStructFieldsPointerValidateFields(obj *datetimeStructFieldsPointer, fields ...string) []error {
+	return datetimeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func datetimeStructFieldsPointerValidateExcept(obj *datetimeStructFieldsPointer, fields ...string) []error {
+	return datetimeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func datetimeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *datetimeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinIntMapPointer != nil && len(*obj.FieldMinIntMapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinIntMapPointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDatetimeStringPointer") {
+		if !(obj.FieldDatetimeStringPointer != nil && types.IsValidDatetime(*obj.FieldDatetimeStringPointer, "2006-01-02")) {
+			errs = append(errs, types.NewValidationError("FieldDatetimeStringPointer must be a valid datetime with layout '2006-01-02'"))
+		}
 	}
-	if !(obj.FieldMinInt8MapPointer != nil && len(*obj.FieldMinInt8MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt8MapPointer must have at least 2 elements"))
+	return errs
+}
+func decimalStructFieldsValidate(obj *decimalStructFields) []error {
+	return decimalStructFieldsValidateContext(context.Background(), obj)
+}
+
+func decimalStructFieldsValidateContext(ctx context.Context, obj *decimalStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinInt16MapPointer != nil && len(*obj.FieldMinInt16MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt16MapPointer must have at least 2 elements"))
+	var errs []error
+	if !(types.IsValidDecimal(obj.FieldDecimalString, 12, 2)) {
+		errs = append(errs, types.NewValidationError("FieldDecimalString must be a decimal(12,2)"))
 	}
-	if !(obj.FieldMinInt32MapPointer != nil && len(*obj.FieldMinInt32MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt32MapPointer must have at least 2 elements"))
+	return errs
+}
+
+func decimalStructFieldsValidateFields(obj *decimalStructFields, fields ...string) []error {
+	return decimalStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimalStructFieldsValidateExcept(obj *decimalStructFields, fields ...string) []error {
+	return decimalStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimalStructFieldsValidatePartialContext(ctx context.Context, obj *decimalStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinInt64MapPointer != nil && len(*obj.FieldMinInt64MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinInt64MapPointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDecimalString") {
+		if !(types.IsValidDecimal(obj.FieldDecimalString, 12, 2)) {
+			errs = append(errs, types.NewValidationError("FieldDecimalString must be a decimal(12,2)"))
+		}
 	}
-	if !(obj.FieldMinUintMapPointer != nil && len(*obj.FieldMinUintMapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUintMapPointer must have at least 2 elements"))
+	return errs
+}
+func decimalStructFieldsPointerValidate(obj *decimalStructFieldsPointer) []error {
+	return decimalStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func decimalStructFieldsPointerValidateContext(ctx context.Context, obj *decimalStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinUint8MapPointer != nil && len(*obj.FieldMinUint8MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint8MapPointer must have at least 2 elements"))
+	var errs []error
+	if !(obj.FieldDecimalStringPointer != nil && types.IsValidDecimal(*obj.FieldDecimalStringPointer, 12, 2)) {
+		errs = append(errs, types.NewValidationError("FieldDecimalStringPointer must be a decimal(12,2)"))
 	}
-	if !(obj.FieldMinUint16MapPointer != nil && len(*obj.FieldMinUint16MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint16MapPointer must have at least 2 elements"))
+	return errs
+}
+
+func decimalStructFieldsPointerValidateFields(obj *decimalStructFieldsPointer, fields ...string) []error {
+	return decimalStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimalStructFieldsPointerValidateExcept(obj *decimalStructFieldsPointer, fields ...string) []error {
+	return decimalStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimalStructFieldsPointerValidatePartialContext(ctx context.Context, obj *decimalStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinUint32MapPointer != nil && len(*obj.FieldMinUint32MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint32MapPointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDecimalStringPointer") {
+		if !(obj.FieldDecimalStringPointer != nil && types.IsValidDecimal(*obj.FieldDecimalStringPointer, 12, 2)) {
+			errs = append(errs, types.NewValidationError("FieldDecimalStringPointer must be a decimal(12,2)"))
+		}
 	}
-	if !(obj.FieldMinUint64MapPointer != nil && len(*obj.FieldMinUint64MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinUint64MapPointer must have at least 2 elements"))
+	return errs
+}
+func decimal_gtStructFieldsValidate(obj *decimal_gtStructFields) []error {
+	return decimal_gtStructFieldsValidateContext(context.Background(), obj)
+}
+
+func decimal_gtStructFieldsValidateContext(ctx context.Context, obj *decimal_gtStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinFloat32MapPointer != nil && len(*obj.FieldMinFloat32MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat32MapPointer must have at least 2 elements"))
+	var errs []error
+	if !(types.IsDecimalGT(obj.FieldDecimal_gtString, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_gtString must be a decimal > 0.01"))
 	}
-	if !(obj.FieldMinFloat64MapPointer != nil && len(*obj.FieldMinFloat64MapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinFloat64MapPointer must have at least 2 elements"))
+	return errs
+}
+
+func decimal_gtStructFieldsValidateFields(obj *decimal_gtStructFields, fields ...string) []error {
+	return decimal_gtStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_gtStructFieldsValidateExcept(obj *decimal_gtStructFields, fields ...string) []error {
+	return decimal_gtStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_gtStructFieldsValidatePartialContext(ctx context.Context, obj *decimal_gtStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldMinBoolMapPointer != nil && len(*obj.FieldMinBoolMapPointer) >= 2) {
-		errs = append(errs, types.NewValidationError("FieldMinBoolMapPointer must have at least 2 elements"))
+	var errs []error
+	if selection.Has("FieldDecimal_gtString") {
+		if !(types.IsDecimalGT(obj.FieldDecimal_gtString, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_gtString must be a decimal > 0.01"))
+		}
 	}
 	return errs
 }
-func neqStructFieldsValidate(obj *neqStructFields) []error {
-	var errs []error
-	if !(obj.FieldNeqString != "abcde") {
-		errs = append(errs, types.NewValidationError("FieldNeqString must not be equal to 'abcde'"))
+func decimal_gtStructFieldsPointerValidate(obj *decimal_gtStructFieldsPointer) []error {
+	return decimal_gtStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func decimal_gtStructFieldsPointerValidateContext(ctx context.Context, obj *decimal_gtStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqInt != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt must not be equal to 32"))
+	var errs []error
+	if !(obj.FieldDecimal_gtStringPointer != nil && types.IsDecimalGT(*obj.FieldDecimal_gtStringPointer, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_gtStringPointer must be a decimal > 0.01"))
 	}
-	if !(obj.FieldNeqInt8 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt8 must not be equal to 32"))
+	return errs
+}
+
+func decimal_gtStructFieldsPointerValidateFields(obj *decimal_gtStructFieldsPointer, fields ...string) []error {
+	return decimal_gtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_gtStructFieldsPointerValidateExcept(obj *decimal_gtStructFieldsPointer, fields ...string) []error {
+	return decimal_gtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_gtStructFieldsPointerValidatePartialContext(ctx context.Context, obj *decimal_gtStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqInt16 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt16 must not be equal to 32"))
+	var errs []error
+	if selection.Has("FieldDecimal_gtStringPointer") {
+		if !(obj.FieldDecimal_gtStringPointer != nil && types.IsDecimalGT(*obj.FieldDecimal_gtStringPointer, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_gtStringPointer must be a decimal > 0.01"))
+		}
 	}
-	if !(obj.FieldNeqInt32 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt32 must not be equal to 32"))
+	return errs
+}
+func decimal_gteStructFieldsValidate(obj *decimal_gteStructFields) []error {
+	return decimal_gteStructFieldsValidateContext(context.Background(), obj)
+}
+
+func decimal_gteStructFieldsValidateContext(ctx context.Context, obj *decimal_gteStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqInt64 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt64 must not be equal to 32"))
+	var errs []error
+	if !(types.IsDecimalGTE(obj.FieldDecimal_gteString, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_gteString must be a decimal >= 0.01"))
 	}
-	if !(obj.FieldNeqUint != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint must not be equal to 32"))
+	return errs
+}
+
+func decimal_gteStructFieldsValidateFields(obj *decimal_gteStructFields, fields ...string) []error {
+	return decimal_gteStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_gteStructFieldsValidateExcept(obj *decimal_gteStructFields, fields ...string) []error {
+	return decimal_gteStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_gteStructFieldsValidatePartialContext(ctx context.Context, obj *decimal_gteStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqUint8 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint8 must not be equal to 32"))
+	var errs []error
+	if selection.Has("FieldDecimal_gteString") {
+		if !(types.IsDecimalGTE(obj.FieldDecimal_gteString, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_gteString must be a decimal >= 0.01"))
+		}
 	}
-	if !(obj.FieldNeqUint16 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint16 must not be equal to 32"))
+	return errs
+}
+func decimal_gteStructFieldsPointerValidate(obj *decimal_gteStructFieldsPointer) []error {
+	return decimal_gteStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func decimal_gteStructFieldsPointerValidateContext(ctx context.Context, obj *decimal_gteStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqUint32 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint32 must not be equal to 32"))
+	var errs []error
+	if !(obj.FieldDecimal_gteStringPointer != nil && types.IsDecimalGTE(*obj.FieldDecimal_gteStringPointer, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_gteStringPointer must be a decimal >= 0.01"))
 	}
-	if !(obj.FieldNeqUint64 != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint64 must not be equal to 32"))
+	return errs
+}
+
+func decimal_gteStructFieldsPointerValidateFields(obj *decimal_gteStructFieldsPointer, fields ...string) []error {
+	return decimal_gteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_gteStructFieldsPointerValidateExcept(obj *decimal_gteStructFieldsPointer, fields ...string) []error {
+	return decimal_gteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_gteStructFieldsPointerValidatePartialContext(ctx context.Context, obj *decimal_gteStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqFloat32 != 12.34) {
-		errs = append(errs, types.NewValidationError("FieldNeqFloat32 must not be equal to 12.34"))
+	var errs []error
+	if selection.Has("FieldDecimal_gteStringPointer") {
+		if !(obj.FieldDecimal_gteStringPointer != nil && types.IsDecimalGTE(*obj.FieldDecimal_gteStringPointer, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_gteStringPointer must be a decimal >= 0.01"))
+		}
 	}
-	if !(obj.FieldNeqFloat64 != 12.34) {
-		errs = append(errs, types.NewValidationError("FieldNeqFloat64 must not be equal to 12.34"))
+	return errs
+}
+func decimal_ltStructFieldsValidate(obj *decimal_ltStructFields) []error {
+	return decimal_ltStructFieldsValidateContext(context.Background(), obj)
+}
+
+func decimal_ltStructFieldsValidateContext(ctx context.Context, obj *decimal_ltStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqBool != true) {
-		errs = append(errs, types.NewValidationError("FieldNeqBool must not be equal to true"))
+	var errs []error
+	if !(types.IsDecimalLT(obj.FieldDecimal_ltString, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_ltString must be a decimal < 0.01"))
 	}
 	return errs
 }
-func neqStructFieldsPointerValidate(obj *neqStructFieldsPointer) []error {
-	var errs []error
-	if !(obj.FieldNeqStringPointer != nil && *obj.FieldNeqStringPointer != "abcde") {
-		errs = append(errs, types.NewValidationError("FieldNeqStringPointer must not be equal to 'abcde'"))
+
+func decimal_ltStructFieldsValidateFields(obj *decimal_ltStructFields, fields ...string) []error {
+	return decimal_ltStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_ltStructFieldsValidateExcept(obj *decimal_ltStructFields, fields ...string) []error {
+	return decimal_ltStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_ltStructFieldsValidatePartialContext(ctx context.Context, obj *decimal_ltStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqIntPointer != nil && *obj.FieldNeqIntPointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqIntPointer must not be equal to 32"))
+	var errs []error
+	if selection.Has("FieldDecimal_ltString") {
+		if !(types.IsDecimalLT(obj.FieldDecimal_ltString, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_ltString must be a decimal < 0.01"))
+		}
 	}
-	if !(obj.FieldNeqInt8Pointer != nil && *obj.FieldNeqInt8Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt8Pointer must not be equal to 32"))
+	return errs
+}
+func decimal_ltStructFieldsPointerValidate(obj *decimal_ltStructFieldsPointer) []error {
+	return decimal_ltStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func decimal_ltStructFieldsPointerValidateContext(ctx context.Context, obj *decimal_ltStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqInt16Pointer != nil && *obj.FieldNeqInt16Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt16Pointer must not be equal to 32"))
+	var errs []error
+	if !(obj.FieldDecimal_ltStringPointer != nil && types.IsDecimalLT(*obj.FieldDecimal_ltStringPointer, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_ltStringPointer must be a decimal < 0.01"))
 	}
-	if !(obj.FieldNeqInt32Pointer != nil && *obj.FieldNeqInt32Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt32Pointer must not be equal to 32"))
+	return errs
+}
+
+func decimal_ltStructFieldsPointerValidateFields(obj *decimal_ltStructFieldsPointer, fields ...string) []error {
+	return decimal_ltStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_ltStructFieldsPointerValidateExcept(obj *decimal_ltStructFieldsPointer, fields ...string) []error {
+	return decimal_ltStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_ltStructFieldsPointerValidatePartialContext(ctx context.Context, obj *decimal_ltStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqInt64Pointer != nil && *obj.FieldNeqInt64Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqInt64Pointer must not be equal to 32"))
+	var errs []error
+	if selection.Has("FieldDecimal_ltStringPointer") {
+		if !(obj.FieldDecimal_ltStringPointer != nil && types.IsDecimalLT(*obj.FieldDecimal_ltStringPointer, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_ltStringPointer must be a decimal < 0.01"))
+		}
 	}
-	if !(obj.FieldNeqUintPointer != nil && *obj.FieldNeqUintPointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUintPointer must not be equal to 32"))
+	return errs
+}
+func decimal_lteStructFieldsValidate(obj *decimal_lteStructFields) []error {
+	return decimal_lteStructFieldsValidateContext(context.Background(), obj)
+}
+
+func decimal_lteStructFieldsValidateContext(ctx context.Context, obj *decimal_lteStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqUint8Pointer != nil && *obj.FieldNeqUint8Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint8Pointer must not be equal to 32"))
+	var errs []error
+	if !(types.IsDecimalLTE(obj.FieldDecimal_lteString, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_lteString must be a decimal <= 0.01"))
 	}
-	if !(obj.FieldNeqUint16Pointer != nil && *obj.FieldNeqUint16Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint16Pointer must not be equal to 32"))
+	return errs
+}
+
+func decimal_lteStructFieldsValidateFields(obj *decimal_lteStructFields, fields ...string) []error {
+	return decimal_lteStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_lteStructFieldsValidateExcept(obj *decimal_lteStructFields, fields ...string) []error {
+	return decimal_lteStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_lteStructFieldsValidatePartialContext(ctx context.Context, obj *decimal_lteStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqUint32Pointer != nil && *obj.FieldNeqUint32Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint32Pointer must not be equal to 32"))
+	var errs []error
+	if selection.Has("FieldDecimal_lteString") {
+		if !(types.IsDecimalLTE(obj.FieldDecimal_lteString, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_lteString must be a decimal <= 0.01"))
+		}
 	}
-	if !(obj.FieldNeqUint64Pointer != nil && *obj.FieldNeqUint64Pointer != 32) {
-		errs = append(errs, types.NewValidationError("FieldNeqUint64Pointer must not be equal to 32"))
+	return errs
+}
+func decimal_lteStructFieldsPointerValidate(obj *decimal_lteStructFieldsPointer) []error {
+	return decimal_lteStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func decimal_lteStructFieldsPointerValidateContext(ctx context.Context, obj *decimal_lteStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqFloat32Pointer != nil && *obj.FieldNeqFloat32Pointer != 12.34) {
-		errs = append(errs, types.NewValidationError("FieldNeqFloat32Pointer must not be equal to 12.34"))
+	var errs []error
+	if !(obj.FieldDecimal_lteStringPointer != nil && types.IsDecimalLTE(*obj.FieldDecimal_lteStringPointer, "0.01")) {
+		errs = append(errs, types.NewValidationError("FieldDecimal_lteStringPointer must be a decimal <= 0.01"))
 	}
-	if !(obj.FieldNeqFloat64Pointer != nil && *obj.FieldNeqFloat64Pointer != 12.34) {
-		errs = append(errs, types.NewValidationError("FieldNeqFloat64Pointer must not be equal to 12.34"))
+	return errs
+}
+
+func decimal_lteStructFieldsPointerValidateFields(obj *decimal_lteStructFieldsPointer, fields ...string) []error {
+	return decimal_lteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func decimal_lteStructFieldsPointerValidateExcept(obj *decimal_lteStructFieldsPointer, fields ...string) []error {
+	return decimal_lteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func decimal_lteStructFieldsPointerValidatePartialContext(ctx context.Context, obj *decimal_lteStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNeqBoolPointer != nil && *obj.FieldNeqBoolPointer != true) {
-		errs = append(errs, types.NewValidationError("FieldNeqBoolPointer must not be equal to true"))
+	var errs []error
+	if selection.Has("FieldDecimal_lteStringPointer") {
+		if !(obj.FieldDecimal_lteStringPointer != nil && types.IsDecimalLTE(*obj.FieldDecimal_lteStringPointer, "0.01")) {
+			errs = append(errs, types.NewValidationError("FieldDecimal_lteStringPointer must be a decimal <= 0.01"))
+		}
 	}
 	return errs
 }
-func neq_ignore_caseStructFieldsValidate(obj *neq_ignore_caseStructFields) []error {
+func durationStructFieldsValidate(obj *durationStructFields) []error {
+	return durationStructFieldsValidateContext(context.Background(), obj)
+}
+
+func durationStructFieldsValidateContext(ctx context.Context, obj *durationStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(!types.EqualFold(obj.FieldNeq_ignore_caseString, "abcde")) {
-		errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseString must not be equal to 'abcde'"))
+	if !(types.IsValidDuration(obj.FieldDurationString)) {
+		errs = append(errs, types.NewValidationError("FieldDurationString must be a valid duration"))
 	}
 	return errs
 }
-func neq_ignore_caseStructFieldsPointerValidate(obj *neq_ignore_caseStructFieldsPointer) []error {
+
+func durationStructFieldsValidateFields(obj *durationStructFields, fields ...string) []error {
+	return durationStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func durationStructFieldsValidateExcept(obj *durationStructFields, fields ...string) []error {
+	return durationStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func durationStructFieldsValidatePartialContext(ctx context.Context, obj *durationStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldNeq_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNeq_ignore_caseStringPointer, "abcde")) {
-		errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseStringPointer must not be equal to 'abcde'"))
+	if selection.Has("FieldDurationString") {
+		if !(types.IsValidDuration(obj.FieldDurationString)) {
+			errs = append(errs, types.NewValidationError("FieldDurationString must be a valid duration"))
+		}
 	}
 	return errs
 }
-func ninStructFieldsValidate(obj *ninStructFields) []error {
+func durationStructFieldsPointerValidate(obj *durationStructFieldsPointer) []error {
+	return durationStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func durationStructFieldsPointerValidateContext(ctx context.Context, obj *durationStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
 	var errs []error
-	if !(obj.FieldNinString != "ab" && obj.FieldNinString != "cd" && obj.FieldNinString != "ef") {
-		errs = append(errs, types.NewValidationError("FieldNinString must not be one of 'ab' 'cd' 'ef'"))
+	if !(obj.FieldDurationStringPointer != nil && types.IsValidDuration(*obj.FieldDurationStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldDurationStringPointer must be a valid duration"))
 	}
-	if !(obj.FieldNinInt != 12 && obj.FieldNinInt != 34 && obj.FieldNinInt != 56) {
+	return errs
+}
+
+func durationStructFieldsPointerValidateFields(obj *durationStructFieldsPointer, fields ...string) []error {
+	return durationStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func durationStructFieldsPointerValidateExcept(obj *durationStructFieldsPointer, fields ...string) []error {
+	return durationStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func durationStructFieldsPointerValidatePartialContext(ctx context.Context, obj *durationStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldDurationStringPointer") {
+		if !(obj.FieldDurationStringPointer != nil && types.IsValidDuration(*obj.FieldDurationStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldDurationStringPointer must be a valid duration"))
+		}
+	}
+	return errs
+}
+func e164StructFieldsValidate(obj *e164StructFields) []error {
+	return e164StructFieldsValidateContext(context.Background(), obj)
+}
+
+func e164StructFieldsValidateContext(ctx context.Context, obj *e164StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidE164(obj.FieldE164String)) {
+		errs = append(errs, types.NewValidationError("FieldE164String must be a valid E.164 phone number"))
+	}
+	return errs
+}
+
+func e164StructFieldsValidateFields(obj *e164StructFields, fields ...string) []error {
+	return e164StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func e164StructFieldsValidateExcept(obj *e164StructFields, fields ...string) []error {
+	return e164StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func e164StructFieldsValidatePartialContext(ctx context.Context, obj *e164StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldE164String") {
+		if !(types.IsValidE164(obj.FieldE164String)) {
+			errs = append(errs, types.NewValidationError("FieldE164String must be a valid E.164 phone number"))
+		}
+	}
+	return errs
+}
+func e164StructFieldsPointerValidate(obj *e164StructFieldsPointer) []error {
+	return e164StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func e164StructFieldsPointerValidateContext(ctx context.Context, obj *e164StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldE164StringPointer != nil && types.IsValidE164(*obj.FieldE164StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldE164StringPointer must be a valid E.164 phone number"))
+	}
+	return errs
+}
+
+func e164StructFieldsPointerValidateFields(obj *e164StructFieldsPointer, fields ...string) []error {
+	return e164StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func e164StructFieldsPointerValidateExcept(obj *e164StructFieldsPointer, fields ...string) []error {
+	return e164StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func e164StructFieldsPointerValidatePartialContext(ctx context.Context, obj *e164StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldE164StringPointer") {
+		if !(obj.FieldE164StringPointer != nil && types.IsValidE164(*obj.FieldE164StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldE164StringPointer must be a valid E.164 phone number"))
+		}
+	}
+	return errs
+}
+func ean13StructFieldsValidate(obj *ean13StructFields) []error {
+	return ean13StructFieldsValidateContext(context.Background(), obj)
+}
+
+func ean13StructFieldsValidateContext(ctx context.Context, obj *ean13StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidEAN13(obj.FieldEan13String)) {
+		errs = append(errs, types.NewValidationError("FieldEan13String must be a valid EAN-13"))
+	}
+	return errs
+}
+
+func ean13StructFieldsValidateFields(obj *ean13StructFields, fields ...string) []error {
+	return ean13StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ean13StructFieldsValidateExcept(obj *ean13StructFields, fields ...string) []error {
+	return ean13StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ean13StructFieldsValidatePartialContext(ctx context.Context, obj *ean13StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEan13String") {
+		if !(types.IsValidEAN13(obj.FieldEan13String)) {
+			errs = append(errs, types.NewValidationError("FieldEan13String must be a valid EAN-13"))
+		}
+	}
+	return errs
+}
+func ean13StructFieldsPointerValidate(obj *ean13StructFieldsPointer) []error {
+	return ean13StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ean13StructFieldsPointerValidateContext(ctx context.Context, obj *ean13StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEan13StringPointer != nil && types.IsValidEAN13(*obj.FieldEan13StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldEan13StringPointer must be a valid EAN-13"))
+	}
+	return errs
+}
+
+func ean13StructFieldsPointerValidateFields(obj *ean13StructFieldsPointer, fields ...string) []error {
+	return ean13StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ean13StructFieldsPointerValidateExcept(obj *ean13StructFieldsPointer, fields ...string) []error {
+	return ean13StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ean13StructFieldsPointerValidatePartialContext(ctx context.Context, obj *ean13StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEan13StringPointer") {
+		if !(obj.FieldEan13StringPointer != nil && types.IsValidEAN13(*obj.FieldEan13StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldEan13StringPointer must be a valid EAN-13"))
+		}
+	}
+	return errs
+}
+func ean8StructFieldsValidate(obj *ean8StructFields) []error {
+	return ean8StructFieldsValidateContext(context.Background(), obj)
+}
+
+func ean8StructFieldsValidateContext(ctx context.Context, obj *ean8StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidEAN8(obj.FieldEan8String)) {
+		errs = append(errs, types.NewValidationError("FieldEan8String must be a valid EAN-8"))
+	}
+	return errs
+}
+
+func ean8StructFieldsValidateFields(obj *ean8StructFields, fields ...string) []error {
+	return ean8StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ean8StructFieldsValidateExcept(obj *ean8StructFields, fields ...string) []error {
+	return ean8StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ean8StructFieldsValidatePartialContext(ctx context.Context, obj *ean8StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEan8String") {
+		if !(types.IsValidEAN8(obj.FieldEan8String)) {
+			errs = append(errs, types.NewValidationError("FieldEan8String must be a valid EAN-8"))
+		}
+	}
+	return errs
+}
+func ean8StructFieldsPointerValidate(obj *ean8StructFieldsPointer) []error {
+	return ean8StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ean8StructFieldsPointerValidateContext(ctx context.Context, obj *ean8StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEan8StringPointer != nil && types.IsValidEAN8(*obj.FieldEan8StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldEan8StringPointer must be a valid EAN-8"))
+	}
+	return errs
+}
+
+func ean8StructFieldsPointerValidateFields(obj *ean8StructFieldsPointer, fields ...string) []error {
+	return ean8StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ean8StructFieldsPointerValidateExcept(obj *ean8StructFieldsPointer, fields ...string) []error {
+	return ean8StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ean8StructFieldsPointerValidatePartialContext(ctx context.Context, obj *ean8StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEan8StringPointer") {
+		if !(obj.FieldEan8StringPointer != nil && types.IsValidEAN8(*obj.FieldEan8StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldEan8StringPointer must be a valid EAN-8"))
+		}
+	}
+	return errs
+}
+func emailStructFieldsValidate(obj *emailStructFields) []error {
+	return emailStructFieldsValidateContext(context.Background(), obj)
+}
+
+func emailStructFieldsValidateContext(ctx context.Context, obj *emailStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidEmail(obj.FieldEmailString)) {
+		errs = append(errs, types.NewValidationError("FieldEmailString must be a valid email"))
+	}
+	return errs
+}
+
+func emailStructFieldsValidateFields(obj *emailStructFields, fields ...string) []error {
+	return emailStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func emailStructFieldsValidateExcept(obj *emailStructFields, fields ...string) []error {
+	return emailStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func emailStructFieldsValidatePartialContext(ctx context.Context, obj *emailStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEmailString") {
+		if !(types.IsValidEmail(obj.FieldEmailString)) {
+			errs = append(errs, types.NewValidationError("FieldEmailString must be a valid email"))
+		}
+	}
+	return errs
+}
+func emailStructFieldsPointerValidate(obj *emailStructFieldsPointer) []error {
+	return emailStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func emailStructFieldsPointerValidateContext(ctx context.Context, obj *emailStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEmailStringPointer != nil && types.IsValidEmail(*obj.FieldEmailStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldEmailStringPointer must be a valid email"))
+	}
+	return errs
+}
+
+func emailStructFieldsPointerValidateFields(obj *emailStructFieldsPointer, fields ...string) []error {
+	return emailStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func emailStructFieldsPointerValidateExcept(obj *emailStructFieldsPointer, fields ...string) []error {
+	return emailStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func emailStructFieldsPointerValidatePartialContext(ctx context.Context, obj *emailStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEmailStringPointer") {
+		if !(obj.FieldEmailStringPointer != nil && types.IsValidEmail(*obj.FieldEmailStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldEmailStringPointer must be a valid email"))
+		}
+	}
+	return errs
+}
+func endsnotwithStructFieldsValidate(obj *endsnotwithStructFields) []error {
+	return endsnotwithStructFieldsValidateContext(context.Background(), obj)
+}
+
+func endsnotwithStructFieldsValidateContext(ctx context.Context, obj *endsnotwithStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(!types.HasSuffix(obj.FieldEndsnotwithString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldEndsnotwithString must not end with 'ab'"))
+	}
+	return errs
+}
+
+func endsnotwithStructFieldsValidateFields(obj *endsnotwithStructFields, fields ...string) []error {
+	return endsnotwithStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func endsnotwithStructFieldsValidateExcept(obj *endsnotwithStructFields, fields ...string) []error {
+	return endsnotwithStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func endsnotwithStructFieldsValidatePartialContext(ctx context.Context, obj *endsnotwithStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEndsnotwithString") {
+		if !(!types.HasSuffix(obj.FieldEndsnotwithString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldEndsnotwithString must not end with 'ab'"))
+		}
+	}
+	return errs
+}
+func endsnotwithStructFieldsPointerValidate(obj *endsnotwithStructFieldsPointer) []error {
+	return endsnotwithStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func endsnotwithStructFieldsPointerValidateContext(ctx context.Context, obj *endsnotwithStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEndsnotwithStringPointer != nil && !types.HasSuffix(*obj.FieldEndsnotwithStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldEndsnotwithStringPointer must not end with 'ab'"))
+	}
+	return errs
+}
+
+func endsnotwithStructFieldsPointerValidateFields(obj *endsnotwithStructFieldsPointer, fields ...string) []error {
+	return endsnotwithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func endsnotwithStructFieldsPointerValidateExcept(obj *endsnotwithStructFieldsPointer, fields ...string) []error {
+	return endsnotwithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func endsnotwithStructFieldsPointerValidatePartialContext(ctx context.Context, obj *endsnotwithStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEndsnotwithStringPointer") {
+		if !(obj.FieldEndsnotwithStringPointer != nil && !types.HasSuffix(*obj.FieldEndsnotwithStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldEndsnotwithStringPointer must not end with 'ab'"))
+		}
+	}
+	return errs
+}
+func endswithStructFieldsValidate(obj *endswithStructFields) []error {
+	return endswithStructFieldsValidateContext(context.Background(), obj)
+}
+
+func endswithStructFieldsValidateContext(ctx context.Context, obj *endswithStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.HasSuffix(obj.FieldEndswithString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldEndswithString must end with 'ab'"))
+	}
+	return errs
+}
+
+func endswithStructFieldsValidateFields(obj *endswithStructFields, fields ...string) []error {
+	return endswithStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func endswithStructFieldsValidateExcept(obj *endswithStructFields, fields ...string) []error {
+	return endswithStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func endswithStructFieldsValidatePartialContext(ctx context.Context, obj *endswithStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEndswithString") {
+		if !(types.HasSuffix(obj.FieldEndswithString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldEndswithString must end with 'ab'"))
+		}
+	}
+	return errs
+}
+func endswithStructFieldsPointerValidate(obj *endswithStructFieldsPointer) []error {
+	return endswithStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func endswithStructFieldsPointerValidateContext(ctx context.Context, obj *endswithStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEndswithStringPointer != nil && types.HasSuffix(*obj.FieldEndswithStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldEndswithStringPointer must end with 'ab'"))
+	}
+	return errs
+}
+
+func endswithStructFieldsPointerValidateFields(obj *endswithStructFieldsPointer, fields ...string) []error {
+	return endswithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func endswithStructFieldsPointerValidateExcept(obj *endswithStructFieldsPointer, fields ...string) []error {
+	return endswithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func endswithStructFieldsPointerValidatePartialContext(ctx context.Context, obj *endswithStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEndswithStringPointer") {
+		if !(obj.FieldEndswithStringPointer != nil && types.HasSuffix(*obj.FieldEndswithStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldEndswithStringPointer must end with 'ab'"))
+		}
+	}
+	return errs
+}
+func eqStructFieldsValidate(obj *eqStructFields) []error {
+	return eqStructFieldsValidateContext(context.Background(), obj)
+}
+
+func eqStructFieldsValidateContext(ctx context.Context, obj *eqStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEqString == "abcde") {
+		errs = append(errs, types.NewValidationError("FieldEqString must be equal to 'abcde'"))
+	}
+	if !(obj.FieldEqInt == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt must be equal to 32"))
+	}
+	if !(obj.FieldEqInt8 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt8 must be equal to 32"))
+	}
+	if !(obj.FieldEqInt16 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt16 must be equal to 32"))
+	}
+	if !(obj.FieldEqInt32 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt32 must be equal to 32"))
+	}
+	if !(obj.FieldEqInt64 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt64 must be equal to 32"))
+	}
+	if !(obj.FieldEqUint == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint must be equal to 32"))
+	}
+	if !(obj.FieldEqUint8 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint8 must be equal to 32"))
+	}
+	if !(obj.FieldEqUint16 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint16 must be equal to 32"))
+	}
+	if !(obj.FieldEqUint32 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint32 must be equal to 32"))
+	}
+	if !(obj.FieldEqUint64 == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint64 must be equal to 32"))
+	}
+	if !(obj.FieldEqFloat32 == 12.34) {
+		errs = append(errs, types.NewValidationError("FieldEqFloat32 must be equal to 12.34"))
+	}
+	if !(obj.FieldEqFloat64 == 12.34) {
+		errs = append(errs, types.NewValidationError("FieldEqFloat64 must be equal to 12.34"))
+	}
+	if !(obj.FieldEqBool == true) {
+		errs = append(errs, types.NewValidationError("FieldEqBool must be equal to true"))
+	}
+	return errs
+}
+
+func eqStructFieldsValidateFields(obj *eqStructFields, fields ...string) []error {
+	return eqStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func eqStructFieldsValidateExcept(obj *eqStructFields, fields ...string) []error {
+	return eqStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func eqStructFieldsValidatePartialContext(ctx context.Context, obj *eqStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEqString") {
+		if !(obj.FieldEqString == "abcde") {
+			errs = append(errs, types.NewValidationError("FieldEqString must be equal to 'abcde'"))
+		}
+	}
+	if selection.Has("FieldEqInt") {
+		if !(obj.FieldEqInt == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt8") {
+		if !(obj.FieldEqInt8 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt8 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt16") {
+		if !(obj.FieldEqInt16 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt16 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt32") {
+		if !(obj.FieldEqInt32 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt32 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt64") {
+		if !(obj.FieldEqInt64 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt64 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint") {
+		if !(obj.FieldEqUint == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint8") {
+		if !(obj.FieldEqUint8 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint8 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint16") {
+		if !(obj.FieldEqUint16 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint16 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint32") {
+		if !(obj.FieldEqUint32 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint32 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint64") {
+		if !(obj.FieldEqUint64 == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint64 must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqFloat32") {
+		if !(obj.FieldEqFloat32 == 12.34) {
+			errs = append(errs, types.NewValidationError("FieldEqFloat32 must be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldEqFloat64") {
+		if !(obj.FieldEqFloat64 == 12.34) {
+			errs = append(errs, types.NewValidationError("FieldEqFloat64 must be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldEqBool") {
+		if !(obj.FieldEqBool == true) {
+			errs = append(errs, types.NewValidationError("FieldEqBool must be equal to true"))
+		}
+	}
+	return errs
+}
+func eqStructFieldsPointerValidate(obj *eqStructFieldsPointer) []error {
+	return eqStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func eqStructFieldsPointerValidateContext(ctx context.Context, obj *eqStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEqStringPointer != nil && *obj.FieldEqStringPointer == "abcde") {
+		errs = append(errs, types.NewValidationError("FieldEqStringPointer must be equal to 'abcde'"))
+	}
+	if !(obj.FieldEqIntPointer != nil && *obj.FieldEqIntPointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqIntPointer must be equal to 32"))
+	}
+	if !(obj.FieldEqInt8Pointer != nil && *obj.FieldEqInt8Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt8Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqInt16Pointer != nil && *obj.FieldEqInt16Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt16Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqInt32Pointer != nil && *obj.FieldEqInt32Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt32Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqInt64Pointer != nil && *obj.FieldEqInt64Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqInt64Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqUintPointer != nil && *obj.FieldEqUintPointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUintPointer must be equal to 32"))
+	}
+	if !(obj.FieldEqUint8Pointer != nil && *obj.FieldEqUint8Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint8Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqUint16Pointer != nil && *obj.FieldEqUint16Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint16Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqUint32Pointer != nil && *obj.FieldEqUint32Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint32Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqUint64Pointer != nil && *obj.FieldEqUint64Pointer == 32) {
+		errs = append(errs, types.NewValidationError("FieldEqUint64Pointer must be equal to 32"))
+	}
+	if !(obj.FieldEqFloat32Pointer != nil && *obj.FieldEqFloat32Pointer == 12.34) {
+		errs = append(errs, types.NewValidationError("FieldEqFloat32Pointer must be equal to 12.34"))
+	}
+	if !(obj.FieldEqFloat64Pointer != nil && *obj.FieldEqFloat64Pointer == 12.34) {
+		errs = append(errs, types.NewValidationError("FieldEqFloat64Pointer must be equal to 12.34"))
+	}
+	if !(obj.FieldEqBoolPointer != nil && *obj.FieldEqBoolPointer == true) {
+		errs = append(errs, types.NewValidationError("FieldEqBoolPointer must be equal to true"))
+	}
+	return errs
+}
+
+func eqStructFieldsPointerValidateFields(obj *eqStructFieldsPointer, fields ...string) []error {
+	return eqStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func eqStructFieldsPointerValidateExcept(obj *eqStructFieldsPointer, fields ...string) []error {
+	return eqStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func eqStructFieldsPointerValidatePartialContext(ctx context.Context, obj *eqStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEqStringPointer") {
+		if !(obj.FieldEqStringPointer != nil && *obj.FieldEqStringPointer == "abcde") {
+			errs = append(errs, types.NewValidationError("FieldEqStringPointer must be equal to 'abcde'"))
+		}
+	}
+	if selection.Has("FieldEqIntPointer") {
+		if !(obj.FieldEqIntPointer != nil && *obj.FieldEqIntPointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqIntPointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt8Pointer") {
+		if !(obj.FieldEqInt8Pointer != nil && *obj.FieldEqInt8Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt8Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt16Pointer") {
+		if !(obj.FieldEqInt16Pointer != nil && *obj.FieldEqInt16Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt16Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt32Pointer") {
+		if !(obj.FieldEqInt32Pointer != nil && *obj.FieldEqInt32Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt32Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqInt64Pointer") {
+		if !(obj.FieldEqInt64Pointer != nil && *obj.FieldEqInt64Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqInt64Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUintPointer") {
+		if !(obj.FieldEqUintPointer != nil && *obj.FieldEqUintPointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUintPointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint8Pointer") {
+		if !(obj.FieldEqUint8Pointer != nil && *obj.FieldEqUint8Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint8Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint16Pointer") {
+		if !(obj.FieldEqUint16Pointer != nil && *obj.FieldEqUint16Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint16Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint32Pointer") {
+		if !(obj.FieldEqUint32Pointer != nil && *obj.FieldEqUint32Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint32Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqUint64Pointer") {
+		if !(obj.FieldEqUint64Pointer != nil && *obj.FieldEqUint64Pointer == 32) {
+			errs = append(errs, types.NewValidationError("FieldEqUint64Pointer must be equal to 32"))
+		}
+	}
+	if selection.Has("FieldEqFloat32Pointer") {
+		if !(obj.FieldEqFloat32Pointer != nil && *obj.FieldEqFloat32Pointer == 12.34) {
+			errs = append(errs, types.NewValidationError("FieldEqFloat32Pointer must be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldEqFloat64Pointer") {
+		if !(obj.FieldEqFloat64Pointer != nil && *obj.FieldEqFloat64Pointer == 12.34) {
+			errs = append(errs, types.NewValidationError("FieldEqFloat64Pointer must be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldEqBoolPointer") {
+		if !(obj.FieldEqBoolPointer != nil && *obj.FieldEqBoolPointer == true) {
+			errs = append(errs, types.NewValidationError("FieldEqBoolPointer must be equal to true"))
+		}
+	}
+	return errs
+}
+func eq_ignore_caseStructFieldsValidate(obj *eq_ignore_caseStructFields) []error {
+	return eq_ignore_caseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func eq_ignore_caseStructFieldsValidateContext(ctx context.Context, obj *eq_ignore_caseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.EqualFold(obj.FieldEq_ignore_caseString, "abcde")) {
+		errs = append(errs, types.NewValidationError("FieldEq_ignore_caseString must be equal to 'abcde'"))
+	}
+	return errs
+}
+
+func eq_ignore_caseStructFieldsValidateFields(obj *eq_ignore_caseStructFields, fields ...string) []error {
+	return eq_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func eq_ignore_caseStructFieldsValidateExcept(obj *eq_ignore_caseStructFields, fields ...string) []error {
+	return eq_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func eq_ignore_caseStructFieldsValidatePartialContext(ctx context.Context, obj *eq_ignore_caseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEq_ignore_caseString") {
+		if !(types.EqualFold(obj.FieldEq_ignore_caseString, "abcde")) {
+			errs = append(errs, types.NewValidationError("FieldEq_ignore_caseString must be equal to 'abcde'"))
+		}
+	}
+	return errs
+}
+func eq_ignore_caseStructFieldsPointerValidate(obj *eq_ignore_caseStructFieldsPointer) []error {
+	return eq_ignore_caseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func eq_ignore_caseStructFieldsPointerValidateContext(ctx context.Context, obj *eq_ignore_caseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldEq_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldEq_ignore_caseStringPointer, "abcde")) {
+		errs = append(errs, types.NewValidationError("FieldEq_ignore_caseStringPointer must be equal to 'abcde'"))
+	}
+	return errs
+}
+
+func eq_ignore_caseStructFieldsPointerValidateFields(obj *eq_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return eq_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func eq_ignore_caseStructFieldsPointerValidateExcept(obj *eq_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return eq_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func eq_ignore_caseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *eq_ignore_caseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldEq_ignore_caseStringPointer") {
+		if !(obj.FieldEq_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldEq_ignore_caseStringPointer, "abcde")) {
+			errs = append(errs, types.NewValidationError("FieldEq_ignore_caseStringPointer must be equal to 'abcde'"))
+		}
+	}
+	return errs
+}
+func excludesStructFieldsValidate(obj *excludesStructFields) []error {
+	return excludesStructFieldsValidateContext(context.Background(), obj)
+}
+
+func excludesStructFieldsValidateContext(ctx context.Context, obj *excludesStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(!types.Contains(obj.FieldExcludesString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldExcludesString must not contain 'ab'"))
+	}
+	return errs
+}
+
+func excludesStructFieldsValidateFields(obj *excludesStructFields, fields ...string) []error {
+	return excludesStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func excludesStructFieldsValidateExcept(obj *excludesStructFields, fields ...string) []error {
+	return excludesStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func excludesStructFieldsValidatePartialContext(ctx context.Context, obj *excludesStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldExcludesString") {
+		if !(!types.Contains(obj.FieldExcludesString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldExcludesString must not contain 'ab'"))
+		}
+	}
+	return errs
+}
+func excludesStructFieldsPointerValidate(obj *excludesStructFieldsPointer) []error {
+	return excludesStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func excludesStructFieldsPointerValidateContext(ctx context.Context, obj *excludesStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldExcludesStringPointer != nil && !types.Contains(*obj.FieldExcludesStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldExcludesStringPointer must not contain 'ab'"))
+	}
+	return errs
+}
+
+func excludesStructFieldsPointerValidateFields(obj *excludesStructFieldsPointer, fields ...string) []error {
+	return excludesStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func excludesStructFieldsPointerValidateExcept(obj *excludesStructFieldsPointer, fields ...string) []error {
+	return excludesStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func excludesStructFieldsPointerValidatePartialContext(ctx context.Context, obj *excludesStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldExcludesStringPointer") {
+		if !(obj.FieldExcludesStringPointer != nil && !types.Contains(*obj.FieldExcludesStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldExcludesStringPointer must not contain 'ab'"))
+		}
+	}
+	return errs
+}
+func excludesallStructFieldsValidate(obj *excludesallStructFields) []error {
+	return excludesallStructFieldsValidateContext(context.Background(), obj)
+}
+
+func excludesallStructFieldsValidateContext(ctx context.Context, obj *excludesallStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(!types.ContainsAny(obj.FieldExcludesallString, "!@#")) {
+		errs = append(errs, types.NewValidationError("FieldExcludesallString must not contain any of '!@#'"))
+	}
+	return errs
+}
+
+func excludesallStructFieldsValidateFields(obj *excludesallStructFields, fields ...string) []error {
+	return excludesallStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func excludesallStructFieldsValidateExcept(obj *excludesallStructFields, fields ...string) []error {
+	return excludesallStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func excludesallStructFieldsValidatePartialContext(ctx context.Context, obj *excludesallStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldExcludesallString") {
+		if !(!types.ContainsAny(obj.FieldExcludesallString, "!@#")) {
+			errs = append(errs, types.NewValidationError("FieldExcludesallString must not contain any of '!@#'"))
+		}
+	}
+	return errs
+}
+func excludesallStructFieldsPointerValidate(obj *excludesallStructFieldsPointer) []error {
+	return excludesallStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func excludesallStructFieldsPointerValidateContext(ctx context.Context, obj *excludesallStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldExcludesallStringPointer != nil && !types.ContainsAny(*obj.FieldExcludesallStringPointer, "!@#")) {
+		errs = append(errs, types.NewValidationError("FieldExcludesallStringPointer must not contain any of '!@#'"))
+	}
+	return errs
+}
+
+func excludesallStructFieldsPointerValidateFields(obj *excludesallStructFieldsPointer, fields ...string) []error {
+	return excludesallStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func excludesallStructFieldsPointerValidateExcept(obj *excludesallStructFieldsPointer, fields ...string) []error {
+	return excludesallStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func excludesallStructFieldsPointerValidatePartialContext(ctx context.Context, obj *excludesallStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldExcludesallStringPointer") {
+		if !(obj.FieldExcludesallStringPointer != nil && !types.ContainsAny(*obj.FieldExcludesallStringPointer, "!@#")) {
+			errs = append(errs, types.NewValidationError("FieldExcludesallStringPointer must not contain any of '!@#'"))
+		}
+	}
+	return errs
+}
+func finiteStructFieldsValidate(obj *finiteStructFields) []error {
+	return finiteStructFieldsValidateContext(context.Background(), obj)
+}
+
+func finiteStructFieldsValidateContext(ctx context.Context, obj *finiteStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsFinite(obj.FieldFiniteFloat32)) {
+		errs = append(errs, types.NewValidationError("FieldFiniteFloat32 must be a finite number"))
+	}
+	if !(types.IsFinite(obj.FieldFiniteFloat64)) {
+		errs = append(errs, types.NewValidationError("FieldFiniteFloat64 must be a finite number"))
+	}
+	return errs
+}
+
+func finiteStructFieldsValidateFields(obj *finiteStructFields, fields ...string) []error {
+	return finiteStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func finiteStructFieldsValidateExcept(obj *finiteStructFields, fields ...string) []error {
+	return finiteStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func finiteStructFieldsValidatePartialContext(ctx context.Context, obj *finiteStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldFiniteFloat32") {
+		if !(types.IsFinite(obj.FieldFiniteFloat32)) {
+			errs = append(errs, types.NewValidationError("FieldFiniteFloat32 must be a finite number"))
+		}
+	}
+	if selection.Has("FieldFiniteFloat64") {
+		if !(types.IsFinite(obj.FieldFiniteFloat64)) {
+			errs = append(errs, types.NewValidationError("FieldFiniteFloat64 must be a finite number"))
+		}
+	}
+	return errs
+}
+func finiteStructFieldsPointerValidate(obj *finiteStructFieldsPointer) []error {
+	return finiteStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func finiteStructFieldsPointerValidateContext(ctx context.Context, obj *finiteStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldFiniteFloat32Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat32Pointer)) {
+		errs = append(errs, types.NewValidationError("FieldFiniteFloat32Pointer must be a finite number"))
+	}
+	if !(obj.FieldFiniteFloat64Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat64Pointer)) {
+		errs = append(errs, types.NewValidationError("FieldFiniteFloat64Pointer must be a finite number"))
+	}
+	return errs
+}
+
+func finiteStructFieldsPointerValidateFields(obj *finiteStructFieldsPointer, fields ...string) []error {
+	return finiteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func finiteStructFieldsPointerValidateExcept(obj *finiteStructFieldsPointer, fields ...string) []error {
+	return finiteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func finiteStructFieldsPointerValidatePartialContext(ctx context.Context, obj *finiteStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldFiniteFloat32Pointer") {
+		if !(obj.FieldFiniteFloat32Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat32Pointer)) {
+			errs = append(errs, types.NewValidationError("FieldFiniteFloat32Pointer must be a finite number"))
+		}
+	}
+	if selection.Has("FieldFiniteFloat64Pointer") {
+		if !(obj.FieldFiniteFloat64Pointer != nil && types.IsFinite(*obj.FieldFiniteFloat64Pointer)) {
+			errs = append(errs, types.NewValidationError("FieldFiniteFloat64Pointer must be a finite number"))
+		}
+	}
+	return errs
+}
+func fqdnStructFieldsValidate(obj *fqdnStructFields) []error {
+	return fqdnStructFieldsValidateContext(context.Background(), obj)
+}
+
+func fqdnStructFieldsValidateContext(ctx context.Context, obj *fqdnStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidFQDN(obj.FieldFqdnString)) {
+		errs = append(errs, types.NewValidationError("FieldFqdnString must be a valid FQDN"))
+	}
+	return errs
+}
+
+func fqdnStructFieldsValidateFields(obj *fqdnStructFields, fields ...string) []error {
+	return fqdnStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func fqdnStructFieldsValidateExcept(obj *fqdnStructFields, fields ...string) []error {
+	return fqdnStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func fqdnStructFieldsValidatePartialContext(ctx context.Context, obj *fqdnStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldFqdnString") {
+		if !(types.IsValidFQDN(obj.FieldFqdnString)) {
+			errs = append(errs, types.NewValidationError("FieldFqdnString must be a valid FQDN"))
+		}
+	}
+	return errs
+}
+func fqdnStructFieldsPointerValidate(obj *fqdnStructFieldsPointer) []error {
+	return fqdnStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func fqdnStructFieldsPointerValidateContext(ctx context.Context, obj *fqdnStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldFqdnStringPointer != nil && types.IsValidFQDN(*obj.FieldFqdnStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldFqdnStringPointer must be a valid FQDN"))
+	}
+	return errs
+}
+
+func fqdnStructFieldsPointerValidateFields(obj *fqdnStructFieldsPointer, fields ...string) []error {
+	return fqdnStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func fqdnStructFieldsPointerValidateExcept(obj *fqdnStructFieldsPointer, fields ...string) []error {
+	return fqdnStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func fqdnStructFieldsPointerValidatePartialContext(ctx context.Context, obj *fqdnStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldFqdnStringPointer") {
+		if !(obj.FieldFqdnStringPointer != nil && types.IsValidFQDN(*obj.FieldFqdnStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldFqdnStringPointer must be a valid FQDN"))
+		}
+	}
+	return errs
+}
+func gtStructFieldsValidate(obj *gtStructFields) []error {
+	return gtStructFieldsValidateContext(context.Background(), obj)
+}
+
+func gtStructFieldsValidateContext(ctx context.Context, obj *gtStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldGtInt > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt must be > 32"))
+	}
+	if !(obj.FieldGtInt8 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt8 must be > 32"))
+	}
+	if !(obj.FieldGtInt16 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt16 must be > 32"))
+	}
+	if !(obj.FieldGtInt32 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt32 must be > 32"))
+	}
+	if !(obj.FieldGtInt64 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt64 must be > 32"))
+	}
+	if !(obj.FieldGtUint > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint must be > 32"))
+	}
+	if !(obj.FieldGtUint8 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint8 must be > 32"))
+	}
+	if !(obj.FieldGtUint16 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint16 must be > 32"))
+	}
+	if !(obj.FieldGtUint32 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint32 must be > 32"))
+	}
+	if !(obj.FieldGtUint64 > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint64 must be > 32"))
+	}
+	if !(obj.FieldGtFloat32 > 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGtFloat32 must be > 12.34"))
+	}
+	if !(obj.FieldGtFloat64 > 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGtFloat64 must be > 12.34"))
+	}
+	return errs
+}
+
+func gtStructFieldsValidateFields(obj *gtStructFields, fields ...string) []error {
+	return gtStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func gtStructFieldsValidateExcept(obj *gtStructFields, fields ...string) []error {
+	return gtStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func gtStructFieldsValidatePartialContext(ctx context.Context, obj *gtStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldGtInt") {
+		if !(obj.FieldGtInt > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt8") {
+		if !(obj.FieldGtInt8 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt8 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt16") {
+		if !(obj.FieldGtInt16 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt16 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt32") {
+		if !(obj.FieldGtInt32 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt32 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt64") {
+		if !(obj.FieldGtInt64 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt64 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint") {
+		if !(obj.FieldGtUint > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint8") {
+		if !(obj.FieldGtUint8 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint8 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint16") {
+		if !(obj.FieldGtUint16 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint16 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint32") {
+		if !(obj.FieldGtUint32 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint32 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint64") {
+		if !(obj.FieldGtUint64 > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint64 must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtFloat32") {
+		if !(obj.FieldGtFloat32 > 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGtFloat32 must be > 12.34"))
+		}
+	}
+	if selection.Has("FieldGtFloat64") {
+		if !(obj.FieldGtFloat64 > 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGtFloat64 must be > 12.34"))
+		}
+	}
+	return errs
+}
+func gtStructFieldsPointerValidate(obj *gtStructFieldsPointer) []error {
+	return gtStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func gtStructFieldsPointerValidateContext(ctx context.Context, obj *gtStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldGtIntPointer != nil && *obj.FieldGtIntPointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtIntPointer must be > 32"))
+	}
+	if !(obj.FieldGtInt8Pointer != nil && *obj.FieldGtInt8Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt8Pointer must be > 32"))
+	}
+	if !(obj.FieldGtInt16Pointer != nil && *obj.FieldGtInt16Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt16Pointer must be > 32"))
+	}
+	if !(obj.FieldGtInt32Pointer != nil && *obj.FieldGtInt32Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt32Pointer must be > 32"))
+	}
+	if !(obj.FieldGtInt64Pointer != nil && *obj.FieldGtInt64Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtInt64Pointer must be > 32"))
+	}
+	if !(obj.FieldGtUintPointer != nil && *obj.FieldGtUintPointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUintPointer must be > 32"))
+	}
+	if !(obj.FieldGtUint8Pointer != nil && *obj.FieldGtUint8Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint8Pointer must be > 32"))
+	}
+	if !(obj.FieldGtUint16Pointer != nil && *obj.FieldGtUint16Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint16Pointer must be > 32"))
+	}
+	if !(obj.FieldGtUint32Pointer != nil && *obj.FieldGtUint32Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint32Pointer must be > 32"))
+	}
+	if !(obj.FieldGtUint64Pointer != nil && *obj.FieldGtUint64Pointer > 32) {
+		errs = append(errs, types.NewValidationError("FieldGtUint64Pointer must be > 32"))
+	}
+	if !(obj.FieldGtFloat32Pointer != nil && *obj.FieldGtFloat32Pointer > 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGtFloat32Pointer must be > 12.34"))
+	}
+	if !(obj.FieldGtFloat64Pointer != nil && *obj.FieldGtFloat64Pointer > 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGtFloat64Pointer must be > 12.34"))
+	}
+	return errs
+}
+
+func gtStructFieldsPointerValidateFields(obj *gtStructFieldsPointer, fields ...string) []error {
+	return gtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func gtStructFieldsPointerValidateExcept(obj *gtStructFieldsPointer, fields ...string) []error {
+	return gtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func gtStructFieldsPointerValidatePartialContext(ctx context.Context, obj *gtStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldGtIntPointer") {
+		if !(obj.FieldGtIntPointer != nil && *obj.FieldGtIntPointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtIntPointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt8Pointer") {
+		if !(obj.FieldGtInt8Pointer != nil && *obj.FieldGtInt8Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt8Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt16Pointer") {
+		if !(obj.FieldGtInt16Pointer != nil && *obj.FieldGtInt16Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt16Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt32Pointer") {
+		if !(obj.FieldGtInt32Pointer != nil && *obj.FieldGtInt32Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt32Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtInt64Pointer") {
+		if !(obj.FieldGtInt64Pointer != nil && *obj.FieldGtInt64Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtInt64Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUintPointer") {
+		if !(obj.FieldGtUintPointer != nil && *obj.FieldGtUintPointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUintPointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint8Pointer") {
+		if !(obj.FieldGtUint8Pointer != nil && *obj.FieldGtUint8Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint8Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint16Pointer") {
+		if !(obj.FieldGtUint16Pointer != nil && *obj.FieldGtUint16Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint16Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint32Pointer") {
+		if !(obj.FieldGtUint32Pointer != nil && *obj.FieldGtUint32Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint32Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtUint64Pointer") {
+		if !(obj.FieldGtUint64Pointer != nil && *obj.FieldGtUint64Pointer > 32) {
+			errs = append(errs, types.NewValidationError("FieldGtUint64Pointer must be > 32"))
+		}
+	}
+	if selection.Has("FieldGtFloat32Pointer") {
+		if !(obj.FieldGtFloat32Pointer != nil && *obj.FieldGtFloat32Pointer > 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGtFloat32Pointer must be > 12.34"))
+		}
+	}
+	if selection.Has("FieldGtFloat64Pointer") {
+		if !(obj.FieldGtFloat64Pointer != nil && *obj.FieldGtFloat64Pointer > 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGtFloat64Pointer must be > 12.34"))
+		}
+	}
+	return errs
+}
+func gteStructFieldsValidate(obj *gteStructFields) []error {
+	return gteStructFieldsValidateContext(context.Background(), obj)
+}
+
+func gteStructFieldsValidateContext(ctx context.Context, obj *gteStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldGteInt >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt must be >= 32"))
+	}
+	if !(obj.FieldGteInt8 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt8 must be >= 32"))
+	}
+	if !(obj.FieldGteInt16 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt16 must be >= 32"))
+	}
+	if !(obj.FieldGteInt32 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt32 must be >= 32"))
+	}
+	if !(obj.FieldGteInt64 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt64 must be >= 32"))
+	}
+	if !(obj.FieldGteUint >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint must be >= 32"))
+	}
+	if !(obj.FieldGteUint8 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint8 must be >= 32"))
+	}
+	if !(obj.FieldGteUint16 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint16 must be >= 32"))
+	}
+	if !(obj.FieldGteUint32 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint32 must be >= 32"))
+	}
+	if !(obj.FieldGteUint64 >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint64 must be >= 32"))
+	}
+	if !(obj.FieldGteFloat32 >= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGteFloat32 must be >= 12.34"))
+	}
+	if !(obj.FieldGteFloat64 >= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGteFloat64 must be >= 12.34"))
+	}
+	return errs
+}
+
+func gteStructFieldsValidateFields(obj *gteStructFields, fields ...string) []error {
+	return gteStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func gteStructFieldsValidateExcept(obj *gteStructFields, fields ...string) []error {
+	return gteStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func gteStructFieldsValidatePartialContext(ctx context.Context, obj *gteStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldGteInt") {
+		if !(obj.FieldGteInt >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt8") {
+		if !(obj.FieldGteInt8 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt8 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt16") {
+		if !(obj.FieldGteInt16 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt16 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt32") {
+		if !(obj.FieldGteInt32 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt32 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt64") {
+		if !(obj.FieldGteInt64 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt64 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint") {
+		if !(obj.FieldGteUint >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint8") {
+		if !(obj.FieldGteUint8 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint8 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint16") {
+		if !(obj.FieldGteUint16 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint16 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint32") {
+		if !(obj.FieldGteUint32 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint32 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint64") {
+		if !(obj.FieldGteUint64 >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint64 must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteFloat32") {
+		if !(obj.FieldGteFloat32 >= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGteFloat32 must be >= 12.34"))
+		}
+	}
+	if selection.Has("FieldGteFloat64") {
+		if !(obj.FieldGteFloat64 >= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGteFloat64 must be >= 12.34"))
+		}
+	}
+	return errs
+}
+func gteStructFieldsPointerValidate(obj *gteStructFieldsPointer) []error {
+	return gteStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func gteStructFieldsPointerValidateContext(ctx context.Context, obj *gteStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldGteIntPointer != nil && *obj.FieldGteIntPointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteIntPointer must be >= 32"))
+	}
+	if !(obj.FieldGteInt8Pointer != nil && *obj.FieldGteInt8Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt8Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteInt16Pointer != nil && *obj.FieldGteInt16Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt16Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteInt32Pointer != nil && *obj.FieldGteInt32Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt32Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteInt64Pointer != nil && *obj.FieldGteInt64Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteInt64Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteUintPointer != nil && *obj.FieldGteUintPointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUintPointer must be >= 32"))
+	}
+	if !(obj.FieldGteUint8Pointer != nil && *obj.FieldGteUint8Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint8Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteUint16Pointer != nil && *obj.FieldGteUint16Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint16Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteUint32Pointer != nil && *obj.FieldGteUint32Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint32Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteUint64Pointer != nil && *obj.FieldGteUint64Pointer >= 32) {
+		errs = append(errs, types.NewValidationError("FieldGteUint64Pointer must be >= 32"))
+	}
+	if !(obj.FieldGteFloat32Pointer != nil && *obj.FieldGteFloat32Pointer >= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGteFloat32Pointer must be >= 12.34"))
+	}
+	if !(obj.FieldGteFloat64Pointer != nil && *obj.FieldGteFloat64Pointer >= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldGteFloat64Pointer must be >= 12.34"))
+	}
+	return errs
+}
+
+func gteStructFieldsPointerValidateFields(obj *gteStructFieldsPointer, fields ...string) []error {
+	return gteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func gteStructFieldsPointerValidateExcept(obj *gteStructFieldsPointer, fields ...string) []error {
+	return gteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func gteStructFieldsPointerValidatePartialContext(ctx context.Context, obj *gteStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldGteIntPointer") {
+		if !(obj.FieldGteIntPointer != nil && *obj.FieldGteIntPointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteIntPointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt8Pointer") {
+		if !(obj.FieldGteInt8Pointer != nil && *obj.FieldGteInt8Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt8Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt16Pointer") {
+		if !(obj.FieldGteInt16Pointer != nil && *obj.FieldGteInt16Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt16Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt32Pointer") {
+		if !(obj.FieldGteInt32Pointer != nil && *obj.FieldGteInt32Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt32Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteInt64Pointer") {
+		if !(obj.FieldGteInt64Pointer != nil && *obj.FieldGteInt64Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteInt64Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUintPointer") {
+		if !(obj.FieldGteUintPointer != nil && *obj.FieldGteUintPointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUintPointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint8Pointer") {
+		if !(obj.FieldGteUint8Pointer != nil && *obj.FieldGteUint8Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint8Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint16Pointer") {
+		if !(obj.FieldGteUint16Pointer != nil && *obj.FieldGteUint16Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint16Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint32Pointer") {
+		if !(obj.FieldGteUint32Pointer != nil && *obj.FieldGteUint32Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint32Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteUint64Pointer") {
+		if !(obj.FieldGteUint64Pointer != nil && *obj.FieldGteUint64Pointer >= 32) {
+			errs = append(errs, types.NewValidationError("FieldGteUint64Pointer must be >= 32"))
+		}
+	}
+	if selection.Has("FieldGteFloat32Pointer") {
+		if !(obj.FieldGteFloat32Pointer != nil && *obj.FieldGteFloat32Pointer >= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGteFloat32Pointer must be >= 12.34"))
+		}
+	}
+	if selection.Has("FieldGteFloat64Pointer") {
+		if !(obj.FieldGteFloat64Pointer != nil && *obj.FieldGteFloat64Pointer >= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldGteFloat64Pointer must be >= 12.34"))
+		}
+	}
+	return errs
+}
+func hexadecimalStructFieldsValidate(obj *hexadecimalStructFields) []error {
+	return hexadecimalStructFieldsValidateContext(context.Background(), obj)
+}
+
+func hexadecimalStructFieldsValidateContext(ctx context.Context, obj *hexadecimalStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHexadecimal(obj.FieldHexadecimalString)) {
+		errs = append(errs, types.NewValidationError("FieldHexadecimalString must be a valid hexadecimal string"))
+	}
+	if !(types.IsValidHexadecimal(string(obj.FieldHexadecimalByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlice must be a valid hexadecimal string"))
+	}
+	return errs
+}
+
+func hexadecimalStructFieldsValidateFields(obj *hexadecimalStructFields, fields ...string) []error {
+	return hexadecimalStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hexadecimalStructFieldsValidateExcept(obj *hexadecimalStructFields, fields ...string) []error {
+	return hexadecimalStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hexadecimalStructFieldsValidatePartialContext(ctx context.Context, obj *hexadecimalStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHexadecimalString") {
+		if !(types.IsValidHexadecimal(obj.FieldHexadecimalString)) {
+			errs = append(errs, types.NewValidationError("FieldHexadecimalString must be a valid hexadecimal string"))
+		}
+	}
+	if selection.Has("FieldHexadecimalByteSlice") {
+		if !(types.IsValidHexadecimal(string(obj.FieldHexadecimalByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlice must be a valid hexadecimal string"))
+		}
+	}
+	return errs
+}
+func hexadecimalStructFieldsPointerValidate(obj *hexadecimalStructFieldsPointer) []error {
+	return hexadecimalStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func hexadecimalStructFieldsPointerValidateContext(ctx context.Context, obj *hexadecimalStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHexadecimalStringPointer != nil && types.IsValidHexadecimal(*obj.FieldHexadecimalStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHexadecimalStringPointer must be a valid hexadecimal string"))
+	}
+	if !(obj.FieldHexadecimalByteSlicePointer != nil && types.IsValidHexadecimal(string(*obj.FieldHexadecimalByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlicePointer must be a valid hexadecimal string"))
+	}
+	return errs
+}
+
+func hexadecimalStructFieldsPointerValidateFields(obj *hexadecimalStructFieldsPointer, fields ...string) []error {
+	return hexadecimalStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hexadecimalStructFieldsPointerValidateExcept(obj *hexadecimalStructFieldsPointer, fields ...string) []error {
+	return hexadecimalStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hexadecimalStructFieldsPointerValidatePartialContext(ctx context.Context, obj *hexadecimalStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHexadecimalStringPointer") {
+		if !(obj.FieldHexadecimalStringPointer != nil && types.IsValidHexadecimal(*obj.FieldHexadecimalStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHexadecimalStringPointer must be a valid hexadecimal string"))
+		}
+	}
+	if selection.Has("FieldHexadecimalByteSlicePointer") {
+		if !(obj.FieldHexadecimalByteSlicePointer != nil && types.IsValidHexadecimal(string(*obj.FieldHexadecimalByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldHexadecimalByteSlicePointer must be a valid hexadecimal string"))
+		}
+	}
+	return errs
+}
+func hexcolorStructFieldsValidate(obj *hexcolorStructFields) []error {
+	return hexcolorStructFieldsValidateContext(context.Background(), obj)
+}
+
+func hexcolorStructFieldsValidateContext(ctx context.Context, obj *hexcolorStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHexColor(obj.FieldHexcolorString)) {
+		errs = append(errs, types.NewValidationError("FieldHexcolorString must be a valid hex color"))
+	}
+	if !(types.IsValidHexColor(string(obj.FieldHexcolorByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldHexcolorByteSlice must be a valid hex color"))
+	}
+	return errs
+}
+
+func hexcolorStructFieldsValidateFields(obj *hexcolorStructFields, fields ...string) []error {
+	return hexcolorStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hexcolorStructFieldsValidateExcept(obj *hexcolorStructFields, fields ...string) []error {
+	return hexcolorStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hexcolorStructFieldsValidatePartialContext(ctx context.Context, obj *hexcolorStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHexcolorString") {
+		if !(types.IsValidHexColor(obj.FieldHexcolorString)) {
+			errs = append(errs, types.NewValidationError("FieldHexcolorString must be a valid hex color"))
+		}
+	}
+	if selection.Has("FieldHexcolorByteSlice") {
+		if !(types.IsValidHexColor(string(obj.FieldHexcolorByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldHexcolorByteSlice must be a valid hex color"))
+		}
+	}
+	return errs
+}
+func hexcolorStructFieldsPointerValidate(obj *hexcolorStructFieldsPointer) []error {
+	return hexcolorStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func hexcolorStructFieldsPointerValidateContext(ctx context.Context, obj *hexcolorStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHexcolorStringPointer != nil && types.IsValidHexColor(*obj.FieldHexcolorStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHexcolorStringPointer must be a valid hex color"))
+	}
+	if !(obj.FieldHexcolorByteSlicePointer != nil && types.IsValidHexColor(string(*obj.FieldHexcolorByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldHexcolorByteSlicePointer must be a valid hex color"))
+	}
+	return errs
+}
+
+func hexcolorStructFieldsPointerValidateFields(obj *hexcolorStructFieldsPointer, fields ...string) []error {
+	return hexcolorStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hexcolorStructFieldsPointerValidateExcept(obj *hexcolorStructFieldsPointer, fields ...string) []error {
+	return hexcolorStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hexcolorStructFieldsPointerValidatePartialContext(ctx context.Context, obj *hexcolorStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHexcolorStringPointer") {
+		if !(obj.FieldHexcolorStringPointer != nil && types.IsValidHexColor(*obj.FieldHexcolorStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHexcolorStringPointer must be a valid hex color"))
+		}
+	}
+	if selection.Has("FieldHexcolorByteSlicePointer") {
+		if !(obj.FieldHexcolorByteSlicePointer != nil && types.IsValidHexColor(string(*obj.FieldHexcolorByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldHexcolorByteSlicePointer must be a valid hex color"))
+		}
+	}
+	return errs
+}
+func hostnameStructFieldsValidate(obj *hostnameStructFields) []error {
+	return hostnameStructFieldsValidateContext(context.Background(), obj)
+}
+
+func hostnameStructFieldsValidateContext(ctx context.Context, obj *hostnameStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHostname(obj.FieldHostnameString)) {
+		errs = append(errs, types.NewValidationError("FieldHostnameString must be a valid hostname"))
+	}
+	return errs
+}
+
+func hostnameStructFieldsValidateFields(obj *hostnameStructFields, fields ...string) []error {
+	return hostnameStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hostnameStructFieldsValidateExcept(obj *hostnameStructFields, fields ...string) []error {
+	return hostnameStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hostnameStructFieldsValidatePartialContext(ctx context.Context, obj *hostnameStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHostnameString") {
+		if !(types.IsValidHostname(obj.FieldHostnameString)) {
+			errs = append(errs, types.NewValidationError("FieldHostnameString must be a valid hostname"))
+		}
+	}
+	return errs
+}
+func hostnameStructFieldsPointerValidate(obj *hostnameStructFieldsPointer) []error {
+	return hostnameStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func hostnameStructFieldsPointerValidateContext(ctx context.Context, obj *hostnameStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHostnameStringPointer != nil && types.IsValidHostname(*obj.FieldHostnameStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHostnameStringPointer must be a valid hostname"))
+	}
+	return errs
+}
+
+func hostnameStructFieldsPointerValidateFields(obj *hostnameStructFieldsPointer, fields ...string) []error {
+	return hostnameStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hostnameStructFieldsPointerValidateExcept(obj *hostnameStructFieldsPointer, fields ...string) []error {
+	return hostnameStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hostnameStructFieldsPointerValidatePartialContext(ctx context.Context, obj *hostnameStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHostnameStringPointer") {
+		if !(obj.FieldHostnameStringPointer != nil && types.IsValidHostname(*obj.FieldHostnameStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHostnameStringPointer must be a valid hostname"))
+		}
+	}
+	return errs
+}
+func hostname_portStructFieldsValidate(obj *hostname_portStructFields) []error {
+	return hostname_portStructFieldsValidateContext(context.Background(), obj)
+}
+
+func hostname_portStructFieldsValidateContext(ctx context.Context, obj *hostname_portStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHostnamePort(obj.FieldHostname_portString)) {
+		errs = append(errs, types.NewValidationError("FieldHostname_portString must be a valid hostname and port"))
+	}
+	return errs
+}
+
+func hostname_portStructFieldsValidateFields(obj *hostname_portStructFields, fields ...string) []error {
+	return hostname_portStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hostname_portStructFieldsValidateExcept(obj *hostname_portStructFields, fields ...string) []error {
+	return hostname_portStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hostname_portStructFieldsValidatePartialContext(ctx context.Context, obj *hostname_portStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHostname_portString") {
+		if !(types.IsValidHostnamePort(obj.FieldHostname_portString)) {
+			errs = append(errs, types.NewValidationError("FieldHostname_portString must be a valid hostname and port"))
+		}
+	}
+	return errs
+}
+func hostname_portStructFieldsPointerValidate(obj *hostname_portStructFieldsPointer) []error {
+	return hostname_portStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func hostname_portStructFieldsPointerValidateContext(ctx context.Context, obj *hostname_portStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHostname_portStringPointer != nil && types.IsValidHostnamePort(*obj.FieldHostname_portStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHostname_portStringPointer must be a valid hostname and port"))
+	}
+	return errs
+}
+
+func hostname_portStructFieldsPointerValidateFields(obj *hostname_portStructFieldsPointer, fields ...string) []error {
+	return hostname_portStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hostname_portStructFieldsPointerValidateExcept(obj *hostname_portStructFieldsPointer, fields ...string) []error {
+	return hostname_portStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hostname_portStructFieldsPointerValidatePartialContext(ctx context.Context, obj *hostname_portStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHostname_portStringPointer") {
+		if !(obj.FieldHostname_portStringPointer != nil && types.IsValidHostnamePort(*obj.FieldHostname_portStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHostname_portStringPointer must be a valid hostname and port"))
+		}
+	}
+	return errs
+}
+func hslStructFieldsValidate(obj *hslStructFields) []error {
+	return hslStructFieldsValidateContext(context.Background(), obj)
+}
+
+func hslStructFieldsValidateContext(ctx context.Context, obj *hslStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHSL(obj.FieldHslString)) {
+		errs = append(errs, types.NewValidationError("FieldHslString must be a valid HSL color"))
+	}
+	if !(types.IsValidHSL(string(obj.FieldHslByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldHslByteSlice must be a valid HSL color"))
+	}
+	return errs
+}
+
+func hslStructFieldsValidateFields(obj *hslStructFields, fields ...string) []error {
+	return hslStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hslStructFieldsValidateExcept(obj *hslStructFields, fields ...string) []error {
+	return hslStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hslStructFieldsValidatePartialContext(ctx context.Context, obj *hslStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHslString") {
+		if !(types.IsValidHSL(obj.FieldHslString)) {
+			errs = append(errs, types.NewValidationError("FieldHslString must be a valid HSL color"))
+		}
+	}
+	if selection.Has("FieldHslByteSlice") {
+		if !(types.IsValidHSL(string(obj.FieldHslByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldHslByteSlice must be a valid HSL color"))
+		}
+	}
+	return errs
+}
+func hslStructFieldsPointerValidate(obj *hslStructFieldsPointer) []error {
+	return hslStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func hslStructFieldsPointerValidateContext(ctx context.Context, obj *hslStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHslStringPointer != nil && types.IsValidHSL(*obj.FieldHslStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHslStringPointer must be a valid HSL color"))
+	}
+	if !(obj.FieldHslByteSlicePointer != nil && types.IsValidHSL(string(*obj.FieldHslByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldHslByteSlicePointer must be a valid HSL color"))
+	}
+	return errs
+}
+
+func hslStructFieldsPointerValidateFields(obj *hslStructFieldsPointer, fields ...string) []error {
+	return hslStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func hslStructFieldsPointerValidateExcept(obj *hslStructFieldsPointer, fields ...string) []error {
+	return hslStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func hslStructFieldsPointerValidatePartialContext(ctx context.Context, obj *hslStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHslStringPointer") {
+		if !(obj.FieldHslStringPointer != nil && types.IsValidHSL(*obj.FieldHslStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHslStringPointer must be a valid HSL color"))
+		}
+	}
+	if selection.Has("FieldHslByteSlicePointer") {
+		if !(obj.FieldHslByteSlicePointer != nil && types.IsValidHSL(string(*obj.FieldHslByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldHslByteSlicePointer must be a valid HSL color"))
+		}
+	}
+	return errs
+}
+func http_urlStructFieldsValidate(obj *http_urlStructFields) []error {
+	return http_urlStructFieldsValidateContext(context.Background(), obj)
+}
+
+func http_urlStructFieldsValidateContext(ctx context.Context, obj *http_urlStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidHTTPURL(obj.FieldHttp_urlString)) {
+		errs = append(errs, types.NewValidationError("FieldHttp_urlString must be a valid HTTP URL"))
+	}
+	return errs
+}
+
+func http_urlStructFieldsValidateFields(obj *http_urlStructFields, fields ...string) []error {
+	return http_urlStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func http_urlStructFieldsValidateExcept(obj *http_urlStructFields, fields ...string) []error {
+	return http_urlStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func http_urlStructFieldsValidatePartialContext(ctx context.Context, obj *http_urlStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHttp_urlString") {
+		if !(types.IsValidHTTPURL(obj.FieldHttp_urlString)) {
+			errs = append(errs, types.NewValidationError("FieldHttp_urlString must be a valid HTTP URL"))
+		}
+	}
+	return errs
+}
+func http_urlStructFieldsPointerValidate(obj *http_urlStructFieldsPointer) []error {
+	return http_urlStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func http_urlStructFieldsPointerValidateContext(ctx context.Context, obj *http_urlStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldHttp_urlStringPointer != nil && types.IsValidHTTPURL(*obj.FieldHttp_urlStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldHttp_urlStringPointer must be a valid HTTP URL"))
+	}
+	return errs
+}
+
+func http_urlStructFieldsPointerValidateFields(obj *http_urlStructFieldsPointer, fields ...string) []error {
+	return http_urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func http_urlStructFieldsPointerValidateExcept(obj *http_urlStructFieldsPointer, fields ...string) []error {
+	return http_urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func http_urlStructFieldsPointerValidatePartialContext(ctx context.Context, obj *http_urlStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldHttp_urlStringPointer") {
+		if !(obj.FieldHttp_urlStringPointer != nil && types.IsValidHTTPURL(*obj.FieldHttp_urlStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldHttp_urlStringPointer must be a valid HTTP URL"))
+		}
+	}
+	return errs
+}
+func ibanStructFieldsValidate(obj *ibanStructFields) []error {
+	return ibanStructFieldsValidateContext(context.Background(), obj)
+}
+
+func ibanStructFieldsValidateContext(ctx context.Context, obj *ibanStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidIBAN(obj.FieldIbanString)) {
+		errs = append(errs, types.NewValidationError("FieldIbanString must be a valid IBAN"))
+	}
+	return errs
+}
+
+func ibanStructFieldsValidateFields(obj *ibanStructFields, fields ...string) []error {
+	return ibanStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ibanStructFieldsValidateExcept(obj *ibanStructFields, fields ...string) []error {
+	return ibanStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ibanStructFieldsValidatePartialContext(ctx context.Context, obj *ibanStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIbanString") {
+		if !(types.IsValidIBAN(obj.FieldIbanString)) {
+			errs = append(errs, types.NewValidationError("FieldIbanString must be a valid IBAN"))
+		}
+	}
+	return errs
+}
+func ibanStructFieldsPointerValidate(obj *ibanStructFieldsPointer) []error {
+	return ibanStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ibanStructFieldsPointerValidateContext(ctx context.Context, obj *ibanStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIbanStringPointer != nil && types.IsValidIBAN(*obj.FieldIbanStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIbanStringPointer must be a valid IBAN"))
+	}
+	return errs
+}
+
+func ibanStructFieldsPointerValidateFields(obj *ibanStructFieldsPointer, fields ...string) []error {
+	return ibanStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ibanStructFieldsPointerValidateExcept(obj *ibanStructFieldsPointer, fields ...string) []error {
+	return ibanStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ibanStructFieldsPointerValidatePartialContext(ctx context.Context, obj *ibanStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIbanStringPointer") {
+		if !(obj.FieldIbanStringPointer != nil && types.IsValidIBAN(*obj.FieldIbanStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIbanStringPointer must be a valid IBAN"))
+		}
+	}
+	return errs
+}
+func inStructFieldsValidate(obj *inStructFields) []error {
+	return inStructFieldsValidateContext(context.Background(), obj)
+}
+
+func inStructFieldsValidateContext(ctx context.Context, obj *inStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldInString == "ab" || obj.FieldInString == "cd" || obj.FieldInString == "ef") {
+		errs = append(errs, types.NewValidationError("FieldInString must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldInInt == 12 || obj.FieldInInt == 34 || obj.FieldInInt == 56) {
+		errs = append(errs, types.NewValidationError("FieldInInt must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt8 == 12 || obj.FieldInInt8 == 34 || obj.FieldInInt8 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInInt8 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt16 == 12 || obj.FieldInInt16 == 34 || obj.FieldInInt16 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInInt16 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt32 == 12 || obj.FieldInInt32 == 34 || obj.FieldInInt32 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInInt32 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt64 == 12 || obj.FieldInInt64 == 34 || obj.FieldInInt64 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInInt64 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint == 12 || obj.FieldInUint == 34 || obj.FieldInUint == 56) {
+		errs = append(errs, types.NewValidationError("FieldInUint must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint8 == 12 || obj.FieldInUint8 == 34 || obj.FieldInUint8 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInUint8 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint16 == 12 || obj.FieldInUint16 == 34 || obj.FieldInUint16 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInUint16 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint32 == 12 || obj.FieldInUint32 == 34 || obj.FieldInUint32 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInUint32 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint64 == 12 || obj.FieldInUint64 == 34 || obj.FieldInUint64 == 56) {
+		errs = append(errs, types.NewValidationError("FieldInUint64 must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInFloat32 == 11.11 || obj.FieldInFloat32 == 22.22 || obj.FieldInFloat32 == 33.33) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32 must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInFloat64 == 11.11 || obj.FieldInFloat64 == 22.22 || obj.FieldInFloat64 == 33.33) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64 must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInBool == true) {
+		errs = append(errs, types.NewValidationError("FieldInBool must be one of 'true'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInStringSlice, []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringSlice elements must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInIntSlice, []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInIntSlice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt8Slice, []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt16Slice, []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt32Slice, []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt64Slice, []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUintSlice, []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUintSlice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint8Slice, []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint16Slice, []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint32Slice, []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint64Slice, []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64Slice elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInFloat32Slice, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32Slice elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInFloat64Slice, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64Slice elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInBoolSlice, []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolSlice elements must be one of 'true'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInStringArray[:], []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringArray elements must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInIntArray[:], []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInIntArray elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt8Array[:], []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt16Array[:], []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt32Array[:], []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInInt64Array[:], []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUintArray[:], []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUintArray elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint8Array[:], []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint16Array[:], []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint32Array[:], []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInUint64Array[:], []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64Array elements must be one of '12' '34' '56'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32Array elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64Array elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceOnlyContains(obj.FieldInBoolArray[:], []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolArray elements must be one of 'true'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInStringMap, []string{"a", "b", "c"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringMap elements must be one of 'a' 'b' 'c'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInIntMap, []int{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInIntMap elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInInt8Map, []int8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInInt16Map, []int16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInInt32Map, []int32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInInt64Map, []int64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInUintMap, []uint{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUintMap elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInUint8Map, []uint8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInUint16Map, []uint16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInUint32Map, []uint32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInUint64Map, []uint64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64Map elements must be one of '1' '2' '3'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInFloat32Map, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32Map elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInFloat64Map, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64Map elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.MapOnlyContains(obj.FieldInBoolMap, []bool{false})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolMap elements must be one of 'false'"))
+	}
+	return errs
+}
+
+func inStructFieldsValidateFields(obj *inStructFields, fields ...string) []error {
+	return inStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func inStructFieldsValidateExcept(obj *inStructFields, fields ...string) []error {
+	return inStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func inStructFieldsValidatePartialContext(ctx context.Context, obj *inStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldInString") {
+		if !(obj.FieldInString == "ab" || obj.FieldInString == "cd" || obj.FieldInString == "ef") {
+			errs = append(errs, types.NewValidationError("FieldInString must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInInt") {
+		if !(obj.FieldInInt == 12 || obj.FieldInInt == 34 || obj.FieldInInt == 56) {
+			errs = append(errs, types.NewValidationError("FieldInInt must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8") {
+		if !(obj.FieldInInt8 == 12 || obj.FieldInInt8 == 34 || obj.FieldInInt8 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInInt8 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16") {
+		if !(obj.FieldInInt16 == 12 || obj.FieldInInt16 == 34 || obj.FieldInInt16 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInInt16 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32") {
+		if !(obj.FieldInInt32 == 12 || obj.FieldInInt32 == 34 || obj.FieldInInt32 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInInt32 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64") {
+		if !(obj.FieldInInt64 == 12 || obj.FieldInInt64 == 34 || obj.FieldInInt64 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInInt64 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint") {
+		if !(obj.FieldInUint == 12 || obj.FieldInUint == 34 || obj.FieldInUint == 56) {
+			errs = append(errs, types.NewValidationError("FieldInUint must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8") {
+		if !(obj.FieldInUint8 == 12 || obj.FieldInUint8 == 34 || obj.FieldInUint8 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInUint8 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16") {
+		if !(obj.FieldInUint16 == 12 || obj.FieldInUint16 == 34 || obj.FieldInUint16 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInUint16 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32") {
+		if !(obj.FieldInUint32 == 12 || obj.FieldInUint32 == 34 || obj.FieldInUint32 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInUint32 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64") {
+		if !(obj.FieldInUint64 == 12 || obj.FieldInUint64 == 34 || obj.FieldInUint64 == 56) {
+			errs = append(errs, types.NewValidationError("FieldInUint64 must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32") {
+		if !(obj.FieldInFloat32 == 11.11 || obj.FieldInFloat32 == 22.22 || obj.FieldInFloat32 == 33.33) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32 must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64") {
+		if !(obj.FieldInFloat64 == 11.11 || obj.FieldInFloat64 == 22.22 || obj.FieldInFloat64 == 33.33) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64 must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBool") {
+		if !(obj.FieldInBool == true) {
+			errs = append(errs, types.NewValidationError("FieldInBool must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringSlice") {
+		if !(types.SliceOnlyContains(obj.FieldInStringSlice, []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringSlice elements must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInIntSlice") {
+		if !(types.SliceOnlyContains(obj.FieldInIntSlice, []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInIntSlice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInInt8Slice, []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInInt16Slice, []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInInt32Slice, []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInInt64Slice, []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUintSlice") {
+		if !(types.SliceOnlyContains(obj.FieldInUintSlice, []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUintSlice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInUint8Slice, []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInUint16Slice, []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInUint32Slice, []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInUint64Slice, []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64Slice elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInFloat32Slice, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32Slice elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64Slice") {
+		if !(types.SliceOnlyContains(obj.FieldInFloat64Slice, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64Slice elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolSlice") {
+		if !(types.SliceOnlyContains(obj.FieldInBoolSlice, []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolSlice elements must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringArray") {
+		if !(types.SliceOnlyContains(obj.FieldInStringArray[:], []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringArray elements must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInIntArray") {
+		if !(types.SliceOnlyContains(obj.FieldInIntArray[:], []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInIntArray elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8Array") {
+		if !(types.SliceOnlyContains(obj.FieldInInt8Array[:], []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16Array") {
+		if !(types.SliceOnlyContains(obj.FieldInInt16Array[:], []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32Array") {
+		if !(types.SliceOnlyContains(obj.FieldInInt32Array[:], []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64Array") {
+		if !(types.SliceOnlyContains(obj.FieldInInt64Array[:], []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUintArray") {
+		if !(types.SliceOnlyContains(obj.FieldInUintArray[:], []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUintArray elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8Array") {
+		if !(types.SliceOnlyContains(obj.FieldInUint8Array[:], []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16Array") {
+		if !(types.SliceOnlyContains(obj.FieldInUint16Array[:], []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32Array") {
+		if !(types.SliceOnlyContains(obj.FieldInUint32Array[:], []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64Array") {
+		if !(types.SliceOnlyContains(obj.FieldInUint64Array[:], []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64Array elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32Array") {
+		if !(types.SliceOnlyContains(obj.FieldInFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32Array elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64Array") {
+		if !(types.SliceOnlyContains(obj.FieldInFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64Array elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolArray") {
+		if !(types.SliceOnlyContains(obj.FieldInBoolArray[:], []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolArray elements must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringMap") {
+		if !(types.MapOnlyContains(obj.FieldInStringMap, []string{"a", "b", "c"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringMap elements must be one of 'a' 'b' 'c'"))
+		}
+	}
+	if selection.Has("FieldInIntMap") {
+		if !(types.MapOnlyContains(obj.FieldInIntMap, []int{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInIntMap elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt8Map") {
+		if !(types.MapOnlyContains(obj.FieldInInt8Map, []int8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt16Map") {
+		if !(types.MapOnlyContains(obj.FieldInInt16Map, []int16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt32Map") {
+		if !(types.MapOnlyContains(obj.FieldInInt32Map, []int32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt64Map") {
+		if !(types.MapOnlyContains(obj.FieldInInt64Map, []int64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUintMap") {
+		if !(types.MapOnlyContains(obj.FieldInUintMap, []uint{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUintMap elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint8Map") {
+		if !(types.MapOnlyContains(obj.FieldInUint8Map, []uint8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint16Map") {
+		if !(types.MapOnlyContains(obj.FieldInUint16Map, []uint16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint32Map") {
+		if !(types.MapOnlyContains(obj.FieldInUint32Map, []uint32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint64Map") {
+		if !(types.MapOnlyContains(obj.FieldInUint64Map, []uint64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64Map elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInFloat32Map") {
+		if !(types.MapOnlyContains(obj.FieldInFloat32Map, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32Map elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64Map") {
+		if !(types.MapOnlyContains(obj.FieldInFloat64Map, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64Map elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolMap") {
+		if !(types.MapOnlyContains(obj.FieldInBoolMap, []bool{false})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolMap elements must be one of 'false'"))
+		}
+	}
+	return errs
+}
+func inStructFieldsPointerValidate(obj *inStructFieldsPointer) []error {
+	return inStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func inStructFieldsPointerValidateContext(ctx context.Context, obj *inStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !((obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ab") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "cd") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ef")) {
+		errs = append(errs, types.NewValidationError("FieldInStringPointer must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !((obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 12) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 34) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInIntPointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 12) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 34) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInInt8Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 12) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 34) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInInt16Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 12) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 34) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInInt32Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 12) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 34) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInInt64Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 12) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 34) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInUintPointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 12) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 34) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInUint8Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 12) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 34) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInUint16Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 12) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 34) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInUint32Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 12) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 34) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 56)) {
+		errs = append(errs, types.NewValidationError("FieldInUint64Pointer must be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 11.11) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 22.22) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 33.33)) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32Pointer must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !((obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 11.11) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 22.22) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 33.33)) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64Pointer must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInBoolPointer != nil && *obj.FieldInBoolPointer == true) {
+		errs = append(errs, types.NewValidationError("FieldInBoolPointer must be one of 'true'"))
+	}
+	if !(obj.FieldInStringSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInStringSlicePointer, []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringSlicePointer elements must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldInIntSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInIntSlicePointer, []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInIntSlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt8SlicePointer, []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt16SlicePointer, []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt32SlicePointer, []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt64SlicePointer, []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUintSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUintSlicePointer, []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUintSlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint8SlicePointer, []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint16SlicePointer, []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint32SlicePointer, []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint64SlicePointer, []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64SlicePointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInFloat32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInFloat64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInBoolSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInBoolSlicePointer, []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolSlicePointer elements must be one of 'true'"))
+	}
+	if !(obj.FieldInStringArrayPointer != nil && types.SliceOnlyContains(obj.FieldInStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringArrayPointer elements must be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldInIntArrayPointer != nil && types.SliceOnlyContains(obj.FieldInIntArrayPointer[:], []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInIntArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt8ArrayPointer[:], []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt16ArrayPointer[:], []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt32ArrayPointer[:], []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInInt64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt64ArrayPointer[:], []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUintArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUintArrayPointer[:], []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUintArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint8ArrayPointer[:], []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint16ArrayPointer[:], []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint32ArrayPointer[:], []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInUint64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint64ArrayPointer[:], []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64ArrayPointer elements must be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldInFloat32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInFloat64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInBoolArrayPointer != nil && types.SliceOnlyContains(obj.FieldInBoolArrayPointer[:], []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolArrayPointer elements must be one of 'true'"))
+	}
+	if !(obj.FieldInStringMapPointer != nil && types.MapOnlyContains(*obj.FieldInStringMapPointer, []string{"a", "b", "c"})) {
+		errs = append(errs, types.NewValidationError("FieldInStringMapPointer elements must be one of 'a' 'b' 'c'"))
+	}
+	if !(obj.FieldInIntMapPointer != nil && types.MapOnlyContains(*obj.FieldInIntMapPointer, []int{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInIntMapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInInt8MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt8MapPointer, []int8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt8MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInInt16MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt16MapPointer, []int16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt16MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInInt32MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt32MapPointer, []int32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt32MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInInt64MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt64MapPointer, []int64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInInt64MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInUintMapPointer != nil && types.MapOnlyContains(*obj.FieldInUintMapPointer, []uint{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUintMapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInUint8MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint8MapPointer, []uint8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint8MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInUint16MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint16MapPointer, []uint16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint16MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInUint32MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint32MapPointer, []uint32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint32MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInUint64MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint64MapPointer, []uint64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldInUint64MapPointer elements must be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldInFloat32MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat32MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInFloat64MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldInFloat64MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldInBoolMapPointer != nil && types.MapOnlyContains(*obj.FieldInBoolMapPointer, []bool{false})) {
+		errs = append(errs, types.NewValidationError("FieldInBoolMapPointer elements must be one of 'false'"))
+	}
+	return errs
+}
+
+func inStructFieldsPointerValidateFields(obj *inStructFieldsPointer, fields ...string) []error {
+	return inStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func inStructFieldsPointerValidateExcept(obj *inStructFieldsPointer, fields ...string) []error {
+	return inStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func inStructFieldsPointerValidatePartialContext(ctx context.Context, obj *inStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldInStringPointer") {
+		if !((obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ab") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "cd") || (obj.FieldInStringPointer != nil && *obj.FieldInStringPointer == "ef")) {
+			errs = append(errs, types.NewValidationError("FieldInStringPointer must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInIntPointer") {
+		if !((obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 12) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 34) || (obj.FieldInIntPointer != nil && *obj.FieldInIntPointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInIntPointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8Pointer") {
+		if !((obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 12) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 34) || (obj.FieldInInt8Pointer != nil && *obj.FieldInInt8Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInInt8Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16Pointer") {
+		if !((obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 12) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 34) || (obj.FieldInInt16Pointer != nil && *obj.FieldInInt16Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInInt16Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32Pointer") {
+		if !((obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 12) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 34) || (obj.FieldInInt32Pointer != nil && *obj.FieldInInt32Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInInt32Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64Pointer") {
+		if !((obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 12) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 34) || (obj.FieldInInt64Pointer != nil && *obj.FieldInInt64Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInInt64Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUintPointer") {
+		if !((obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 12) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 34) || (obj.FieldInUintPointer != nil && *obj.FieldInUintPointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInUintPointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8Pointer") {
+		if !((obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 12) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 34) || (obj.FieldInUint8Pointer != nil && *obj.FieldInUint8Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInUint8Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16Pointer") {
+		if !((obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 12) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 34) || (obj.FieldInUint16Pointer != nil && *obj.FieldInUint16Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInUint16Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32Pointer") {
+		if !((obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 12) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 34) || (obj.FieldInUint32Pointer != nil && *obj.FieldInUint32Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInUint32Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64Pointer") {
+		if !((obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 12) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 34) || (obj.FieldInUint64Pointer != nil && *obj.FieldInUint64Pointer == 56)) {
+			errs = append(errs, types.NewValidationError("FieldInUint64Pointer must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32Pointer") {
+		if !((obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 11.11) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 22.22) || (obj.FieldInFloat32Pointer != nil && *obj.FieldInFloat32Pointer == 33.33)) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32Pointer must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64Pointer") {
+		if !((obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 11.11) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 22.22) || (obj.FieldInFloat64Pointer != nil && *obj.FieldInFloat64Pointer == 33.33)) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64Pointer must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolPointer") {
+		if !(obj.FieldInBoolPointer != nil && *obj.FieldInBoolPointer == true) {
+			errs = append(errs, types.NewValidationError("FieldInBoolPointer must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringSlicePointer") {
+		if !(obj.FieldInStringSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInStringSlicePointer, []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringSlicePointer elements must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInIntSlicePointer") {
+		if !(obj.FieldInIntSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInIntSlicePointer, []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInIntSlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8SlicePointer") {
+		if !(obj.FieldInInt8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt8SlicePointer, []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16SlicePointer") {
+		if !(obj.FieldInInt16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt16SlicePointer, []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32SlicePointer") {
+		if !(obj.FieldInInt32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt32SlicePointer, []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64SlicePointer") {
+		if !(obj.FieldInInt64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInInt64SlicePointer, []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUintSlicePointer") {
+		if !(obj.FieldInUintSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUintSlicePointer, []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUintSlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8SlicePointer") {
+		if !(obj.FieldInUint8SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint8SlicePointer, []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16SlicePointer") {
+		if !(obj.FieldInUint16SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint16SlicePointer, []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32SlicePointer") {
+		if !(obj.FieldInUint32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint32SlicePointer, []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64SlicePointer") {
+		if !(obj.FieldInUint64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInUint64SlicePointer, []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64SlicePointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32SlicePointer") {
+		if !(obj.FieldInFloat32SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64SlicePointer") {
+		if !(obj.FieldInFloat64SlicePointer != nil && types.SliceOnlyContains(*obj.FieldInFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64SlicePointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolSlicePointer") {
+		if !(obj.FieldInBoolSlicePointer != nil && types.SliceOnlyContains(*obj.FieldInBoolSlicePointer, []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolSlicePointer elements must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringArrayPointer") {
+		if !(obj.FieldInStringArrayPointer != nil && types.SliceOnlyContains(obj.FieldInStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringArrayPointer elements must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldInIntArrayPointer") {
+		if !(obj.FieldInIntArrayPointer != nil && types.SliceOnlyContains(obj.FieldInIntArrayPointer[:], []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInIntArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt8ArrayPointer") {
+		if !(obj.FieldInInt8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt8ArrayPointer[:], []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt16ArrayPointer") {
+		if !(obj.FieldInInt16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt16ArrayPointer[:], []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt32ArrayPointer") {
+		if !(obj.FieldInInt32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt32ArrayPointer[:], []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInInt64ArrayPointer") {
+		if !(obj.FieldInInt64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInInt64ArrayPointer[:], []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUintArrayPointer") {
+		if !(obj.FieldInUintArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUintArrayPointer[:], []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUintArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint8ArrayPointer") {
+		if !(obj.FieldInUint8ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint8ArrayPointer[:], []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint16ArrayPointer") {
+		if !(obj.FieldInUint16ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint16ArrayPointer[:], []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint32ArrayPointer") {
+		if !(obj.FieldInUint32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint32ArrayPointer[:], []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInUint64ArrayPointer") {
+		if !(obj.FieldInUint64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInUint64ArrayPointer[:], []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64ArrayPointer elements must be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldInFloat32ArrayPointer") {
+		if !(obj.FieldInFloat32ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64ArrayPointer") {
+		if !(obj.FieldInFloat64ArrayPointer != nil && types.SliceOnlyContains(obj.FieldInFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64ArrayPointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolArrayPointer") {
+		if !(obj.FieldInBoolArrayPointer != nil && types.SliceOnlyContains(obj.FieldInBoolArrayPointer[:], []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolArrayPointer elements must be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldInStringMapPointer") {
+		if !(obj.FieldInStringMapPointer != nil && types.MapOnlyContains(*obj.FieldInStringMapPointer, []string{"a", "b", "c"})) {
+			errs = append(errs, types.NewValidationError("FieldInStringMapPointer elements must be one of 'a' 'b' 'c'"))
+		}
+	}
+	if selection.Has("FieldInIntMapPointer") {
+		if !(obj.FieldInIntMapPointer != nil && types.MapOnlyContains(*obj.FieldInIntMapPointer, []int{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInIntMapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt8MapPointer") {
+		if !(obj.FieldInInt8MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt8MapPointer, []int8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt8MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt16MapPointer") {
+		if !(obj.FieldInInt16MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt16MapPointer, []int16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt16MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt32MapPointer") {
+		if !(obj.FieldInInt32MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt32MapPointer, []int32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt32MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInInt64MapPointer") {
+		if !(obj.FieldInInt64MapPointer != nil && types.MapOnlyContains(*obj.FieldInInt64MapPointer, []int64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInInt64MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUintMapPointer") {
+		if !(obj.FieldInUintMapPointer != nil && types.MapOnlyContains(*obj.FieldInUintMapPointer, []uint{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUintMapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint8MapPointer") {
+		if !(obj.FieldInUint8MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint8MapPointer, []uint8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint8MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint16MapPointer") {
+		if !(obj.FieldInUint16MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint16MapPointer, []uint16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint16MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint32MapPointer") {
+		if !(obj.FieldInUint32MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint32MapPointer, []uint32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint32MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInUint64MapPointer") {
+		if !(obj.FieldInUint64MapPointer != nil && types.MapOnlyContains(*obj.FieldInUint64MapPointer, []uint64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldInUint64MapPointer elements must be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldInFloat32MapPointer") {
+		if !(obj.FieldInFloat32MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat32MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInFloat64MapPointer") {
+		if !(obj.FieldInFloat64MapPointer != nil && types.MapOnlyContains(*obj.FieldInFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldInFloat64MapPointer elements must be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldInBoolMapPointer") {
+		if !(obj.FieldInBoolMapPointer != nil && types.MapOnlyContains(*obj.FieldInBoolMapPointer, []bool{false})) {
+			errs = append(errs, types.NewValidationError("FieldInBoolMapPointer elements must be one of 'false'"))
+		}
+	}
+	return errs
+}
+func in_ignore_caseStructFieldsValidate(obj *in_ignore_caseStructFields) []error {
+	return in_ignore_caseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func in_ignore_caseStructFieldsValidateContext(ctx context.Context, obj *in_ignore_caseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.EqualFold(obj.FieldIn_ignore_caseString, "ab") || types.EqualFold(obj.FieldIn_ignore_caseString, "cd") || types.EqualFold(obj.FieldIn_ignore_caseString, "ef")) {
+		errs = append(errs, types.NewValidationError("FieldIn_ignore_caseString must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+
+func in_ignore_caseStructFieldsValidateFields(obj *in_ignore_caseStructFields, fields ...string) []error {
+	return in_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func in_ignore_caseStructFieldsValidateExcept(obj *in_ignore_caseStructFields, fields ...string) []error {
+	return in_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func in_ignore_caseStructFieldsValidatePartialContext(ctx context.Context, obj *in_ignore_caseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIn_ignore_caseString") {
+		if !(types.EqualFold(obj.FieldIn_ignore_caseString, "ab") || types.EqualFold(obj.FieldIn_ignore_caseString, "cd") || types.EqualFold(obj.FieldIn_ignore_caseString, "ef")) {
+			errs = append(errs, types.NewValidationError("FieldIn_ignore_caseString must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	return errs
+}
+func in_ignore_caseStructFieldsPointerValidate(obj *in_ignore_caseStructFieldsPointer) []error {
+	return in_ignore_caseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func in_ignore_caseStructFieldsPointerValidateContext(ctx context.Context, obj *in_ignore_caseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ab") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "cd") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ef")) {
+		errs = append(errs, types.NewValidationError("FieldIn_ignore_caseStringPointer must be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+
+func in_ignore_caseStructFieldsPointerValidateFields(obj *in_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return in_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func in_ignore_caseStructFieldsPointerValidateExcept(obj *in_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return in_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func in_ignore_caseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *in_ignore_caseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIn_ignore_caseStringPointer") {
+		if !(obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ab") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "cd") || obj.FieldIn_ignore_caseStringPointer != nil && types.EqualFold(*obj.FieldIn_ignore_caseStringPointer, "ef")) {
+			errs = append(errs, types.NewValidationError("FieldIn_ignore_caseStringPointer must be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	return errs
+}
+func ipStructFieldsValidate(obj *ipStructFields) []error {
+	return ipStructFieldsValidateContext(context.Background(), obj)
+}
+
+func ipStructFieldsValidateContext(ctx context.Context, obj *ipStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidIP(obj.FieldIpString)) {
+		errs = append(errs, types.NewValidationError("FieldIpString must be a valid IP address"))
+	}
+	return errs
+}
+
+func ipStructFieldsValidateFields(obj *ipStructFields, fields ...string) []error {
+	return ipStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipStructFieldsValidateExcept(obj *ipStructFields, fields ...string) []error {
+	return ipStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipStructFieldsValidatePartialContext(ctx context.Context, obj *ipStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpString") {
+		if !(types.IsValidIP(obj.FieldIpString)) {
+			errs = append(errs, types.NewValidationError("FieldIpString must be a valid IP address"))
+		}
+	}
+	return errs
+}
+func ipStructFieldsPointerValidate(obj *ipStructFieldsPointer) []error {
+	return ipStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ipStructFieldsPointerValidateContext(ctx context.Context, obj *ipStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIpStringPointer != nil && types.IsValidIP(*obj.FieldIpStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIpStringPointer must be a valid IP address"))
+	}
+	return errs
+}
+
+func ipStructFieldsPointerValidateFields(obj *ipStructFieldsPointer, fields ...string) []error {
+	return ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipStructFieldsPointerValidateExcept(obj *ipStructFieldsPointer, fields ...string) []error {
+	return ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipStructFieldsPointerValidatePartialContext(ctx context.Context, obj *ipStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpStringPointer") {
+		if !(obj.FieldIpStringPointer != nil && types.IsValidIP(*obj.FieldIpStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIpStringPointer must be a valid IP address"))
+		}
+	}
+	return errs
+}
+func ipv4StructFieldsValidate(obj *ipv4StructFields) []error {
+	return ipv4StructFieldsValidateContext(context.Background(), obj)
+}
+
+func ipv4StructFieldsValidateContext(ctx context.Context, obj *ipv4StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidIPv4(obj.FieldIpv4String)) {
+		errs = append(errs, types.NewValidationError("FieldIpv4String must be a valid IPv4 address"))
+	}
+	return errs
+}
+
+func ipv4StructFieldsValidateFields(obj *ipv4StructFields, fields ...string) []error {
+	return ipv4StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipv4StructFieldsValidateExcept(obj *ipv4StructFields, fields ...string) []error {
+	return ipv4StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipv4StructFieldsValidatePartialContext(ctx context.Context, obj *ipv4StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpv4String") {
+		if !(types.IsValidIPv4(obj.FieldIpv4String)) {
+			errs = append(errs, types.NewValidationError("FieldIpv4String must be a valid IPv4 address"))
+		}
+	}
+	return errs
+}
+func ipv4StructFieldsPointerValidate(obj *ipv4StructFieldsPointer) []error {
+	return ipv4StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ipv4StructFieldsPointerValidateContext(ctx context.Context, obj *ipv4StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIpv4StringPointer != nil && types.IsValidIPv4(*obj.FieldIpv4StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIpv4StringPointer must be a valid IPv4 address"))
+	}
+	return errs
+}
+
+func ipv4StructFieldsPointerValidateFields(obj *ipv4StructFieldsPointer, fields ...string) []error {
+	return ipv4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipv4StructFieldsPointerValidateExcept(obj *ipv4StructFieldsPointer, fields ...string) []error {
+	return ipv4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipv4StructFieldsPointerValidatePartialContext(ctx context.Context, obj *ipv4StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpv4StringPointer") {
+		if !(obj.FieldIpv4StringPointer != nil && types.IsValidIPv4(*obj.FieldIpv4StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIpv4StringPointer must be a valid IPv4 address"))
+		}
+	}
+	return errs
+}
+func ipv6StructFieldsValidate(obj *ipv6StructFields) []error {
+	return ipv6StructFieldsValidateContext(context.Background(), obj)
+}
+
+func ipv6StructFieldsValidateContext(ctx context.Context, obj *ipv6StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidIPv6(obj.FieldIpv6String)) {
+		errs = append(errs, types.NewValidationError("FieldIpv6String must be a valid IPv6 address"))
+	}
+	return errs
+}
+
+func ipv6StructFieldsValidateFields(obj *ipv6StructFields, fields ...string) []error {
+	return ipv6StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipv6StructFieldsValidateExcept(obj *ipv6StructFields, fields ...string) []error {
+	return ipv6StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipv6StructFieldsValidatePartialContext(ctx context.Context, obj *ipv6StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpv6String") {
+		if !(types.IsValidIPv6(obj.FieldIpv6String)) {
+			errs = append(errs, types.NewValidationError("FieldIpv6String must be a valid IPv6 address"))
+		}
+	}
+	return errs
+}
+func ipv6StructFieldsPointerValidate(obj *ipv6StructFieldsPointer) []error {
+	return ipv6StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ipv6StructFieldsPointerValidateContext(ctx context.Context, obj *ipv6StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIpv6StringPointer != nil && types.IsValidIPv6(*obj.FieldIpv6StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIpv6StringPointer must be a valid IPv6 address"))
+	}
+	return errs
+}
+
+func ipv6StructFieldsPointerValidateFields(obj *ipv6StructFieldsPointer, fields ...string) []error {
+	return ipv6StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ipv6StructFieldsPointerValidateExcept(obj *ipv6StructFieldsPointer, fields ...string) []error {
+	return ipv6StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ipv6StructFieldsPointerValidatePartialContext(ctx context.Context, obj *ipv6StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIpv6StringPointer") {
+		if !(obj.FieldIpv6StringPointer != nil && types.IsValidIPv6(*obj.FieldIpv6StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIpv6StringPointer must be a valid IPv6 address"))
+		}
+	}
+	return errs
+}
+func isbn10StructFieldsValidate(obj *isbn10StructFields) []error {
+	return isbn10StructFieldsValidateContext(context.Background(), obj)
+}
+
+func isbn10StructFieldsValidateContext(ctx context.Context, obj *isbn10StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISBN10(obj.FieldIsbn10String)) {
+		errs = append(errs, types.NewValidationError("FieldIsbn10String must be a valid ISBN-10"))
+	}
+	return errs
+}
+
+func isbn10StructFieldsValidateFields(obj *isbn10StructFields, fields ...string) []error {
+	return isbn10StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func isbn10StructFieldsValidateExcept(obj *isbn10StructFields, fields ...string) []error {
+	return isbn10StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func isbn10StructFieldsValidatePartialContext(ctx context.Context, obj *isbn10StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIsbn10String") {
+		if !(types.IsValidISBN10(obj.FieldIsbn10String)) {
+			errs = append(errs, types.NewValidationError("FieldIsbn10String must be a valid ISBN-10"))
+		}
+	}
+	return errs
+}
+func isbn10StructFieldsPointerValidate(obj *isbn10StructFieldsPointer) []error {
+	return isbn10StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func isbn10StructFieldsPointerValidateContext(ctx context.Context, obj *isbn10StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIsbn10StringPointer != nil && types.IsValidISBN10(*obj.FieldIsbn10StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIsbn10StringPointer must be a valid ISBN-10"))
+	}
+	return errs
+}
+
+func isbn10StructFieldsPointerValidateFields(obj *isbn10StructFieldsPointer, fields ...string) []error {
+	return isbn10StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func isbn10StructFieldsPointerValidateExcept(obj *isbn10StructFieldsPointer, fields ...string) []error {
+	return isbn10StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func isbn10StructFieldsPointerValidatePartialContext(ctx context.Context, obj *isbn10StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIsbn10StringPointer") {
+		if !(obj.FieldIsbn10StringPointer != nil && types.IsValidISBN10(*obj.FieldIsbn10StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIsbn10StringPointer must be a valid ISBN-10"))
+		}
+	}
+	return errs
+}
+func isbn13StructFieldsValidate(obj *isbn13StructFields) []error {
+	return isbn13StructFieldsValidateContext(context.Background(), obj)
+}
+
+func isbn13StructFieldsValidateContext(ctx context.Context, obj *isbn13StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISBN13(obj.FieldIsbn13String)) {
+		errs = append(errs, types.NewValidationError("FieldIsbn13String must be a valid ISBN-13"))
+	}
+	return errs
+}
+
+func isbn13StructFieldsValidateFields(obj *isbn13StructFields, fields ...string) []error {
+	return isbn13StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func isbn13StructFieldsValidateExcept(obj *isbn13StructFields, fields ...string) []error {
+	return isbn13StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func isbn13StructFieldsValidatePartialContext(ctx context.Context, obj *isbn13StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIsbn13String") {
+		if !(types.IsValidISBN13(obj.FieldIsbn13String)) {
+			errs = append(errs, types.NewValidationError("FieldIsbn13String must be a valid ISBN-13"))
+		}
+	}
+	return errs
+}
+func isbn13StructFieldsPointerValidate(obj *isbn13StructFieldsPointer) []error {
+	return isbn13StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func isbn13StructFieldsPointerValidateContext(ctx context.Context, obj *isbn13StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIsbn13StringPointer != nil && types.IsValidISBN13(*obj.FieldIsbn13StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIsbn13StringPointer must be a valid ISBN-13"))
+	}
+	return errs
+}
+
+func isbn13StructFieldsPointerValidateFields(obj *isbn13StructFieldsPointer, fields ...string) []error {
+	return isbn13StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func isbn13StructFieldsPointerValidateExcept(obj *isbn13StructFieldsPointer, fields ...string) []error {
+	return isbn13StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func isbn13StructFieldsPointerValidatePartialContext(ctx context.Context, obj *isbn13StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIsbn13StringPointer") {
+		if !(obj.FieldIsbn13StringPointer != nil && types.IsValidISBN13(*obj.FieldIsbn13StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIsbn13StringPointer must be a valid ISBN-13"))
+		}
+	}
+	return errs
+}
+func iso3166_1_alpha2StructFieldsValidate(obj *iso3166_1_alpha2StructFields) []error {
+	return iso3166_1_alpha2StructFieldsValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_alpha2StructFieldsValidateContext(ctx context.Context, obj *iso3166_1_alpha2StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO3166Alpha2(obj.FieldIso3166_1_alpha2String)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2String must be a valid ISO 3166-1 alpha-2 country code"))
+	}
+	return errs
+}
+
+func iso3166_1_alpha2StructFieldsValidateFields(obj *iso3166_1_alpha2StructFields, fields ...string) []error {
+	return iso3166_1_alpha2StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_alpha2StructFieldsValidateExcept(obj *iso3166_1_alpha2StructFields, fields ...string) []error {
+	return iso3166_1_alpha2StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_alpha2StructFieldsValidatePartialContext(ctx context.Context, obj *iso3166_1_alpha2StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_alpha2String") {
+		if !(types.IsValidISO3166Alpha2(obj.FieldIso3166_1_alpha2String)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2String must be a valid ISO 3166-1 alpha-2 country code"))
+		}
+	}
+	return errs
+}
+func iso3166_1_alpha2StructFieldsPointerValidate(obj *iso3166_1_alpha2StructFieldsPointer) []error {
+	return iso3166_1_alpha2StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_alpha2StructFieldsPointerValidateContext(ctx context.Context, obj *iso3166_1_alpha2StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIso3166_1_alpha2StringPointer != nil && types.IsValidISO3166Alpha2(*obj.FieldIso3166_1_alpha2StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2StringPointer must be a valid ISO 3166-1 alpha-2 country code"))
+	}
+	return errs
+}
+
+func iso3166_1_alpha2StructFieldsPointerValidateFields(obj *iso3166_1_alpha2StructFieldsPointer, fields ...string) []error {
+	return iso3166_1_alpha2StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_alpha2StructFieldsPointerValidateExcept(obj *iso3166_1_alpha2StructFieldsPointer, fields ...string) []error {
+	return iso3166_1_alpha2StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_alpha2StructFieldsPointerValidatePartialContext(ctx context.Context, obj *iso3166_1_alpha2StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_alpha2StringPointer") {
+		if !(obj.FieldIso3166_1_alpha2StringPointer != nil && types.IsValidISO3166Alpha2(*obj.FieldIso3166_1_alpha2StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha2StringPointer must be a valid ISO 3166-1 alpha-2 country code"))
+		}
+	}
+	return errs
+}
+func iso3166_1_alpha3StructFieldsValidate(obj *iso3166_1_alpha3StructFields) []error {
+	return iso3166_1_alpha3StructFieldsValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_alpha3StructFieldsValidateContext(ctx context.Context, obj *iso3166_1_alpha3StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO3166Alpha3(obj.FieldIso3166_1_alpha3String)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3String must be a valid ISO 3166-1 alpha-3 country code"))
+	}
+	return errs
+}
+
+func iso3166_1_alpha3StructFieldsValidateFields(obj *iso3166_1_alpha3StructFields, fields ...string) []error {
+	return iso3166_1_alpha3StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_alpha3StructFieldsValidateExcept(obj *iso3166_1_alpha3StructFields, fields ...string) []error {
+	return iso3166_1_alpha3StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_alpha3StructFieldsValidatePartialContext(ctx context.Context, obj *iso3166_1_alpha3StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_alpha3String") {
+		if !(types.IsValidISO3166Alpha3(obj.FieldIso3166_1_alpha3String)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3String must be a valid ISO 3166-1 alpha-3 country code"))
+		}
+	}
+	return errs
+}
+func iso3166_1_alpha3StructFieldsPointerValidate(obj *iso3166_1_alpha3StructFieldsPointer) []error {
+	return iso3166_1_alpha3StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_alpha3StructFieldsPointerValidateContext(ctx context.Context, obj *iso3166_1_alpha3StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIso3166_1_alpha3StringPointer != nil && types.IsValidISO3166Alpha3(*obj.FieldIso3166_1_alpha3StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3StringPointer must be a valid ISO 3166-1 alpha-3 country code"))
+	}
+	return errs
+}
+
+func iso3166_1_alpha3StructFieldsPointerValidateFields(obj *iso3166_1_alpha3StructFieldsPointer, fields ...string) []error {
+	return iso3166_1_alpha3StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_alpha3StructFieldsPointerValidateExcept(obj *iso3166_1_alpha3StructFieldsPointer, fields ...string) []error {
+	return iso3166_1_alpha3StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_alpha3StructFieldsPointerValidatePartialContext(ctx context.Context, obj *iso3166_1_alpha3StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_alpha3StringPointer") {
+		if !(obj.FieldIso3166_1_alpha3StringPointer != nil && types.IsValidISO3166Alpha3(*obj.FieldIso3166_1_alpha3StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_alpha3StringPointer must be a valid ISO 3166-1 alpha-3 country code"))
+		}
+	}
+	return errs
+}
+func iso3166_1_numericStructFieldsValidate(obj *iso3166_1_numericStructFields) []error {
+	return iso3166_1_numericStructFieldsValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_numericStructFieldsValidateContext(ctx context.Context, obj *iso3166_1_numericStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO3166NumericString(obj.FieldIso3166_1_numericString)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericString must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt8))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt16))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt32))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt64))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint8))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint16))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint32))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32 must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint64))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64 must be a valid ISO 3166-1 numeric country code"))
+	}
+	return errs
+}
+
+func iso3166_1_numericStructFieldsValidateFields(obj *iso3166_1_numericStructFields, fields ...string) []error {
+	return iso3166_1_numericStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_numericStructFieldsValidateExcept(obj *iso3166_1_numericStructFields, fields ...string) []error {
+	return iso3166_1_numericStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_numericStructFieldsValidatePartialContext(ctx context.Context, obj *iso3166_1_numericStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_numericString") {
+		if !(types.IsValidISO3166NumericString(obj.FieldIso3166_1_numericString)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericString must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt8") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt8))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt16") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt16))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt32") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt32))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt64") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericInt64))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint8") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint8))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint16") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint16))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint32") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint32))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint64") {
+		if !(types.IsValidISO3166Numeric(int(obj.FieldIso3166_1_numericUint64))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64 must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	return errs
+}
+func iso3166_1_numericStructFieldsPointerValidate(obj *iso3166_1_numericStructFieldsPointer) []error {
+	return iso3166_1_numericStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func iso3166_1_numericStructFieldsPointerValidateContext(ctx context.Context, obj *iso3166_1_numericStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIso3166_1_numericStringPointer != nil && types.IsValidISO3166NumericString(*obj.FieldIso3166_1_numericStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericStringPointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericIntPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericIntPointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericIntPointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericInt8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt8Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericInt16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt16Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericInt32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt32Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericInt64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt64Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericUintPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUintPointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUintPointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericUint8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint8Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericUint16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint16Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericUint32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint32Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	if !(obj.FieldIso3166_1_numericUint64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint64Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64Pointer must be a valid ISO 3166-1 numeric country code"))
+	}
+	return errs
+}
+
+func iso3166_1_numericStructFieldsPointerValidateFields(obj *iso3166_1_numericStructFieldsPointer, fields ...string) []error {
+	return iso3166_1_numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso3166_1_numericStructFieldsPointerValidateExcept(obj *iso3166_1_numericStructFieldsPointer, fields ...string) []error {
+	return iso3166_1_numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso3166_1_numericStructFieldsPointerValidatePartialContext(ctx context.Context, obj *iso3166_1_numericStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso3166_1_numericStringPointer") {
+		if !(obj.FieldIso3166_1_numericStringPointer != nil && types.IsValidISO3166NumericString(*obj.FieldIso3166_1_numericStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericStringPointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericIntPointer") {
+		if !(obj.FieldIso3166_1_numericIntPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericIntPointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericIntPointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt8Pointer") {
+		if !(obj.FieldIso3166_1_numericInt8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt8Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt8Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt16Pointer") {
+		if !(obj.FieldIso3166_1_numericInt16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt16Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt16Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt32Pointer") {
+		if !(obj.FieldIso3166_1_numericInt32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt32Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt32Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericInt64Pointer") {
+		if !(obj.FieldIso3166_1_numericInt64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericInt64Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericInt64Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUintPointer") {
+		if !(obj.FieldIso3166_1_numericUintPointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUintPointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUintPointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint8Pointer") {
+		if !(obj.FieldIso3166_1_numericUint8Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint8Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint8Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint16Pointer") {
+		if !(obj.FieldIso3166_1_numericUint16Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint16Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint16Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint32Pointer") {
+		if !(obj.FieldIso3166_1_numericUint32Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint32Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint32Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	if selection.Has("FieldIso3166_1_numericUint64Pointer") {
+		if !(obj.FieldIso3166_1_numericUint64Pointer != nil && types.IsValidISO3166Numeric(int(*obj.FieldIso3166_1_numericUint64Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso3166_1_numericUint64Pointer must be a valid ISO 3166-1 numeric country code"))
+		}
+	}
+	return errs
+}
+func iso4217StructFieldsValidate(obj *iso4217StructFields) []error {
+	return iso4217StructFieldsValidateContext(context.Background(), obj)
+}
+
+func iso4217StructFieldsValidateContext(ctx context.Context, obj *iso4217StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO4217(obj.FieldIso4217String)) {
+		errs = append(errs, types.NewValidationError("FieldIso4217String must be a valid ISO 4217 currency code"))
+	}
+	return errs
+}
+
+func iso4217StructFieldsValidateFields(obj *iso4217StructFields, fields ...string) []error {
+	return iso4217StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso4217StructFieldsValidateExcept(obj *iso4217StructFields, fields ...string) []error {
+	return iso4217StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso4217StructFieldsValidatePartialContext(ctx context.Context, obj *iso4217StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso4217String") {
+		if !(types.IsValidISO4217(obj.FieldIso4217String)) {
+			errs = append(errs, types.NewValidationError("FieldIso4217String must be a valid ISO 4217 currency code"))
+		}
+	}
+	return errs
+}
+func iso4217StructFieldsPointerValidate(obj *iso4217StructFieldsPointer) []error {
+	return iso4217StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func iso4217StructFieldsPointerValidateContext(ctx context.Context, obj *iso4217StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIso4217StringPointer != nil && types.IsValidISO4217(*obj.FieldIso4217StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIso4217StringPointer must be a valid ISO 4217 currency code"))
+	}
+	return errs
+}
+
+func iso4217StructFieldsPointerValidateFields(obj *iso4217StructFieldsPointer, fields ...string) []error {
+	return iso4217StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso4217StructFieldsPointerValidateExcept(obj *iso4217StructFieldsPointer, fields ...string) []error {
+	return iso4217StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso4217StructFieldsPointerValidatePartialContext(ctx context.Context, obj *iso4217StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso4217StringPointer") {
+		if !(obj.FieldIso4217StringPointer != nil && types.IsValidISO4217(*obj.FieldIso4217StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIso4217StringPointer must be a valid ISO 4217 currency code"))
+		}
+	}
+	return errs
+}
+func iso4217_numericStructFieldsValidate(obj *iso4217_numericStructFields) []error {
+	return iso4217_numericStructFieldsValidateContext(context.Background(), obj)
+}
+
+func iso4217_numericStructFieldsValidateContext(ctx context.Context, obj *iso4217_numericStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISO4217NumericString(obj.FieldIso4217_numericString)) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericString must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt8))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt16))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt32))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt64))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint8))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint16))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint32))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32 must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint64))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64 must be a valid ISO 4217 numeric currency code"))
+	}
+	return errs
+}
+
+func iso4217_numericStructFieldsValidateFields(obj *iso4217_numericStructFields, fields ...string) []error {
+	return iso4217_numericStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso4217_numericStructFieldsValidateExcept(obj *iso4217_numericStructFields, fields ...string) []error {
+	return iso4217_numericStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso4217_numericStructFieldsValidatePartialContext(ctx context.Context, obj *iso4217_numericStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso4217_numericString") {
+		if !(types.IsValidISO4217NumericString(obj.FieldIso4217_numericString)) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericString must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt8") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt8))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt16") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt16))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt32") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt32))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt64") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericInt64))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint8") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint8))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint16") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint16))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint32") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint32))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint64") {
+		if !(types.IsValidISO4217Numeric(int(obj.FieldIso4217_numericUint64))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64 must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	return errs
+}
+func iso4217_numericStructFieldsPointerValidate(obj *iso4217_numericStructFieldsPointer) []error {
+	return iso4217_numericStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func iso4217_numericStructFieldsPointerValidateContext(ctx context.Context, obj *iso4217_numericStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIso4217_numericStringPointer != nil && types.IsValidISO4217NumericString(*obj.FieldIso4217_numericStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericStringPointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericIntPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericIntPointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericIntPointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericInt8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt8Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericInt16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt16Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericInt32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt32Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericInt64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt64Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericUintPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUintPointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUintPointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericUint8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint8Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericUint16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint16Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericUint32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint32Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	if !(obj.FieldIso4217_numericUint64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint64Pointer))) {
+		errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64Pointer must be a valid ISO 4217 numeric currency code"))
+	}
+	return errs
+}
+
+func iso4217_numericStructFieldsPointerValidateFields(obj *iso4217_numericStructFieldsPointer, fields ...string) []error {
+	return iso4217_numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func iso4217_numericStructFieldsPointerValidateExcept(obj *iso4217_numericStructFieldsPointer, fields ...string) []error {
+	return iso4217_numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func iso4217_numericStructFieldsPointerValidatePartialContext(ctx context.Context, obj *iso4217_numericStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIso4217_numericStringPointer") {
+		if !(obj.FieldIso4217_numericStringPointer != nil && types.IsValidISO4217NumericString(*obj.FieldIso4217_numericStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericStringPointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericIntPointer") {
+		if !(obj.FieldIso4217_numericIntPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericIntPointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericIntPointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt8Pointer") {
+		if !(obj.FieldIso4217_numericInt8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt8Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt8Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt16Pointer") {
+		if !(obj.FieldIso4217_numericInt16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt16Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt16Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt32Pointer") {
+		if !(obj.FieldIso4217_numericInt32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt32Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt32Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericInt64Pointer") {
+		if !(obj.FieldIso4217_numericInt64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericInt64Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericInt64Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUintPointer") {
+		if !(obj.FieldIso4217_numericUintPointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUintPointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUintPointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint8Pointer") {
+		if !(obj.FieldIso4217_numericUint8Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint8Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint8Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint16Pointer") {
+		if !(obj.FieldIso4217_numericUint16Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint16Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint16Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint32Pointer") {
+		if !(obj.FieldIso4217_numericUint32Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint32Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint32Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	if selection.Has("FieldIso4217_numericUint64Pointer") {
+		if !(obj.FieldIso4217_numericUint64Pointer != nil && types.IsValidISO4217Numeric(int(*obj.FieldIso4217_numericUint64Pointer))) {
+			errs = append(errs, types.NewValidationError("FieldIso4217_numericUint64Pointer must be a valid ISO 4217 numeric currency code"))
+		}
+	}
+	return errs
+}
+func issnStructFieldsValidate(obj *issnStructFields) []error {
+	return issnStructFieldsValidateContext(context.Background(), obj)
+}
+
+func issnStructFieldsValidateContext(ctx context.Context, obj *issnStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidISSN(obj.FieldIssnString)) {
+		errs = append(errs, types.NewValidationError("FieldIssnString must be a valid ISSN"))
+	}
+	return errs
+}
+
+func issnStructFieldsValidateFields(obj *issnStructFields, fields ...string) []error {
+	return issnStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func issnStructFieldsValidateExcept(obj *issnStructFields, fields ...string) []error {
+	return issnStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func issnStructFieldsValidatePartialContext(ctx context.Context, obj *issnStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIssnString") {
+		if !(types.IsValidISSN(obj.FieldIssnString)) {
+			errs = append(errs, types.NewValidationError("FieldIssnString must be a valid ISSN"))
+		}
+	}
+	return errs
+}
+func issnStructFieldsPointerValidate(obj *issnStructFieldsPointer) []error {
+	return issnStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func issnStructFieldsPointerValidateContext(ctx context.Context, obj *issnStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldIssnStringPointer != nil && types.IsValidISSN(*obj.FieldIssnStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldIssnStringPointer must be a valid ISSN"))
+	}
+	return errs
+}
+
+func issnStructFieldsPointerValidateFields(obj *issnStructFieldsPointer, fields ...string) []error {
+	return issnStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func issnStructFieldsPointerValidateExcept(obj *issnStructFieldsPointer, fields ...string) []error {
+	return issnStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func issnStructFieldsPointerValidatePartialContext(ctx context.Context, obj *issnStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldIssnStringPointer") {
+		if !(obj.FieldIssnStringPointer != nil && types.IsValidISSN(*obj.FieldIssnStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldIssnStringPointer must be a valid ISSN"))
+		}
+	}
+	return errs
+}
+func jsonStructFieldsValidate(obj *jsonStructFields) []error {
+	return jsonStructFieldsValidateContext(context.Background(), obj)
+}
+
+func jsonStructFieldsValidateContext(ctx context.Context, obj *jsonStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidJSON(obj.FieldJsonString)) {
+		errs = append(errs, types.NewValidationError("FieldJsonString must be a valid JSON"))
+	}
+	if !(types.IsValidJSON(string(obj.FieldJsonByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldJsonByteSlice must be a valid JSON"))
+	}
+	return errs
+}
+
+func jsonStructFieldsValidateFields(obj *jsonStructFields, fields ...string) []error {
+	return jsonStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func jsonStructFieldsValidateExcept(obj *jsonStructFields, fields ...string) []error {
+	return jsonStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func jsonStructFieldsValidatePartialContext(ctx context.Context, obj *jsonStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldJsonString") {
+		if !(types.IsValidJSON(obj.FieldJsonString)) {
+			errs = append(errs, types.NewValidationError("FieldJsonString must be a valid JSON"))
+		}
+	}
+	if selection.Has("FieldJsonByteSlice") {
+		if !(types.IsValidJSON(string(obj.FieldJsonByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldJsonByteSlice must be a valid JSON"))
+		}
+	}
+	return errs
+}
+func jsonStructFieldsPointerValidate(obj *jsonStructFieldsPointer) []error {
+	return jsonStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func jsonStructFieldsPointerValidateContext(ctx context.Context, obj *jsonStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldJsonStringPointer != nil && types.IsValidJSON(*obj.FieldJsonStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldJsonStringPointer must be a valid JSON"))
+	}
+	if !(obj.FieldJsonByteSlicePointer != nil && types.IsValidJSON(string(*obj.FieldJsonByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldJsonByteSlicePointer must be a valid JSON"))
+	}
+	return errs
+}
+
+func jsonStructFieldsPointerValidateFields(obj *jsonStructFieldsPointer, fields ...string) []error {
+	return jsonStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func jsonStructFieldsPointerValidateExcept(obj *jsonStructFieldsPointer, fields ...string) []error {
+	return jsonStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func jsonStructFieldsPointerValidatePartialContext(ctx context.Context, obj *jsonStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldJsonStringPointer") {
+		if !(obj.FieldJsonStringPointer != nil && types.IsValidJSON(*obj.FieldJsonStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldJsonStringPointer must be a valid JSON"))
+		}
+	}
+	if selection.Has("FieldJsonByteSlicePointer") {
+		if !(obj.FieldJsonByteSlicePointer != nil && types.IsValidJSON(string(*obj.FieldJsonByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldJsonByteSlicePointer must be a valid JSON"))
+		}
+	}
+	return errs
+}
+func jwtStructFieldsValidate(obj *jwtStructFields) []error {
+	return jwtStructFieldsValidateContext(context.Background(), obj)
+}
+
+func jwtStructFieldsValidateContext(ctx context.Context, obj *jwtStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidJWT(obj.FieldJwtString)) {
+		errs = append(errs, types.NewValidationError("FieldJwtString must be a valid JWT"))
+	}
+	if !(types.IsValidJWT(string(obj.FieldJwtByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldJwtByteSlice must be a valid JWT"))
+	}
+	return errs
+}
+
+func jwtStructFieldsValidateFields(obj *jwtStructFields, fields ...string) []error {
+	return jwtStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func jwtStructFieldsValidateExcept(obj *jwtStructFields, fields ...string) []error {
+	return jwtStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func jwtStructFieldsValidatePartialContext(ctx context.Context, obj *jwtStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldJwtString") {
+		if !(types.IsValidJWT(obj.FieldJwtString)) {
+			errs = append(errs, types.NewValidationError("FieldJwtString must be a valid JWT"))
+		}
+	}
+	if selection.Has("FieldJwtByteSlice") {
+		if !(types.IsValidJWT(string(obj.FieldJwtByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldJwtByteSlice must be a valid JWT"))
+		}
+	}
+	return errs
+}
+func jwtStructFieldsPointerValidate(obj *jwtStructFieldsPointer) []error {
+	return jwtStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func jwtStructFieldsPointerValidateContext(ctx context.Context, obj *jwtStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldJwtStringPointer != nil && types.IsValidJWT(*obj.FieldJwtStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldJwtStringPointer must be a valid JWT"))
+	}
+	if !(obj.FieldJwtByteSlicePointer != nil && types.IsValidJWT(string(*obj.FieldJwtByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldJwtByteSlicePointer must be a valid JWT"))
+	}
+	return errs
+}
+
+func jwtStructFieldsPointerValidateFields(obj *jwtStructFieldsPointer, fields ...string) []error {
+	return jwtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func jwtStructFieldsPointerValidateExcept(obj *jwtStructFieldsPointer, fields ...string) []error {
+	return jwtStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func jwtStructFieldsPointerValidatePartialContext(ctx context.Context, obj *jwtStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldJwtStringPointer") {
+		if !(obj.FieldJwtStringPointer != nil && types.IsValidJWT(*obj.FieldJwtStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldJwtStringPointer must be a valid JWT"))
+		}
+	}
+	if selection.Has("FieldJwtByteSlicePointer") {
+		if !(obj.FieldJwtByteSlicePointer != nil && types.IsValidJWT(string(*obj.FieldJwtByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldJwtByteSlicePointer must be a valid JWT"))
+		}
+	}
+	return errs
+}
+func latitudeStructFieldsValidate(obj *latitudeStructFields) []error {
+	return latitudeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func latitudeStructFieldsValidateContext(ctx context.Context, obj *latitudeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidLatitude(obj.FieldLatitudeString)) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeString must be a valid latitude (-90 to 90)"))
+	}
+	if !(obj.FieldLatitudeFloat32 >= -90 && obj.FieldLatitudeFloat32 <= 90) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeFloat32 must be a valid latitude (-90 to 90)"))
+	}
+	if !(obj.FieldLatitudeFloat64 >= -90 && obj.FieldLatitudeFloat64 <= 90) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeFloat64 must be a valid latitude (-90 to 90)"))
+	}
+	return errs
+}
+
+func latitudeStructFieldsValidateFields(obj *latitudeStructFields, fields ...string) []error {
+	return latitudeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func latitudeStructFieldsValidateExcept(obj *latitudeStructFields, fields ...string) []error {
+	return latitudeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func latitudeStructFieldsValidatePartialContext(ctx context.Context, obj *latitudeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLatitudeString") {
+		if !(types.IsValidLatitude(obj.FieldLatitudeString)) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeString must be a valid latitude (-90 to 90)"))
+		}
+	}
+	if selection.Has("FieldLatitudeFloat32") {
+		if !(obj.FieldLatitudeFloat32 >= -90 && obj.FieldLatitudeFloat32 <= 90) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeFloat32 must be a valid latitude (-90 to 90)"))
+		}
+	}
+	if selection.Has("FieldLatitudeFloat64") {
+		if !(obj.FieldLatitudeFloat64 >= -90 && obj.FieldLatitudeFloat64 <= 90) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeFloat64 must be a valid latitude (-90 to 90)"))
+		}
+	}
+	return errs
+}
+func latitudeStructFieldsPointerValidate(obj *latitudeStructFieldsPointer) []error {
+	return latitudeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func latitudeStructFieldsPointerValidateContext(ctx context.Context, obj *latitudeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLatitudeStringPointer != nil && types.IsValidLatitude(*obj.FieldLatitudeStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeStringPointer must be a valid latitude (-90 to 90)"))
+	}
+	if !(obj.FieldLatitudeFloat32Pointer != nil && *obj.FieldLatitudeFloat32Pointer >= -90 && *obj.FieldLatitudeFloat32Pointer <= 90) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeFloat32Pointer must be a valid latitude (-90 to 90)"))
+	}
+	if !(obj.FieldLatitudeFloat64Pointer != nil && *obj.FieldLatitudeFloat64Pointer >= -90 && *obj.FieldLatitudeFloat64Pointer <= 90) {
+		errs = append(errs, types.NewValidationError("FieldLatitudeFloat64Pointer must be a valid latitude (-90 to 90)"))
+	}
+	return errs
+}
+
+func latitudeStructFieldsPointerValidateFields(obj *latitudeStructFieldsPointer, fields ...string) []error {
+	return latitudeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func latitudeStructFieldsPointerValidateExcept(obj *latitudeStructFieldsPointer, fields ...string) []error {
+	return latitudeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func latitudeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *latitudeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLatitudeStringPointer") {
+		if !(obj.FieldLatitudeStringPointer != nil && types.IsValidLatitude(*obj.FieldLatitudeStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeStringPointer must be a valid latitude (-90 to 90)"))
+		}
+	}
+	if selection.Has("FieldLatitudeFloat32Pointer") {
+		if !(obj.FieldLatitudeFloat32Pointer != nil && *obj.FieldLatitudeFloat32Pointer >= -90 && *obj.FieldLatitudeFloat32Pointer <= 90) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeFloat32Pointer must be a valid latitude (-90 to 90)"))
+		}
+	}
+	if selection.Has("FieldLatitudeFloat64Pointer") {
+		if !(obj.FieldLatitudeFloat64Pointer != nil && *obj.FieldLatitudeFloat64Pointer >= -90 && *obj.FieldLatitudeFloat64Pointer <= 90) {
+			errs = append(errs, types.NewValidationError("FieldLatitudeFloat64Pointer must be a valid latitude (-90 to 90)"))
+		}
+	}
+	return errs
+}
+func lenStructFieldsValidate(obj *lenStructFields) []error {
+	return lenStructFieldsValidateContext(context.Background(), obj)
+}
+
+func lenStructFieldsValidateContext(ctx context.Context, obj *lenStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(len(obj.FieldLenString) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenString length must be 2"))
+	}
+	if !(len(obj.FieldLenStringSlice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenStringSlice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenIntSlice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenIntSlice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt8Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt8Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt16Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt16Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt32Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt32Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt64Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt64Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUintSlice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUintSlice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint8Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint8Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint16Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint16Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint32Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint32Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint64Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint64Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenFloat32Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat32Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenFloat64Slice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat64Slice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenBoolSlice) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenBoolSlice must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenStringMap) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenStringMap must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenIntMap) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenIntMap must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt8Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt8Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt16Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt16Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt32Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt32Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenInt64Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt64Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUintMap) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUintMap must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint8Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint8Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint16Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint16Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint32Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint32Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenUint64Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint64Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenFloat32Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat32Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenFloat64Map) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat64Map must have exactly 2 elements"))
+	}
+	if !(len(obj.FieldLenBoolMap) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenBoolMap must have exactly 2 elements"))
+	}
+	return errs
+}
+
+func lenStructFieldsValidateFields(obj *lenStructFields, fields ...string) []error {
+	return lenStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lenStructFieldsValidateExcept(obj *lenStructFields, fields ...string) []error {
+	return lenStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lenStructFieldsValidatePartialContext(ctx context.Context, obj *lenStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLenString") {
+		if !(len(obj.FieldLenString) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenString length must be 2"))
+		}
+	}
+	if selection.Has("FieldLenStringSlice") {
+		if !(len(obj.FieldLenStringSlice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenStringSlice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenIntSlice") {
+		if !(len(obj.FieldLenIntSlice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenIntSlice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt8Slice") {
+		if !(len(obj.FieldLenInt8Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt8Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt16Slice") {
+		if !(len(obj.FieldLenInt16Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt16Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt32Slice") {
+		if !(len(obj.FieldLenInt32Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt32Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt64Slice") {
+		if !(len(obj.FieldLenInt64Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt64Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUintSlice") {
+		if !(len(obj.FieldLenUintSlice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUintSlice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint8Slice") {
+		if !(len(obj.FieldLenUint8Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint8Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint16Slice") {
+		if !(len(obj.FieldLenUint16Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint16Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint32Slice") {
+		if !(len(obj.FieldLenUint32Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint32Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint64Slice") {
+		if !(len(obj.FieldLenUint64Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint64Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat32Slice") {
+		if !(len(obj.FieldLenFloat32Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat32Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat64Slice") {
+		if !(len(obj.FieldLenFloat64Slice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat64Slice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenBoolSlice") {
+		if !(len(obj.FieldLenBoolSlice) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenBoolSlice must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenStringMap") {
+		if !(len(obj.FieldLenStringMap) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenStringMap must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenIntMap") {
+		if !(len(obj.FieldLenIntMap) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenIntMap must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt8Map") {
+		if !(len(obj.FieldLenInt8Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt8Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt16Map") {
+		if !(len(obj.FieldLenInt16Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt16Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt32Map") {
+		if !(len(obj.FieldLenInt32Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt32Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt64Map") {
+		if !(len(obj.FieldLenInt64Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt64Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUintMap") {
+		if !(len(obj.FieldLenUintMap) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUintMap must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint8Map") {
+		if !(len(obj.FieldLenUint8Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint8Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint16Map") {
+		if !(len(obj.FieldLenUint16Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint16Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint32Map") {
+		if !(len(obj.FieldLenUint32Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint32Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint64Map") {
+		if !(len(obj.FieldLenUint64Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint64Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat32Map") {
+		if !(len(obj.FieldLenFloat32Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat32Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat64Map") {
+		if !(len(obj.FieldLenFloat64Map) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat64Map must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenBoolMap") {
+		if !(len(obj.FieldLenBoolMap) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenBoolMap must have exactly 2 elements"))
+		}
+	}
+	return errs
+}
+func lenStructFieldsPointerValidate(obj *lenStructFieldsPointer) []error {
+	return lenStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func lenStructFieldsPointerValidateContext(ctx context.Context, obj *lenStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLenStringPointer != nil && len(*obj.FieldLenStringPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenStringPointer length must be 2"))
+	}
+	if !(obj.FieldLenStringSlicePointer != nil && len(*obj.FieldLenStringSlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenStringSlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenIntSlicePointer != nil && len(*obj.FieldLenIntSlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenIntSlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt8SlicePointer != nil && len(*obj.FieldLenInt8SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt8SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt16SlicePointer != nil && len(*obj.FieldLenInt16SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt16SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt32SlicePointer != nil && len(*obj.FieldLenInt32SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt32SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt64SlicePointer != nil && len(*obj.FieldLenInt64SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt64SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUintSlicePointer != nil && len(*obj.FieldLenUintSlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUintSlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint8SlicePointer != nil && len(*obj.FieldLenUint8SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint8SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint16SlicePointer != nil && len(*obj.FieldLenUint16SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint16SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint32SlicePointer != nil && len(*obj.FieldLenUint32SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint32SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint64SlicePointer != nil && len(*obj.FieldLenUint64SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint64SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenFloat32SlicePointer != nil && len(*obj.FieldLenFloat32SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat32SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenFloat64SlicePointer != nil && len(*obj.FieldLenFloat64SlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat64SlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenBoolSlicePointer != nil && len(*obj.FieldLenBoolSlicePointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenBoolSlicePointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenStringMapPointer != nil && len(*obj.FieldLenStringMapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenStringMapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenIntMapPointer != nil && len(*obj.FieldLenIntMapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenIntMapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt8MapPointer != nil && len(*obj.FieldLenInt8MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt8MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt16MapPointer != nil && len(*obj.FieldLenInt16MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt16MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt32MapPointer != nil && len(*obj.FieldLenInt32MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt32MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenInt64MapPointer != nil && len(*obj.FieldLenInt64MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenInt64MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUintMapPointer != nil && len(*obj.FieldLenUintMapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUintMapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint8MapPointer != nil && len(*obj.FieldLenUint8MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint8MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint16MapPointer != nil && len(*obj.FieldLenUint16MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint16MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint32MapPointer != nil && len(*obj.FieldLenUint32MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint32MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenUint64MapPointer != nil && len(*obj.FieldLenUint64MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenUint64MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenFloat32MapPointer != nil && len(*obj.FieldLenFloat32MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat32MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenFloat64MapPointer != nil && len(*obj.FieldLenFloat64MapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenFloat64MapPointer must have exactly 2 elements"))
+	}
+	if !(obj.FieldLenBoolMapPointer != nil && len(*obj.FieldLenBoolMapPointer) == 2) {
+		errs = append(errs, types.NewValidationError("FieldLenBoolMapPointer must have exactly 2 elements"))
+	}
+	return errs
+}
+
+func lenStructFieldsPointerValidateFields(obj *lenStructFieldsPointer, fields ...string) []error {
+	return lenStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lenStructFieldsPointerValidateExcept(obj *lenStructFieldsPointer, fields ...string) []error {
+	return lenStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lenStructFieldsPointerValidatePartialContext(ctx context.Context, obj *lenStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLenStringPointer") {
+		if !(obj.FieldLenStringPointer != nil && len(*obj.FieldLenStringPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenStringPointer length must be 2"))
+		}
+	}
+	if selection.Has("FieldLenStringSlicePointer") {
+		if !(obj.FieldLenStringSlicePointer != nil && len(*obj.FieldLenStringSlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenStringSlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenIntSlicePointer") {
+		if !(obj.FieldLenIntSlicePointer != nil && len(*obj.FieldLenIntSlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenIntSlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt8SlicePointer") {
+		if !(obj.FieldLenInt8SlicePointer != nil && len(*obj.FieldLenInt8SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt8SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt16SlicePointer") {
+		if !(obj.FieldLenInt16SlicePointer != nil && len(*obj.FieldLenInt16SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt16SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt32SlicePointer") {
+		if !(obj.FieldLenInt32SlicePointer != nil && len(*obj.FieldLenInt32SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt32SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt64SlicePointer") {
+		if !(obj.FieldLenInt64SlicePointer != nil && len(*obj.FieldLenInt64SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt64SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUintSlicePointer") {
+		if !(obj.FieldLenUintSlicePointer != nil && len(*obj.FieldLenUintSlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUintSlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint8SlicePointer") {
+		if !(obj.FieldLenUint8SlicePointer != nil && len(*obj.FieldLenUint8SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint8SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint16SlicePointer") {
+		if !(obj.FieldLenUint16SlicePointer != nil && len(*obj.FieldLenUint16SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint16SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint32SlicePointer") {
+		if !(obj.FieldLenUint32SlicePointer != nil && len(*obj.FieldLenUint32SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint32SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint64SlicePointer") {
+		if !(obj.FieldLenUint64SlicePointer != nil && len(*obj.FieldLenUint64SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint64SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat32SlicePointer") {
+		if !(obj.FieldLenFloat32SlicePointer != nil && len(*obj.FieldLenFloat32SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat32SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat64SlicePointer") {
+		if !(obj.FieldLenFloat64SlicePointer != nil && len(*obj.FieldLenFloat64SlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat64SlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenBoolSlicePointer") {
+		if !(obj.FieldLenBoolSlicePointer != nil && len(*obj.FieldLenBoolSlicePointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenBoolSlicePointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenStringMapPointer") {
+		if !(obj.FieldLenStringMapPointer != nil && len(*obj.FieldLenStringMapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenStringMapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenIntMapPointer") {
+		if !(obj.FieldLenIntMapPointer != nil && len(*obj.FieldLenIntMapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenIntMapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt8MapPointer") {
+		if !(obj.FieldLenInt8MapPointer != nil && len(*obj.FieldLenInt8MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt8MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt16MapPointer") {
+		if !(obj.FieldLenInt16MapPointer != nil && len(*obj.FieldLenInt16MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt16MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt32MapPointer") {
+		if !(obj.FieldLenInt32MapPointer != nil && len(*obj.FieldLenInt32MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt32MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenInt64MapPointer") {
+		if !(obj.FieldLenInt64MapPointer != nil && len(*obj.FieldLenInt64MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenInt64MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUintMapPointer") {
+		if !(obj.FieldLenUintMapPointer != nil && len(*obj.FieldLenUintMapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUintMapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint8MapPointer") {
+		if !(obj.FieldLenUint8MapPointer != nil && len(*obj.FieldLenUint8MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint8MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint16MapPointer") {
+		if !(obj.FieldLenUint16MapPointer != nil && len(*obj.FieldLenUint16MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint16MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint32MapPointer") {
+		if !(obj.FieldLenUint32MapPointer != nil && len(*obj.FieldLenUint32MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint32MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenUint64MapPointer") {
+		if !(obj.FieldLenUint64MapPointer != nil && len(*obj.FieldLenUint64MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenUint64MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat32MapPointer") {
+		if !(obj.FieldLenFloat32MapPointer != nil && len(*obj.FieldLenFloat32MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat32MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenFloat64MapPointer") {
+		if !(obj.FieldLenFloat64MapPointer != nil && len(*obj.FieldLenFloat64MapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenFloat64MapPointer must have exactly 2 elements"))
+		}
+	}
+	if selection.Has("FieldLenBoolMapPointer") {
+		if !(obj.FieldLenBoolMapPointer != nil && len(*obj.FieldLenBoolMapPointer) == 2) {
+			errs = append(errs, types.NewValidationError("FieldLenBoolMapPointer must have exactly 2 elements"))
+		}
+	}
+	return errs
+}
+func longitudeStructFieldsValidate(obj *longitudeStructFields) []error {
+	return longitudeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func longitudeStructFieldsValidateContext(ctx context.Context, obj *longitudeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidLongitude(obj.FieldLongitudeString)) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeString must be a valid longitude (-180 to 180)"))
+	}
+	if !(obj.FieldLongitudeFloat32 >= -180 && obj.FieldLongitudeFloat32 <= 180) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeFloat32 must be a valid longitude (-180 to 180)"))
+	}
+	if !(obj.FieldLongitudeFloat64 >= -180 && obj.FieldLongitudeFloat64 <= 180) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeFloat64 must be a valid longitude (-180 to 180)"))
+	}
+	return errs
+}
+
+func longitudeStructFieldsValidateFields(obj *longitudeStructFields, fields ...string) []error {
+	return longitudeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func longitudeStructFieldsValidateExcept(obj *longitudeStructFields, fields ...string) []error {
+	return longitudeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func longitudeStructFieldsValidatePartialContext(ctx context.Context, obj *longitudeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLongitudeString") {
+		if !(types.IsValidLongitude(obj.FieldLongitudeString)) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeString must be a valid longitude (-180 to 180)"))
+		}
+	}
+	if selection.Has("FieldLongitudeFloat32") {
+		if !(obj.FieldLongitudeFloat32 >= -180 && obj.FieldLongitudeFloat32 <= 180) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeFloat32 must be a valid longitude (-180 to 180)"))
+		}
+	}
+	if selection.Has("FieldLongitudeFloat64") {
+		if !(obj.FieldLongitudeFloat64 >= -180 && obj.FieldLongitudeFloat64 <= 180) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeFloat64 must be a valid longitude (-180 to 180)"))
+		}
+	}
+	return errs
+}
+func longitudeStructFieldsPointerValidate(obj *longitudeStructFieldsPointer) []error {
+	return longitudeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func longitudeStructFieldsPointerValidateContext(ctx context.Context, obj *longitudeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLongitudeStringPointer != nil && types.IsValidLongitude(*obj.FieldLongitudeStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeStringPointer must be a valid longitude (-180 to 180)"))
+	}
+	if !(obj.FieldLongitudeFloat32Pointer != nil && *obj.FieldLongitudeFloat32Pointer >= -180 && *obj.FieldLongitudeFloat32Pointer <= 180) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeFloat32Pointer must be a valid longitude (-180 to 180)"))
+	}
+	if !(obj.FieldLongitudeFloat64Pointer != nil && *obj.FieldLongitudeFloat64Pointer >= -180 && *obj.FieldLongitudeFloat64Pointer <= 180) {
+		errs = append(errs, types.NewValidationError("FieldLongitudeFloat64Pointer must be a valid longitude (-180 to 180)"))
+	}
+	return errs
+}
+
+func longitudeStructFieldsPointerValidateFields(obj *longitudeStructFieldsPointer, fields ...string) []error {
+	return longitudeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func longitudeStructFieldsPointerValidateExcept(obj *longitudeStructFieldsPointer, fields ...string) []error {
+	return longitudeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func longitudeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *longitudeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLongitudeStringPointer") {
+		if !(obj.FieldLongitudeStringPointer != nil && types.IsValidLongitude(*obj.FieldLongitudeStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeStringPointer must be a valid longitude (-180 to 180)"))
+		}
+	}
+	if selection.Has("FieldLongitudeFloat32Pointer") {
+		if !(obj.FieldLongitudeFloat32Pointer != nil && *obj.FieldLongitudeFloat32Pointer >= -180 && *obj.FieldLongitudeFloat32Pointer <= 180) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeFloat32Pointer must be a valid longitude (-180 to 180)"))
+		}
+	}
+	if selection.Has("FieldLongitudeFloat64Pointer") {
+		if !(obj.FieldLongitudeFloat64Pointer != nil && *obj.FieldLongitudeFloat64Pointer >= -180 && *obj.FieldLongitudeFloat64Pointer <= 180) {
+			errs = append(errs, types.NewValidationError("FieldLongitudeFloat64Pointer must be a valid longitude (-180 to 180)"))
+		}
+	}
+	return errs
+}
+func lowercaseStructFieldsValidate(obj *lowercaseStructFields) []error {
+	return lowercaseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func lowercaseStructFieldsValidateContext(ctx context.Context, obj *lowercaseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsLowercase(obj.FieldLowercaseString)) {
+		errs = append(errs, types.NewValidationError("FieldLowercaseString must be lowercase"))
+	}
+	return errs
+}
+
+func lowercaseStructFieldsValidateFields(obj *lowercaseStructFields, fields ...string) []error {
+	return lowercaseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lowercaseStructFieldsValidateExcept(obj *lowercaseStructFields, fields ...string) []error {
+	return lowercaseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lowercaseStructFieldsValidatePartialContext(ctx context.Context, obj *lowercaseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLowercaseString") {
+		if !(types.IsLowercase(obj.FieldLowercaseString)) {
+			errs = append(errs, types.NewValidationError("FieldLowercaseString must be lowercase"))
+		}
+	}
+	return errs
+}
+func lowercaseStructFieldsPointerValidate(obj *lowercaseStructFieldsPointer) []error {
+	return lowercaseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func lowercaseStructFieldsPointerValidateContext(ctx context.Context, obj *lowercaseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLowercaseStringPointer != nil && types.IsLowercase(*obj.FieldLowercaseStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldLowercaseStringPointer must be lowercase"))
+	}
+	return errs
+}
+
+func lowercaseStructFieldsPointerValidateFields(obj *lowercaseStructFieldsPointer, fields ...string) []error {
+	return lowercaseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lowercaseStructFieldsPointerValidateExcept(obj *lowercaseStructFieldsPointer, fields ...string) []error {
+	return lowercaseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lowercaseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *lowercaseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLowercaseStringPointer") {
+		if !(obj.FieldLowercaseStringPointer != nil && types.IsLowercase(*obj.FieldLowercaseStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldLowercaseStringPointer must be lowercase"))
+		}
+	}
+	return errs
+}
+func ltStructFieldsValidate(obj *ltStructFields) []error {
+	return ltStructFieldsValidateContext(context.Background(), obj)
+}
+
+func ltStructFieldsValidateContext(ctx context.Context, obj *ltStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLtInt < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt must be < 32"))
+	}
+	if !(obj.FieldLtInt8 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt8 must be < 32"))
+	}
+	if !(obj.FieldLtInt16 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt16 must be < 32"))
+	}
+	if !(obj.FieldLtInt32 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt32 must be < 32"))
+	}
+	if !(obj.FieldLtInt64 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt64 must be < 32"))
+	}
+	if !(obj.FieldLtUint < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint must be < 32"))
+	}
+	if !(obj.FieldLtUint8 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint8 must be < 32"))
+	}
+	if !(obj.FieldLtUint16 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint16 must be < 32"))
+	}
+	if !(obj.FieldLtUint32 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint32 must be < 32"))
+	}
+	if !(obj.FieldLtUint64 < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint64 must be < 32"))
+	}
+	if !(obj.FieldLtFloat32 < 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLtFloat32 must be < 12.34"))
+	}
+	if !(obj.FieldLtFloat64 < 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLtFloat64 must be < 12.34"))
+	}
+	return errs
+}
+
+func ltStructFieldsValidateFields(obj *ltStructFields, fields ...string) []error {
+	return ltStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ltStructFieldsValidateExcept(obj *ltStructFields, fields ...string) []error {
+	return ltStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ltStructFieldsValidatePartialContext(ctx context.Context, obj *ltStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLtInt") {
+		if !(obj.FieldLtInt < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt8") {
+		if !(obj.FieldLtInt8 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt8 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt16") {
+		if !(obj.FieldLtInt16 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt16 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt32") {
+		if !(obj.FieldLtInt32 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt32 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt64") {
+		if !(obj.FieldLtInt64 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt64 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint") {
+		if !(obj.FieldLtUint < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint8") {
+		if !(obj.FieldLtUint8 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint8 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint16") {
+		if !(obj.FieldLtUint16 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint16 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint32") {
+		if !(obj.FieldLtUint32 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint32 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint64") {
+		if !(obj.FieldLtUint64 < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint64 must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtFloat32") {
+		if !(obj.FieldLtFloat32 < 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLtFloat32 must be < 12.34"))
+		}
+	}
+	if selection.Has("FieldLtFloat64") {
+		if !(obj.FieldLtFloat64 < 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLtFloat64 must be < 12.34"))
+		}
+	}
+	return errs
+}
+func ltStructFieldsPointerValidate(obj *ltStructFieldsPointer) []error {
+	return ltStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ltStructFieldsPointerValidateContext(ctx context.Context, obj *ltStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLtIntPointer != nil && *obj.FieldLtIntPointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtIntPointer must be < 32"))
+	}
+	if !(obj.FieldLtInt8Pointer != nil && *obj.FieldLtInt8Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt8Pointer must be < 32"))
+	}
+	if !(obj.FieldLtInt16Pointer != nil && *obj.FieldLtInt16Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt16Pointer must be < 32"))
+	}
+	if !(obj.FieldLtInt32Pointer != nil && *obj.FieldLtInt32Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt32Pointer must be < 32"))
+	}
+	if !(obj.FieldLtInt64Pointer != nil && *obj.FieldLtInt64Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtInt64Pointer must be < 32"))
+	}
+	if !(obj.FieldLtUintPointer != nil && *obj.FieldLtUintPointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUintPointer must be < 32"))
+	}
+	if !(obj.FieldLtUint8Pointer != nil && *obj.FieldLtUint8Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint8Pointer must be < 32"))
+	}
+	if !(obj.FieldLtUint16Pointer != nil && *obj.FieldLtUint16Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint16Pointer must be < 32"))
+	}
+	if !(obj.FieldLtUint32Pointer != nil && *obj.FieldLtUint32Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint32Pointer must be < 32"))
+	}
+	if !(obj.FieldLtUint64Pointer != nil && *obj.FieldLtUint64Pointer < 32) {
+		errs = append(errs, types.NewValidationError("FieldLtUint64Pointer must be < 32"))
+	}
+	if !(obj.FieldLtFloat32Pointer != nil && *obj.FieldLtFloat32Pointer < 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLtFloat32Pointer must be < 12.34"))
+	}
+	if !(obj.FieldLtFloat64Pointer != nil && *obj.FieldLtFloat64Pointer < 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLtFloat64Pointer must be < 12.34"))
+	}
+	return errs
+}
+
+func ltStructFieldsPointerValidateFields(obj *ltStructFieldsPointer, fields ...string) []error {
+	return ltStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ltStructFieldsPointerValidateExcept(obj *ltStructFieldsPointer, fields ...string) []error {
+	return ltStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ltStructFieldsPointerValidatePartialContext(ctx context.Context, obj *ltStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLtIntPointer") {
+		if !(obj.FieldLtIntPointer != nil && *obj.FieldLtIntPointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtIntPointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt8Pointer") {
+		if !(obj.FieldLtInt8Pointer != nil && *obj.FieldLtInt8Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt8Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt16Pointer") {
+		if !(obj.FieldLtInt16Pointer != nil && *obj.FieldLtInt16Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt16Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt32Pointer") {
+		if !(obj.FieldLtInt32Pointer != nil && *obj.FieldLtInt32Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt32Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtInt64Pointer") {
+		if !(obj.FieldLtInt64Pointer != nil && *obj.FieldLtInt64Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtInt64Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUintPointer") {
+		if !(obj.FieldLtUintPointer != nil && *obj.FieldLtUintPointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUintPointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint8Pointer") {
+		if !(obj.FieldLtUint8Pointer != nil && *obj.FieldLtUint8Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint8Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint16Pointer") {
+		if !(obj.FieldLtUint16Pointer != nil && *obj.FieldLtUint16Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint16Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint32Pointer") {
+		if !(obj.FieldLtUint32Pointer != nil && *obj.FieldLtUint32Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint32Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtUint64Pointer") {
+		if !(obj.FieldLtUint64Pointer != nil && *obj.FieldLtUint64Pointer < 32) {
+			errs = append(errs, types.NewValidationError("FieldLtUint64Pointer must be < 32"))
+		}
+	}
+	if selection.Has("FieldLtFloat32Pointer") {
+		if !(obj.FieldLtFloat32Pointer != nil && *obj.FieldLtFloat32Pointer < 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLtFloat32Pointer must be < 12.34"))
+		}
+	}
+	if selection.Has("FieldLtFloat64Pointer") {
+		if !(obj.FieldLtFloat64Pointer != nil && *obj.FieldLtFloat64Pointer < 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLtFloat64Pointer must be < 12.34"))
+		}
+	}
+	return errs
+}
+func lteStructFieldsValidate(obj *lteStructFields) []error {
+	return lteStructFieldsValidateContext(context.Background(), obj)
+}
+
+func lteStructFieldsValidateContext(ctx context.Context, obj *lteStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLteInt <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt must be <= 32"))
+	}
+	if !(obj.FieldLteInt8 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt8 must be <= 32"))
+	}
+	if !(obj.FieldLteInt16 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt16 must be <= 32"))
+	}
+	if !(obj.FieldLteInt32 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt32 must be <= 32"))
+	}
+	if !(obj.FieldLteInt64 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt64 must be <= 32"))
+	}
+	if !(obj.FieldLteUint <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint must be <= 32"))
+	}
+	if !(obj.FieldLteUint8 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint8 must be <= 32"))
+	}
+	if !(obj.FieldLteUint16 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint16 must be <= 32"))
+	}
+	if !(obj.FieldLteUint32 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint32 must be <= 32"))
+	}
+	if !(obj.FieldLteUint64 <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint64 must be <= 32"))
+	}
+	if !(obj.FieldLteFloat32 <= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLteFloat32 must be <= 12.34"))
+	}
+	if !(obj.FieldLteFloat64 <= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLteFloat64 must be <= 12.34"))
+	}
+	return errs
+}
+
+func lteStructFieldsValidateFields(obj *lteStructFields, fields ...string) []error {
+	return lteStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lteStructFieldsValidateExcept(obj *lteStructFields, fields ...string) []error {
+	return lteStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lteStructFieldsValidatePartialContext(ctx context.Context, obj *lteStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLteInt") {
+		if !(obj.FieldLteInt <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt8") {
+		if !(obj.FieldLteInt8 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt8 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt16") {
+		if !(obj.FieldLteInt16 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt16 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt32") {
+		if !(obj.FieldLteInt32 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt32 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt64") {
+		if !(obj.FieldLteInt64 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt64 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint") {
+		if !(obj.FieldLteUint <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint8") {
+		if !(obj.FieldLteUint8 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint8 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint16") {
+		if !(obj.FieldLteUint16 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint16 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint32") {
+		if !(obj.FieldLteUint32 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint32 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint64") {
+		if !(obj.FieldLteUint64 <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint64 must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteFloat32") {
+		if !(obj.FieldLteFloat32 <= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLteFloat32 must be <= 12.34"))
+		}
+	}
+	if selection.Has("FieldLteFloat64") {
+		if !(obj.FieldLteFloat64 <= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLteFloat64 must be <= 12.34"))
+		}
+	}
+	return errs
+}
+func lteStructFieldsPointerValidate(obj *lteStructFieldsPointer) []error {
+	return lteStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func lteStructFieldsPointerValidateContext(ctx context.Context, obj *lteStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLteIntPointer != nil && *obj.FieldLteIntPointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteIntPointer must be <= 32"))
+	}
+	if !(obj.FieldLteInt8Pointer != nil && *obj.FieldLteInt8Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt8Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteInt16Pointer != nil && *obj.FieldLteInt16Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt16Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteInt32Pointer != nil && *obj.FieldLteInt32Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt32Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteInt64Pointer != nil && *obj.FieldLteInt64Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteInt64Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteUintPointer != nil && *obj.FieldLteUintPointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUintPointer must be <= 32"))
+	}
+	if !(obj.FieldLteUint8Pointer != nil && *obj.FieldLteUint8Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint8Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteUint16Pointer != nil && *obj.FieldLteUint16Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint16Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteUint32Pointer != nil && *obj.FieldLteUint32Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint32Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteUint64Pointer != nil && *obj.FieldLteUint64Pointer <= 32) {
+		errs = append(errs, types.NewValidationError("FieldLteUint64Pointer must be <= 32"))
+	}
+	if !(obj.FieldLteFloat32Pointer != nil && *obj.FieldLteFloat32Pointer <= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLteFloat32Pointer must be <= 12.34"))
+	}
+	if !(obj.FieldLteFloat64Pointer != nil && *obj.FieldLteFloat64Pointer <= 12.34) {
+		errs = append(errs, types.NewValidationError("FieldLteFloat64Pointer must be <= 12.34"))
+	}
+	return errs
+}
+
+func lteStructFieldsPointerValidateFields(obj *lteStructFieldsPointer, fields ...string) []error {
+	return lteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func lteStructFieldsPointerValidateExcept(obj *lteStructFieldsPointer, fields ...string) []error {
+	return lteStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func lteStructFieldsPointerValidatePartialContext(ctx context.Context, obj *lteStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLteIntPointer") {
+		if !(obj.FieldLteIntPointer != nil && *obj.FieldLteIntPointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteIntPointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt8Pointer") {
+		if !(obj.FieldLteInt8Pointer != nil && *obj.FieldLteInt8Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt8Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt16Pointer") {
+		if !(obj.FieldLteInt16Pointer != nil && *obj.FieldLteInt16Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt16Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt32Pointer") {
+		if !(obj.FieldLteInt32Pointer != nil && *obj.FieldLteInt32Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt32Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteInt64Pointer") {
+		if !(obj.FieldLteInt64Pointer != nil && *obj.FieldLteInt64Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteInt64Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUintPointer") {
+		if !(obj.FieldLteUintPointer != nil && *obj.FieldLteUintPointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUintPointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint8Pointer") {
+		if !(obj.FieldLteUint8Pointer != nil && *obj.FieldLteUint8Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint8Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint16Pointer") {
+		if !(obj.FieldLteUint16Pointer != nil && *obj.FieldLteUint16Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint16Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint32Pointer") {
+		if !(obj.FieldLteUint32Pointer != nil && *obj.FieldLteUint32Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint32Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteUint64Pointer") {
+		if !(obj.FieldLteUint64Pointer != nil && *obj.FieldLteUint64Pointer <= 32) {
+			errs = append(errs, types.NewValidationError("FieldLteUint64Pointer must be <= 32"))
+		}
+	}
+	if selection.Has("FieldLteFloat32Pointer") {
+		if !(obj.FieldLteFloat32Pointer != nil && *obj.FieldLteFloat32Pointer <= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLteFloat32Pointer must be <= 12.34"))
+		}
+	}
+	if selection.Has("FieldLteFloat64Pointer") {
+		if !(obj.FieldLteFloat64Pointer != nil && *obj.FieldLteFloat64Pointer <= 12.34) {
+			errs = append(errs, types.NewValidationError("FieldLteFloat64Pointer must be <= 12.34"))
+		}
+	}
+	return errs
+}
+func luhn_checksumStructFieldsValidate(obj *luhn_checksumStructFields) []error {
+	return luhn_checksumStructFieldsValidateContext(context.Background(), obj)
+}
+
+func luhn_checksumStructFieldsValidateContext(ctx context.Context, obj *luhn_checksumStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidLuhnChecksum(obj.FieldLuhn_checksumString)) {
+		errs = append(errs, types.NewValidationError("FieldLuhn_checksumString must have a valid Luhn checksum"))
+	}
+	return errs
+}
+
+func luhn_checksumStructFieldsValidateFields(obj *luhn_checksumStructFields, fields ...string) []error {
+	return luhn_checksumStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func luhn_checksumStructFieldsValidateExcept(obj *luhn_checksumStructFields, fields ...string) []error {
+	return luhn_checksumStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func luhn_checksumStructFieldsValidatePartialContext(ctx context.Context, obj *luhn_checksumStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLuhn_checksumString") {
+		if !(types.IsValidLuhnChecksum(obj.FieldLuhn_checksumString)) {
+			errs = append(errs, types.NewValidationError("FieldLuhn_checksumString must have a valid Luhn checksum"))
+		}
+	}
+	return errs
+}
+func luhn_checksumStructFieldsPointerValidate(obj *luhn_checksumStructFieldsPointer) []error {
+	return luhn_checksumStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func luhn_checksumStructFieldsPointerValidateContext(ctx context.Context, obj *luhn_checksumStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldLuhn_checksumStringPointer != nil && types.IsValidLuhnChecksum(*obj.FieldLuhn_checksumStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldLuhn_checksumStringPointer must have a valid Luhn checksum"))
+	}
+	return errs
+}
+
+func luhn_checksumStructFieldsPointerValidateFields(obj *luhn_checksumStructFieldsPointer, fields ...string) []error {
+	return luhn_checksumStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func luhn_checksumStructFieldsPointerValidateExcept(obj *luhn_checksumStructFieldsPointer, fields ...string) []error {
+	return luhn_checksumStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func luhn_checksumStructFieldsPointerValidatePartialContext(ctx context.Context, obj *luhn_checksumStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldLuhn_checksumStringPointer") {
+		if !(obj.FieldLuhn_checksumStringPointer != nil && types.IsValidLuhnChecksum(*obj.FieldLuhn_checksumStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldLuhn_checksumStringPointer must have a valid Luhn checksum"))
+		}
+	}
+	return errs
+}
+func macStructFieldsValidate(obj *macStructFields) []error {
+	return macStructFieldsValidateContext(context.Background(), obj)
+}
+
+func macStructFieldsValidateContext(ctx context.Context, obj *macStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidMAC(obj.FieldMacString)) {
+		errs = append(errs, types.NewValidationError("FieldMacString must be a valid MAC address"))
+	}
+	return errs
+}
+
+func macStructFieldsValidateFields(obj *macStructFields, fields ...string) []error {
+	return macStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func macStructFieldsValidateExcept(obj *macStructFields, fields ...string) []error {
+	return macStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func macStructFieldsValidatePartialContext(ctx context.Context, obj *macStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMacString") {
+		if !(types.IsValidMAC(obj.FieldMacString)) {
+			errs = append(errs, types.NewValidationError("FieldMacString must be a valid MAC address"))
+		}
+	}
+	return errs
+}
+func macStructFieldsPointerValidate(obj *macStructFieldsPointer) []error {
+	return macStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func macStructFieldsPointerValidateContext(ctx context.Context, obj *macStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMacStringPointer != nil && types.IsValidMAC(*obj.FieldMacStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldMacStringPointer must be a valid MAC address"))
+	}
+	return errs
+}
+
+func macStructFieldsPointerValidateFields(obj *macStructFieldsPointer, fields ...string) []error {
+	return macStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func macStructFieldsPointerValidateExcept(obj *macStructFieldsPointer, fields ...string) []error {
+	return macStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func macStructFieldsPointerValidatePartialContext(ctx context.Context, obj *macStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMacStringPointer") {
+		if !(obj.FieldMacStringPointer != nil && types.IsValidMAC(*obj.FieldMacStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldMacStringPointer must be a valid MAC address"))
+		}
+	}
+	return errs
+}
+func maxStructFieldsValidate(obj *maxStructFields) []error {
+	return maxStructFieldsValidateContext(context.Background(), obj)
+}
+
+func maxStructFieldsValidateContext(ctx context.Context, obj *maxStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(len(obj.FieldMaxString) <= 3) {
+		errs = append(errs, types.NewValidationError("FieldMaxString length must be <= 3"))
+	}
+	if !(len(obj.FieldMaxStringSlice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxStringSlice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxIntSlice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxIntSlice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt8Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt8Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt16Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt16Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt32Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt32Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt64Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt64Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUintSlice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUintSlice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint8Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint8Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint16Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint16Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint32Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint32Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint64Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint64Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxFloat32Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat32Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxFloat64Slice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat64Slice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxBoolSlice) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxBoolSlice must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxStringMap) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxStringMap must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxIntMap) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxIntMap must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt8Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt8Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt16Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt16Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt32Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt32Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxInt64Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt64Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUintMap) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUintMap must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint8Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint8Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint16Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint16Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint32Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint32Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxUint64Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint64Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxFloat32Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat32Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxFloat64Map) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat64Map must have at most 2 elements"))
+	}
+	if !(len(obj.FieldMaxBoolMap) <= 1) {
+		errs = append(errs, types.NewValidationError("FieldMaxBoolMap must have at most 1 elements"))
+	}
+	return errs
+}
+
+func maxStructFieldsValidateFields(obj *maxStructFields, fields ...string) []error {
+	return maxStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func maxStructFieldsValidateExcept(obj *maxStructFields, fields ...string) []error {
+	return maxStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func maxStructFieldsValidatePartialContext(ctx context.Context, obj *maxStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMaxString") {
+		if !(len(obj.FieldMaxString) <= 3) {
+			errs = append(errs, types.NewValidationError("FieldMaxString length must be <= 3"))
+		}
+	}
+	if selection.Has("FieldMaxStringSlice") {
+		if !(len(obj.FieldMaxStringSlice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxStringSlice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxIntSlice") {
+		if !(len(obj.FieldMaxIntSlice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxIntSlice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt8Slice") {
+		if !(len(obj.FieldMaxInt8Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt8Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt16Slice") {
+		if !(len(obj.FieldMaxInt16Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt16Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt32Slice") {
+		if !(len(obj.FieldMaxInt32Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt32Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt64Slice") {
+		if !(len(obj.FieldMaxInt64Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt64Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUintSlice") {
+		if !(len(obj.FieldMaxUintSlice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUintSlice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint8Slice") {
+		if !(len(obj.FieldMaxUint8Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint8Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint16Slice") {
+		if !(len(obj.FieldMaxUint16Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint16Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint32Slice") {
+		if !(len(obj.FieldMaxUint32Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint32Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint64Slice") {
+		if !(len(obj.FieldMaxUint64Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint64Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat32Slice") {
+		if !(len(obj.FieldMaxFloat32Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat32Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat64Slice") {
+		if !(len(obj.FieldMaxFloat64Slice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat64Slice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxBoolSlice") {
+		if !(len(obj.FieldMaxBoolSlice) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxBoolSlice must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxStringMap") {
+		if !(len(obj.FieldMaxStringMap) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxStringMap must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxIntMap") {
+		if !(len(obj.FieldMaxIntMap) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxIntMap must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt8Map") {
+		if !(len(obj.FieldMaxInt8Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt8Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt16Map") {
+		if !(len(obj.FieldMaxInt16Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt16Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt32Map") {
+		if !(len(obj.FieldMaxInt32Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt32Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt64Map") {
+		if !(len(obj.FieldMaxInt64Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt64Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUintMap") {
+		if !(len(obj.FieldMaxUintMap) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUintMap must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint8Map") {
+		if !(len(obj.FieldMaxUint8Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint8Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint16Map") {
+		if !(len(obj.FieldMaxUint16Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint16Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint32Map") {
+		if !(len(obj.FieldMaxUint32Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint32Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint64Map") {
+		if !(len(obj.FieldMaxUint64Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint64Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat32Map") {
+		if !(len(obj.FieldMaxFloat32Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat32Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat64Map") {
+		if !(len(obj.FieldMaxFloat64Map) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat64Map must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxBoolMap") {
+		if !(len(obj.FieldMaxBoolMap) <= 1) {
+			errs = append(errs, types.NewValidationError("FieldMaxBoolMap must have at most 1 elements"))
+		}
+	}
+	return errs
+}
+func maxStructFieldsPointerValidate(obj *maxStructFieldsPointer) []error {
+	return maxStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func maxStructFieldsPointerValidateContext(ctx context.Context, obj *maxStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMaxStringPointer != nil && len(*obj.FieldMaxStringPointer) <= 3) {
+		errs = append(errs, types.NewValidationError("FieldMaxStringPointer length must be <= 3"))
+	}
+	if !(obj.FieldMaxStringSlicePointer != nil && len(*obj.FieldMaxStringSlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxStringSlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxIntSlicePointer != nil && len(*obj.FieldMaxIntSlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxIntSlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt8SlicePointer != nil && len(*obj.FieldMaxInt8SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt8SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt16SlicePointer != nil && len(*obj.FieldMaxInt16SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt16SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt32SlicePointer != nil && len(*obj.FieldMaxInt32SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt32SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt64SlicePointer != nil && len(*obj.FieldMaxInt64SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt64SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUintSlicePointer != nil && len(*obj.FieldMaxUintSlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUintSlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint8SlicePointer != nil && len(*obj.FieldMaxUint8SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint8SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint16SlicePointer != nil && len(*obj.FieldMaxUint16SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint16SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint32SlicePointer != nil && len(*obj.FieldMaxUint32SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint32SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint64SlicePointer != nil && len(*obj.FieldMaxUint64SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint64SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxFloat32SlicePointer != nil && len(*obj.FieldMaxFloat32SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat32SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxFloat64SlicePointer != nil && len(*obj.FieldMaxFloat64SlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat64SlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxBoolSlicePointer != nil && len(*obj.FieldMaxBoolSlicePointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxBoolSlicePointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxStringMapPointer != nil && len(*obj.FieldMaxStringMapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxStringMapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxIntMapPointer != nil && len(*obj.FieldMaxIntMapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxIntMapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt8MapPointer != nil && len(*obj.FieldMaxInt8MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt8MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt16MapPointer != nil && len(*obj.FieldMaxInt16MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt16MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt32MapPointer != nil && len(*obj.FieldMaxInt32MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt32MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxInt64MapPointer != nil && len(*obj.FieldMaxInt64MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxInt64MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUintMapPointer != nil && len(*obj.FieldMaxUintMapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUintMapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint8MapPointer != nil && len(*obj.FieldMaxUint8MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint8MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint16MapPointer != nil && len(*obj.FieldMaxUint16MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint16MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint32MapPointer != nil && len(*obj.FieldMaxUint32MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint32MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxUint64MapPointer != nil && len(*obj.FieldMaxUint64MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxUint64MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxFloat32MapPointer != nil && len(*obj.FieldMaxFloat32MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat32MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxFloat64MapPointer != nil && len(*obj.FieldMaxFloat64MapPointer) <= 2) {
+		errs = append(errs, types.NewValidationError("FieldMaxFloat64MapPointer must have at most 2 elements"))
+	}
+	if !(obj.FieldMaxBoolMapPointer != nil && len(*obj.FieldMaxBoolMapPointer) <= 1) {
+		errs = append(errs, types.NewValidationError("FieldMaxBoolMapPointer must have at most 1 elements"))
+	}
+	return errs
+}
+
+func maxStructFieldsPointerValidateFields(obj *maxStructFieldsPointer, fields ...string) []error {
+	return maxStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func maxStructFieldsPointerValidateExcept(obj *maxStructFieldsPointer, fields ...string) []error {
+	return maxStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func maxStructFieldsPointerValidatePartialContext(ctx context.Context, obj *maxStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMaxStringPointer") {
+		if !(obj.FieldMaxStringPointer != nil && len(*obj.FieldMaxStringPointer) <= 3) {
+			errs = append(errs, types.NewValidationError("FieldMaxStringPointer length must be <= 3"))
+		}
+	}
+	if selection.Has("FieldMaxStringSlicePointer") {
+		if !(obj.FieldMaxStringSlicePointer != nil && len(*obj.FieldMaxStringSlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxStringSlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxIntSlicePointer") {
+		if !(obj.FieldMaxIntSlicePointer != nil && len(*obj.FieldMaxIntSlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxIntSlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt8SlicePointer") {
+		if !(obj.FieldMaxInt8SlicePointer != nil && len(*obj.FieldMaxInt8SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt8SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt16SlicePointer") {
+		if !(obj.FieldMaxInt16SlicePointer != nil && len(*obj.FieldMaxInt16SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt16SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt32SlicePointer") {
+		if !(obj.FieldMaxInt32SlicePointer != nil && len(*obj.FieldMaxInt32SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt32SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt64SlicePointer") {
+		if !(obj.FieldMaxInt64SlicePointer != nil && len(*obj.FieldMaxInt64SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt64SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUintSlicePointer") {
+		if !(obj.FieldMaxUintSlicePointer != nil && len(*obj.FieldMaxUintSlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUintSlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint8SlicePointer") {
+		if !(obj.FieldMaxUint8SlicePointer != nil && len(*obj.FieldMaxUint8SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint8SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint16SlicePointer") {
+		if !(obj.FieldMaxUint16SlicePointer != nil && len(*obj.FieldMaxUint16SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint16SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint32SlicePointer") {
+		if !(obj.FieldMaxUint32SlicePointer != nil && len(*obj.FieldMaxUint32SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint32SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint64SlicePointer") {
+		if !(obj.FieldMaxUint64SlicePointer != nil && len(*obj.FieldMaxUint64SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint64SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat32SlicePointer") {
+		if !(obj.FieldMaxFloat32SlicePointer != nil && len(*obj.FieldMaxFloat32SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat32SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat64SlicePointer") {
+		if !(obj.FieldMaxFloat64SlicePointer != nil && len(*obj.FieldMaxFloat64SlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat64SlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxBoolSlicePointer") {
+		if !(obj.FieldMaxBoolSlicePointer != nil && len(*obj.FieldMaxBoolSlicePointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxBoolSlicePointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxStringMapPointer") {
+		if !(obj.FieldMaxStringMapPointer != nil && len(*obj.FieldMaxStringMapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxStringMapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxIntMapPointer") {
+		if !(obj.FieldMaxIntMapPointer != nil && len(*obj.FieldMaxIntMapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxIntMapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt8MapPointer") {
+		if !(obj.FieldMaxInt8MapPointer != nil && len(*obj.FieldMaxInt8MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt8MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt16MapPointer") {
+		if !(obj.FieldMaxInt16MapPointer != nil && len(*obj.FieldMaxInt16MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt16MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt32MapPointer") {
+		if !(obj.FieldMaxInt32MapPointer != nil && len(*obj.FieldMaxInt32MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt32MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxInt64MapPointer") {
+		if !(obj.FieldMaxInt64MapPointer != nil && len(*obj.FieldMaxInt64MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxInt64MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUintMapPointer") {
+		if !(obj.FieldMaxUintMapPointer != nil && len(*obj.FieldMaxUintMapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUintMapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint8MapPointer") {
+		if !(obj.FieldMaxUint8MapPointer != nil && len(*obj.FieldMaxUint8MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint8MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint16MapPointer") {
+		if !(obj.FieldMaxUint16MapPointer != nil && len(*obj.FieldMaxUint16MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint16MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint32MapPointer") {
+		if !(obj.FieldMaxUint32MapPointer != nil && len(*obj.FieldMaxUint32MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint32MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxUint64MapPointer") {
+		if !(obj.FieldMaxUint64MapPointer != nil && len(*obj.FieldMaxUint64MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxUint64MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat32MapPointer") {
+		if !(obj.FieldMaxFloat32MapPointer != nil && len(*obj.FieldMaxFloat32MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat32MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxFloat64MapPointer") {
+		if !(obj.FieldMaxFloat64MapPointer != nil && len(*obj.FieldMaxFloat64MapPointer) <= 2) {
+			errs = append(errs, types.NewValidationError("FieldMaxFloat64MapPointer must have at most 2 elements"))
+		}
+	}
+	if selection.Has("FieldMaxBoolMapPointer") {
+		if !(obj.FieldMaxBoolMapPointer != nil && len(*obj.FieldMaxBoolMapPointer) <= 1) {
+			errs = append(errs, types.NewValidationError("FieldMaxBoolMapPointer must have at most 1 elements"))
+		}
+	}
+	return errs
+}
+func maxdecimalsStructFieldsValidate(obj *maxdecimalsStructFields) []error {
+	return maxdecimalsStructFieldsValidateContext(context.Background(), obj)
+}
+
+func maxdecimalsStructFieldsValidateContext(ctx context.Context, obj *maxdecimalsStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat32, 2)) {
+		errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32 must have at most 2 decimal places"))
+	}
+	if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat64, 2)) {
+		errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64 must have at most 2 decimal places"))
+	}
+	return errs
+}
+
+func maxdecimalsStructFieldsValidateFields(obj *maxdecimalsStructFields, fields ...string) []error {
+	return maxdecimalsStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func maxdecimalsStructFieldsValidateExcept(obj *maxdecimalsStructFields, fields ...string) []error {
+	return maxdecimalsStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func maxdecimalsStructFieldsValidatePartialContext(ctx context.Context, obj *maxdecimalsStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMaxdecimalsFloat32") {
+		if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat32, 2)) {
+			errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32 must have at most 2 decimal places"))
+		}
+	}
+	if selection.Has("FieldMaxdecimalsFloat64") {
+		if !(types.HasMaxDecimals(obj.FieldMaxdecimalsFloat64, 2)) {
+			errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64 must have at most 2 decimal places"))
+		}
+	}
+	return errs
+}
+func maxdecimalsStructFieldsPointerValidate(obj *maxdecimalsStructFieldsPointer) []error {
+	return maxdecimalsStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func maxdecimalsStructFieldsPointerValidateContext(ctx context.Context, obj *maxdecimalsStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMaxdecimalsFloat32Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat32Pointer, 2)) {
+		errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32Pointer must have at most 2 decimal places"))
+	}
+	if !(obj.FieldMaxdecimalsFloat64Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat64Pointer, 2)) {
+		errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64Pointer must have at most 2 decimal places"))
+	}
+	return errs
+}
+
+func maxdecimalsStructFieldsPointerValidateFields(obj *maxdecimalsStructFieldsPointer, fields ...string) []error {
+	return maxdecimalsStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func maxdecimalsStructFieldsPointerValidateExcept(obj *maxdecimalsStructFieldsPointer, fields ...string) []error {
+	return maxdecimalsStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func maxdecimalsStructFieldsPointerValidatePartialContext(ctx context.Context, obj *maxdecimalsStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMaxdecimalsFloat32Pointer") {
+		if !(obj.FieldMaxdecimalsFloat32Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat32Pointer, 2)) {
+			errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat32Pointer must have at most 2 decimal places"))
+		}
+	}
+	if selection.Has("FieldMaxdecimalsFloat64Pointer") {
+		if !(obj.FieldMaxdecimalsFloat64Pointer != nil && types.HasMaxDecimals(*obj.FieldMaxdecimalsFloat64Pointer, 2)) {
+			errs = append(errs, types.NewValidationError("FieldMaxdecimalsFloat64Pointer must have at most 2 decimal places"))
+		}
+	}
+	return errs
+}
+func mimetypeStructFieldsValidate(obj *mimetypeStructFields) []error {
+	return mimetypeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func mimetypeStructFieldsValidateContext(ctx context.Context, obj *mimetypeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidMIMEType(obj.FieldMimetypeString)) {
+		errs = append(errs, types.NewValidationError("FieldMimetypeString must be a valid MIME type"))
+	}
+	if !(types.IsValidMIMEType(string(obj.FieldMimetypeByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldMimetypeByteSlice must be a valid MIME type"))
+	}
+	return errs
+}
+
+func mimetypeStructFieldsValidateFields(obj *mimetypeStructFields, fields ...string) []error {
+	return mimetypeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func mimetypeStructFieldsValidateExcept(obj *mimetypeStructFields, fields ...string) []error {
+	return mimetypeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func mimetypeStructFieldsValidatePartialContext(ctx context.Context, obj *mimetypeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMimetypeString") {
+		if !(types.IsValidMIMEType(obj.FieldMimetypeString)) {
+			errs = append(errs, types.NewValidationError("FieldMimetypeString must be a valid MIME type"))
+		}
+	}
+	if selection.Has("FieldMimetypeByteSlice") {
+		if !(types.IsValidMIMEType(string(obj.FieldMimetypeByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldMimetypeByteSlice must be a valid MIME type"))
+		}
+	}
+	return errs
+}
+func mimetypeStructFieldsPointerValidate(obj *mimetypeStructFieldsPointer) []error {
+	return mimetypeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func mimetypeStructFieldsPointerValidateContext(ctx context.Context, obj *mimetypeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMimetypeStringPointer != nil && types.IsValidMIMEType(*obj.FieldMimetypeStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldMimetypeStringPointer must be a valid MIME type"))
+	}
+	if !(obj.FieldMimetypeByteSlicePointer != nil && types.IsValidMIMEType(string(*obj.FieldMimetypeByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldMimetypeByteSlicePointer must be a valid MIME type"))
+	}
+	return errs
+}
+
+func mimetypeStructFieldsPointerValidateFields(obj *mimetypeStructFieldsPointer, fields ...string) []error {
+	return mimetypeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func mimetypeStructFieldsPointerValidateExcept(obj *mimetypeStructFieldsPointer, fields ...string) []error {
+	return mimetypeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func mimetypeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *mimetypeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMimetypeStringPointer") {
+		if !(obj.FieldMimetypeStringPointer != nil && types.IsValidMIMEType(*obj.FieldMimetypeStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldMimetypeStringPointer must be a valid MIME type"))
+		}
+	}
+	if selection.Has("FieldMimetypeByteSlicePointer") {
+		if !(obj.FieldMimetypeByteSlicePointer != nil && types.IsValidMIMEType(string(*obj.FieldMimetypeByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldMimetypeByteSlicePointer must be a valid MIME type"))
+		}
+	}
+	return errs
+}
+func minStructFieldsValidate(obj *minStructFields) []error {
+	return minStructFieldsValidateContext(context.Background(), obj)
+}
+
+func minStructFieldsValidateContext(ctx context.Context, obj *minStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(len(obj.FieldMinString) >= 5) {
+		errs = append(errs, types.NewValidationError("FieldMinString length must be >= 5"))
+	}
+	if !(len(obj.FieldMinStringSlice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinStringSlice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinIntSlice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinIntSlice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt8Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt8Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt16Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt16Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt32Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt32Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt64Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt64Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUintSlice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUintSlice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint8Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint8Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint16Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint16Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint32Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint32Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint64Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint64Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinFloat32Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat32Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinFloat64Slice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat64Slice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinBoolSlice) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinBoolSlice must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinStringMap) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinStringMap must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinIntMap) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinIntMap must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt8Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt8Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt16Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt16Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt32Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt32Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinInt64Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt64Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUintMap) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUintMap must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint8Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint8Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint16Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint16Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint32Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint32Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinUint64Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint64Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinFloat32Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat32Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinFloat64Map) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat64Map must have at least 2 elements"))
+	}
+	if !(len(obj.FieldMinBoolMap) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinBoolMap must have at least 2 elements"))
+	}
+	return errs
+}
+
+func minStructFieldsValidateFields(obj *minStructFields, fields ...string) []error {
+	return minStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func minStructFieldsValidateExcept(obj *minStructFields, fields ...string) []error {
+	return minStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func minStructFieldsValidatePartialContext(ctx context.Context, obj *minStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMinString") {
+		if !(len(obj.FieldMinString) >= 5) {
+			errs = append(errs, types.NewValidationError("FieldMinString length must be >= 5"))
+		}
+	}
+	if selection.Has("FieldMinStringSlice") {
+		if !(len(obj.FieldMinStringSlice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinStringSlice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinIntSlice") {
+		if !(len(obj.FieldMinIntSlice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinIntSlice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt8Slice") {
+		if !(len(obj.FieldMinInt8Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt8Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt16Slice") {
+		if !(len(obj.FieldMinInt16Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt16Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt32Slice") {
+		if !(len(obj.FieldMinInt32Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt32Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt64Slice") {
+		if !(len(obj.FieldMinInt64Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt64Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUintSlice") {
+		if !(len(obj.FieldMinUintSlice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUintSlice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint8Slice") {
+		if !(len(obj.FieldMinUint8Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint8Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint16Slice") {
+		if !(len(obj.FieldMinUint16Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint16Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint32Slice") {
+		if !(len(obj.FieldMinUint32Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint32Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint64Slice") {
+		if !(len(obj.FieldMinUint64Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint64Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat32Slice") {
+		if !(len(obj.FieldMinFloat32Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat32Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat64Slice") {
+		if !(len(obj.FieldMinFloat64Slice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat64Slice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinBoolSlice") {
+		if !(len(obj.FieldMinBoolSlice) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinBoolSlice must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinStringMap") {
+		if !(len(obj.FieldMinStringMap) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinStringMap must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinIntMap") {
+		if !(len(obj.FieldMinIntMap) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinIntMap must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt8Map") {
+		if !(len(obj.FieldMinInt8Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt8Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt16Map") {
+		if !(len(obj.FieldMinInt16Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt16Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt32Map") {
+		if !(len(obj.FieldMinInt32Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt32Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt64Map") {
+		if !(len(obj.FieldMinInt64Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt64Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUintMap") {
+		if !(len(obj.FieldMinUintMap) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUintMap must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint8Map") {
+		if !(len(obj.FieldMinUint8Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint8Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint16Map") {
+		if !(len(obj.FieldMinUint16Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint16Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint32Map") {
+		if !(len(obj.FieldMinUint32Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint32Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint64Map") {
+		if !(len(obj.FieldMinUint64Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint64Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat32Map") {
+		if !(len(obj.FieldMinFloat32Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat32Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat64Map") {
+		if !(len(obj.FieldMinFloat64Map) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat64Map must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinBoolMap") {
+		if !(len(obj.FieldMinBoolMap) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinBoolMap must have at least 2 elements"))
+		}
+	}
+	return errs
+}
+func minStructFieldsPointerValidate(obj *minStructFieldsPointer) []error {
+	return minStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func minStructFieldsPointerValidateContext(ctx context.Context, obj *minStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMinStringPointer != nil && len(*obj.FieldMinStringPointer) >= 5) {
+		errs = append(errs, types.NewValidationError("FieldMinStringPointer length must be >= 5"))
+	}
+	if !(obj.FieldMinStringSlicePointer != nil && len(*obj.FieldMinStringSlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinStringSlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinIntSlicePointer != nil && len(*obj.FieldMinIntSlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinIntSlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt8SlicePointer != nil && len(*obj.FieldMinInt8SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt8SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt16SlicePointer != nil && len(*obj.FieldMinInt16SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt16SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt32SlicePointer != nil && len(*obj.FieldMinInt32SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt32SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt64SlicePointer != nil && len(*obj.FieldMinInt64SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt64SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUintSlicePointer != nil && len(*obj.FieldMinUintSlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUintSlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint8SlicePointer != nil && len(*obj.FieldMinUint8SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint8SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint16SlicePointer != nil && len(*obj.FieldMinUint16SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint16SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint32SlicePointer != nil && len(*obj.FieldMinUint32SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint32SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint64SlicePointer != nil && len(*obj.FieldMinUint64SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint64SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinFloat32SlicePointer != nil && len(*obj.FieldMinFloat32SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat32SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinFloat64SlicePointer != nil && len(*obj.FieldMinFloat64SlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat64SlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinBoolSlicePointer != nil && len(*obj.FieldMinBoolSlicePointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinBoolSlicePointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinStringMapPointer != nil && len(*obj.FieldMinStringMapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinStringMapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinIntMapPointer != nil && len(*obj.FieldMinIntMapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinIntMapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt8MapPointer != nil && len(*obj.FieldMinInt8MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt8MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt16MapPointer != nil && len(*obj.FieldMinInt16MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt16MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt32MapPointer != nil && len(*obj.FieldMinInt32MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt32MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinInt64MapPointer != nil && len(*obj.FieldMinInt64MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinInt64MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUintMapPointer != nil && len(*obj.FieldMinUintMapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUintMapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint8MapPointer != nil && len(*obj.FieldMinUint8MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint8MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint16MapPointer != nil && len(*obj.FieldMinUint16MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint16MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint32MapPointer != nil && len(*obj.FieldMinUint32MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint32MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinUint64MapPointer != nil && len(*obj.FieldMinUint64MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinUint64MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinFloat32MapPointer != nil && len(*obj.FieldMinFloat32MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat32MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinFloat64MapPointer != nil && len(*obj.FieldMinFloat64MapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinFloat64MapPointer must have at least 2 elements"))
+	}
+	if !(obj.FieldMinBoolMapPointer != nil && len(*obj.FieldMinBoolMapPointer) >= 2) {
+		errs = append(errs, types.NewValidationError("FieldMinBoolMapPointer must have at least 2 elements"))
+	}
+	return errs
+}
+
+func minStructFieldsPointerValidateFields(obj *minStructFieldsPointer, fields ...string) []error {
+	return minStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func minStructFieldsPointerValidateExcept(obj *minStructFieldsPointer, fields ...string) []error {
+	return minStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func minStructFieldsPointerValidatePartialContext(ctx context.Context, obj *minStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMinStringPointer") {
+		if !(obj.FieldMinStringPointer != nil && len(*obj.FieldMinStringPointer) >= 5) {
+			errs = append(errs, types.NewValidationError("FieldMinStringPointer length must be >= 5"))
+		}
+	}
+	if selection.Has("FieldMinStringSlicePointer") {
+		if !(obj.FieldMinStringSlicePointer != nil && len(*obj.FieldMinStringSlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinStringSlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinIntSlicePointer") {
+		if !(obj.FieldMinIntSlicePointer != nil && len(*obj.FieldMinIntSlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinIntSlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt8SlicePointer") {
+		if !(obj.FieldMinInt8SlicePointer != nil && len(*obj.FieldMinInt8SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt8SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt16SlicePointer") {
+		if !(obj.FieldMinInt16SlicePointer != nil && len(*obj.FieldMinInt16SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt16SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt32SlicePointer") {
+		if !(obj.FieldMinInt32SlicePointer != nil && len(*obj.FieldMinInt32SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt32SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt64SlicePointer") {
+		if !(obj.FieldMinInt64SlicePointer != nil && len(*obj.FieldMinInt64SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt64SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUintSlicePointer") {
+		if !(obj.FieldMinUintSlicePointer != nil && len(*obj.FieldMinUintSlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUintSlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint8SlicePointer") {
+		if !(obj.FieldMinUint8SlicePointer != nil && len(*obj.FieldMinUint8SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint8SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint16SlicePointer") {
+		if !(obj.FieldMinUint16SlicePointer != nil && len(*obj.FieldMinUint16SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint16SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint32SlicePointer") {
+		if !(obj.FieldMinUint32SlicePointer != nil && len(*obj.FieldMinUint32SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint32SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint64SlicePointer") {
+		if !(obj.FieldMinUint64SlicePointer != nil && len(*obj.FieldMinUint64SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint64SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat32SlicePointer") {
+		if !(obj.FieldMinFloat32SlicePointer != nil && len(*obj.FieldMinFloat32SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat32SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat64SlicePointer") {
+		if !(obj.FieldMinFloat64SlicePointer != nil && len(*obj.FieldMinFloat64SlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat64SlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinBoolSlicePointer") {
+		if !(obj.FieldMinBoolSlicePointer != nil && len(*obj.FieldMinBoolSlicePointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinBoolSlicePointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinStringMapPointer") {
+		if !(obj.FieldMinStringMapPointer != nil && len(*obj.FieldMinStringMapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinStringMapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinIntMapPointer") {
+		if !(obj.FieldMinIntMapPointer != nil && len(*obj.FieldMinIntMapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinIntMapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt8MapPointer") {
+		if !(obj.FieldMinInt8MapPointer != nil && len(*obj.FieldMinInt8MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt8MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt16MapPointer") {
+		if !(obj.FieldMinInt16MapPointer != nil && len(*obj.FieldMinInt16MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt16MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt32MapPointer") {
+		if !(obj.FieldMinInt32MapPointer != nil && len(*obj.FieldMinInt32MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt32MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinInt64MapPointer") {
+		if !(obj.FieldMinInt64MapPointer != nil && len(*obj.FieldMinInt64MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinInt64MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUintMapPointer") {
+		if !(obj.FieldMinUintMapPointer != nil && len(*obj.FieldMinUintMapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUintMapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint8MapPointer") {
+		if !(obj.FieldMinUint8MapPointer != nil && len(*obj.FieldMinUint8MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint8MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint16MapPointer") {
+		if !(obj.FieldMinUint16MapPointer != nil && len(*obj.FieldMinUint16MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint16MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint32MapPointer") {
+		if !(obj.FieldMinUint32MapPointer != nil && len(*obj.FieldMinUint32MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint32MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinUint64MapPointer") {
+		if !(obj.FieldMinUint64MapPointer != nil && len(*obj.FieldMinUint64MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinUint64MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat32MapPointer") {
+		if !(obj.FieldMinFloat32MapPointer != nil && len(*obj.FieldMinFloat32MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat32MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinFloat64MapPointer") {
+		if !(obj.FieldMinFloat64MapPointer != nil && len(*obj.FieldMinFloat64MapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinFloat64MapPointer must have at least 2 elements"))
+		}
+	}
+	if selection.Has("FieldMinBoolMapPointer") {
+		if !(obj.FieldMinBoolMapPointer != nil && len(*obj.FieldMinBoolMapPointer) >= 2) {
+			errs = append(errs, types.NewValidationError("FieldMinBoolMapPointer must have at least 2 elements"))
+		}
+	}
+	return errs
+}
+func mongodbStructFieldsValidate(obj *mongodbStructFields) []error {
+	return mongodbStructFieldsValidateContext(context.Background(), obj)
+}
+
+func mongodbStructFieldsValidateContext(ctx context.Context, obj *mongodbStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidMongoDBObjectID(obj.FieldMongodbString)) {
+		errs = append(errs, types.NewValidationError("FieldMongodbString must be a valid MongoDB ObjectID"))
+	}
+	return errs
+}
+
+func mongodbStructFieldsValidateFields(obj *mongodbStructFields, fields ...string) []error {
+	return mongodbStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func mongodbStructFieldsValidateExcept(obj *mongodbStructFields, fields ...string) []error {
+	return mongodbStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func mongodbStructFieldsValidatePartialContext(ctx context.Context, obj *mongodbStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMongodbString") {
+		if !(types.IsValidMongoDBObjectID(obj.FieldMongodbString)) {
+			errs = append(errs, types.NewValidationError("FieldMongodbString must be a valid MongoDB ObjectID"))
+		}
+	}
+	return errs
+}
+func mongodbStructFieldsPointerValidate(obj *mongodbStructFieldsPointer) []error {
+	return mongodbStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func mongodbStructFieldsPointerValidateContext(ctx context.Context, obj *mongodbStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMongodbStringPointer != nil && types.IsValidMongoDBObjectID(*obj.FieldMongodbStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldMongodbStringPointer must be a valid MongoDB ObjectID"))
+	}
+	return errs
+}
+
+func mongodbStructFieldsPointerValidateFields(obj *mongodbStructFieldsPointer, fields ...string) []error {
+	return mongodbStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func mongodbStructFieldsPointerValidateExcept(obj *mongodbStructFieldsPointer, fields ...string) []error {
+	return mongodbStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func mongodbStructFieldsPointerValidatePartialContext(ctx context.Context, obj *mongodbStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMongodbStringPointer") {
+		if !(obj.FieldMongodbStringPointer != nil && types.IsValidMongoDBObjectID(*obj.FieldMongodbStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldMongodbStringPointer must be a valid MongoDB ObjectID"))
+		}
+	}
+	return errs
+}
+func multipleofStructFieldsValidate(obj *multipleofStructFields) []error {
+	return multipleofStructFieldsValidateContext(context.Background(), obj)
+}
+
+func multipleofStructFieldsValidateContext(ctx context.Context, obj *multipleofStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMultipleofInt%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt8%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt8 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt16%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt16 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt32%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt32 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt64%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt64 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint8%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint8 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint16%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint16 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint32%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint32 must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint64%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint64 must be a multiple of 5"))
+	}
+	if !(types.IsMultipleOf(obj.FieldMultipleofFloat32, 0.25)) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofFloat32 must be a multiple of 0.25"))
+	}
+	if !(types.IsMultipleOf(obj.FieldMultipleofFloat64, 0.25)) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofFloat64 must be a multiple of 0.25"))
+	}
+	return errs
+}
+
+func multipleofStructFieldsValidateFields(obj *multipleofStructFields, fields ...string) []error {
+	return multipleofStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func multipleofStructFieldsValidateExcept(obj *multipleofStructFields, fields ...string) []error {
+	return multipleofStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func multipleofStructFieldsValidatePartialContext(ctx context.Context, obj *multipleofStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMultipleofInt") {
+		if !(obj.FieldMultipleofInt%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt8") {
+		if !(obj.FieldMultipleofInt8%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt8 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt16") {
+		if !(obj.FieldMultipleofInt16%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt16 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt32") {
+		if !(obj.FieldMultipleofInt32%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt32 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt64") {
+		if !(obj.FieldMultipleofInt64%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt64 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint") {
+		if !(obj.FieldMultipleofUint%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint8") {
+		if !(obj.FieldMultipleofUint8%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint8 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint16") {
+		if !(obj.FieldMultipleofUint16%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint16 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint32") {
+		if !(obj.FieldMultipleofUint32%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint32 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint64") {
+		if !(obj.FieldMultipleofUint64%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint64 must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofFloat32") {
+		if !(types.IsMultipleOf(obj.FieldMultipleofFloat32, 0.25)) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofFloat32 must be a multiple of 0.25"))
+		}
+	}
+	if selection.Has("FieldMultipleofFloat64") {
+		if !(types.IsMultipleOf(obj.FieldMultipleofFloat64, 0.25)) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofFloat64 must be a multiple of 0.25"))
+		}
+	}
+	return errs
+}
+func multipleofStructFieldsPointerValidate(obj *multipleofStructFieldsPointer) []error {
+	return multipleofStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func multipleofStructFieldsPointerValidateContext(ctx context.Context, obj *multipleofStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldMultipleofIntPointer != nil && *obj.FieldMultipleofIntPointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofIntPointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt8Pointer != nil && *obj.FieldMultipleofInt8Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt8Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt16Pointer != nil && *obj.FieldMultipleofInt16Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt16Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt32Pointer != nil && *obj.FieldMultipleofInt32Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt32Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofInt64Pointer != nil && *obj.FieldMultipleofInt64Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofInt64Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUintPointer != nil && *obj.FieldMultipleofUintPointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUintPointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint8Pointer != nil && *obj.FieldMultipleofUint8Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint8Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint16Pointer != nil && *obj.FieldMultipleofUint16Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint16Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint32Pointer != nil && *obj.FieldMultipleofUint32Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint32Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofUint64Pointer != nil && *obj.FieldMultipleofUint64Pointer%5 == 0) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofUint64Pointer must be a multiple of 5"))
+	}
+	if !(obj.FieldMultipleofFloat32Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat32Pointer, 0.25)) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofFloat32Pointer must be a multiple of 0.25"))
+	}
+	if !(obj.FieldMultipleofFloat64Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat64Pointer, 0.25)) {
+		errs = append(errs, types.NewValidationError("FieldMultipleofFloat64Pointer must be a multiple of 0.25"))
+	}
+	return errs
+}
+
+func multipleofStructFieldsPointerValidateFields(obj *multipleofStructFieldsPointer, fields ...string) []error {
+	return multipleofStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func multipleofStructFieldsPointerValidateExcept(obj *multipleofStructFieldsPointer, fields ...string) []error {
+	return multipleofStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func multipleofStructFieldsPointerValidatePartialContext(ctx context.Context, obj *multipleofStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldMultipleofIntPointer") {
+		if !(obj.FieldMultipleofIntPointer != nil && *obj.FieldMultipleofIntPointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofIntPointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt8Pointer") {
+		if !(obj.FieldMultipleofInt8Pointer != nil && *obj.FieldMultipleofInt8Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt8Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt16Pointer") {
+		if !(obj.FieldMultipleofInt16Pointer != nil && *obj.FieldMultipleofInt16Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt16Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt32Pointer") {
+		if !(obj.FieldMultipleofInt32Pointer != nil && *obj.FieldMultipleofInt32Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt32Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofInt64Pointer") {
+		if !(obj.FieldMultipleofInt64Pointer != nil && *obj.FieldMultipleofInt64Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofInt64Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUintPointer") {
+		if !(obj.FieldMultipleofUintPointer != nil && *obj.FieldMultipleofUintPointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUintPointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint8Pointer") {
+		if !(obj.FieldMultipleofUint8Pointer != nil && *obj.FieldMultipleofUint8Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint8Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint16Pointer") {
+		if !(obj.FieldMultipleofUint16Pointer != nil && *obj.FieldMultipleofUint16Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint16Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint32Pointer") {
+		if !(obj.FieldMultipleofUint32Pointer != nil && *obj.FieldMultipleofUint32Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint32Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofUint64Pointer") {
+		if !(obj.FieldMultipleofUint64Pointer != nil && *obj.FieldMultipleofUint64Pointer%5 == 0) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofUint64Pointer must be a multiple of 5"))
+		}
+	}
+	if selection.Has("FieldMultipleofFloat32Pointer") {
+		if !(obj.FieldMultipleofFloat32Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat32Pointer, 0.25)) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofFloat32Pointer must be a multiple of 0.25"))
+		}
+	}
+	if selection.Has("FieldMultipleofFloat64Pointer") {
+		if !(obj.FieldMultipleofFloat64Pointer != nil && types.IsMultipleOf(*obj.FieldMultipleofFloat64Pointer, 0.25)) {
+			errs = append(errs, types.NewValidationError("FieldMultipleofFloat64Pointer must be a multiple of 0.25"))
+		}
+	}
+	return errs
+}
+func negativeStructFieldsValidate(obj *negativeStructFields) []error {
+	return negativeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func negativeStructFieldsValidateContext(ctx context.Context, obj *negativeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNegativeFloat32 < 0) {
+		errs = append(errs, types.NewValidationError("FieldNegativeFloat32 must be negative"))
+	}
+	if !(obj.FieldNegativeFloat64 < 0) {
+		errs = append(errs, types.NewValidationError("FieldNegativeFloat64 must be negative"))
+	}
+	return errs
+}
+
+func negativeStructFieldsValidateFields(obj *negativeStructFields, fields ...string) []error {
+	return negativeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func negativeStructFieldsValidateExcept(obj *negativeStructFields, fields ...string) []error {
+	return negativeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func negativeStructFieldsValidatePartialContext(ctx context.Context, obj *negativeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNegativeFloat32") {
+		if !(obj.FieldNegativeFloat32 < 0) {
+			errs = append(errs, types.NewValidationError("FieldNegativeFloat32 must be negative"))
+		}
+	}
+	if selection.Has("FieldNegativeFloat64") {
+		if !(obj.FieldNegativeFloat64 < 0) {
+			errs = append(errs, types.NewValidationError("FieldNegativeFloat64 must be negative"))
+		}
+	}
+	return errs
+}
+func negativeStructFieldsPointerValidate(obj *negativeStructFieldsPointer) []error {
+	return negativeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func negativeStructFieldsPointerValidateContext(ctx context.Context, obj *negativeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNegativeFloat32Pointer != nil && *obj.FieldNegativeFloat32Pointer < 0) {
+		errs = append(errs, types.NewValidationError("FieldNegativeFloat32Pointer must be negative"))
+	}
+	if !(obj.FieldNegativeFloat64Pointer != nil && *obj.FieldNegativeFloat64Pointer < 0) {
+		errs = append(errs, types.NewValidationError("FieldNegativeFloat64Pointer must be negative"))
+	}
+	return errs
+}
+
+func negativeStructFieldsPointerValidateFields(obj *negativeStructFieldsPointer, fields ...string) []error {
+	return negativeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func negativeStructFieldsPointerValidateExcept(obj *negativeStructFieldsPointer, fields ...string) []error {
+	return negativeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func negativeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *negativeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNegativeFloat32Pointer") {
+		if !(obj.FieldNegativeFloat32Pointer != nil && *obj.FieldNegativeFloat32Pointer < 0) {
+			errs = append(errs, types.NewValidationError("FieldNegativeFloat32Pointer must be negative"))
+		}
+	}
+	if selection.Has("FieldNegativeFloat64Pointer") {
+		if !(obj.FieldNegativeFloat64Pointer != nil && *obj.FieldNegativeFloat64Pointer < 0) {
+			errs = append(errs, types.NewValidationError("FieldNegativeFloat64Pointer must be negative"))
+		}
+	}
+	return errs
+}
+func neqStructFieldsValidate(obj *neqStructFields) []error {
+	return neqStructFieldsValidateContext(context.Background(), obj)
+}
+
+func neqStructFieldsValidateContext(ctx context.Context, obj *neqStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNeqString != "abcde") {
+		errs = append(errs, types.NewValidationError("FieldNeqString must not be equal to 'abcde'"))
+	}
+	if !(obj.FieldNeqInt != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt8 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt8 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt16 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt16 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt32 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt32 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt64 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt64 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint8 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint8 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint16 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint16 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint32 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint32 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint64 != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint64 must not be equal to 32"))
+	}
+	if !(obj.FieldNeqFloat32 != 12.34) {
+		errs = append(errs, types.NewValidationError("FieldNeqFloat32 must not be equal to 12.34"))
+	}
+	if !(obj.FieldNeqFloat64 != 12.34) {
+		errs = append(errs, types.NewValidationError("FieldNeqFloat64 must not be equal to 12.34"))
+	}
+	if !(obj.FieldNeqBool != true) {
+		errs = append(errs, types.NewValidationError("FieldNeqBool must not be equal to true"))
+	}
+	return errs
+}
+
+func neqStructFieldsValidateFields(obj *neqStructFields, fields ...string) []error {
+	return neqStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func neqStructFieldsValidateExcept(obj *neqStructFields, fields ...string) []error {
+	return neqStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func neqStructFieldsValidatePartialContext(ctx context.Context, obj *neqStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNeqString") {
+		if !(obj.FieldNeqString != "abcde") {
+			errs = append(errs, types.NewValidationError("FieldNeqString must not be equal to 'abcde'"))
+		}
+	}
+	if selection.Has("FieldNeqInt") {
+		if !(obj.FieldNeqInt != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt8") {
+		if !(obj.FieldNeqInt8 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt8 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt16") {
+		if !(obj.FieldNeqInt16 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt16 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt32") {
+		if !(obj.FieldNeqInt32 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt32 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt64") {
+		if !(obj.FieldNeqInt64 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt64 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint") {
+		if !(obj.FieldNeqUint != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint8") {
+		if !(obj.FieldNeqUint8 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint8 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint16") {
+		if !(obj.FieldNeqUint16 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint16 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint32") {
+		if !(obj.FieldNeqUint32 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint32 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint64") {
+		if !(obj.FieldNeqUint64 != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint64 must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqFloat32") {
+		if !(obj.FieldNeqFloat32 != 12.34) {
+			errs = append(errs, types.NewValidationError("FieldNeqFloat32 must not be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldNeqFloat64") {
+		if !(obj.FieldNeqFloat64 != 12.34) {
+			errs = append(errs, types.NewValidationError("FieldNeqFloat64 must not be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldNeqBool") {
+		if !(obj.FieldNeqBool != true) {
+			errs = append(errs, types.NewValidationError("FieldNeqBool must not be equal to true"))
+		}
+	}
+	return errs
+}
+func neqStructFieldsPointerValidate(obj *neqStructFieldsPointer) []error {
+	return neqStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func neqStructFieldsPointerValidateContext(ctx context.Context, obj *neqStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNeqStringPointer != nil && *obj.FieldNeqStringPointer != "abcde") {
+		errs = append(errs, types.NewValidationError("FieldNeqStringPointer must not be equal to 'abcde'"))
+	}
+	if !(obj.FieldNeqIntPointer != nil && *obj.FieldNeqIntPointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqIntPointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt8Pointer != nil && *obj.FieldNeqInt8Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt8Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt16Pointer != nil && *obj.FieldNeqInt16Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt16Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt32Pointer != nil && *obj.FieldNeqInt32Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt32Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqInt64Pointer != nil && *obj.FieldNeqInt64Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqInt64Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUintPointer != nil && *obj.FieldNeqUintPointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUintPointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint8Pointer != nil && *obj.FieldNeqUint8Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint8Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint16Pointer != nil && *obj.FieldNeqUint16Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint16Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint32Pointer != nil && *obj.FieldNeqUint32Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint32Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqUint64Pointer != nil && *obj.FieldNeqUint64Pointer != 32) {
+		errs = append(errs, types.NewValidationError("FieldNeqUint64Pointer must not be equal to 32"))
+	}
+	if !(obj.FieldNeqFloat32Pointer != nil && *obj.FieldNeqFloat32Pointer != 12.34) {
+		errs = append(errs, types.NewValidationError("FieldNeqFloat32Pointer must not be equal to 12.34"))
+	}
+	if !(obj.FieldNeqFloat64Pointer != nil && *obj.FieldNeqFloat64Pointer != 12.34) {
+		errs = append(errs, types.NewValidationError("FieldNeqFloat64Pointer must not be equal to 12.34"))
+	}
+	if !(obj.FieldNeqBoolPointer != nil && *obj.FieldNeqBoolPointer != true) {
+		errs = append(errs, types.NewValidationError("FieldNeqBoolPointer must not be equal to true"))
+	}
+	return errs
+}
+
+func neqStructFieldsPointerValidateFields(obj *neqStructFieldsPointer, fields ...string) []error {
+	return neqStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func neqStructFieldsPointerValidateExcept(obj *neqStructFieldsPointer, fields ...string) []error {
+	return neqStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func neqStructFieldsPointerValidatePartialContext(ctx context.Context, obj *neqStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNeqStringPointer") {
+		if !(obj.FieldNeqStringPointer != nil && *obj.FieldNeqStringPointer != "abcde") {
+			errs = append(errs, types.NewValidationError("FieldNeqStringPointer must not be equal to 'abcde'"))
+		}
+	}
+	if selection.Has("FieldNeqIntPointer") {
+		if !(obj.FieldNeqIntPointer != nil && *obj.FieldNeqIntPointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqIntPointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt8Pointer") {
+		if !(obj.FieldNeqInt8Pointer != nil && *obj.FieldNeqInt8Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt8Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt16Pointer") {
+		if !(obj.FieldNeqInt16Pointer != nil && *obj.FieldNeqInt16Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt16Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt32Pointer") {
+		if !(obj.FieldNeqInt32Pointer != nil && *obj.FieldNeqInt32Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt32Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqInt64Pointer") {
+		if !(obj.FieldNeqInt64Pointer != nil && *obj.FieldNeqInt64Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqInt64Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUintPointer") {
+		if !(obj.FieldNeqUintPointer != nil && *obj.FieldNeqUintPointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUintPointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint8Pointer") {
+		if !(obj.FieldNeqUint8Pointer != nil && *obj.FieldNeqUint8Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint8Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint16Pointer") {
+		if !(obj.FieldNeqUint16Pointer != nil && *obj.FieldNeqUint16Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint16Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint32Pointer") {
+		if !(obj.FieldNeqUint32Pointer != nil && *obj.FieldNeqUint32Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint32Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqUint64Pointer") {
+		if !(obj.FieldNeqUint64Pointer != nil && *obj.FieldNeqUint64Pointer != 32) {
+			errs = append(errs, types.NewValidationError("FieldNeqUint64Pointer must not be equal to 32"))
+		}
+	}
+	if selection.Has("FieldNeqFloat32Pointer") {
+		if !(obj.FieldNeqFloat32Pointer != nil && *obj.FieldNeqFloat32Pointer != 12.34) {
+			errs = append(errs, types.NewValidationError("FieldNeqFloat32Pointer must not be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldNeqFloat64Pointer") {
+		if !(obj.FieldNeqFloat64Pointer != nil && *obj.FieldNeqFloat64Pointer != 12.34) {
+			errs = append(errs, types.NewValidationError("FieldNeqFloat64Pointer must not be equal to 12.34"))
+		}
+	}
+	if selection.Has("FieldNeqBoolPointer") {
+		if !(obj.FieldNeqBoolPointer != nil && *obj.FieldNeqBoolPointer != true) {
+			errs = append(errs, types.NewValidationError("FieldNeqBoolPointer must not be equal to true"))
+		}
+	}
+	return errs
+}
+func neq_ignore_caseStructFieldsValidate(obj *neq_ignore_caseStructFields) []error {
+	return neq_ignore_caseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func neq_ignore_caseStructFieldsValidateContext(ctx context.Context, obj *neq_ignore_caseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(!types.EqualFold(obj.FieldNeq_ignore_caseString, "abcde")) {
+		errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseString must not be equal to 'abcde'"))
+	}
+	return errs
+}
+
+func neq_ignore_caseStructFieldsValidateFields(obj *neq_ignore_caseStructFields, fields ...string) []error {
+	return neq_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func neq_ignore_caseStructFieldsValidateExcept(obj *neq_ignore_caseStructFields, fields ...string) []error {
+	return neq_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func neq_ignore_caseStructFieldsValidatePartialContext(ctx context.Context, obj *neq_ignore_caseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNeq_ignore_caseString") {
+		if !(!types.EqualFold(obj.FieldNeq_ignore_caseString, "abcde")) {
+			errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseString must not be equal to 'abcde'"))
+		}
+	}
+	return errs
+}
+func neq_ignore_caseStructFieldsPointerValidate(obj *neq_ignore_caseStructFieldsPointer) []error {
+	return neq_ignore_caseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func neq_ignore_caseStructFieldsPointerValidateContext(ctx context.Context, obj *neq_ignore_caseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNeq_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNeq_ignore_caseStringPointer, "abcde")) {
+		errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseStringPointer must not be equal to 'abcde'"))
+	}
+	return errs
+}
+
+func neq_ignore_caseStructFieldsPointerValidateFields(obj *neq_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return neq_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func neq_ignore_caseStructFieldsPointerValidateExcept(obj *neq_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return neq_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func neq_ignore_caseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *neq_ignore_caseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNeq_ignore_caseStringPointer") {
+		if !(obj.FieldNeq_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNeq_ignore_caseStringPointer, "abcde")) {
+			errs = append(errs, types.NewValidationError("FieldNeq_ignore_caseStringPointer must not be equal to 'abcde'"))
+		}
+	}
+	return errs
+}
+func ninStructFieldsValidate(obj *ninStructFields) []error {
+	return ninStructFieldsValidateContext(context.Background(), obj)
+}
+
+func ninStructFieldsValidateContext(ctx context.Context, obj *ninStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNinString != "ab" && obj.FieldNinString != "cd" && obj.FieldNinString != "ef") {
+		errs = append(errs, types.NewValidationError("FieldNinString must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldNinInt != 12 && obj.FieldNinInt != 34 && obj.FieldNinInt != 56) {
 		errs = append(errs, types.NewValidationError("FieldNinInt must not be one of '12' '34' '56'"))
 	}
-	if !(obj.FieldNinInt8 != 12 && obj.FieldNinInt8 != 34 && obj.FieldNinInt8 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8 must not be one of '12' '34' '56'"))
+	if !(obj.FieldNinInt8 != 12 && obj.FieldNinInt8 != 34 && obj.FieldNinInt8 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt16 != 12 && obj.FieldNinInt16 != 34 && obj.FieldNinInt16 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt32 != 12 && obj.FieldNinInt32 != 34 && obj.FieldNinInt32 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt64 != 12 && obj.FieldNinInt64 != 34 && obj.FieldNinInt64 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint != 12 && obj.FieldNinUint != 34 && obj.FieldNinUint != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinUint must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint8 != 12 && obj.FieldNinUint8 != 34 && obj.FieldNinUint8 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint16 != 12 && obj.FieldNinUint16 != 34 && obj.FieldNinUint16 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint32 != 12 && obj.FieldNinUint32 != 34 && obj.FieldNinUint32 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint64 != 12 && obj.FieldNinUint64 != 34 && obj.FieldNinUint64 != 56) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64 must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinFloat32 != 11.11 && obj.FieldNinFloat32 != 22.22 && obj.FieldNinFloat32 != 33.33) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32 must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinFloat64 != 11.11 && obj.FieldNinFloat64 != 22.22 && obj.FieldNinFloat64 != 33.33) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64 must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinBool != true) {
+		errs = append(errs, types.NewValidationError("FieldNinBool must not be one of 'true'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinStringSlice, []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringSlice elements must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinIntSlice, []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntSlice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt8Slice, []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt16Slice, []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt32Slice, []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt64Slice, []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUintSlice, []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintSlice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint8Slice, []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint16Slice, []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint32Slice, []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint64Slice, []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64Slice elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinFloat32Slice, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32Slice elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinFloat64Slice, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64Slice elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinBoolSlice, []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolSlice elements must not be one of 'true'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinStringArray[:], []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringArray elements must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinIntArray[:], []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntArray elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt8Array[:], []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt16Array[:], []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt32Array[:], []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinInt64Array[:], []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUintArray[:], []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintArray elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint8Array[:], []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint16Array[:], []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint32Array[:], []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinUint64Array[:], []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64Array elements must not be one of '12' '34' '56'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32Array elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64Array elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.SliceNotContains(obj.FieldNinBoolArray[:], []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolArray elements must not be one of 'true'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinStringMap, []string{"a", "b", "c"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringMap elements must not be one of 'a' 'b' 'c'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinIntMap, []int{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntMap elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinInt8Map, []int8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinInt16Map, []int16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinInt32Map, []int32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinInt64Map, []int64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinUintMap, []uint{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintMap elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinUint8Map, []uint8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinUint16Map, []uint16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinUint32Map, []uint32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinUint64Map, []uint64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64Map elements must not be one of '1' '2' '3'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinFloat32Map, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32Map elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinFloat64Map, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64Map elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(types.MapNotContains(obj.FieldNinBoolMap, []bool{false})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolMap elements must not be one of 'false'"))
+	}
+	return errs
+}
+
+func ninStructFieldsValidateFields(obj *ninStructFields, fields ...string) []error {
+	return ninStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ninStructFieldsValidateExcept(obj *ninStructFields, fields ...string) []error {
+	return ninStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ninStructFieldsValidatePartialContext(ctx context.Context, obj *ninStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNinString") {
+		if !(obj.FieldNinString != "ab" && obj.FieldNinString != "cd" && obj.FieldNinString != "ef") {
+			errs = append(errs, types.NewValidationError("FieldNinString must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinInt") {
+		if !(obj.FieldNinInt != 12 && obj.FieldNinInt != 34 && obj.FieldNinInt != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinInt must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8") {
+		if !(obj.FieldNinInt8 != 12 && obj.FieldNinInt8 != 34 && obj.FieldNinInt8 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16") {
+		if !(obj.FieldNinInt16 != 12 && obj.FieldNinInt16 != 34 && obj.FieldNinInt16 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32") {
+		if !(obj.FieldNinInt32 != 12 && obj.FieldNinInt32 != 34 && obj.FieldNinInt32 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64") {
+		if !(obj.FieldNinInt64 != 12 && obj.FieldNinInt64 != 34 && obj.FieldNinInt64 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint") {
+		if !(obj.FieldNinUint != 12 && obj.FieldNinUint != 34 && obj.FieldNinUint != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinUint must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8") {
+		if !(obj.FieldNinUint8 != 12 && obj.FieldNinUint8 != 34 && obj.FieldNinUint8 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16") {
+		if !(obj.FieldNinUint16 != 12 && obj.FieldNinUint16 != 34 && obj.FieldNinUint16 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32") {
+		if !(obj.FieldNinUint32 != 12 && obj.FieldNinUint32 != 34 && obj.FieldNinUint32 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64") {
+		if !(obj.FieldNinUint64 != 12 && obj.FieldNinUint64 != 34 && obj.FieldNinUint64 != 56) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64 must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32") {
+		if !(obj.FieldNinFloat32 != 11.11 && obj.FieldNinFloat32 != 22.22 && obj.FieldNinFloat32 != 33.33) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32 must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64") {
+		if !(obj.FieldNinFloat64 != 11.11 && obj.FieldNinFloat64 != 22.22 && obj.FieldNinFloat64 != 33.33) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64 must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBool") {
+		if !(obj.FieldNinBool != true) {
+			errs = append(errs, types.NewValidationError("FieldNinBool must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringSlice") {
+		if !(types.SliceNotContains(obj.FieldNinStringSlice, []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringSlice elements must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinIntSlice") {
+		if !(types.SliceNotContains(obj.FieldNinIntSlice, []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntSlice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8Slice") {
+		if !(types.SliceNotContains(obj.FieldNinInt8Slice, []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16Slice") {
+		if !(types.SliceNotContains(obj.FieldNinInt16Slice, []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32Slice") {
+		if !(types.SliceNotContains(obj.FieldNinInt32Slice, []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64Slice") {
+		if !(types.SliceNotContains(obj.FieldNinInt64Slice, []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUintSlice") {
+		if !(types.SliceNotContains(obj.FieldNinUintSlice, []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintSlice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8Slice") {
+		if !(types.SliceNotContains(obj.FieldNinUint8Slice, []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16Slice") {
+		if !(types.SliceNotContains(obj.FieldNinUint16Slice, []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32Slice") {
+		if !(types.SliceNotContains(obj.FieldNinUint32Slice, []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64Slice") {
+		if !(types.SliceNotContains(obj.FieldNinUint64Slice, []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64Slice elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32Slice") {
+		if !(types.SliceNotContains(obj.FieldNinFloat32Slice, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32Slice elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64Slice") {
+		if !(types.SliceNotContains(obj.FieldNinFloat64Slice, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64Slice elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolSlice") {
+		if !(types.SliceNotContains(obj.FieldNinBoolSlice, []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolSlice elements must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringArray") {
+		if !(types.SliceNotContains(obj.FieldNinStringArray[:], []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringArray elements must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinIntArray") {
+		if !(types.SliceNotContains(obj.FieldNinIntArray[:], []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntArray elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8Array") {
+		if !(types.SliceNotContains(obj.FieldNinInt8Array[:], []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16Array") {
+		if !(types.SliceNotContains(obj.FieldNinInt16Array[:], []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32Array") {
+		if !(types.SliceNotContains(obj.FieldNinInt32Array[:], []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64Array") {
+		if !(types.SliceNotContains(obj.FieldNinInt64Array[:], []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUintArray") {
+		if !(types.SliceNotContains(obj.FieldNinUintArray[:], []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintArray elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8Array") {
+		if !(types.SliceNotContains(obj.FieldNinUint8Array[:], []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16Array") {
+		if !(types.SliceNotContains(obj.FieldNinUint16Array[:], []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32Array") {
+		if !(types.SliceNotContains(obj.FieldNinUint32Array[:], []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64Array") {
+		if !(types.SliceNotContains(obj.FieldNinUint64Array[:], []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64Array elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32Array") {
+		if !(types.SliceNotContains(obj.FieldNinFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32Array elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64Array") {
+		if !(types.SliceNotContains(obj.FieldNinFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64Array elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolArray") {
+		if !(types.SliceNotContains(obj.FieldNinBoolArray[:], []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolArray elements must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringMap") {
+		if !(types.MapNotContains(obj.FieldNinStringMap, []string{"a", "b", "c"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringMap elements must not be one of 'a' 'b' 'c'"))
+		}
+	}
+	if selection.Has("FieldNinIntMap") {
+		if !(types.MapNotContains(obj.FieldNinIntMap, []int{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntMap elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt8Map") {
+		if !(types.MapNotContains(obj.FieldNinInt8Map, []int8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt16Map") {
+		if !(types.MapNotContains(obj.FieldNinInt16Map, []int16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt32Map") {
+		if !(types.MapNotContains(obj.FieldNinInt32Map, []int32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt64Map") {
+		if !(types.MapNotContains(obj.FieldNinInt64Map, []int64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUintMap") {
+		if !(types.MapNotContains(obj.FieldNinUintMap, []uint{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintMap elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint8Map") {
+		if !(types.MapNotContains(obj.FieldNinUint8Map, []uint8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint16Map") {
+		if !(types.MapNotContains(obj.FieldNinUint16Map, []uint16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint32Map") {
+		if !(types.MapNotContains(obj.FieldNinUint32Map, []uint32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint64Map") {
+		if !(types.MapNotContains(obj.FieldNinUint64Map, []uint64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64Map elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32Map") {
+		if !(types.MapNotContains(obj.FieldNinFloat32Map, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32Map elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64Map") {
+		if !(types.MapNotContains(obj.FieldNinFloat64Map, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64Map elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolMap") {
+		if !(types.MapNotContains(obj.FieldNinBoolMap, []bool{false})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolMap elements must not be one of 'false'"))
+		}
+	}
+	return errs
+}
+func ninStructFieldsPointerValidate(obj *ninStructFieldsPointer) []error {
+	return ninStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ninStructFieldsPointerValidateContext(ctx context.Context, obj *ninStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !((obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ab") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "cd") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ef")) {
+		errs = append(errs, types.NewValidationError("FieldNinStringPointer must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !((obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 12) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 34) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinIntPointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 12) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 34) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 12) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 34) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 12) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 34) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 12) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 34) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 12) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 34) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinUintPointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 12) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 34) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 12) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 34) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 12) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 34) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 12) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 34) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 56)) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64Pointer must not be one of '12' '34' '56'"))
+	}
+	if !((obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 11.11) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 22.22) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 33.33)) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32Pointer must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !((obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 11.11) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 22.22) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 33.33)) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64Pointer must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinBoolPointer != nil && *obj.FieldNinBoolPointer != true) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolPointer must not be one of 'true'"))
+	}
+	if !(obj.FieldNinStringSlicePointer != nil && types.SliceNotContains(*obj.FieldNinStringSlicePointer, []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringSlicePointer elements must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldNinIntSlicePointer != nil && types.SliceNotContains(*obj.FieldNinIntSlicePointer, []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntSlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt8SlicePointer, []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt16SlicePointer, []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt32SlicePointer, []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt64SlicePointer, []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUintSlicePointer != nil && types.SliceNotContains(*obj.FieldNinUintSlicePointer, []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintSlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint8SlicePointer, []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint16SlicePointer, []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint32SlicePointer, []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint64SlicePointer, []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64SlicePointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinFloat32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinFloat64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinBoolSlicePointer != nil && types.SliceNotContains(*obj.FieldNinBoolSlicePointer, []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolSlicePointer elements must not be one of 'true'"))
+	}
+	if !(obj.FieldNinStringArrayPointer != nil && types.SliceNotContains(obj.FieldNinStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringArrayPointer elements must not be one of 'ab' 'cd' 'ef'"))
+	}
+	if !(obj.FieldNinIntArrayPointer != nil && types.SliceNotContains(obj.FieldNinIntArrayPointer[:], []int{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt8ArrayPointer[:], []int8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt16ArrayPointer[:], []int16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt32ArrayPointer[:], []int32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinInt64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt64ArrayPointer[:], []int64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUintArrayPointer != nil && types.SliceNotContains(obj.FieldNinUintArrayPointer[:], []uint{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint8ArrayPointer[:], []uint8{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint16ArrayPointer[:], []uint16{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint32ArrayPointer[:], []uint32{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinUint64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint64ArrayPointer[:], []uint64{12, 34, 56})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64ArrayPointer elements must not be one of '12' '34' '56'"))
+	}
+	if !(obj.FieldNinFloat32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinFloat64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinBoolArrayPointer != nil && types.SliceNotContains(obj.FieldNinBoolArrayPointer[:], []bool{true})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolArrayPointer elements must not be one of 'true'"))
+	}
+	if !(obj.FieldNinStringMapPointer != nil && types.MapNotContains(*obj.FieldNinStringMapPointer, []string{"a", "b", "c"})) {
+		errs = append(errs, types.NewValidationError("FieldNinStringMapPointer elements must not be one of 'a' 'b' 'c'"))
+	}
+	if !(obj.FieldNinIntMapPointer != nil && types.MapNotContains(*obj.FieldNinIntMapPointer, []int{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinIntMapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinInt8MapPointer != nil && types.MapNotContains(*obj.FieldNinInt8MapPointer, []int8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt8MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinInt16MapPointer != nil && types.MapNotContains(*obj.FieldNinInt16MapPointer, []int16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt16MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinInt32MapPointer != nil && types.MapNotContains(*obj.FieldNinInt32MapPointer, []int32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt32MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinInt64MapPointer != nil && types.MapNotContains(*obj.FieldNinInt64MapPointer, []int64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinInt64MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinUintMapPointer != nil && types.MapNotContains(*obj.FieldNinUintMapPointer, []uint{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUintMapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinUint8MapPointer != nil && types.MapNotContains(*obj.FieldNinUint8MapPointer, []uint8{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint8MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinUint16MapPointer != nil && types.MapNotContains(*obj.FieldNinUint16MapPointer, []uint16{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint16MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinUint32MapPointer != nil && types.MapNotContains(*obj.FieldNinUint32MapPointer, []uint32{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint32MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinUint64MapPointer != nil && types.MapNotContains(*obj.FieldNinUint64MapPointer, []uint64{1, 2, 3})) {
+		errs = append(errs, types.NewValidationError("FieldNinUint64MapPointer elements must not be one of '1' '2' '3'"))
+	}
+	if !(obj.FieldNinFloat32MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat32MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinFloat64MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
+		errs = append(errs, types.NewValidationError("FieldNinFloat64MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	}
+	if !(obj.FieldNinBoolMapPointer != nil && types.MapNotContains(*obj.FieldNinBoolMapPointer, []bool{false})) {
+		errs = append(errs, types.NewValidationError("FieldNinBoolMapPointer elements must not be one of 'false'"))
+	}
+	return errs
+}
+
+func ninStructFieldsPointerValidateFields(obj *ninStructFieldsPointer, fields ...string) []error {
+	return ninStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ninStructFieldsPointerValidateExcept(obj *ninStructFieldsPointer, fields ...string) []error {
+	return ninStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ninStructFieldsPointerValidatePartialContext(ctx context.Context, obj *ninStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNinStringPointer") {
+		if !((obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ab") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "cd") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ef")) {
+			errs = append(errs, types.NewValidationError("FieldNinStringPointer must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinIntPointer") {
+		if !((obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 12) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 34) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinIntPointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8Pointer") {
+		if !((obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 12) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 34) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16Pointer") {
+		if !((obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 12) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 34) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32Pointer") {
+		if !((obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 12) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 34) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64Pointer") {
+		if !((obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 12) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 34) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUintPointer") {
+		if !((obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 12) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 34) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinUintPointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8Pointer") {
+		if !((obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 12) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 34) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16Pointer") {
+		if !((obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 12) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 34) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32Pointer") {
+		if !((obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 12) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 34) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64Pointer") {
+		if !((obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 12) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 34) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 56)) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64Pointer must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32Pointer") {
+		if !((obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 11.11) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 22.22) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 33.33)) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32Pointer must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64Pointer") {
+		if !((obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 11.11) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 22.22) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 33.33)) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64Pointer must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolPointer") {
+		if !(obj.FieldNinBoolPointer != nil && *obj.FieldNinBoolPointer != true) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolPointer must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringSlicePointer") {
+		if !(obj.FieldNinStringSlicePointer != nil && types.SliceNotContains(*obj.FieldNinStringSlicePointer, []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringSlicePointer elements must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinIntSlicePointer") {
+		if !(obj.FieldNinIntSlicePointer != nil && types.SliceNotContains(*obj.FieldNinIntSlicePointer, []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntSlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8SlicePointer") {
+		if !(obj.FieldNinInt8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt8SlicePointer, []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16SlicePointer") {
+		if !(obj.FieldNinInt16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt16SlicePointer, []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32SlicePointer") {
+		if !(obj.FieldNinInt32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt32SlicePointer, []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64SlicePointer") {
+		if !(obj.FieldNinInt64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt64SlicePointer, []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUintSlicePointer") {
+		if !(obj.FieldNinUintSlicePointer != nil && types.SliceNotContains(*obj.FieldNinUintSlicePointer, []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintSlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8SlicePointer") {
+		if !(obj.FieldNinUint8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint8SlicePointer, []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16SlicePointer") {
+		if !(obj.FieldNinUint16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint16SlicePointer, []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32SlicePointer") {
+		if !(obj.FieldNinUint32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint32SlicePointer, []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64SlicePointer") {
+		if !(obj.FieldNinUint64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint64SlicePointer, []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64SlicePointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32SlicePointer") {
+		if !(obj.FieldNinFloat32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64SlicePointer") {
+		if !(obj.FieldNinFloat64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolSlicePointer") {
+		if !(obj.FieldNinBoolSlicePointer != nil && types.SliceNotContains(*obj.FieldNinBoolSlicePointer, []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolSlicePointer elements must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringArrayPointer") {
+		if !(obj.FieldNinStringArrayPointer != nil && types.SliceNotContains(obj.FieldNinStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringArrayPointer elements must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	if selection.Has("FieldNinIntArrayPointer") {
+		if !(obj.FieldNinIntArrayPointer != nil && types.SliceNotContains(obj.FieldNinIntArrayPointer[:], []int{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt8ArrayPointer") {
+		if !(obj.FieldNinInt8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt8ArrayPointer[:], []int8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt16ArrayPointer") {
+		if !(obj.FieldNinInt16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt16ArrayPointer[:], []int16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt32ArrayPointer") {
+		if !(obj.FieldNinInt32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt32ArrayPointer[:], []int32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinInt64ArrayPointer") {
+		if !(obj.FieldNinInt64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt64ArrayPointer[:], []int64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUintArrayPointer") {
+		if !(obj.FieldNinUintArrayPointer != nil && types.SliceNotContains(obj.FieldNinUintArrayPointer[:], []uint{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint8ArrayPointer") {
+		if !(obj.FieldNinUint8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint8ArrayPointer[:], []uint8{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint16ArrayPointer") {
+		if !(obj.FieldNinUint16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint16ArrayPointer[:], []uint16{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint32ArrayPointer") {
+		if !(obj.FieldNinUint32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint32ArrayPointer[:], []uint32{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinUint64ArrayPointer") {
+		if !(obj.FieldNinUint64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint64ArrayPointer[:], []uint64{12, 34, 56})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64ArrayPointer elements must not be one of '12' '34' '56'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32ArrayPointer") {
+		if !(obj.FieldNinFloat32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64ArrayPointer") {
+		if !(obj.FieldNinFloat64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolArrayPointer") {
+		if !(obj.FieldNinBoolArrayPointer != nil && types.SliceNotContains(obj.FieldNinBoolArrayPointer[:], []bool{true})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolArrayPointer elements must not be one of 'true'"))
+		}
+	}
+	if selection.Has("FieldNinStringMapPointer") {
+		if !(obj.FieldNinStringMapPointer != nil && types.MapNotContains(*obj.FieldNinStringMapPointer, []string{"a", "b", "c"})) {
+			errs = append(errs, types.NewValidationError("FieldNinStringMapPointer elements must not be one of 'a' 'b' 'c'"))
+		}
+	}
+	if selection.Has("FieldNinIntMapPointer") {
+		if !(obj.FieldNinIntMapPointer != nil && types.MapNotContains(*obj.FieldNinIntMapPointer, []int{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinIntMapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt8MapPointer") {
+		if !(obj.FieldNinInt8MapPointer != nil && types.MapNotContains(*obj.FieldNinInt8MapPointer, []int8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt8MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt16MapPointer") {
+		if !(obj.FieldNinInt16MapPointer != nil && types.MapNotContains(*obj.FieldNinInt16MapPointer, []int16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt16MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt32MapPointer") {
+		if !(obj.FieldNinInt32MapPointer != nil && types.MapNotContains(*obj.FieldNinInt32MapPointer, []int32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt32MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinInt64MapPointer") {
+		if !(obj.FieldNinInt64MapPointer != nil && types.MapNotContains(*obj.FieldNinInt64MapPointer, []int64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinInt64MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUintMapPointer") {
+		if !(obj.FieldNinUintMapPointer != nil && types.MapNotContains(*obj.FieldNinUintMapPointer, []uint{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUintMapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint8MapPointer") {
+		if !(obj.FieldNinUint8MapPointer != nil && types.MapNotContains(*obj.FieldNinUint8MapPointer, []uint8{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint8MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint16MapPointer") {
+		if !(obj.FieldNinUint16MapPointer != nil && types.MapNotContains(*obj.FieldNinUint16MapPointer, []uint16{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint16MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint32MapPointer") {
+		if !(obj.FieldNinUint32MapPointer != nil && types.MapNotContains(*obj.FieldNinUint32MapPointer, []uint32{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint32MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinUint64MapPointer") {
+		if !(obj.FieldNinUint64MapPointer != nil && types.MapNotContains(*obj.FieldNinUint64MapPointer, []uint64{1, 2, 3})) {
+			errs = append(errs, types.NewValidationError("FieldNinUint64MapPointer elements must not be one of '1' '2' '3'"))
+		}
+	}
+	if selection.Has("FieldNinFloat32MapPointer") {
+		if !(obj.FieldNinFloat32MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat32MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinFloat64MapPointer") {
+		if !(obj.FieldNinFloat64MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
+			errs = append(errs, types.NewValidationError("FieldNinFloat64MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+		}
+	}
+	if selection.Has("FieldNinBoolMapPointer") {
+		if !(obj.FieldNinBoolMapPointer != nil && types.MapNotContains(*obj.FieldNinBoolMapPointer, []bool{false})) {
+			errs = append(errs, types.NewValidationError("FieldNinBoolMapPointer elements must not be one of 'false'"))
+		}
+	}
+	return errs
+}
+func nin_ignore_caseStructFieldsValidate(obj *nin_ignore_caseStructFields) []error {
+	return nin_ignore_caseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func nin_ignore_caseStructFieldsValidateContext(ctx context.Context, obj *nin_ignore_caseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(!types.EqualFold(obj.FieldNin_ignore_caseString, "ab") && !types.EqualFold(obj.FieldNin_ignore_caseString, "cd") && !types.EqualFold(obj.FieldNin_ignore_caseString, "ef")) {
+		errs = append(errs, types.NewValidationError("FieldNin_ignore_caseString must not be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+
+func nin_ignore_caseStructFieldsValidateFields(obj *nin_ignore_caseStructFields, fields ...string) []error {
+	return nin_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func nin_ignore_caseStructFieldsValidateExcept(obj *nin_ignore_caseStructFields, fields ...string) []error {
+	return nin_ignore_caseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func nin_ignore_caseStructFieldsValidatePartialContext(ctx context.Context, obj *nin_ignore_caseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNin_ignore_caseString") {
+		if !(!types.EqualFold(obj.FieldNin_ignore_caseString, "ab") && !types.EqualFold(obj.FieldNin_ignore_caseString, "cd") && !types.EqualFold(obj.FieldNin_ignore_caseString, "ef")) {
+			errs = append(errs, types.NewValidationError("FieldNin_ignore_caseString must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	return errs
+}
+func nin_ignore_caseStructFieldsPointerValidate(obj *nin_ignore_caseStructFieldsPointer) []error {
+	return nin_ignore_caseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func nin_ignore_caseStructFieldsPointerValidateContext(ctx context.Context, obj *nin_ignore_caseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ab") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "cd") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ef")) {
+		errs = append(errs, types.NewValidationError("FieldNin_ignore_caseStringPointer must not be one of 'ab' 'cd' 'ef'"))
+	}
+	return errs
+}
+
+func nin_ignore_caseStructFieldsPointerValidateFields(obj *nin_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return nin_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func nin_ignore_caseStructFieldsPointerValidateExcept(obj *nin_ignore_caseStructFieldsPointer, fields ...string) []error {
+	return nin_ignore_caseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func nin_ignore_caseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *nin_ignore_caseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNin_ignore_caseStringPointer") {
+		if !(obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ab") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "cd") && obj.FieldNin_ignore_caseStringPointer != nil && !types.EqualFold(*obj.FieldNin_ignore_caseStringPointer, "ef")) {
+			errs = append(errs, types.NewValidationError("FieldNin_ignore_caseStringPointer must not be one of 'ab' 'cd' 'ef'"))
+		}
+	}
+	return errs
+}
+func nonzeroStructFieldsValidate(obj *nonzeroStructFields) []error {
+	return nonzeroStructFieldsValidateContext(context.Background(), obj)
+}
+
+func nonzeroStructFieldsValidateContext(ctx context.Context, obj *nonzeroStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNonzeroInt != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt8 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt8 must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt16 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt16 must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt32 must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt64 must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint8 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint8 must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint16 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint16 must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint32 must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint64 must not be zero"))
+	}
+	if !(obj.FieldNonzeroFloat32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroFloat32 must not be zero"))
+	}
+	if !(obj.FieldNonzeroFloat64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroFloat64 must not be zero"))
+	}
+	return errs
+}
+
+func nonzeroStructFieldsValidateFields(obj *nonzeroStructFields, fields ...string) []error {
+	return nonzeroStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func nonzeroStructFieldsValidateExcept(obj *nonzeroStructFields, fields ...string) []error {
+	return nonzeroStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func nonzeroStructFieldsValidatePartialContext(ctx context.Context, obj *nonzeroStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNonzeroInt") {
+		if !(obj.FieldNonzeroInt != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt8") {
+		if !(obj.FieldNonzeroInt8 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt8 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt16") {
+		if !(obj.FieldNonzeroInt16 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt16 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt32") {
+		if !(obj.FieldNonzeroInt32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt32 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt64") {
+		if !(obj.FieldNonzeroInt64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt64 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint") {
+		if !(obj.FieldNonzeroUint != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint8") {
+		if !(obj.FieldNonzeroUint8 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint8 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint16") {
+		if !(obj.FieldNonzeroUint16 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint16 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint32") {
+		if !(obj.FieldNonzeroUint32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint32 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint64") {
+		if !(obj.FieldNonzeroUint64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint64 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroFloat32") {
+		if !(obj.FieldNonzeroFloat32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroFloat32 must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroFloat64") {
+		if !(obj.FieldNonzeroFloat64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroFloat64 must not be zero"))
+		}
+	}
+	return errs
+}
+func nonzeroStructFieldsPointerValidate(obj *nonzeroStructFieldsPointer) []error {
+	return nonzeroStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func nonzeroStructFieldsPointerValidateContext(ctx context.Context, obj *nonzeroStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNonzeroIntPointer != nil && *obj.FieldNonzeroIntPointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroIntPointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt8Pointer != nil && *obj.FieldNonzeroInt8Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt8Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt16Pointer != nil && *obj.FieldNonzeroInt16Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt16Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt32Pointer != nil && *obj.FieldNonzeroInt32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt32Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroInt64Pointer != nil && *obj.FieldNonzeroInt64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroInt64Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroUintPointer != nil && *obj.FieldNonzeroUintPointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUintPointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint8Pointer != nil && *obj.FieldNonzeroUint8Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint8Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint16Pointer != nil && *obj.FieldNonzeroUint16Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint16Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint32Pointer != nil && *obj.FieldNonzeroUint32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint32Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroUint64Pointer != nil && *obj.FieldNonzeroUint64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroUint64Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroFloat32Pointer != nil && *obj.FieldNonzeroFloat32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroFloat32Pointer must not be zero"))
+	}
+	if !(obj.FieldNonzeroFloat64Pointer != nil && *obj.FieldNonzeroFloat64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldNonzeroFloat64Pointer must not be zero"))
+	}
+	return errs
+}
+
+func nonzeroStructFieldsPointerValidateFields(obj *nonzeroStructFieldsPointer, fields ...string) []error {
+	return nonzeroStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func nonzeroStructFieldsPointerValidateExcept(obj *nonzeroStructFieldsPointer, fields ...string) []error {
+	return nonzeroStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func nonzeroStructFieldsPointerValidatePartialContext(ctx context.Context, obj *nonzeroStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNonzeroIntPointer") {
+		if !(obj.FieldNonzeroIntPointer != nil && *obj.FieldNonzeroIntPointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroIntPointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt8Pointer") {
+		if !(obj.FieldNonzeroInt8Pointer != nil && *obj.FieldNonzeroInt8Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt8Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt16Pointer") {
+		if !(obj.FieldNonzeroInt16Pointer != nil && *obj.FieldNonzeroInt16Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt16Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt32Pointer") {
+		if !(obj.FieldNonzeroInt32Pointer != nil && *obj.FieldNonzeroInt32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt32Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroInt64Pointer") {
+		if !(obj.FieldNonzeroInt64Pointer != nil && *obj.FieldNonzeroInt64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroInt64Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUintPointer") {
+		if !(obj.FieldNonzeroUintPointer != nil && *obj.FieldNonzeroUintPointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUintPointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint8Pointer") {
+		if !(obj.FieldNonzeroUint8Pointer != nil && *obj.FieldNonzeroUint8Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint8Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint16Pointer") {
+		if !(obj.FieldNonzeroUint16Pointer != nil && *obj.FieldNonzeroUint16Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint16Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint32Pointer") {
+		if !(obj.FieldNonzeroUint32Pointer != nil && *obj.FieldNonzeroUint32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint32Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroUint64Pointer") {
+		if !(obj.FieldNonzeroUint64Pointer != nil && *obj.FieldNonzeroUint64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroUint64Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroFloat32Pointer") {
+		if !(obj.FieldNonzeroFloat32Pointer != nil && *obj.FieldNonzeroFloat32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroFloat32Pointer must not be zero"))
+		}
+	}
+	if selection.Has("FieldNonzeroFloat64Pointer") {
+		if !(obj.FieldNonzeroFloat64Pointer != nil && *obj.FieldNonzeroFloat64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldNonzeroFloat64Pointer must not be zero"))
+		}
+	}
+	return errs
+}
+func notblankStructFieldsValidate(obj *notblankStructFields) []error {
+	return notblankStructFieldsValidateContext(context.Background(), obj)
+}
+
+func notblankStructFieldsValidateContext(ctx context.Context, obj *notblankStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsNotBlank(obj.FieldNotblankString)) {
+		errs = append(errs, types.NewValidationError("FieldNotblankString must not be blank"))
+	}
+	return errs
+}
+
+func notblankStructFieldsValidateFields(obj *notblankStructFields, fields ...string) []error {
+	return notblankStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func notblankStructFieldsValidateExcept(obj *notblankStructFields, fields ...string) []error {
+	return notblankStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func notblankStructFieldsValidatePartialContext(ctx context.Context, obj *notblankStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNotblankString") {
+		if !(types.IsNotBlank(obj.FieldNotblankString)) {
+			errs = append(errs, types.NewValidationError("FieldNotblankString must not be blank"))
+		}
+	}
+	return errs
+}
+func notblankStructFieldsPointerValidate(obj *notblankStructFieldsPointer) []error {
+	return notblankStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func notblankStructFieldsPointerValidateContext(ctx context.Context, obj *notblankStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNotblankStringPointer != nil && types.IsNotBlank(*obj.FieldNotblankStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldNotblankStringPointer must not be blank"))
+	}
+	return errs
+}
+
+func notblankStructFieldsPointerValidateFields(obj *notblankStructFieldsPointer, fields ...string) []error {
+	return notblankStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func notblankStructFieldsPointerValidateExcept(obj *notblankStructFieldsPointer, fields ...string) []error {
+	return notblankStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func notblankStructFieldsPointerValidatePartialContext(ctx context.Context, obj *notblankStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNotblankStringPointer") {
+		if !(obj.FieldNotblankStringPointer != nil && types.IsNotBlank(*obj.FieldNotblankStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldNotblankStringPointer must not be blank"))
+		}
+	}
+	return errs
+}
+func numberStructFieldsValidate(obj *numberStructFields) []error {
+	return numberStructFieldsValidateContext(context.Background(), obj)
+}
+
+func numberStructFieldsValidateContext(ctx context.Context, obj *numberStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsNumber(obj.FieldNumberString)) {
+		errs = append(errs, types.NewValidationError("FieldNumberString must contain only digits"))
+	}
+	return errs
+}
+
+func numberStructFieldsValidateFields(obj *numberStructFields, fields ...string) []error {
+	return numberStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func numberStructFieldsValidateExcept(obj *numberStructFields, fields ...string) []error {
+	return numberStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func numberStructFieldsValidatePartialContext(ctx context.Context, obj *numberStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNumberString") {
+		if !(types.IsNumber(obj.FieldNumberString)) {
+			errs = append(errs, types.NewValidationError("FieldNumberString must contain only digits"))
+		}
+	}
+	return errs
+}
+func numberStructFieldsPointerValidate(obj *numberStructFieldsPointer) []error {
+	return numberStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func numberStructFieldsPointerValidateContext(ctx context.Context, obj *numberStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNumberStringPointer != nil && types.IsNumber(*obj.FieldNumberStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldNumberStringPointer must contain only digits"))
+	}
+	return errs
+}
+
+func numberStructFieldsPointerValidateFields(obj *numberStructFieldsPointer, fields ...string) []error {
+	return numberStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func numberStructFieldsPointerValidateExcept(obj *numberStructFieldsPointer, fields ...string) []error {
+	return numberStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func numberStructFieldsPointerValidatePartialContext(ctx context.Context, obj *numberStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNumberStringPointer") {
+		if !(obj.FieldNumberStringPointer != nil && types.IsNumber(*obj.FieldNumberStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldNumberStringPointer must contain only digits"))
+		}
+	}
+	return errs
+}
+func numericStructFieldsValidate(obj *numericStructFields) []error {
+	return numericStructFieldsValidateContext(context.Background(), obj)
+}
+
+func numericStructFieldsValidateContext(ctx context.Context, obj *numericStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsNumeric(obj.FieldNumericString)) {
+		errs = append(errs, types.NewValidationError("FieldNumericString must be a numeric value"))
+	}
+	return errs
+}
+
+func numericStructFieldsValidateFields(obj *numericStructFields, fields ...string) []error {
+	return numericStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func numericStructFieldsValidateExcept(obj *numericStructFields, fields ...string) []error {
+	return numericStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func numericStructFieldsValidatePartialContext(ctx context.Context, obj *numericStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNumericString") {
+		if !(types.IsNumeric(obj.FieldNumericString)) {
+			errs = append(errs, types.NewValidationError("FieldNumericString must be a numeric value"))
+		}
+	}
+	return errs
+}
+func numericStructFieldsPointerValidate(obj *numericStructFieldsPointer) []error {
+	return numericStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func numericStructFieldsPointerValidateContext(ctx context.Context, obj *numericStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldNumericStringPointer != nil && types.IsNumeric(*obj.FieldNumericStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldNumericStringPointer must be a numeric value"))
+	}
+	return errs
+}
+
+func numericStructFieldsPointerValidateFields(obj *numericStructFieldsPointer, fields ...string) []error {
+	return numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func numericStructFieldsPointerValidateExcept(obj *numericStructFieldsPointer, fields ...string) []error {
+	return numericStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func numericStructFieldsPointerValidatePartialContext(ctx context.Context, obj *numericStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldNumericStringPointer") {
+		if !(obj.FieldNumericStringPointer != nil && types.IsNumeric(*obj.FieldNumericStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldNumericStringPointer must be a numeric value"))
+		}
+	}
+	return errs
+}
+func positiveStructFieldsValidate(obj *positiveStructFields) []error {
+	return positiveStructFieldsValidateContext(context.Background(), obj)
+}
+
+func positiveStructFieldsValidateContext(ctx context.Context, obj *positiveStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPositiveInt > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt must be positive"))
+	}
+	if !(obj.FieldPositiveInt8 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt8 must be positive"))
+	}
+	if !(obj.FieldPositiveInt16 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt16 must be positive"))
+	}
+	if !(obj.FieldPositiveInt32 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt32 must be positive"))
+	}
+	if !(obj.FieldPositiveInt64 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt64 must be positive"))
+	}
+	if !(obj.FieldPositiveUint > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint must be positive"))
+	}
+	if !(obj.FieldPositiveUint8 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint8 must be positive"))
+	}
+	if !(obj.FieldPositiveUint16 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint16 must be positive"))
+	}
+	if !(obj.FieldPositiveUint32 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint32 must be positive"))
+	}
+	if !(obj.FieldPositiveUint64 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint64 must be positive"))
+	}
+	if !(obj.FieldPositiveFloat32 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveFloat32 must be positive"))
+	}
+	if !(obj.FieldPositiveFloat64 > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveFloat64 must be positive"))
+	}
+	return errs
+}
+
+func positiveStructFieldsValidateFields(obj *positiveStructFields, fields ...string) []error {
+	return positiveStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func positiveStructFieldsValidateExcept(obj *positiveStructFields, fields ...string) []error {
+	return positiveStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func positiveStructFieldsValidatePartialContext(ctx context.Context, obj *positiveStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPositiveInt") {
+		if !(obj.FieldPositiveInt > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt8") {
+		if !(obj.FieldPositiveInt8 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt8 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt16") {
+		if !(obj.FieldPositiveInt16 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt16 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt32") {
+		if !(obj.FieldPositiveInt32 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt32 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt64") {
+		if !(obj.FieldPositiveInt64 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt64 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint") {
+		if !(obj.FieldPositiveUint > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint8") {
+		if !(obj.FieldPositiveUint8 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint8 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint16") {
+		if !(obj.FieldPositiveUint16 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint16 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint32") {
+		if !(obj.FieldPositiveUint32 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint32 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint64") {
+		if !(obj.FieldPositiveUint64 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint64 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveFloat32") {
+		if !(obj.FieldPositiveFloat32 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveFloat32 must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveFloat64") {
+		if !(obj.FieldPositiveFloat64 > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveFloat64 must be positive"))
+		}
+	}
+	return errs
+}
+func positiveStructFieldsPointerValidate(obj *positiveStructFieldsPointer) []error {
+	return positiveStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func positiveStructFieldsPointerValidateContext(ctx context.Context, obj *positiveStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPositiveIntPointer != nil && *obj.FieldPositiveIntPointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveIntPointer must be positive"))
+	}
+	if !(obj.FieldPositiveInt8Pointer != nil && *obj.FieldPositiveInt8Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt8Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveInt16Pointer != nil && *obj.FieldPositiveInt16Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt16Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveInt32Pointer != nil && *obj.FieldPositiveInt32Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt32Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveInt64Pointer != nil && *obj.FieldPositiveInt64Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveInt64Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveUintPointer != nil && *obj.FieldPositiveUintPointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUintPointer must be positive"))
+	}
+	if !(obj.FieldPositiveUint8Pointer != nil && *obj.FieldPositiveUint8Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint8Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveUint16Pointer != nil && *obj.FieldPositiveUint16Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint16Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveUint32Pointer != nil && *obj.FieldPositiveUint32Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint32Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveUint64Pointer != nil && *obj.FieldPositiveUint64Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveUint64Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveFloat32Pointer != nil && *obj.FieldPositiveFloat32Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveFloat32Pointer must be positive"))
+	}
+	if !(obj.FieldPositiveFloat64Pointer != nil && *obj.FieldPositiveFloat64Pointer > 0) {
+		errs = append(errs, types.NewValidationError("FieldPositiveFloat64Pointer must be positive"))
+	}
+	return errs
+}
+
+func positiveStructFieldsPointerValidateFields(obj *positiveStructFieldsPointer, fields ...string) []error {
+	return positiveStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func positiveStructFieldsPointerValidateExcept(obj *positiveStructFieldsPointer, fields ...string) []error {
+	return positiveStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func positiveStructFieldsPointerValidatePartialContext(ctx context.Context, obj *positiveStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPositiveIntPointer") {
+		if !(obj.FieldPositiveIntPointer != nil && *obj.FieldPositiveIntPointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveIntPointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt8Pointer") {
+		if !(obj.FieldPositiveInt8Pointer != nil && *obj.FieldPositiveInt8Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt8Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt16Pointer") {
+		if !(obj.FieldPositiveInt16Pointer != nil && *obj.FieldPositiveInt16Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt16Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt32Pointer") {
+		if !(obj.FieldPositiveInt32Pointer != nil && *obj.FieldPositiveInt32Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt32Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveInt64Pointer") {
+		if !(obj.FieldPositiveInt64Pointer != nil && *obj.FieldPositiveInt64Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveInt64Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUintPointer") {
+		if !(obj.FieldPositiveUintPointer != nil && *obj.FieldPositiveUintPointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUintPointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint8Pointer") {
+		if !(obj.FieldPositiveUint8Pointer != nil && *obj.FieldPositiveUint8Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint8Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint16Pointer") {
+		if !(obj.FieldPositiveUint16Pointer != nil && *obj.FieldPositiveUint16Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint16Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint32Pointer") {
+		if !(obj.FieldPositiveUint32Pointer != nil && *obj.FieldPositiveUint32Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint32Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveUint64Pointer") {
+		if !(obj.FieldPositiveUint64Pointer != nil && *obj.FieldPositiveUint64Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveUint64Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveFloat32Pointer") {
+		if !(obj.FieldPositiveFloat32Pointer != nil && *obj.FieldPositiveFloat32Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveFloat32Pointer must be positive"))
+		}
+	}
+	if selection.Has("FieldPositiveFloat64Pointer") {
+		if !(obj.FieldPositiveFloat64Pointer != nil && *obj.FieldPositiveFloat64Pointer > 0) {
+			errs = append(errs, types.NewValidationError("FieldPositiveFloat64Pointer must be positive"))
+		}
+	}
+	return errs
+}
+func postcode_iso3166_alpha2StructFieldsValidate(obj *postcode_iso3166_alpha2StructFields) []error {
+	return postcode_iso3166_alpha2StructFieldsValidateContext(context.Background(), obj)
+}
+
+func postcode_iso3166_alpha2StructFieldsValidateContext(ctx context.Context, obj *postcode_iso3166_alpha2StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsValidPostcode(obj.FieldPostcode_iso3166_alpha2String, "BR")) {
+		errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2String must be a valid postal code for 'BR'"))
+	}
+	return errs
+}
+
+func postcode_iso3166_alpha2StructFieldsValidateFields(obj *postcode_iso3166_alpha2StructFields, fields ...string) []error {
+	return postcode_iso3166_alpha2StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func postcode_iso3166_alpha2StructFieldsValidateExcept(obj *postcode_iso3166_alpha2StructFields, fields ...string) []error {
+	return postcode_iso3166_alpha2StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func postcode_iso3166_alpha2StructFieldsValidatePartialContext(ctx context.Context, obj *postcode_iso3166_alpha2StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPostcode_iso3166_alpha2String") {
+		if !(types.IsValidPostcode(obj.FieldPostcode_iso3166_alpha2String, "BR")) {
+			errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2String must be a valid postal code for 'BR'"))
+		}
+	}
+	return errs
+}
+func postcode_iso3166_alpha2StructFieldsPointerValidate(obj *postcode_iso3166_alpha2StructFieldsPointer) []error {
+	return postcode_iso3166_alpha2StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func postcode_iso3166_alpha2StructFieldsPointerValidateContext(ctx context.Context, obj *postcode_iso3166_alpha2StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPostcode_iso3166_alpha2StringPointer != nil && types.IsValidPostcode(*obj.FieldPostcode_iso3166_alpha2StringPointer, "BR")) {
+		errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2StringPointer must be a valid postal code for 'BR'"))
+	}
+	return errs
+}
+
+func postcode_iso3166_alpha2StructFieldsPointerValidateFields(obj *postcode_iso3166_alpha2StructFieldsPointer, fields ...string) []error {
+	return postcode_iso3166_alpha2StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func postcode_iso3166_alpha2StructFieldsPointerValidateExcept(obj *postcode_iso3166_alpha2StructFieldsPointer, fields ...string) []error {
+	return postcode_iso3166_alpha2StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func postcode_iso3166_alpha2StructFieldsPointerValidatePartialContext(ctx context.Context, obj *postcode_iso3166_alpha2StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPostcode_iso3166_alpha2StringPointer") {
+		if !(obj.FieldPostcode_iso3166_alpha2StringPointer != nil && types.IsValidPostcode(*obj.FieldPostcode_iso3166_alpha2StringPointer, "BR")) {
+			errs = append(errs, types.NewValidationError("FieldPostcode_iso3166_alpha2StringPointer must be a valid postal code for 'BR'"))
+		}
+	}
+	return errs
+}
+func printasciiStructFieldsValidate(obj *printasciiStructFields) []error {
+	return printasciiStructFieldsValidateContext(context.Background(), obj)
+}
+
+func printasciiStructFieldsValidateContext(ctx context.Context, obj *printasciiStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsPrintableASCII(obj.FieldPrintasciiString)) {
+		errs = append(errs, types.NewValidationError("FieldPrintasciiString must contain only printable ASCII characters"))
+	}
+	return errs
+}
+
+func printasciiStructFieldsValidateFields(obj *printasciiStructFields, fields ...string) []error {
+	return printasciiStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func printasciiStructFieldsValidateExcept(obj *printasciiStructFields, fields ...string) []error {
+	return printasciiStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func printasciiStructFieldsValidatePartialContext(ctx context.Context, obj *printasciiStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPrintasciiString") {
+		if !(types.IsPrintableASCII(obj.FieldPrintasciiString)) {
+			errs = append(errs, types.NewValidationError("FieldPrintasciiString must contain only printable ASCII characters"))
+		}
+	}
+	return errs
+}
+func printasciiStructFieldsPointerValidate(obj *printasciiStructFieldsPointer) []error {
+	return printasciiStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func printasciiStructFieldsPointerValidateContext(ctx context.Context, obj *printasciiStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPrintasciiStringPointer != nil && types.IsPrintableASCII(*obj.FieldPrintasciiStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldPrintasciiStringPointer must contain only printable ASCII characters"))
+	}
+	return errs
+}
+
+func printasciiStructFieldsPointerValidateFields(obj *printasciiStructFieldsPointer, fields ...string) []error {
+	return printasciiStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func printasciiStructFieldsPointerValidateExcept(obj *printasciiStructFieldsPointer, fields ...string) []error {
+	return printasciiStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func printasciiStructFieldsPointerValidatePartialContext(ctx context.Context, obj *printasciiStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPrintasciiStringPointer") {
+		if !(obj.FieldPrintasciiStringPointer != nil && types.IsPrintableASCII(*obj.FieldPrintasciiStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldPrintasciiStringPointer must contain only printable ASCII characters"))
+		}
+	}
+	return errs
+}
+func private_ipStructFieldsValidate(obj *private_ipStructFields) []error {
+	return private_ipStructFieldsValidateContext(context.Background(), obj)
+}
+
+func private_ipStructFieldsValidateContext(ctx context.Context, obj *private_ipStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsPrivateIP(obj.FieldPrivate_ipString)) {
+		errs = append(errs, types.NewValidationError("FieldPrivate_ipString must be a private IP address"))
+	}
+	return errs
+}
+
+func private_ipStructFieldsValidateFields(obj *private_ipStructFields, fields ...string) []error {
+	return private_ipStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func private_ipStructFieldsValidateExcept(obj *private_ipStructFields, fields ...string) []error {
+	return private_ipStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func private_ipStructFieldsValidatePartialContext(ctx context.Context, obj *private_ipStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPrivate_ipString") {
+		if !(types.IsPrivateIP(obj.FieldPrivate_ipString)) {
+			errs = append(errs, types.NewValidationError("FieldPrivate_ipString must be a private IP address"))
+		}
+	}
+	return errs
+}
+func private_ipStructFieldsPointerValidate(obj *private_ipStructFieldsPointer) []error {
+	return private_ipStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func private_ipStructFieldsPointerValidateContext(ctx context.Context, obj *private_ipStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPrivate_ipStringPointer != nil && types.IsPrivateIP(*obj.FieldPrivate_ipStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldPrivate_ipStringPointer must be a private IP address"))
+	}
+	return errs
+}
+
+func private_ipStructFieldsPointerValidateFields(obj *private_ipStructFieldsPointer, fields ...string) []error {
+	return private_ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func private_ipStructFieldsPointerValidateExcept(obj *private_ipStructFieldsPointer, fields ...string) []error {
+	return private_ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func private_ipStructFieldsPointerValidatePartialContext(ctx context.Context, obj *private_ipStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPrivate_ipStringPointer") {
+		if !(obj.FieldPrivate_ipStringPointer != nil && types.IsPrivateIP(*obj.FieldPrivate_ipStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldPrivate_ipStringPointer must be a private IP address"))
+		}
+	}
+	return errs
+}
+func public_ipStructFieldsValidate(obj *public_ipStructFields) []error {
+	return public_ipStructFieldsValidateContext(context.Background(), obj)
+}
+
+func public_ipStructFieldsValidateContext(ctx context.Context, obj *public_ipStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(types.IsPublicIP(obj.FieldPublic_ipString)) {
+		errs = append(errs, types.NewValidationError("FieldPublic_ipString must be a public IP address"))
+	}
+	return errs
+}
+
+func public_ipStructFieldsValidateFields(obj *public_ipStructFields, fields ...string) []error {
+	return public_ipStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func public_ipStructFieldsValidateExcept(obj *public_ipStructFields, fields ...string) []error {
+	return public_ipStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func public_ipStructFieldsValidatePartialContext(ctx context.Context, obj *public_ipStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPublic_ipString") {
+		if !(types.IsPublicIP(obj.FieldPublic_ipString)) {
+			errs = append(errs, types.NewValidationError("FieldPublic_ipString must be a public IP address"))
+		}
+	}
+	return errs
+}
+func public_ipStructFieldsPointerValidate(obj *public_ipStructFieldsPointer) []error {
+	return public_ipStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func public_ipStructFieldsPointerValidateContext(ctx context.Context, obj *public_ipStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldPublic_ipStringPointer != nil && types.IsPublicIP(*obj.FieldPublic_ipStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldPublic_ipStringPointer must be a public IP address"))
+	}
+	return errs
+}
+
+func public_ipStructFieldsPointerValidateFields(obj *public_ipStructFieldsPointer, fields ...string) []error {
+	return public_ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func public_ipStructFieldsPointerValidateExcept(obj *public_ipStructFieldsPointer, fields ...string) []error {
+	return public_ipStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func public_ipStructFieldsPointerValidatePartialContext(ctx context.Context, obj *public_ipStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldPublic_ipStringPointer") {
+		if !(obj.FieldPublic_ipStringPointer != nil && types.IsPublicIP(*obj.FieldPublic_ipStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldPublic_ipStringPointer must be a public IP address"))
+		}
+	}
+	return errs
+}
+func regexStructFieldsValidate(obj *regexStructFields) []error {
+	return regexStructFieldsValidateContext(context.Background(), obj)
+}
+
+func regexStructFieldsValidateContext(ctx context.Context, obj *regexStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(validgenRegex691022c6.MatchString(obj.FieldRegexString)) {
+		errs = append(errs, types.NewValidationError("FieldRegexString must match the pattern '^[a-z]{2,5}$'"))
+	}
+	return errs
+}
+
+func regexStructFieldsValidateFields(obj *regexStructFields, fields ...string) []error {
+	return regexStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func regexStructFieldsValidateExcept(obj *regexStructFields, fields ...string) []error {
+	return regexStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func regexStructFieldsValidatePartialContext(ctx context.Context, obj *regexStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldRegexString") {
+		if !(validgenRegex691022c6.MatchString(obj.FieldRegexString)) {
+			errs = append(errs, types.NewValidationError("FieldRegexString must match the pattern '^[a-z]{2,5}$'"))
+		}
+	}
+	return errs
+}
+func regexStructFieldsPointerValidate(obj *regexStructFieldsPointer) []error {
+	return regexStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func regexStructFieldsPointerValidateContext(ctx context.Context, obj *regexStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldRegexStringPointer != nil && validgenRegex691022c6.MatchString(*obj.FieldRegexStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldRegexStringPointer must match the pattern '^[a-z]{2,5}$'"))
+	}
+	return errs
+}
+
+func regexStructFieldsPointerValidateFields(obj *regexStructFieldsPointer, fields ...string) []error {
+	return regexStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func regexStructFieldsPointerValidateExcept(obj *regexStructFieldsPointer, fields ...string) []error {
+	return regexStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func regexStructFieldsPointerValidatePartialContext(ctx context.Context, obj *regexStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if selection.Has("FieldRegexStringPointer") {
+		if !(obj.FieldRegexStringPointer != nil && validgenRegex691022c6.MatchString(*obj.FieldRegexStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldRegexStringPointer must match the pattern '^[a-z]{2,5}$'"))
+		}
+	}
+	return errs
+}
+func requiredStructFieldsValidate(obj *requiredStructFields) []error {
+	return requiredStructFieldsValidateContext(context.Background(), obj)
+}
+
+func requiredStructFieldsValidateContext(ctx context.Context, obj *requiredStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if !(obj.FieldRequiredString != "") {
+		errs = append(errs, types.NewValidationError("FieldRequiredString is required"))
+	}
+	if !(obj.FieldRequiredInt != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt is required"))
+	}
+	if !(obj.FieldRequiredInt8 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8 is required"))
+	}
+	if !(obj.FieldRequiredInt16 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16 is required"))
+	}
+	if !(obj.FieldRequiredInt32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32 is required"))
+	}
+	if !(obj.FieldRequiredInt64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64 is required"))
+	}
+	if !(obj.FieldRequiredUint != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint is required"))
+	}
+	if !(obj.FieldRequiredUint8 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8 is required"))
+	}
+	if !(obj.FieldRequiredUint16 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16 is required"))
+	}
+	if !(obj.FieldRequiredUint32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32 is required"))
+	}
+	if !(obj.FieldRequiredUint64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64 is required"))
+	}
+	if !(obj.FieldRequiredFloat32 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32 is required"))
+	}
+	if !(obj.FieldRequiredFloat64 != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64 is required"))
+	}
+	if !(obj.FieldRequiredBool != false) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBool is required"))
+	}
+	if !(len(obj.FieldRequiredStringSlice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringSlice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredIntSlice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntSlice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt8Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt16Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt32Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt64Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredUintSlice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintSlice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredUint8Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredUint16Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredUint32Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredUint64Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredFloat32Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredFloat64Slice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Slice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredBoolSlice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolSlice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredByteSlice) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredByteSlice must not be empty"))
+	}
+	if !(len(obj.FieldRequiredStringMap) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringMap must not be empty"))
+	}
+	if !(len(obj.FieldRequiredIntMap) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntMap must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt8Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8Map must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt16Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16Map must not be empty"))
+	}
+	if !(len(obj.FieldRequiredInt32Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32Map must not be empty"))
 	}
-	if !(obj.FieldNinInt16 != 12 && obj.FieldNinInt16 != 34 && obj.FieldNinInt16 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredInt64Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64Map must not be empty"))
 	}
-	if !(obj.FieldNinInt32 != 12 && obj.FieldNinInt32 != 34 && obj.FieldNinInt32 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredUintMap) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintMap must not be empty"))
 	}
-	if !(obj.FieldNinInt64 != 12 && obj.FieldNinInt64 != 34 && obj.FieldNinInt64 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredUint8Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8Map must not be empty"))
 	}
-	if !(obj.FieldNinUint != 12 && obj.FieldNinUint != 34 && obj.FieldNinUint != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinUint must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredUint16Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16Map must not be empty"))
 	}
-	if !(obj.FieldNinUint8 != 12 && obj.FieldNinUint8 != 34 && obj.FieldNinUint8 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredUint32Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32Map must not be empty"))
 	}
-	if !(obj.FieldNinUint16 != 12 && obj.FieldNinUint16 != 34 && obj.FieldNinUint16 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredUint64Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64Map must not be empty"))
 	}
-	if !(obj.FieldNinUint32 != 12 && obj.FieldNinUint32 != 34 && obj.FieldNinUint32 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredFloat32Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Map must not be empty"))
 	}
-	if !(obj.FieldNinUint64 != 12 && obj.FieldNinUint64 != 34 && obj.FieldNinUint64 != 56) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64 must not be one of '12' '34' '56'"))
+	if !(len(obj.FieldRequiredFloat64Map) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Map must not be empty"))
 	}
-	if !(obj.FieldNinFloat32 != 11.11 && obj.FieldNinFloat32 != 22.22 && obj.FieldNinFloat32 != 33.33) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32 must not be one of '11.11' '22.22' '33.33'"))
+	if !(len(obj.FieldRequiredBoolMap) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolMap must not be empty"))
 	}
-	if !(obj.FieldNinFloat64 != 11.11 && obj.FieldNinFloat64 != 22.22 && obj.FieldNinFloat64 != 33.33) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64 must not be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func requiredStructFieldsValidateFields(obj *requiredStructFields, fields ...string) []error {
+	return requiredStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func requiredStructFieldsValidateExcept(obj *requiredStructFields, fields ...string) []error {
+	return requiredStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func requiredStructFieldsValidatePartialContext(ctx context.Context, obj *requiredStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinBool != true) {
-		errs = append(errs, types.NewValidationError("FieldNinBool must not be one of 'true'"))
+	var errs []error
+	if selection.Has("FieldRequiredString") {
+		if !(obj.FieldRequiredString != "") {
+			errs = append(errs, types.NewValidationError("FieldRequiredString is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt") {
+		if !(obj.FieldRequiredInt != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8") {
+		if !(obj.FieldRequiredInt8 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16") {
+		if !(obj.FieldRequiredInt16 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32") {
+		if !(obj.FieldRequiredInt32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64") {
+		if !(obj.FieldRequiredInt64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint") {
+		if !(obj.FieldRequiredUint != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8") {
+		if !(obj.FieldRequiredUint8 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16") {
+		if !(obj.FieldRequiredUint16 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32") {
+		if !(obj.FieldRequiredUint32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64") {
+		if !(obj.FieldRequiredUint64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32") {
+		if !(obj.FieldRequiredFloat32 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64") {
+		if !(obj.FieldRequiredFloat64 != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64 is required"))
+		}
+	}
+	if selection.Has("FieldRequiredBool") {
+		if !(obj.FieldRequiredBool != false) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBool is required"))
+		}
+	}
+	if selection.Has("FieldRequiredStringSlice") {
+		if !(len(obj.FieldRequiredStringSlice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringSlice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredIntSlice") {
+		if !(len(obj.FieldRequiredIntSlice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntSlice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8Slice") {
+		if !(len(obj.FieldRequiredInt8Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16Slice") {
+		if !(len(obj.FieldRequiredInt16Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32Slice") {
+		if !(len(obj.FieldRequiredInt32Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64Slice") {
+		if !(len(obj.FieldRequiredInt64Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUintSlice") {
+		if !(len(obj.FieldRequiredUintSlice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintSlice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8Slice") {
+		if !(len(obj.FieldRequiredUint8Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16Slice") {
+		if !(len(obj.FieldRequiredUint16Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32Slice") {
+		if !(len(obj.FieldRequiredUint32Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64Slice") {
+		if !(len(obj.FieldRequiredUint64Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32Slice") {
+		if !(len(obj.FieldRequiredFloat32Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64Slice") {
+		if !(len(obj.FieldRequiredFloat64Slice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64Slice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolSlice") {
+		if !(len(obj.FieldRequiredBoolSlice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolSlice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredByteSlice") {
+		if !(len(obj.FieldRequiredByteSlice) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredByteSlice must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredStringMap") {
+		if !(len(obj.FieldRequiredStringMap) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringMap must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredIntMap") {
+		if !(len(obj.FieldRequiredIntMap) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntMap must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8Map") {
+		if !(len(obj.FieldRequiredInt8Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16Map") {
+		if !(len(obj.FieldRequiredInt16Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32Map") {
+		if !(len(obj.FieldRequiredInt32Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64Map") {
+		if !(len(obj.FieldRequiredInt64Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUintMap") {
+		if !(len(obj.FieldRequiredUintMap) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintMap must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8Map") {
+		if !(len(obj.FieldRequiredUint8Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16Map") {
+		if !(len(obj.FieldRequiredUint16Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32Map") {
+		if !(len(obj.FieldRequiredUint32Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64Map") {
+		if !(len(obj.FieldRequiredUint64Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32Map") {
+		if !(len(obj.FieldRequiredFloat32Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64Map") {
+		if !(len(obj.FieldRequiredFloat64Map) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64Map must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolMap") {
+		if !(len(obj.FieldRequiredBoolMap) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolMap must not be empty"))
+		}
 	}
-	if !(types.SliceNotContains(obj.FieldNinStringSlice, []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringSlice elements must not be one of 'ab' 'cd' 'ef'"))
+	return errs
+}
+func requiredStructFieldsPointerValidate(obj *requiredStructFieldsPointer) []error {
+	return requiredStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func requiredStructFieldsPointerValidateContext(ctx context.Context, obj *requiredStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(types.SliceNotContains(obj.FieldNinIntSlice, []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntSlice elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldRequiredStringPointer != nil && *obj.FieldRequiredStringPointer != "") {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringPointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt8Slice, []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredIntPointer != nil && *obj.FieldRequiredIntPointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntPointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt16Slice, []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt8Pointer != nil && *obj.FieldRequiredInt8Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt32Slice, []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt16Pointer != nil && *obj.FieldRequiredInt16Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt64Slice, []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt32Pointer != nil && *obj.FieldRequiredInt32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUintSlice, []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintSlice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt64Pointer != nil && *obj.FieldRequiredInt64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint8Slice, []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUintPointer != nil && *obj.FieldRequiredUintPointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintPointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint16Slice, []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint8Pointer != nil && *obj.FieldRequiredUint8Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint32Slice, []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint16Pointer != nil && *obj.FieldRequiredUint16Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint64Slice, []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64Slice elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint32Pointer != nil && *obj.FieldRequiredUint32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinFloat32Slice, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32Slice elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredUint64Pointer != nil && *obj.FieldRequiredUint64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinFloat64Slice, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64Slice elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredFloat32Pointer != nil && *obj.FieldRequiredFloat32Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinBoolSlice, []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolSlice elements must not be one of 'true'"))
+	if !(obj.FieldRequiredFloat64Pointer != nil && *obj.FieldRequiredFloat64Pointer != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Pointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinStringArray[:], []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringArray elements must not be one of 'ab' 'cd' 'ef'"))
+	if !(obj.FieldRequiredBoolPointer != nil && *obj.FieldRequiredBoolPointer != false) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolPointer is required"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinIntArray[:], []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntArray elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredStringSlicePointer != nil && len(*obj.FieldRequiredStringSlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringSlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt8Array[:], []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredIntSlicePointer != nil && len(*obj.FieldRequiredIntSlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntSlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt16Array[:], []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt8SlicePointer != nil && len(*obj.FieldRequiredInt8SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt32Array[:], []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt16SlicePointer != nil && len(*obj.FieldRequiredInt16SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinInt64Array[:], []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt32SlicePointer != nil && len(*obj.FieldRequiredInt32SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUintArray[:], []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintArray elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredInt64SlicePointer != nil && len(*obj.FieldRequiredInt64SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint8Array[:], []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUintSlicePointer != nil && len(*obj.FieldRequiredUintSlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintSlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint16Array[:], []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint8SlicePointer != nil && len(*obj.FieldRequiredUint8SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint32Array[:], []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint16SlicePointer != nil && len(*obj.FieldRequiredUint16SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinUint64Array[:], []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64Array elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint32SlicePointer != nil && len(*obj.FieldRequiredUint32SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinFloat32Array[:], []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32Array elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredUint64SlicePointer != nil && len(*obj.FieldRequiredUint64SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinFloat64Array[:], []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64Array elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredFloat32SlicePointer != nil && len(*obj.FieldRequiredFloat32SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32SlicePointer must not be empty"))
 	}
-	if !(types.SliceNotContains(obj.FieldNinBoolArray[:], []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolArray elements must not be one of 'true'"))
+	if !(obj.FieldRequiredFloat64SlicePointer != nil && len(*obj.FieldRequiredFloat64SlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64SlicePointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinStringMap, []string{"a", "b", "c"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringMap elements must not be one of 'a' 'b' 'c'"))
+	if !(obj.FieldRequiredBoolSlicePointer != nil && len(*obj.FieldRequiredBoolSlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolSlicePointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinIntMap, []int{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntMap elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredByteSlicePointer != nil && len(*obj.FieldRequiredByteSlicePointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredByteSlicePointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinInt8Map, []int8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredStringArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinInt16Map, []int16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredIntArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinInt32Map, []int32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredInt8ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinInt64Map, []int64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredInt16ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinUintMap, []uint{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintMap elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredInt32ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinUint8Map, []uint8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredInt64ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinUint16Map, []uint16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredUintArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinUint32Map, []uint32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredUint8ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinUint64Map, []uint64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64Map elements must not be one of '1' '2' '3'"))
+	if !(obj.FieldRequiredUint16ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinFloat32Map, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32Map elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredUint32ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinFloat64Map, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64Map elements must not be one of '11.11' '22.22' '33.33'"))
+	if !(obj.FieldRequiredUint64ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64ArrayPointer must not be empty"))
 	}
-	if !(types.MapNotContains(obj.FieldNinBoolMap, []bool{false})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolMap elements must not be one of 'false'"))
+	if !(obj.FieldRequiredFloat32ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32ArrayPointer must not be empty"))
 	}
-	return errs
-}
-func ninStructFieldsPointerValidate(obj *ninStructFieldsPointer) []error {
-	var errs []error
-	if !((obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ab") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "cd") && (obj.FieldNinStringPointer != nil && *obj.FieldNinStringPointer != "ef")) {
-		errs = append(errs, types.NewValidationError("FieldNinStringPointer must not be one of 'ab' 'cd' 'ef'"))
+	if !(obj.FieldRequiredFloat64ArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64ArrayPointer must not be empty"))
 	}
-	if !((obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 12) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 34) && (obj.FieldNinIntPointer != nil && *obj.FieldNinIntPointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinIntPointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredBoolArrayPointer != nil) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolArrayPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredStringMapPointer != nil && len(*obj.FieldRequiredStringMapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredStringMapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredIntMapPointer != nil && len(*obj.FieldRequiredIntMapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredIntMapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredInt8MapPointer != nil && len(*obj.FieldRequiredInt8MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt8MapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredInt16MapPointer != nil && len(*obj.FieldRequiredInt16MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt16MapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredInt32MapPointer != nil && len(*obj.FieldRequiredInt32MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt32MapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredInt64MapPointer != nil && len(*obj.FieldRequiredInt64MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredInt64MapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredUintMapPointer != nil && len(*obj.FieldRequiredUintMapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUintMapPointer must not be empty"))
+	}
+	if !(obj.FieldRequiredUint8MapPointer != nil && len(*obj.FieldRequiredUint8MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint8MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 12) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 34) && (obj.FieldNinInt8Pointer != nil && *obj.FieldNinInt8Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8Pointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint16MapPointer != nil && len(*obj.FieldRequiredUint16MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint16MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 12) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 34) && (obj.FieldNinInt16Pointer != nil && *obj.FieldNinInt16Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16Pointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint32MapPointer != nil && len(*obj.FieldRequiredUint32MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint32MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 12) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 34) && (obj.FieldNinInt32Pointer != nil && *obj.FieldNinInt32Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32Pointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredUint64MapPointer != nil && len(*obj.FieldRequiredUint64MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredUint64MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 12) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 34) && (obj.FieldNinInt64Pointer != nil && *obj.FieldNinInt64Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64Pointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredFloat32MapPointer != nil && len(*obj.FieldRequiredFloat32MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat32MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 12) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 34) && (obj.FieldNinUintPointer != nil && *obj.FieldNinUintPointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinUintPointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredFloat64MapPointer != nil && len(*obj.FieldRequiredFloat64MapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredFloat64MapPointer must not be empty"))
 	}
-	if !((obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 12) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 34) && (obj.FieldNinUint8Pointer != nil && *obj.FieldNinUint8Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8Pointer must not be one of '12' '34' '56'"))
+	if !(obj.FieldRequiredBoolMapPointer != nil && len(*obj.FieldRequiredBoolMapPointer) != 0) {
+		errs = append(errs, types.NewValidationError("FieldRequiredBoolMapPointer must not be empty"))
 	}
-	if !((obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 12) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 34) && (obj.FieldNinUint16Pointer != nil && *obj.FieldNinUint16Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16Pointer must not be one of '12' '34' '56'"))
+	return errs
+}
+
+func requiredStructFieldsPointerValidateFields(obj *requiredStructFieldsPointer, fields ...string) []error {
+	return requiredStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func requiredStructFieldsPointerValidateExcept(obj *requiredStructFieldsPointer, fields ...string) []error {
+	return requiredStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func requiredStructFieldsPointerValidatePartialContext(ctx context.Context, obj *requiredStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 12) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 34) && (obj.FieldNinUint32Pointer != nil && *obj.FieldNinUint32Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32Pointer must not be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldRequiredStringPointer") {
+		if !(obj.FieldRequiredStringPointer != nil && *obj.FieldRequiredStringPointer != "") {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringPointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredIntPointer") {
+		if !(obj.FieldRequiredIntPointer != nil && *obj.FieldRequiredIntPointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntPointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8Pointer") {
+		if !(obj.FieldRequiredInt8Pointer != nil && *obj.FieldRequiredInt8Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16Pointer") {
+		if !(obj.FieldRequiredInt16Pointer != nil && *obj.FieldRequiredInt16Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32Pointer") {
+		if !(obj.FieldRequiredInt32Pointer != nil && *obj.FieldRequiredInt32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64Pointer") {
+		if !(obj.FieldRequiredInt64Pointer != nil && *obj.FieldRequiredInt64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUintPointer") {
+		if !(obj.FieldRequiredUintPointer != nil && *obj.FieldRequiredUintPointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintPointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8Pointer") {
+		if !(obj.FieldRequiredUint8Pointer != nil && *obj.FieldRequiredUint8Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16Pointer") {
+		if !(obj.FieldRequiredUint16Pointer != nil && *obj.FieldRequiredUint16Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32Pointer") {
+		if !(obj.FieldRequiredUint32Pointer != nil && *obj.FieldRequiredUint32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64Pointer") {
+		if !(obj.FieldRequiredUint64Pointer != nil && *obj.FieldRequiredUint64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32Pointer") {
+		if !(obj.FieldRequiredFloat32Pointer != nil && *obj.FieldRequiredFloat32Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64Pointer") {
+		if !(obj.FieldRequiredFloat64Pointer != nil && *obj.FieldRequiredFloat64Pointer != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64Pointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolPointer") {
+		if !(obj.FieldRequiredBoolPointer != nil && *obj.FieldRequiredBoolPointer != false) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolPointer is required"))
+		}
+	}
+	if selection.Has("FieldRequiredStringSlicePointer") {
+		if !(obj.FieldRequiredStringSlicePointer != nil && len(*obj.FieldRequiredStringSlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringSlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredIntSlicePointer") {
+		if !(obj.FieldRequiredIntSlicePointer != nil && len(*obj.FieldRequiredIntSlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntSlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8SlicePointer") {
+		if !(obj.FieldRequiredInt8SlicePointer != nil && len(*obj.FieldRequiredInt8SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16SlicePointer") {
+		if !(obj.FieldRequiredInt16SlicePointer != nil && len(*obj.FieldRequiredInt16SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32SlicePointer") {
+		if !(obj.FieldRequiredInt32SlicePointer != nil && len(*obj.FieldRequiredInt32SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64SlicePointer") {
+		if !(obj.FieldRequiredInt64SlicePointer != nil && len(*obj.FieldRequiredInt64SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUintSlicePointer") {
+		if !(obj.FieldRequiredUintSlicePointer != nil && len(*obj.FieldRequiredUintSlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintSlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8SlicePointer") {
+		if !(obj.FieldRequiredUint8SlicePointer != nil && len(*obj.FieldRequiredUint8SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16SlicePointer") {
+		if !(obj.FieldRequiredUint16SlicePointer != nil && len(*obj.FieldRequiredUint16SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32SlicePointer") {
+		if !(obj.FieldRequiredUint32SlicePointer != nil && len(*obj.FieldRequiredUint32SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64SlicePointer") {
+		if !(obj.FieldRequiredUint64SlicePointer != nil && len(*obj.FieldRequiredUint64SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32SlicePointer") {
+		if !(obj.FieldRequiredFloat32SlicePointer != nil && len(*obj.FieldRequiredFloat32SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64SlicePointer") {
+		if !(obj.FieldRequiredFloat64SlicePointer != nil && len(*obj.FieldRequiredFloat64SlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64SlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolSlicePointer") {
+		if !(obj.FieldRequiredBoolSlicePointer != nil && len(*obj.FieldRequiredBoolSlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolSlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredByteSlicePointer") {
+		if !(obj.FieldRequiredByteSlicePointer != nil && len(*obj.FieldRequiredByteSlicePointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredByteSlicePointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredStringArrayPointer") {
+		if !(obj.FieldRequiredStringArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredIntArrayPointer") {
+		if !(obj.FieldRequiredIntArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8ArrayPointer") {
+		if !(obj.FieldRequiredInt8ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16ArrayPointer") {
+		if !(obj.FieldRequiredInt16ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32ArrayPointer") {
+		if !(obj.FieldRequiredInt32ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64ArrayPointer") {
+		if !(obj.FieldRequiredInt64ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUintArrayPointer") {
+		if !(obj.FieldRequiredUintArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8ArrayPointer") {
+		if !(obj.FieldRequiredUint8ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16ArrayPointer") {
+		if !(obj.FieldRequiredUint16ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32ArrayPointer") {
+		if !(obj.FieldRequiredUint32ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64ArrayPointer") {
+		if !(obj.FieldRequiredUint64ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32ArrayPointer") {
+		if !(obj.FieldRequiredFloat32ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64ArrayPointer") {
+		if !(obj.FieldRequiredFloat64ArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64ArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolArrayPointer") {
+		if !(obj.FieldRequiredBoolArrayPointer != nil) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolArrayPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredStringMapPointer") {
+		if !(obj.FieldRequiredStringMapPointer != nil && len(*obj.FieldRequiredStringMapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredStringMapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredIntMapPointer") {
+		if !(obj.FieldRequiredIntMapPointer != nil && len(*obj.FieldRequiredIntMapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredIntMapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt8MapPointer") {
+		if !(obj.FieldRequiredInt8MapPointer != nil && len(*obj.FieldRequiredInt8MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt8MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt16MapPointer") {
+		if !(obj.FieldRequiredInt16MapPointer != nil && len(*obj.FieldRequiredInt16MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt16MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt32MapPointer") {
+		if !(obj.FieldRequiredInt32MapPointer != nil && len(*obj.FieldRequiredInt32MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt32MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredInt64MapPointer") {
+		if !(obj.FieldRequiredInt64MapPointer != nil && len(*obj.FieldRequiredInt64MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredInt64MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUintMapPointer") {
+		if !(obj.FieldRequiredUintMapPointer != nil && len(*obj.FieldRequiredUintMapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUintMapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint8MapPointer") {
+		if !(obj.FieldRequiredUint8MapPointer != nil && len(*obj.FieldRequiredUint8MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint8MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint16MapPointer") {
+		if !(obj.FieldRequiredUint16MapPointer != nil && len(*obj.FieldRequiredUint16MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint16MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint32MapPointer") {
+		if !(obj.FieldRequiredUint32MapPointer != nil && len(*obj.FieldRequiredUint32MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint32MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredUint64MapPointer") {
+		if !(obj.FieldRequiredUint64MapPointer != nil && len(*obj.FieldRequiredUint64MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredUint64MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat32MapPointer") {
+		if !(obj.FieldRequiredFloat32MapPointer != nil && len(*obj.FieldRequiredFloat32MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat32MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredFloat64MapPointer") {
+		if !(obj.FieldRequiredFloat64MapPointer != nil && len(*obj.FieldRequiredFloat64MapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredFloat64MapPointer must not be empty"))
+		}
+	}
+	if selection.Has("FieldRequiredBoolMapPointer") {
+		if !(obj.FieldRequiredBoolMapPointer != nil && len(*obj.FieldRequiredBoolMapPointer) != 0) {
+			errs = append(errs, types.NewValidationError("FieldRequiredBoolMapPointer must not be empty"))
+		}
 	}
-	if !((obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 12) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 34) && (obj.FieldNinUint64Pointer != nil && *obj.FieldNinUint64Pointer != 56)) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64Pointer must not be one of '12' '34' '56'"))
+	return errs
+}
+func rgbStructFieldsValidate(obj *rgbStructFields) []error {
+	return rgbStructFieldsValidateContext(context.Background(), obj)
+}
+
+func rgbStructFieldsValidateContext(ctx context.Context, obj *rgbStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !((obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 11.11) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 22.22) && (obj.FieldNinFloat32Pointer != nil && *obj.FieldNinFloat32Pointer != 33.33)) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32Pointer must not be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if !(types.IsValidRGB(obj.FieldRgbString)) {
+		errs = append(errs, types.NewValidationError("FieldRgbString must be a valid RGB color"))
 	}
-	if !((obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 11.11) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 22.22) && (obj.FieldNinFloat64Pointer != nil && *obj.FieldNinFloat64Pointer != 33.33)) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64Pointer must not be one of '11.11' '22.22' '33.33'"))
+	if !(types.IsValidRGB(string(obj.FieldRgbByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldRgbByteSlice must be a valid RGB color"))
 	}
-	if !(obj.FieldNinBoolPointer != nil && *obj.FieldNinBoolPointer != true) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolPointer must not be one of 'true'"))
+	return errs
+}
+
+func rgbStructFieldsValidateFields(obj *rgbStructFields, fields ...string) []error {
+	return rgbStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func rgbStructFieldsValidateExcept(obj *rgbStructFields, fields ...string) []error {
+	return rgbStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func rgbStructFieldsValidatePartialContext(ctx context.Context, obj *rgbStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinStringSlicePointer != nil && types.SliceNotContains(*obj.FieldNinStringSlicePointer, []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringSlicePointer elements must not be one of 'ab' 'cd' 'ef'"))
+	var errs []error
+	if selection.Has("FieldRgbString") {
+		if !(types.IsValidRGB(obj.FieldRgbString)) {
+			errs = append(errs, types.NewValidationError("FieldRgbString must be a valid RGB color"))
+		}
 	}
-	if !(obj.FieldNinIntSlicePointer != nil && types.SliceNotContains(*obj.FieldNinIntSlicePointer, []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntSlicePointer elements must not be one of '12' '34' '56'"))
+	if selection.Has("FieldRgbByteSlice") {
+		if !(types.IsValidRGB(string(obj.FieldRgbByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldRgbByteSlice must be a valid RGB color"))
+		}
 	}
-	if !(obj.FieldNinInt8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt8SlicePointer, []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8SlicePointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+func rgbStructFieldsPointerValidate(obj *rgbStructFieldsPointer) []error {
+	return rgbStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func rgbStructFieldsPointerValidateContext(ctx context.Context, obj *rgbStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinInt16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt16SlicePointer, []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16SlicePointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldRgbStringPointer != nil && types.IsValidRGB(*obj.FieldRgbStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldRgbStringPointer must be a valid RGB color"))
 	}
-	if !(obj.FieldNinInt32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt32SlicePointer, []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32SlicePointer elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRgbByteSlicePointer != nil && types.IsValidRGB(string(*obj.FieldRgbByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldRgbByteSlicePointer must be a valid RGB color"))
 	}
-	if !(obj.FieldNinInt64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinInt64SlicePointer, []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64SlicePointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+
+func rgbStructFieldsPointerValidateFields(obj *rgbStructFieldsPointer, fields ...string) []error {
+	return rgbStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func rgbStructFieldsPointerValidateExcept(obj *rgbStructFieldsPointer, fields ...string) []error {
+	return rgbStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func rgbStructFieldsPointerValidatePartialContext(ctx context.Context, obj *rgbStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUintSlicePointer != nil && types.SliceNotContains(*obj.FieldNinUintSlicePointer, []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintSlicePointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldRgbStringPointer") {
+		if !(obj.FieldRgbStringPointer != nil && types.IsValidRGB(*obj.FieldRgbStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldRgbStringPointer must be a valid RGB color"))
+		}
 	}
-	if !(obj.FieldNinUint8SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint8SlicePointer, []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8SlicePointer elements must not be one of '12' '34' '56'"))
+	if selection.Has("FieldRgbByteSlicePointer") {
+		if !(obj.FieldRgbByteSlicePointer != nil && types.IsValidRGB(string(*obj.FieldRgbByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldRgbByteSlicePointer must be a valid RGB color"))
+		}
 	}
-	if !(obj.FieldNinUint16SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint16SlicePointer, []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16SlicePointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+func rgbaStructFieldsValidate(obj *rgbaStructFields) []error {
+	return rgbaStructFieldsValidateContext(context.Background(), obj)
+}
+
+func rgbaStructFieldsValidateContext(ctx context.Context, obj *rgbaStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUint32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint32SlicePointer, []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32SlicePointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if !(types.IsValidRGBA(obj.FieldRgbaString)) {
+		errs = append(errs, types.NewValidationError("FieldRgbaString must be a valid RGBA color"))
 	}
-	if !(obj.FieldNinUint64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinUint64SlicePointer, []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64SlicePointer elements must not be one of '12' '34' '56'"))
+	if !(types.IsValidRGBA(string(obj.FieldRgbaByteSlice))) {
+		errs = append(errs, types.NewValidationError("FieldRgbaByteSlice must be a valid RGBA color"))
 	}
-	if !(obj.FieldNinFloat32SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat32SlicePointer, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func rgbaStructFieldsValidateFields(obj *rgbaStructFields, fields ...string) []error {
+	return rgbaStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func rgbaStructFieldsValidateExcept(obj *rgbaStructFields, fields ...string) []error {
+	return rgbaStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func rgbaStructFieldsValidatePartialContext(ctx context.Context, obj *rgbaStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinFloat64SlicePointer != nil && types.SliceNotContains(*obj.FieldNinFloat64SlicePointer, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64SlicePointer elements must not be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if selection.Has("FieldRgbaString") {
+		if !(types.IsValidRGBA(obj.FieldRgbaString)) {
+			errs = append(errs, types.NewValidationError("FieldRgbaString must be a valid RGBA color"))
+		}
 	}
-	if !(obj.FieldNinBoolSlicePointer != nil && types.SliceNotContains(*obj.FieldNinBoolSlicePointer, []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolSlicePointer elements must not be one of 'true'"))
+	if selection.Has("FieldRgbaByteSlice") {
+		if !(types.IsValidRGBA(string(obj.FieldRgbaByteSlice))) {
+			errs = append(errs, types.NewValidationError("FieldRgbaByteSlice must be a valid RGBA color"))
+		}
 	}
-	if !(obj.FieldNinStringArrayPointer != nil && types.SliceNotContains(obj.FieldNinStringArrayPointer[:], []string{"ab", "cd", "ef"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringArrayPointer elements must not be one of 'ab' 'cd' 'ef'"))
+	return errs
+}
+func rgbaStructFieldsPointerValidate(obj *rgbaStructFieldsPointer) []error {
+	return rgbaStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func rgbaStructFieldsPointerValidateContext(ctx context.Context, obj *rgbaStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinIntArrayPointer != nil && types.SliceNotContains(obj.FieldNinIntArrayPointer[:], []int{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntArrayPointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if !(obj.FieldRgbaStringPointer != nil && types.IsValidRGBA(*obj.FieldRgbaStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldRgbaStringPointer must be a valid RGBA color"))
 	}
-	if !(obj.FieldNinInt8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt8ArrayPointer[:], []int8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8ArrayPointer elements must not be one of '12' '34' '56'"))
+	if !(obj.FieldRgbaByteSlicePointer != nil && types.IsValidRGBA(string(*obj.FieldRgbaByteSlicePointer))) {
+		errs = append(errs, types.NewValidationError("FieldRgbaByteSlicePointer must be a valid RGBA color"))
 	}
-	if !(obj.FieldNinInt16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt16ArrayPointer[:], []int16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16ArrayPointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+
+func rgbaStructFieldsPointerValidateFields(obj *rgbaStructFieldsPointer, fields ...string) []error {
+	return rgbaStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func rgbaStructFieldsPointerValidateExcept(obj *rgbaStructFieldsPointer, fields ...string) []error {
+	return rgbaStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func rgbaStructFieldsPointerValidatePartialContext(ctx context.Context, obj *rgbaStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinInt32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt32ArrayPointer[:], []int32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32ArrayPointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldRgbaStringPointer") {
+		if !(obj.FieldRgbaStringPointer != nil && types.IsValidRGBA(*obj.FieldRgbaStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldRgbaStringPointer must be a valid RGBA color"))
+		}
 	}
-	if !(obj.FieldNinInt64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinInt64ArrayPointer[:], []int64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64ArrayPointer elements must not be one of '12' '34' '56'"))
+	if selection.Has("FieldRgbaByteSlicePointer") {
+		if !(obj.FieldRgbaByteSlicePointer != nil && types.IsValidRGBA(string(*obj.FieldRgbaByteSlicePointer))) {
+			errs = append(errs, types.NewValidationError("FieldRgbaByteSlicePointer must be a valid RGBA color"))
+		}
 	}
-	if !(obj.FieldNinUintArrayPointer != nil && types.SliceNotContains(obj.FieldNinUintArrayPointer[:], []uint{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintArrayPointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+func semverStructFieldsValidate(obj *semverStructFields) []error {
+	return semverStructFieldsValidateContext(context.Background(), obj)
+}
+
+func semverStructFieldsValidateContext(ctx context.Context, obj *semverStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUint8ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint8ArrayPointer[:], []uint8{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8ArrayPointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if !(types.IsValidSemver(obj.FieldSemverString)) {
+		errs = append(errs, types.NewValidationError("FieldSemverString must be a valid semantic version (e.g. 1.2.3)"))
 	}
-	if !(obj.FieldNinUint16ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint16ArrayPointer[:], []uint16{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16ArrayPointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+
+func semverStructFieldsValidateFields(obj *semverStructFields, fields ...string) []error {
+	return semverStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func semverStructFieldsValidateExcept(obj *semverStructFields, fields ...string) []error {
+	return semverStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func semverStructFieldsValidatePartialContext(ctx context.Context, obj *semverStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUint32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint32ArrayPointer[:], []uint32{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32ArrayPointer elements must not be one of '12' '34' '56'"))
+	var errs []error
+	if selection.Has("FieldSemverString") {
+		if !(types.IsValidSemver(obj.FieldSemverString)) {
+			errs = append(errs, types.NewValidationError("FieldSemverString must be a valid semantic version (e.g. 1.2.3)"))
+		}
 	}
-	if !(obj.FieldNinUint64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinUint64ArrayPointer[:], []uint64{12, 34, 56})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64ArrayPointer elements must not be one of '12' '34' '56'"))
+	return errs
+}
+func semverStructFieldsPointerValidate(obj *semverStructFieldsPointer) []error {
+	return semverStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func semverStructFieldsPointerValidateContext(ctx context.Context, obj *semverStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinFloat32ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat32ArrayPointer[:], []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if !(obj.FieldSemverStringPointer != nil && types.IsValidSemver(*obj.FieldSemverStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldSemverStringPointer must be a valid semantic version (e.g. 1.2.3)"))
 	}
-	if !(obj.FieldNinFloat64ArrayPointer != nil && types.SliceNotContains(obj.FieldNinFloat64ArrayPointer[:], []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64ArrayPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+
+func semverStructFieldsPointerValidateFields(obj *semverStructFieldsPointer, fields ...string) []error {
+	return semverStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func semverStructFieldsPointerValidateExcept(obj *semverStructFieldsPointer, fields ...string) []error {
+	return semverStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func semverStructFieldsPointerValidatePartialContext(ctx context.Context, obj *semverStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinBoolArrayPointer != nil && types.SliceNotContains(obj.FieldNinBoolArrayPointer[:], []bool{true})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolArrayPointer elements must not be one of 'true'"))
+	var errs []error
+	if selection.Has("FieldSemverStringPointer") {
+		if !(obj.FieldSemverStringPointer != nil && types.IsValidSemver(*obj.FieldSemverStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldSemverStringPointer must be a valid semantic version (e.g. 1.2.3)"))
+		}
 	}
-	if !(obj.FieldNinStringMapPointer != nil && types.MapNotContains(*obj.FieldNinStringMapPointer, []string{"a", "b", "c"})) {
-		errs = append(errs, types.NewValidationError("FieldNinStringMapPointer elements must not be one of 'a' 'b' 'c'"))
+	return errs
+}
+func startsnotwithStructFieldsValidate(obj *startsnotwithStructFields) []error {
+	return startsnotwithStructFieldsValidateContext(context.Background(), obj)
+}
+
+func startsnotwithStructFieldsValidateContext(ctx context.Context, obj *startsnotwithStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinIntMapPointer != nil && types.MapNotContains(*obj.FieldNinIntMapPointer, []int{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinIntMapPointer elements must not be one of '1' '2' '3'"))
+	var errs []error
+	if !(!types.HasPrefix(obj.FieldStartsnotwithString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldStartsnotwithString must not start with 'ab'"))
 	}
-	if !(obj.FieldNinInt8MapPointer != nil && types.MapNotContains(*obj.FieldNinInt8MapPointer, []int8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt8MapPointer elements must not be one of '1' '2' '3'"))
+	return errs
+}
+
+func startsnotwithStructFieldsValidateFields(obj *startsnotwithStructFields, fields ...string) []error {
+	return startsnotwithStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func startsnotwithStructFieldsValidateExcept(obj *startsnotwithStructFields, fields ...string) []error {
+	return startsnotwithStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func startsnotwithStructFieldsValidatePartialContext(ctx context.Context, obj *startsnotwithStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinInt16MapPointer != nil && types.MapNotContains(*obj.FieldNinInt16MapPointer, []int16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt16MapPointer elements must not be one of '1' '2' '3'"))
+	var errs []error
+	if selection.Has("FieldStartsnotwithString") {
+		if !(!types.HasPrefix(obj.FieldStartsnotwithString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldStartsnotwithString must not start with 'ab'"))
+		}
 	}
-	if !(obj.FieldNinInt32MapPointer != nil && types.MapNotContains(*obj.FieldNinInt32MapPointer, []int32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt32MapPointer elements must not be one of '1' '2' '3'"))
+	return errs
+}
+func startsnotwithStructFieldsPointerValidate(obj *startsnotwithStructFieldsPointer) []error {
+	return startsnotwithStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func startsnotwithStructFieldsPointerValidateContext(ctx context.Context, obj *startsnotwithStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinInt64MapPointer != nil && types.MapNotContains(*obj.FieldNinInt64MapPointer, []int64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinInt64MapPointer elements must not be one of '1' '2' '3'"))
+	var errs []error
+	if !(obj.FieldStartsnotwithStringPointer != nil && !types.HasPrefix(*obj.FieldStartsnotwithStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldStartsnotwithStringPointer must not start with 'ab'"))
 	}
-	if !(obj.FieldNinUintMapPointer != nil && types.MapNotContains(*obj.FieldNinUintMapPointer, []uint{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUintMapPointer elements must not be one of '1' '2' '3'"))
+	return errs
+}
+
+func startsnotwithStructFieldsPointerValidateFields(obj *startsnotwithStructFieldsPointer, fields ...string) []error {
+	return startsnotwithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func startsnotwithStructFieldsPointerValidateExcept(obj *startsnotwithStructFieldsPointer, fields ...string) []error {
+	return startsnotwithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func startsnotwithStructFieldsPointerValidatePartialContext(ctx context.Context, obj *startsnotwithStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUint8MapPointer != nil && types.MapNotContains(*obj.FieldNinUint8MapPointer, []uint8{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint8MapPointer elements must not be one of '1' '2' '3'"))
+	var errs []error
+	if selection.Has("FieldStartsnotwithStringPointer") {
+		if !(obj.FieldStartsnotwithStringPointer != nil && !types.HasPrefix(*obj.FieldStartsnotwithStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldStartsnotwithStringPointer must not start with 'ab'"))
+		}
 	}
-	if !(obj.FieldNinUint16MapPointer != nil && types.MapNotContains(*obj.FieldNinUint16MapPointer, []uint16{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint16MapPointer elements must not be one of '1' '2' '3'"))
+	return errs
+}
+func startswithStructFieldsValidate(obj *startswithStructFields) []error {
+	return startswithStructFieldsValidateContext(context.Background(), obj)
+}
+
+func startswithStructFieldsValidateContext(ctx context.Context, obj *startswithStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinUint32MapPointer != nil && types.MapNotContains(*obj.FieldNinUint32MapPointer, []uint32{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint32MapPointer elements must not be one of '1' '2' '3'"))
+	var errs []error
+	if !(types.HasPrefix(obj.FieldStartswithString, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldStartswithString must start with 'ab'"))
 	}
-	if !(obj.FieldNinUint64MapPointer != nil && types.MapNotContains(*obj.FieldNinUint64MapPointer, []uint64{1, 2, 3})) {
-		errs = append(errs, types.NewValidationError("FieldNinUint64MapPointer elements must not be one of '1' '2' '3'"))
+	return errs
+}
+
+func startswithStructFieldsValidateFields(obj *startswithStructFields, fields ...string) []error {
+	return startswithStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func startswithStructFieldsValidateExcept(obj *startswithStructFields, fields ...string) []error {
+	return startswithStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func startswithStructFieldsValidatePartialContext(ctx context.Context, obj *startswithStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinFloat32MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat32MapPointer, []float32{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat32MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	var errs []error
+	if selection.Has("FieldStartswithString") {
+		if !(types.HasPrefix(obj.FieldStartswithString, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldStartswithString must start with 'ab'"))
+		}
 	}
-	if !(obj.FieldNinFloat64MapPointer != nil && types.MapNotContains(*obj.FieldNinFloat64MapPointer, []float64{11.11, 22.22, 33.33})) {
-		errs = append(errs, types.NewValidationError("FieldNinFloat64MapPointer elements must not be one of '11.11' '22.22' '33.33'"))
+	return errs
+}
+func startswithStructFieldsPointerValidate(obj *startswithStructFieldsPointer) []error {
+	return startswithStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func startswithStructFieldsPointerValidateContext(ctx context.Context, obj *startswithStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldNinBoolMapPointer != nil && types.MapNotContains(*obj.FieldNinBoolMapPointer, []bool{false})) {
-		errs = append(errs, types.NewValidationError("FieldNinBoolMapPointer elements must not be one of 'false'"))
+	var errs []error
+	if !(obj.FieldStartswithStringPointer != nil && types.HasPrefix(*obj.FieldStartswithStringPointer, "ab")) {
+		errs = append(errs, types.NewValidationError("FieldStartswithStringPointer must start with 'ab'"))
 	}
 	return errs
 }
-func requiredStructFieldsValidate(obj *requiredStructFields) []error {
-	var errs []error
-	if !(obj.FieldRequiredString != "") {
-		errs = append(errs, types.NewValidationError("FieldRequiredString is required"))
+
+func startswithStructFieldsPointerValidateFields(obj *startswithStructFieldsPointer, fields ...string) []error {
+	return startswithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func startswithStructFieldsPointerValidateExcept(obj *startswithStructFieldsPointer, fields ...string) []error {
+	return startswithStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func startswithStructFieldsPointerValidatePartialContext(ctx context.Context, obj *startswithStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt is required"))
+	var errs []error
+	if selection.Has("FieldStartswithStringPointer") {
+		if !(obj.FieldStartswithStringPointer != nil && types.HasPrefix(*obj.FieldStartswithStringPointer, "ab")) {
+			errs = append(errs, types.NewValidationError("FieldStartswithStringPointer must start with 'ab'"))
+		}
 	}
-	if !(obj.FieldRequiredInt8 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8 is required"))
+	return errs
+}
+func timezoneStructFieldsValidate(obj *timezoneStructFields) []error {
+	return timezoneStructFieldsValidateContext(context.Background(), obj)
+}
+
+func timezoneStructFieldsValidateContext(ctx context.Context, obj *timezoneStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt16 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16 is required"))
+	var errs []error
+	if !(types.IsValidTimezone(obj.FieldTimezoneString)) {
+		errs = append(errs, types.NewValidationError("FieldTimezoneString must be a valid time zone"))
 	}
-	if !(obj.FieldRequiredInt32 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32 is required"))
+	return errs
+}
+
+func timezoneStructFieldsValidateFields(obj *timezoneStructFields, fields ...string) []error {
+	return timezoneStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func timezoneStructFieldsValidateExcept(obj *timezoneStructFields, fields ...string) []error {
+	return timezoneStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func timezoneStructFieldsValidatePartialContext(ctx context.Context, obj *timezoneStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt64 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64 is required"))
+	var errs []error
+	if selection.Has("FieldTimezoneString") {
+		if !(types.IsValidTimezone(obj.FieldTimezoneString)) {
+			errs = append(errs, types.NewValidationError("FieldTimezoneString must be a valid time zone"))
+		}
 	}
-	if !(obj.FieldRequiredUint != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint is required"))
+	return errs
+}
+func timezoneStructFieldsPointerValidate(obj *timezoneStructFieldsPointer) []error {
+	return timezoneStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func timezoneStructFieldsPointerValidateContext(ctx context.Context, obj *timezoneStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint8 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8 is required"))
+	var errs []error
+	if !(obj.FieldTimezoneStringPointer != nil && types.IsValidTimezone(*obj.FieldTimezoneStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldTimezoneStringPointer must be a valid time zone"))
 	}
-	if !(obj.FieldRequiredUint16 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16 is required"))
+	return errs
+}
+
+func timezoneStructFieldsPointerValidateFields(obj *timezoneStructFieldsPointer, fields ...string) []error {
+	return timezoneStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func timezoneStructFieldsPointerValidateExcept(obj *timezoneStructFieldsPointer, fields ...string) []error {
+	return timezoneStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func timezoneStructFieldsPointerValidatePartialContext(ctx context.Context, obj *timezoneStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint32 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32 is required"))
+	var errs []error
+	if selection.Has("FieldTimezoneStringPointer") {
+		if !(obj.FieldTimezoneStringPointer != nil && types.IsValidTimezone(*obj.FieldTimezoneStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldTimezoneStringPointer must be a valid time zone"))
+		}
 	}
-	if !(obj.FieldRequiredUint64 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64 is required"))
+	return errs
+}
+func ulidStructFieldsValidate(obj *ulidStructFields) []error {
+	return ulidStructFieldsValidateContext(context.Background(), obj)
+}
+
+func ulidStructFieldsValidateContext(ctx context.Context, obj *ulidStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredFloat32 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32 is required"))
+	var errs []error
+	if !(types.IsValidULID(obj.FieldUlidString)) {
+		errs = append(errs, types.NewValidationError("FieldUlidString must be a valid ULID"))
 	}
-	if !(obj.FieldRequiredFloat64 != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64 is required"))
+	return errs
+}
+
+func ulidStructFieldsValidateFields(obj *ulidStructFields, fields ...string) []error {
+	return ulidStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ulidStructFieldsValidateExcept(obj *ulidStructFields, fields ...string) []error {
+	return ulidStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ulidStructFieldsValidatePartialContext(ctx context.Context, obj *ulidStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredBool != false) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBool is required"))
+	var errs []error
+	if selection.Has("FieldUlidString") {
+		if !(types.IsValidULID(obj.FieldUlidString)) {
+			errs = append(errs, types.NewValidationError("FieldUlidString must be a valid ULID"))
+		}
 	}
-	if !(len(obj.FieldRequiredStringSlice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringSlice must not be empty"))
+	return errs
+}
+func ulidStructFieldsPointerValidate(obj *ulidStructFieldsPointer) []error {
+	return ulidStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func ulidStructFieldsPointerValidateContext(ctx context.Context, obj *ulidStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredIntSlice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntSlice must not be empty"))
+	var errs []error
+	if !(obj.FieldUlidStringPointer != nil && types.IsValidULID(*obj.FieldUlidStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUlidStringPointer must be a valid ULID"))
 	}
-	if !(len(obj.FieldRequiredInt8Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8Slice must not be empty"))
+	return errs
+}
+
+func ulidStructFieldsPointerValidateFields(obj *ulidStructFieldsPointer, fields ...string) []error {
+	return ulidStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func ulidStructFieldsPointerValidateExcept(obj *ulidStructFieldsPointer, fields ...string) []error {
+	return ulidStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func ulidStructFieldsPointerValidatePartialContext(ctx context.Context, obj *ulidStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredInt16Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16Slice must not be empty"))
+	var errs []error
+	if selection.Has("FieldUlidStringPointer") {
+		if !(obj.FieldUlidStringPointer != nil && types.IsValidULID(*obj.FieldUlidStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUlidStringPointer must be a valid ULID"))
+		}
 	}
-	if !(len(obj.FieldRequiredInt32Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32Slice must not be empty"))
+	return errs
+}
+func upcStructFieldsValidate(obj *upcStructFields) []error {
+	return upcStructFieldsValidateContext(context.Background(), obj)
+}
+
+func upcStructFieldsValidateContext(ctx context.Context, obj *upcStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredInt64Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64Slice must not be empty"))
+	var errs []error
+	if !(types.IsValidUPC(obj.FieldUpcString)) {
+		errs = append(errs, types.NewValidationError("FieldUpcString must be a valid UPC"))
 	}
-	if !(len(obj.FieldRequiredUintSlice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintSlice must not be empty"))
+	return errs
+}
+
+func upcStructFieldsValidateFields(obj *upcStructFields, fields ...string) []error {
+	return upcStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func upcStructFieldsValidateExcept(obj *upcStructFields, fields ...string) []error {
+	return upcStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func upcStructFieldsValidatePartialContext(ctx context.Context, obj *upcStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredUint8Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8Slice must not be empty"))
+	var errs []error
+	if selection.Has("FieldUpcString") {
+		if !(types.IsValidUPC(obj.FieldUpcString)) {
+			errs = append(errs, types.NewValidationError("FieldUpcString must be a valid UPC"))
+		}
 	}
-	if !(len(obj.FieldRequiredUint16Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16Slice must not be empty"))
+	return errs
+}
+func upcStructFieldsPointerValidate(obj *upcStructFieldsPointer) []error {
+	return upcStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func upcStructFieldsPointerValidateContext(ctx context.Context, obj *upcStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredUint32Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32Slice must not be empty"))
+	var errs []error
+	if !(obj.FieldUpcStringPointer != nil && types.IsValidUPC(*obj.FieldUpcStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUpcStringPointer must be a valid UPC"))
 	}
-	if !(len(obj.FieldRequiredUint64Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64Slice must not be empty"))
+	return errs
+}
+
+func upcStructFieldsPointerValidateFields(obj *upcStructFieldsPointer, fields ...string) []error {
+	return upcStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func upcStructFieldsPointerValidateExcept(obj *upcStructFieldsPointer, fields ...string) []error {
+	return upcStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func upcStructFieldsPointerValidatePartialContext(ctx context.Context, obj *upcStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredFloat32Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Slice must not be empty"))
+	var errs []error
+	if selection.Has("FieldUpcStringPointer") {
+		if !(obj.FieldUpcStringPointer != nil && types.IsValidUPC(*obj.FieldUpcStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUpcStringPointer must be a valid UPC"))
+		}
 	}
-	if !(len(obj.FieldRequiredFloat64Slice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Slice must not be empty"))
+	return errs
+}
+func uppercaseStructFieldsValidate(obj *uppercaseStructFields) []error {
+	return uppercaseStructFieldsValidateContext(context.Background(), obj)
+}
+
+func uppercaseStructFieldsValidateContext(ctx context.Context, obj *uppercaseStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredBoolSlice) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolSlice must not be empty"))
+	var errs []error
+	if !(types.IsUppercase(obj.FieldUppercaseString)) {
+		errs = append(errs, types.NewValidationError("FieldUppercaseString must be uppercase"))
 	}
-	if !(len(obj.FieldRequiredStringMap) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringMap must not be empty"))
+	return errs
+}
+
+func uppercaseStructFieldsValidateFields(obj *uppercaseStructFields, fields ...string) []error {
+	return uppercaseStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uppercaseStructFieldsValidateExcept(obj *uppercaseStructFields, fields ...string) []error {
+	return uppercaseStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uppercaseStructFieldsValidatePartialContext(ctx context.Context, obj *uppercaseStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredIntMap) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntMap must not be empty"))
+	var errs []error
+	if selection.Has("FieldUppercaseString") {
+		if !(types.IsUppercase(obj.FieldUppercaseString)) {
+			errs = append(errs, types.NewValidationError("FieldUppercaseString must be uppercase"))
+		}
 	}
-	if !(len(obj.FieldRequiredInt8Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8Map must not be empty"))
+	return errs
+}
+func uppercaseStructFieldsPointerValidate(obj *uppercaseStructFieldsPointer) []error {
+	return uppercaseStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uppercaseStructFieldsPointerValidateContext(ctx context.Context, obj *uppercaseStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredInt16Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16Map must not be empty"))
+	var errs []error
+	if !(obj.FieldUppercaseStringPointer != nil && types.IsUppercase(*obj.FieldUppercaseStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUppercaseStringPointer must be uppercase"))
 	}
-	if !(len(obj.FieldRequiredInt32Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32Map must not be empty"))
+	return errs
+}
+
+func uppercaseStructFieldsPointerValidateFields(obj *uppercaseStructFieldsPointer, fields ...string) []error {
+	return uppercaseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uppercaseStructFieldsPointerValidateExcept(obj *uppercaseStructFieldsPointer, fields ...string) []error {
+	return uppercaseStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uppercaseStructFieldsPointerValidatePartialContext(ctx context.Context, obj *uppercaseStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredInt64Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64Map must not be empty"))
+	var errs []error
+	if selection.Has("FieldUppercaseStringPointer") {
+		if !(obj.FieldUppercaseStringPointer != nil && types.IsUppercase(*obj.FieldUppercaseStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUppercaseStringPointer must be uppercase"))
+		}
 	}
-	if !(len(obj.FieldRequiredUintMap) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintMap must not be empty"))
+	return errs
+}
+func uriStructFieldsValidate(obj *uriStructFields) []error {
+	return uriStructFieldsValidateContext(context.Background(), obj)
+}
+
+func uriStructFieldsValidateContext(ctx context.Context, obj *uriStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredUint8Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8Map must not be empty"))
+	var errs []error
+	if !(types.IsValidURI(obj.FieldUriString)) {
+		errs = append(errs, types.NewValidationError("FieldUriString must be a valid URI"))
 	}
-	if !(len(obj.FieldRequiredUint16Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16Map must not be empty"))
+	return errs
+}
+
+func uriStructFieldsValidateFields(obj *uriStructFields, fields ...string) []error {
+	return uriStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uriStructFieldsValidateExcept(obj *uriStructFields, fields ...string) []error {
+	return uriStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uriStructFieldsValidatePartialContext(ctx context.Context, obj *uriStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredUint32Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32Map must not be empty"))
+	var errs []error
+	if selection.Has("FieldUriString") {
+		if !(types.IsValidURI(obj.FieldUriString)) {
+			errs = append(errs, types.NewValidationError("FieldUriString must be a valid URI"))
+		}
 	}
-	if !(len(obj.FieldRequiredUint64Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64Map must not be empty"))
+	return errs
+}
+func uriStructFieldsPointerValidate(obj *uriStructFieldsPointer) []error {
+	return uriStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uriStructFieldsPointerValidateContext(ctx context.Context, obj *uriStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredFloat32Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Map must not be empty"))
+	var errs []error
+	if !(obj.FieldUriStringPointer != nil && types.IsValidURI(*obj.FieldUriStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUriStringPointer must be a valid URI"))
 	}
-	if !(len(obj.FieldRequiredFloat64Map) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Map must not be empty"))
+	return errs
+}
+
+func uriStructFieldsPointerValidateFields(obj *uriStructFieldsPointer, fields ...string) []error {
+	return uriStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uriStructFieldsPointerValidateExcept(obj *uriStructFieldsPointer, fields ...string) []error {
+	return uriStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uriStructFieldsPointerValidatePartialContext(ctx context.Context, obj *uriStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(len(obj.FieldRequiredBoolMap) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolMap must not be empty"))
+	var errs []error
+	if selection.Has("FieldUriStringPointer") {
+		if !(obj.FieldUriStringPointer != nil && types.IsValidURI(*obj.FieldUriStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUriStringPointer must be a valid URI"))
+		}
 	}
 	return errs
 }
-func requiredStructFieldsPointerValidate(obj *requiredStructFieldsPointer) []error {
-	var errs []error
-	if !(obj.FieldRequiredStringPointer != nil && *obj.FieldRequiredStringPointer != "") {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringPointer is required"))
+func urlStructFieldsValidate(obj *urlStructFields) []error {
+	return urlStructFieldsValidateContext(context.Background(), obj)
+}
+
+func urlStructFieldsValidateContext(ctx context.Context, obj *urlStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredIntPointer != nil && *obj.FieldRequiredIntPointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntPointer is required"))
+	var errs []error
+	if !(types.IsValidURL(obj.FieldUrlString)) {
+		errs = append(errs, types.NewValidationError("FieldUrlString must be a valid URL"))
 	}
-	if !(obj.FieldRequiredInt8Pointer != nil && *obj.FieldRequiredInt8Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8Pointer is required"))
+	return errs
+}
+
+func urlStructFieldsValidateFields(obj *urlStructFields, fields ...string) []error {
+	return urlStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func urlStructFieldsValidateExcept(obj *urlStructFields, fields ...string) []error {
+	return urlStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func urlStructFieldsValidatePartialContext(ctx context.Context, obj *urlStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt16Pointer != nil && *obj.FieldRequiredInt16Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16Pointer is required"))
+	var errs []error
+	if selection.Has("FieldUrlString") {
+		if !(types.IsValidURL(obj.FieldUrlString)) {
+			errs = append(errs, types.NewValidationError("FieldUrlString must be a valid URL"))
+		}
 	}
-	if !(obj.FieldRequiredInt32Pointer != nil && *obj.FieldRequiredInt32Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32Pointer is required"))
+	return errs
+}
+func urlStructFieldsPointerValidate(obj *urlStructFieldsPointer) []error {
+	return urlStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func urlStructFieldsPointerValidateContext(ctx context.Context, obj *urlStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt64Pointer != nil && *obj.FieldRequiredInt64Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64Pointer is required"))
+	var errs []error
+	if !(obj.FieldUrlStringPointer != nil && types.IsValidURL(*obj.FieldUrlStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUrlStringPointer must be a valid URL"))
 	}
-	if !(obj.FieldRequiredUintPointer != nil && *obj.FieldRequiredUintPointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintPointer is required"))
+	return errs
+}
+
+func urlStructFieldsPointerValidateFields(obj *urlStructFieldsPointer, fields ...string) []error {
+	return urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func urlStructFieldsPointerValidateExcept(obj *urlStructFieldsPointer, fields ...string) []error {
+	return urlStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func urlStructFieldsPointerValidatePartialContext(ctx context.Context, obj *urlStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint8Pointer != nil && *obj.FieldRequiredUint8Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8Pointer is required"))
+	var errs []error
+	if selection.Has("FieldUrlStringPointer") {
+		if !(obj.FieldUrlStringPointer != nil && types.IsValidURL(*obj.FieldUrlStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUrlStringPointer must be a valid URL"))
+		}
 	}
-	if !(obj.FieldRequiredUint16Pointer != nil && *obj.FieldRequiredUint16Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16Pointer is required"))
+	return errs
+}
+func url_schemeStructFieldsValidate(obj *url_schemeStructFields) []error {
+	return url_schemeStructFieldsValidateContext(context.Background(), obj)
+}
+
+func url_schemeStructFieldsValidateContext(ctx context.Context, obj *url_schemeStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint32Pointer != nil && *obj.FieldRequiredUint32Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32Pointer is required"))
+	var errs []error
+	if !(types.IsValidURLWithScheme(obj.FieldUrl_schemeString, []string{"https", "wss"})) {
+		errs = append(errs, types.NewValidationError("FieldUrl_schemeString must be a valid URL with scheme 'https' 'wss'"))
 	}
-	if !(obj.FieldRequiredUint64Pointer != nil && *obj.FieldRequiredUint64Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64Pointer is required"))
+	return errs
+}
+
+func url_schemeStructFieldsValidateFields(obj *url_schemeStructFields, fields ...string) []error {
+	return url_schemeStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func url_schemeStructFieldsValidateExcept(obj *url_schemeStructFields, fields ...string) []error {
+	return url_schemeStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func url_schemeStructFieldsValidatePartialContext(ctx context.Context, obj *url_schemeStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredFloat32Pointer != nil && *obj.FieldRequiredFloat32Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32Pointer is required"))
+	var errs []error
+	if selection.Has("FieldUrl_schemeString") {
+		if !(types.IsValidURLWithScheme(obj.FieldUrl_schemeString, []string{"https", "wss"})) {
+			errs = append(errs, types.NewValidationError("FieldUrl_schemeString must be a valid URL with scheme 'https' 'wss'"))
+		}
 	}
-	if !(obj.FieldRequiredFloat64Pointer != nil && *obj.FieldRequiredFloat64Pointer != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64Pointer is required"))
+	return errs
+}
+func url_schemeStructFieldsPointerValidate(obj *url_schemeStructFieldsPointer) []error {
+	return url_schemeStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func url_schemeStructFieldsPointerValidateContext(ctx context.Context, obj *url_schemeStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredBoolPointer != nil && *obj.FieldRequiredBoolPointer != false) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolPointer is required"))
+	var errs []error
+	if !(obj.FieldUrl_schemeStringPointer != nil && types.IsValidURLWithScheme(*obj.FieldUrl_schemeStringPointer, []string{"https", "wss"})) {
+		errs = append(errs, types.NewValidationError("FieldUrl_schemeStringPointer must be a valid URL with scheme 'https' 'wss'"))
 	}
-	if !(obj.FieldRequiredStringSlicePointer != nil && len(*obj.FieldRequiredStringSlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringSlicePointer must not be empty"))
+	return errs
+}
+
+func url_schemeStructFieldsPointerValidateFields(obj *url_schemeStructFieldsPointer, fields ...string) []error {
+	return url_schemeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func url_schemeStructFieldsPointerValidateExcept(obj *url_schemeStructFieldsPointer, fields ...string) []error {
+	return url_schemeStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func url_schemeStructFieldsPointerValidatePartialContext(ctx context.Context, obj *url_schemeStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredIntSlicePointer != nil && len(*obj.FieldRequiredIntSlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntSlicePointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUrl_schemeStringPointer") {
+		if !(obj.FieldUrl_schemeStringPointer != nil && types.IsValidURLWithScheme(*obj.FieldUrl_schemeStringPointer, []string{"https", "wss"})) {
+			errs = append(errs, types.NewValidationError("FieldUrl_schemeStringPointer must be a valid URL with scheme 'https' 'wss'"))
+		}
 	}
-	if !(obj.FieldRequiredInt8SlicePointer != nil && len(*obj.FieldRequiredInt8SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8SlicePointer must not be empty"))
+	return errs
+}
+func uuid3StructFieldsValidate(obj *uuid3StructFields) []error {
+	return uuid3StructFieldsValidateContext(context.Background(), obj)
+}
+
+func uuid3StructFieldsValidateContext(ctx context.Context, obj *uuid3StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt16SlicePointer != nil && len(*obj.FieldRequiredInt16SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16SlicePointer must not be empty"))
+	var errs []error
+	if !(types.IsValidUUID3(obj.FieldUuid3String)) {
+		errs = append(errs, types.NewValidationError("FieldUuid3String must be a valid UUID v3"))
 	}
-	if !(obj.FieldRequiredInt32SlicePointer != nil && len(*obj.FieldRequiredInt32SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32SlicePointer must not be empty"))
+	return errs
+}
+
+func uuid3StructFieldsValidateFields(obj *uuid3StructFields, fields ...string) []error {
+	return uuid3StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid3StructFieldsValidateExcept(obj *uuid3StructFields, fields ...string) []error {
+	return uuid3StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid3StructFieldsValidatePartialContext(ctx context.Context, obj *uuid3StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt64SlicePointer != nil && len(*obj.FieldRequiredInt64SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64SlicePointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid3String") {
+		if !(types.IsValidUUID3(obj.FieldUuid3String)) {
+			errs = append(errs, types.NewValidationError("FieldUuid3String must be a valid UUID v3"))
+		}
 	}
-	if !(obj.FieldRequiredUintSlicePointer != nil && len(*obj.FieldRequiredUintSlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintSlicePointer must not be empty"))
+	return errs
+}
+func uuid3StructFieldsPointerValidate(obj *uuid3StructFieldsPointer) []error {
+	return uuid3StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uuid3StructFieldsPointerValidateContext(ctx context.Context, obj *uuid3StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint8SlicePointer != nil && len(*obj.FieldRequiredUint8SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8SlicePointer must not be empty"))
+	var errs []error
+	if !(obj.FieldUuid3StringPointer != nil && types.IsValidUUID3(*obj.FieldUuid3StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUuid3StringPointer must be a valid UUID v3"))
 	}
-	if !(obj.FieldRequiredUint16SlicePointer != nil && len(*obj.FieldRequiredUint16SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16SlicePointer must not be empty"))
+	return errs
+}
+
+func uuid3StructFieldsPointerValidateFields(obj *uuid3StructFieldsPointer, fields ...string) []error {
+	return uuid3StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid3StructFieldsPointerValidateExcept(obj *uuid3StructFieldsPointer, fields ...string) []error {
+	return uuid3StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid3StructFieldsPointerValidatePartialContext(ctx context.Context, obj *uuid3StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint32SlicePointer != nil && len(*obj.FieldRequiredUint32SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32SlicePointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid3StringPointer") {
+		if !(obj.FieldUuid3StringPointer != nil && types.IsValidUUID3(*obj.FieldUuid3StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUuid3StringPointer must be a valid UUID v3"))
+		}
 	}
-	if !(obj.FieldRequiredUint64SlicePointer != nil && len(*obj.FieldRequiredUint64SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64SlicePointer must not be empty"))
+	return errs
+}
+func uuid4StructFieldsValidate(obj *uuid4StructFields) []error {
+	return uuid4StructFieldsValidateContext(context.Background(), obj)
+}
+
+func uuid4StructFieldsValidateContext(ctx context.Context, obj *uuid4StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredFloat32SlicePointer != nil && len(*obj.FieldRequiredFloat32SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32SlicePointer must not be empty"))
+	var errs []error
+	if !(types.IsValidUUID4(obj.FieldUuid4String)) {
+		errs = append(errs, types.NewValidationError("FieldUuid4String must be a valid UUID v4"))
 	}
-	if !(obj.FieldRequiredFloat64SlicePointer != nil && len(*obj.FieldRequiredFloat64SlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64SlicePointer must not be empty"))
+	return errs
+}
+
+func uuid4StructFieldsValidateFields(obj *uuid4StructFields, fields ...string) []error {
+	return uuid4StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid4StructFieldsValidateExcept(obj *uuid4StructFields, fields ...string) []error {
+	return uuid4StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid4StructFieldsValidatePartialContext(ctx context.Context, obj *uuid4StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredBoolSlicePointer != nil && len(*obj.FieldRequiredBoolSlicePointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolSlicePointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid4String") {
+		if !(types.IsValidUUID4(obj.FieldUuid4String)) {
+			errs = append(errs, types.NewValidationError("FieldUuid4String must be a valid UUID v4"))
+		}
 	}
-	if !(obj.FieldRequiredStringArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringArrayPointer must not be empty"))
+	return errs
+}
+func uuid4StructFieldsPointerValidate(obj *uuid4StructFieldsPointer) []error {
+	return uuid4StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uuid4StructFieldsPointerValidateContext(ctx context.Context, obj *uuid4StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredIntArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntArrayPointer must not be empty"))
+	var errs []error
+	if !(obj.FieldUuid4StringPointer != nil && types.IsValidUUID4(*obj.FieldUuid4StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUuid4StringPointer must be a valid UUID v4"))
 	}
-	if !(obj.FieldRequiredInt8ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8ArrayPointer must not be empty"))
+	return errs
+}
+
+func uuid4StructFieldsPointerValidateFields(obj *uuid4StructFieldsPointer, fields ...string) []error {
+	return uuid4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid4StructFieldsPointerValidateExcept(obj *uuid4StructFieldsPointer, fields ...string) []error {
+	return uuid4StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid4StructFieldsPointerValidatePartialContext(ctx context.Context, obj *uuid4StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt16ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16ArrayPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid4StringPointer") {
+		if !(obj.FieldUuid4StringPointer != nil && types.IsValidUUID4(*obj.FieldUuid4StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUuid4StringPointer must be a valid UUID v4"))
+		}
 	}
-	if !(obj.FieldRequiredInt32ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32ArrayPointer must not be empty"))
+	return errs
+}
+func uuid5StructFieldsValidate(obj *uuid5StructFields) []error {
+	return uuid5StructFieldsValidateContext(context.Background(), obj)
+}
+
+func uuid5StructFieldsValidateContext(ctx context.Context, obj *uuid5StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt64ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64ArrayPointer must not be empty"))
+	var errs []error
+	if !(types.IsValidUUID5(obj.FieldUuid5String)) {
+		errs = append(errs, types.NewValidationError("FieldUuid5String must be a valid UUID v5"))
 	}
-	if !(obj.FieldRequiredUintArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintArrayPointer must not be empty"))
+	return errs
+}
+
+func uuid5StructFieldsValidateFields(obj *uuid5StructFields, fields ...string) []error {
+	return uuid5StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid5StructFieldsValidateExcept(obj *uuid5StructFields, fields ...string) []error {
+	return uuid5StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid5StructFieldsValidatePartialContext(ctx context.Context, obj *uuid5StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint8ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8ArrayPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid5String") {
+		if !(types.IsValidUUID5(obj.FieldUuid5String)) {
+			errs = append(errs, types.NewValidationError("FieldUuid5String must be a valid UUID v5"))
+		}
 	}
-	if !(obj.FieldRequiredUint16ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16ArrayPointer must not be empty"))
+	return errs
+}
+func uuid5StructFieldsPointerValidate(obj *uuid5StructFieldsPointer) []error {
+	return uuid5StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uuid5StructFieldsPointerValidateContext(ctx context.Context, obj *uuid5StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint32ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32ArrayPointer must not be empty"))
+	var errs []error
+	if !(obj.FieldUuid5StringPointer != nil && types.IsValidUUID5(*obj.FieldUuid5StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUuid5StringPointer must be a valid UUID v5"))
 	}
-	if !(obj.FieldRequiredUint64ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64ArrayPointer must not be empty"))
+	return errs
+}
+
+func uuid5StructFieldsPointerValidateFields(obj *uuid5StructFieldsPointer, fields ...string) []error {
+	return uuid5StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid5StructFieldsPointerValidateExcept(obj *uuid5StructFieldsPointer, fields ...string) []error {
+	return uuid5StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid5StructFieldsPointerValidatePartialContext(ctx context.Context, obj *uuid5StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredFloat32ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32ArrayPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid5StringPointer") {
+		if !(obj.FieldUuid5StringPointer != nil && types.IsValidUUID5(*obj.FieldUuid5StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUuid5StringPointer must be a valid UUID v5"))
+		}
 	}
-	if !(obj.FieldRequiredFloat64ArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64ArrayPointer must not be empty"))
+	return errs
+}
+func uuid7StructFieldsValidate(obj *uuid7StructFields) []error {
+	return uuid7StructFieldsValidateContext(context.Background(), obj)
+}
+
+func uuid7StructFieldsValidateContext(ctx context.Context, obj *uuid7StructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredBoolArrayPointer != nil) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolArrayPointer must not be empty"))
+	var errs []error
+	if !(types.IsValidUUID7(obj.FieldUuid7String)) {
+		errs = append(errs, types.NewValidationError("FieldUuid7String must be a valid UUID v7"))
 	}
-	if !(obj.FieldRequiredStringMapPointer != nil && len(*obj.FieldRequiredStringMapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredStringMapPointer must not be empty"))
+	return errs
+}
+
+func uuid7StructFieldsValidateFields(obj *uuid7StructFields, fields ...string) []error {
+	return uuid7StructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid7StructFieldsValidateExcept(obj *uuid7StructFields, fields ...string) []error {
+	return uuid7StructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid7StructFieldsValidatePartialContext(ctx context.Context, obj *uuid7StructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredIntMapPointer != nil && len(*obj.FieldRequiredIntMapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredIntMapPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid7String") {
+		if !(types.IsValidUUID7(obj.FieldUuid7String)) {
+			errs = append(errs, types.NewValidationError("FieldUuid7String must be a valid UUID v7"))
+		}
 	}
-	if !(obj.FieldRequiredInt8MapPointer != nil && len(*obj.FieldRequiredInt8MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt8MapPointer must not be empty"))
+	return errs
+}
+func uuid7StructFieldsPointerValidate(obj *uuid7StructFieldsPointer) []error {
+	return uuid7StructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uuid7StructFieldsPointerValidateContext(ctx context.Context, obj *uuid7StructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt16MapPointer != nil && len(*obj.FieldRequiredInt16MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt16MapPointer must not be empty"))
+	var errs []error
+	if !(obj.FieldUuid7StringPointer != nil && types.IsValidUUID7(*obj.FieldUuid7StringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUuid7StringPointer must be a valid UUID v7"))
 	}
-	if !(obj.FieldRequiredInt32MapPointer != nil && len(*obj.FieldRequiredInt32MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt32MapPointer must not be empty"))
+	return errs
+}
+
+func uuid7StructFieldsPointerValidateFields(obj *uuid7StructFieldsPointer, fields ...string) []error {
+	return uuid7StructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuid7StructFieldsPointerValidateExcept(obj *uuid7StructFieldsPointer, fields ...string) []error {
+	return uuid7StructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuid7StructFieldsPointerValidatePartialContext(ctx context.Context, obj *uuid7StructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredInt64MapPointer != nil && len(*obj.FieldRequiredInt64MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredInt64MapPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuid7StringPointer") {
+		if !(obj.FieldUuid7StringPointer != nil && types.IsValidUUID7(*obj.FieldUuid7StringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUuid7StringPointer must be a valid UUID v7"))
+		}
 	}
-	if !(obj.FieldRequiredUintMapPointer != nil && len(*obj.FieldRequiredUintMapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUintMapPointer must not be empty"))
+	return errs
+}
+func uuidStructFieldsValidate(obj *uuidStructFields) []error {
+	return uuidStructFieldsValidateContext(context.Background(), obj)
+}
+
+func uuidStructFieldsValidateContext(ctx context.Context, obj *uuidStructFields) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint8MapPointer != nil && len(*obj.FieldRequiredUint8MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint8MapPointer must not be empty"))
+	var errs []error
+	if !(types.IsValidUUID(obj.FieldUuidString)) {
+		errs = append(errs, types.NewValidationError("FieldUuidString must be a valid UUID"))
 	}
-	if !(obj.FieldRequiredUint16MapPointer != nil && len(*obj.FieldRequiredUint16MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint16MapPointer must not be empty"))
+	return errs
+}
+
+func uuidStructFieldsValidateFields(obj *uuidStructFields, fields ...string) []error {
+	return uuidStructFieldsValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuidStructFieldsValidateExcept(obj *uuidStructFields, fields ...string) []error {
+	return uuidStructFieldsValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuidStructFieldsValidatePartialContext(ctx context.Context, obj *uuidStructFields, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredUint32MapPointer != nil && len(*obj.FieldRequiredUint32MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint32MapPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuidString") {
+		if !(types.IsValidUUID(obj.FieldUuidString)) {
+			errs = append(errs, types.NewValidationError("FieldUuidString must be a valid UUID"))
+		}
 	}
-	if !(obj.FieldRequiredUint64MapPointer != nil && len(*obj.FieldRequiredUint64MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredUint64MapPointer must not be empty"))
+	return errs
+}
+func uuidStructFieldsPointerValidate(obj *uuidStructFieldsPointer) []error {
+	return uuidStructFieldsPointerValidateContext(context.Background(), obj)
+}
+
+func uuidStructFieldsPointerValidateContext(ctx context.Context, obj *uuidStructFieldsPointer) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredFloat32MapPointer != nil && len(*obj.FieldRequiredFloat32MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat32MapPointer must not be empty"))
+	var errs []error
+	if !(obj.FieldUuidStringPointer != nil && types.IsValidUUID(*obj.FieldUuidStringPointer)) {
+		errs = append(errs, types.NewValidationError("FieldUuidStringPointer must be a valid UUID"))
 	}
-	if !(obj.FieldRequiredFloat64MapPointer != nil && len(*obj.FieldRequiredFloat64MapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredFloat64MapPointer must not be empty"))
+	return errs
+}
+
+func uuidStructFieldsPointerValidateFields(obj *uuidStructFieldsPointer, fields ...string) []error {
+	return uuidStructFieldsPointerValidatePartialContext(context.Background(), obj, types.SelectFields(fields...))
+}
+
+func uuidStructFieldsPointerValidateExcept(obj *uuidStructFieldsPointer, fields ...string) []error {
+	return uuidStructFieldsPointerValidatePartialContext(context.Background(), obj, types.ExceptFields(fields...))
+}
+
+func uuidStructFieldsPointerValidatePartialContext(ctx context.Context, obj *uuidStructFieldsPointer, selection types.FieldSelection) []error {
+	if err := ctx.Err(); err != nil {
+		return []error{err}
 	}
-	if !(obj.FieldRequiredBoolMapPointer != nil && len(*obj.FieldRequiredBoolMapPointer) != 0) {
-		errs = append(errs, types.NewValidationError("FieldRequiredBoolMapPointer must not be empty"))
+	var errs []error
+	if selection.Has("FieldUuidStringPointer") {
+		if !(obj.FieldUuidStringPointer != nil && types.IsValidUUID(*obj.FieldUuidStringPointer)) {
+			errs = append(errs, types.NewValidationError("FieldUuidStringPointer must be a valid UUID"))
+		}
 	}
 	return errs
 }
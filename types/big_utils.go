@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ParseBigInt parses a base 10 integer of any size (e.g. -12345678901234567890).
+func ParseBigInt(s string) (*big.Int, bool) {
+	return new(big.Int).SetString(s, 10)
+}
+
+// ParseBigFloat parses a float with the default precision of big.Float (e.g. 1234.5678 or 1e-30).
+func ParseBigFloat(s string) (*big.Float, bool) {
+	return new(big.Float).SetString(s)
+}
+
+// ParseBigRat parses a fraction or a decimal number as an exact rational number (e.g. 1/3 or 0.1).
+func ParseBigRat(s string) (*big.Rat, bool) {
+	return new(big.Rat).SetString(s)
+}
+
+// MustParseBigInt is like ParseBigInt but panics if the value is invalid.
+// It's used by the generated code with values checked at generation time.
+func MustParseBigInt(s string) *big.Int {
+	n, ok := ParseBigInt(s)
+	if !ok {
+		panic(fmt.Sprintf("types: invalid big.Int %q", s))
+	}
+
+	return n
+}
+
+// MustParseBigFloat is like ParseBigFloat but panics if the value is invalid.
+func MustParseBigFloat(s string) *big.Float {
+	f, ok := ParseBigFloat(s)
+	if !ok {
+		panic(fmt.Sprintf("types: invalid big.Float %q", s))
+	}
+
+	return f
+}
+
+// MustParseBigRat is like ParseBigRat but panics if the value is invalid.
+func MustParseBigRat(s string) *big.Rat {
+	r, ok := ParseBigRat(s)
+	if !ok {
+		panic(fmt.Sprintf("types: invalid big.Rat %q", s))
+	}
+
+	return r
+}
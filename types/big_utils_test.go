@@ -0,0 +1,67 @@
+package types
+
+import "testing"
+
+func TestParseBigNumbers(t *testing.T) {
+	tests := []struct {
+		name    string
+		parse   func(string) bool
+		valid   []string
+		invalid []string
+	}{
+		{
+			name:    "big.Int",
+			parse:   func(s string) bool { _, ok := ParseBigInt(s); return ok },
+			valid:   []string{"0", "-1", "+42", "123456789012345678901234567890"},
+			invalid: []string{"", "1.5", "1e3", "0x10", "abc"},
+		},
+		{
+			name:    "big.Float",
+			parse:   func(s string) bool { _, ok := ParseBigFloat(s); return ok },
+			valid:   []string{"0", "-1.5", "1e-30", "123456789012345678901234567890.123"},
+			invalid: []string{"", "1/3", "1.2.3", "abc"},
+		},
+		{
+			name:    "big.Rat",
+			parse:   func(s string) bool { _, ok := ParseBigRat(s); return ok },
+			valid:   []string{"0", "1/3", "-2/4", "0.1", "1e3"},
+			invalid: []string{"", "1/0", "1//3", "abc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.valid {
+				if !tt.parse(s) {
+					t.Errorf("parse %s(%q) = false, want true", tt.name, s)
+				}
+			}
+			for _, s := range tt.invalid {
+				if tt.parse(s) {
+					t.Errorf("parse %s(%q) = true, want false", tt.name, s)
+				}
+			}
+		})
+	}
+}
+
+func TestMustParseBigNumbers(t *testing.T) {
+	if MustParseBigInt("-7").Int64() != -7 {
+		t.Error("MustParseBigInt(-7) must be -7")
+	}
+
+	if f, _ := MustParseBigFloat("0.5").Float64(); f != 0.5 {
+		t.Error("MustParseBigFloat(0.5) must be 0.5")
+	}
+
+	if MustParseBigRat("0.1").Cmp(MustParseBigRat("1/10")) != 0 {
+		t.Error("MustParseBigRat(0.1) must be 1/10")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseBigInt with invalid value must panic")
+		}
+	}()
+	MustParseBigInt("1.5")
+}
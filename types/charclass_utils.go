@@ -0,0 +1,173 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IsAlpha validates if a non-empty string has only ASCII letters.
+func IsAlpha(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isAlpha(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsAlphanumeric validates if a non-empty string has only ASCII letters and digits.
+func IsAlphanumeric(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isAlphanumeric(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsAlphaUnicode validates if a non-empty string has only unicode letters.
+func IsAlphaUnicode(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsAlphanumericUnicode validates if a non-empty string has only unicode letters and numbers.
+func IsAlphanumericUnicode(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsNumeric validates if a string is a decimal number with optional sign and fraction (e.g. -12.5).
+func IsNumeric(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	if !IsNumber(integer) {
+		return false
+	}
+
+	return !hasFraction || IsNumber(fraction)
+}
+
+// IsNumber validates if a non-empty string has only ASCII digits.
+func IsNumber(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsASCII validates if a string has only ASCII characters.
+func IsASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsPrintableASCII validates if a string has only printable ASCII characters (space to tilde).
+func IsPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsLowercase validates if a non-empty string has no uppercase or titlecase characters.
+func IsLowercase(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if unicode.ToLower(r) != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsUppercase validates if a non-empty string has no lowercase or titlecase characters.
+func IsUppercase(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if unicode.ToUpper(r) != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsNotBlank validates if a string has at least one non white space character.
+func IsNotBlank(s string) bool {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			return true
+		}
+		i += size
+	}
+
+	return false
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlphanumeric(c byte) bool {
+	return isAlpha(c) || isDigit(c)
+}
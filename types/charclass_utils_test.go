@@ -0,0 +1,76 @@
+package types
+
+import "testing"
+
+func TestCharacterClassValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "alpha",
+			validate: IsAlpha,
+			valid:    []string{"abc", "ABCdef"},
+			invalid:  []string{"", "abc1", "ab c", "ação"},
+		},
+		{
+			name:     "alphanum",
+			validate: IsAlphanumeric,
+			valid:    []string{"abc123", "ABC", "123"},
+			invalid:  []string{"", "abc-123", "ab c", "ação1"},
+		},
+		{
+			name:     "alphaunicode",
+			validate: IsAlphaUnicode,
+			valid:    []string{"abc", "ação", "日本語"},
+			invalid:  []string{"", "ação1", "ab c", "\xff"},
+		},
+		{
+			name:     "alphanumunicode",
+			validate: IsAlphanumericUnicode,
+			valid:    []string{"abc123", "ação1", "日本語٣"},
+			invalid:  []string{"", "ação-1", "ab c"},
+		},
+		{
+			name:     "numeric",
+			validate: IsNumeric,
+			valid:    []string{"0", "123", "-123", "+1.5", "0.25"},
+			invalid:  []string{"", "-", "1.", ".5", "1.2.3", "1e3", "12a", "--1"},
+		},
+		{
+			name:     "number",
+			validate: IsNumber,
+			valid:    []string{"0", "0123456789"},
+			invalid:  []string{"", "-1", "1.5", "12a", "٣"},
+		},
+		{
+			name:     "ascii",
+			validate: IsASCII,
+			valid:    []string{"", "abc 123", "\x00\x7f"},
+			invalid:  []string{"ação", "\x80"},
+		},
+		{
+			name:     "printascii",
+			validate: IsPrintableASCII,
+			valid:    []string{"", "abc 123 ~!@"},
+			invalid:  []string{"abc\n", "\x7f", "ação"},
+		},
+		{
+			name:     "lowercase",
+			validate: IsLowercase,
+			valid:    []string{"abc", "abc 123", "ação", "123"},
+			invalid:  []string{"", "Abc", "AÇÃO", "ǅ"},
+		},
+		{
+			name:     "uppercase",
+			validate: IsUppercase,
+			valid:    []string{"ABC", "ABC 123", "AÇÃO", "123"},
+			invalid:  []string{"", "aBC", "ação", "ǅ"},
+		},
+		{
+			name:     "notblank",
+			validate: IsNotBlank,
+			valid:    []string{"a", " a ", "\t x"},
+			invalid:  []string{"", " ", "\t\n\r ", "  "},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
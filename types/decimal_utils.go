@@ -0,0 +1,124 @@
+package types
+
+import "strings"
+
+// decimal is a decimal number split in sign, integer part without leading zeros and
+// fraction without trailing zeros, so it can be compared without float conversion.
+type decimal struct {
+	negative bool
+	integer  string
+	fraction string
+}
+
+// IsValidDecimal validates if a string is a decimal number (e.g. 1234.50) that fits in a SQL
+// DECIMAL(precision, scale): at most precision digits, with at most scale of them in the fraction.
+func IsValidDecimal(s string, precision, scale int) bool {
+	d, ok := parseDecimal(s)
+	if !ok {
+		return false
+	}
+
+	_, fraction, _ := strings.Cut(s, ".")
+
+	return len(fraction) <= scale && len(d.integer) <= precision-scale
+}
+
+// IsDecimalGT validates if a decimal string is greater than the target decimal string.
+func IsDecimalGT(s, target string) bool {
+	cmp, ok := compareDecimal(s, target)
+
+	return ok && cmp > 0
+}
+
+// IsDecimalGTE validates if a decimal string is greater than or equal to the target decimal string.
+func IsDecimalGTE(s, target string) bool {
+	cmp, ok := compareDecimal(s, target)
+
+	return ok && cmp >= 0
+}
+
+// IsDecimalLT validates if a decimal string is less than the target decimal string.
+func IsDecimalLT(s, target string) bool {
+	cmp, ok := compareDecimal(s, target)
+
+	return ok && cmp < 0
+}
+
+// IsDecimalLTE validates if a decimal string is less than or equal to the target decimal string.
+func IsDecimalLTE(s, target string) bool {
+	cmp, ok := compareDecimal(s, target)
+
+	return ok && cmp <= 0
+}
+
+// compareDecimal compares two decimal strings, returning -1, 0 or +1, and false if any of them
+// isn't a decimal number.
+func compareDecimal(a, b string) (int, bool) {
+	x, ok := parseDecimal(a)
+	if !ok {
+		return 0, false
+	}
+
+	y, ok := parseDecimal(b)
+	if !ok {
+		return 0, false
+	}
+
+	if x.negative != y.negative {
+		if x.negative {
+			return -1, true
+		}
+
+		return 1, true
+	}
+
+	cmp := compareAbsDecimal(x, y)
+	if x.negative {
+		cmp = -cmp
+	}
+
+	return cmp, true
+}
+
+func compareAbsDecimal(x, y decimal) int {
+	if len(x.integer) != len(y.integer) {
+		if len(x.integer) < len(y.integer) {
+			return -1
+		}
+
+		return 1
+	}
+
+	if cmp := strings.Compare(x.integer, y.integer); cmp != 0 {
+		return cmp
+	}
+
+	// Without trailing zeros, the fractions can be compared as strings.
+	return strings.Compare(x.fraction, y.fraction)
+}
+
+func parseDecimal(s string) (decimal, bool) {
+	if !IsNumeric(s) {
+		return decimal{}, false
+	}
+
+	var d decimal
+	switch s[0] {
+	case '-':
+		d.negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(s, ".")
+	d.integer = strings.TrimLeft(integer, "0")
+	d.fraction = strings.TrimRight(fraction, "0")
+
+	// Zero has no sign (e.g. -0.00 is equal to 0).
+	if d.integer == "" && d.fraction == "" {
+		d.negative = false
+	}
+
+	return d, true
+}
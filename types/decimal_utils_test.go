@@ -0,0 +1,83 @@
+package types
+
+import "testing"
+
+func TestIsValidDecimal(t *testing.T) {
+	tests := []struct {
+		s         string
+		precision int
+		scale     int
+		want      bool
+	}{
+		{s: "1234.50", precision: 12, scale: 2, want: true},
+		{s: "-1234.5", precision: 12, scale: 2, want: true},
+		{s: "+0.01", precision: 12, scale: 2, want: true},
+		{s: "0001234", precision: 7, scale: 0, want: true},
+		{s: "9999999999.99", precision: 12, scale: 2, want: true},
+		{s: "0.99", precision: 2, scale: 2, want: true},
+		{s: "99999999999.99", precision: 12, scale: 2, want: false},
+		{s: "1234.567", precision: 12, scale: 2, want: false},
+		{s: "1.0", precision: 5, scale: 0, want: false},
+		{s: "1,234.50", precision: 12, scale: 2, want: false},
+		{s: "1e3", precision: 12, scale: 2, want: false},
+		{s: "1.", precision: 12, scale: 2, want: false},
+		{s: ".5", precision: 12, scale: 2, want: false},
+		{s: "", precision: 12, scale: 2, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidDecimal(tt.s, tt.precision, tt.scale); got != tt.want {
+			t.Errorf("IsValidDecimal(%q, %d, %d) = %v, want %v", tt.s, tt.precision, tt.scale, got, tt.want)
+		}
+	}
+}
+
+func TestCompareDecimal(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "0.01", b: "0.01", want: 0},
+		{a: "0.010", b: "+00.01", want: 0},
+		{a: "-0.00", b: "0", want: 0},
+		{a: "0.02", b: "0.01", want: 1},
+		{a: "0.1", b: "0.09", want: 1},
+		{a: "10", b: "9.99", want: 1},
+		{a: "12345678901234567890.01", b: "12345678901234567890", want: 1},
+		{a: "-1", b: "0", want: -1},
+		{a: "-10", b: "-9", want: -1},
+		{a: "-0.5", b: "-0.50001", want: 1},
+		{a: "0.005", b: "0.01", want: -1},
+	}
+
+	for _, tt := range tests {
+		if got, ok := compareDecimal(tt.a, tt.b); !ok || got != tt.want {
+			t.Errorf("compareDecimal(%q, %q) = %d, %v, want %d, true", tt.a, tt.b, got, ok, tt.want)
+		}
+	}
+
+	for _, s := range []string{"", "abc", "1.2.3", "NaN", "1e2"} {
+		if _, ok := compareDecimal(s, "0"); ok {
+			t.Errorf("compareDecimal(%q, \"0\") is ok, want invalid", s)
+		}
+	}
+}
+
+func TestDecimalComparisons(t *testing.T) {
+	if !IsDecimalGT("0.02", "0.01") || IsDecimalGT("0.01", "0.01") {
+		t.Error("IsDecimalGT must accept only greater values")
+	}
+
+	if !IsDecimalGTE("0.01", "0.010") || IsDecimalGTE("0.009", "0.01") || IsDecimalGTE("abc", "0.01") {
+		t.Error("IsDecimalGTE must accept only greater or equal values")
+	}
+
+	if !IsDecimalLT("-1", "0") || IsDecimalLT("0", "-0") {
+		t.Error("IsDecimalLT must accept only less values")
+	}
+
+	if !IsDecimalLTE("1000.00", "1000") || IsDecimalLTE("1000.01", "1000") || IsDecimalLTE("", "1000") {
+		t.Error("IsDecimalLTE must accept only less or equal values")
+	}
+}
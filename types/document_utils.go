@@ -0,0 +1,148 @@
+package types
+
+// DocumentFormat defines if a document (e.g. CPF) must have its punctuation.
+type DocumentFormat int
+
+const (
+	// AnyDocumentFormat accepts formatted and unformatted documents.
+	AnyDocumentFormat DocumentFormat = iota
+	// FormattedDocument accepts only documents with punctuation (e.g. 529.982.247-25).
+	FormattedDocument
+	// UnformattedDocument accepts only documents without punctuation (e.g. 52998224725).
+	UnformattedDocument
+)
+
+const (
+	cpfMask  = "###.###.###-##"
+	cnpjMask = "##.###.###/####-##"
+	cepMask  = "#####-###"
+)
+
+// IsValidCPF validates if a string is a Brazilian individual taxpayer number (CPF) with valid check digits.
+func IsValidCPF(s string, format DocumentFormat) bool {
+	var cpf [11]byte
+	if !unformatDocument(s, cpfMask, format, cpf[:]) {
+		return false
+	}
+
+	for _, c := range cpf {
+		if !isDigit(c) {
+			return false
+		}
+	}
+
+	if allEqual(cpf[:]) {
+		return false
+	}
+
+	return cpf[9] == cpfCheckDigit(cpf[:9]) && cpf[10] == cpfCheckDigit(cpf[:10])
+}
+
+// IsValidCNPJ validates if a string is a Brazilian company taxpayer number (CNPJ) with valid check digits.
+// The alphanumeric format (uppercase letters in the first 12 characters, e.g. 12.ABC.345/01DE-35) is accepted.
+func IsValidCNPJ(s string, format DocumentFormat) bool {
+	var cnpj [14]byte
+	if !unformatDocument(s, cnpjMask, format, cnpj[:]) {
+		return false
+	}
+
+	for i, c := range cnpj {
+		if !isDigit(c) && (i >= 12 || c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+
+	if allEqual(cnpj[:]) {
+		return false
+	}
+
+	return cnpj[12] == cnpjCheckDigit(cnpj[:12]) && cnpj[13] == cnpjCheckDigit(cnpj[:13])
+}
+
+// IsValidCPFOrCNPJ validates if a string is a valid CPF or CNPJ.
+func IsValidCPFOrCNPJ(s string, format DocumentFormat) bool {
+	return IsValidCPF(s, format) || IsValidCNPJ(s, format)
+}
+
+// IsValidCEP validates if a string is a Brazilian postal code (CEP) (e.g. 01310-100).
+func IsValidCEP(s string, format DocumentFormat) bool {
+	var cep [8]byte
+	if !unformatDocument(s, cepMask, format, cep[:]) {
+		return false
+	}
+
+	for _, c := range cep {
+		if !isDigit(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unformatDocument copies the characters of s to document, removing the punctuation of the mask
+// ('#' is a document character) when s is formatted.
+func unformatDocument(s, mask string, format DocumentFormat, document []byte) bool {
+	switch {
+	case len(s) == len(document) && format != FormattedDocument:
+		copy(document, s)
+
+		return true
+	case len(s) == len(mask) && format != UnformattedDocument:
+		n := 0
+		for i := 0; i < len(mask); i++ {
+			if mask[i] != '#' {
+				if s[i] != mask[i] {
+					return false
+				}
+				continue
+			}
+			document[n] = s[i]
+			n++
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func cpfCheckDigit(digits []byte) byte {
+	sum := 0
+	weight := len(digits) + 1
+	for _, c := range digits {
+		sum += int(c-'0') * weight
+		weight--
+	}
+
+	return byte(sum*10%11%10) + '0'
+}
+
+func cnpjCheckDigit(chars []byte) byte {
+	sum := 0
+	weight := len(chars) - 7
+	for _, c := range chars {
+		if weight < 2 {
+			weight = 9
+		}
+		sum += int(c-'0') * weight
+		weight--
+	}
+
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+
+	return byte(11-rest) + '0'
+}
+
+func allEqual(chars []byte) bool {
+	for _, c := range chars[1:] {
+		if c != chars[0] {
+			return false
+		}
+	}
+
+	return true
+}
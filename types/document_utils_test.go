@@ -0,0 +1,93 @@
+package types
+
+import "testing"
+
+func TestDocumentValidations(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string, DocumentFormat) bool
+		format   DocumentFormat
+		valid    []string
+		invalid  []string
+	}{
+		{
+			name:     "cpf",
+			validate: IsValidCPF,
+			format:   AnyDocumentFormat,
+			valid:    []string{"529.982.247-25", "52998224725", "111.444.777-35", "12345678909"},
+			invalid:  []string{"", "529.982.247-24", "52998224724", "111.111.111-11", "11111111111", "529.982.24725", "529-982-247.25", "5299822472", "A2998224725"},
+		},
+		{
+			name:     "cpf formatted",
+			validate: IsValidCPF,
+			format:   FormattedDocument,
+			valid:    []string{"529.982.247-25"},
+			invalid:  []string{"52998224725"},
+		},
+		{
+			name:     "cpf unformatted",
+			validate: IsValidCPF,
+			format:   UnformattedDocument,
+			valid:    []string{"52998224725"},
+			invalid:  []string{"529.982.247-25"},
+		},
+		{
+			name:     "cnpj",
+			validate: IsValidCNPJ,
+			format:   AnyDocumentFormat,
+			valid:    []string{"11.222.333/0001-81", "11222333000181", "11.444.777/0001-61", "12.ABC.345/01DE-35", "12ABC34501DE35", "ABCDEFGHIJKL80"},
+			invalid:  []string{"", "11.222.333/0001-82", "11222333000180", "00.000.000/0000-00", "12.abc.345/01de-35", "12.ABC.345/01DE-3A", "11.222.333.0001-81", "1122233300018"},
+		},
+		{
+			name:     "cnpj formatted",
+			validate: IsValidCNPJ,
+			format:   FormattedDocument,
+			valid:    []string{"12.ABC.345/01DE-35"},
+			invalid:  []string{"12ABC34501DE35"},
+		},
+		{
+			name:     "cnpj unformatted",
+			validate: IsValidCNPJ,
+			format:   UnformattedDocument,
+			valid:    []string{"12ABC34501DE35"},
+			invalid:  []string{"12.ABC.345/01DE-35"},
+		},
+		{
+			name:     "cpf or cnpj",
+			validate: IsValidCPFOrCNPJ,
+			format:   AnyDocumentFormat,
+			valid:    []string{"529.982.247-25", "11.222.333/0001-81", "12ABC34501DE35"},
+			invalid:  []string{"", "529.982.247-24", "11.222.333/0001-82"},
+		},
+		{
+			name:     "cep",
+			validate: IsValidCEP,
+			format:   AnyDocumentFormat,
+			valid:    []string{"01310-100", "01310100"},
+			invalid:  []string{"", "01310-10", "0131010", "01310.100", "0131A-100"},
+		},
+		{
+			name:     "cep formatted",
+			validate: IsValidCEP,
+			format:   FormattedDocument,
+			valid:    []string{"01310-100"},
+			invalid:  []string{"01310100"},
+		},
+		{
+			name:     "cep unformatted",
+			validate: IsValidCEP,
+			format:   UnformattedDocument,
+			valid:    []string{"01310100"},
+			invalid:  []string{"01310-100"},
+		},
+	}
+
+	for _, tt := range tests {
+		runStringValidationTests(t, []stringValidationTest{{
+			name:     tt.name,
+			validate: func(s string) bool { return tt.validate(s, tt.format) },
+			valid:    tt.valid,
+			invalid:  tt.invalid,
+		}})
+	}
+}
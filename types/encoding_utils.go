@@ -0,0 +1,268 @@
+package types
+
+import (
+	"encoding/json"
+	"mime"
+	"strings"
+)
+
+// IsValidBase64 validates if a string is standard base64 encoded with padding (e.g. Zm9vYg==).
+func IsValidBase64(s string) bool {
+	return isValidBase64(s, "+/", true)
+}
+
+// IsValidBase64URL validates if a string is base64 URL encoded with padding (e.g. Zm9vYg==).
+func IsValidBase64URL(s string) bool {
+	return isValidBase64(s, "-_", true)
+}
+
+// IsValidBase64RawURL validates if a string is base64 URL encoded without padding (e.g. Zm9vYg).
+func IsValidBase64RawURL(s string) bool {
+	return isValidBase64(s, "-_", false)
+}
+
+// IsValidHexadecimal validates if a string is a hexadecimal number with optional 0x prefix (e.g. 0x1F).
+func IsValidHexadecimal(s string) bool {
+	if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+
+	return len(s) > 0 && isHex(s)
+}
+
+// IsValidJSON validates if a string is a valid JSON value.
+func IsValidJSON(s string) bool {
+	return json.Valid([]byte(s))
+}
+
+// IsValidJWT validates if a string has the JSON Web Token compact form: header, payload and
+// optional signature as base64 URL encoded (without padding) segments separated by dots.
+func IsValidJWT(s string) bool {
+	header, rest, found := strings.Cut(s, ".")
+	if !found {
+		return false
+	}
+
+	payload, signature, found := strings.Cut(rest, ".")
+	if !found {
+		return false
+	}
+
+	return IsValidBase64RawURL(header) && IsValidBase64RawURL(payload) &&
+		(signature == "" || IsValidBase64RawURL(signature))
+}
+
+// IsValidHexColor validates if a string is a hex color with 3, 4, 6 or 8 digits (e.g. #ff0000).
+func IsValidHexColor(s string) bool {
+	if len(s) == 0 || s[0] != '#' {
+		return false
+	}
+
+	switch len(s) - 1 {
+	case 3, 4, 6, 8:
+		return isHex(s[1:])
+	}
+
+	return false
+}
+
+// IsValidRGB validates if a string is a rgb color (e.g. rgb(255, 0, 0) or rgb(100%, 0%, 0%)).
+func IsValidRGB(s string) bool {
+	var args [3]string
+	if !functionArgs(s, "rgb", args[:]) {
+		return false
+	}
+
+	return isValidRGBChannels(args[:])
+}
+
+// IsValidRGBA validates if a string is a rgba color with alpha between 0 and 1 (e.g. rgba(255, 0, 0, 0.5)).
+func IsValidRGBA(s string) bool {
+	var args [4]string
+	if !functionArgs(s, "rgba", args[:]) {
+		return false
+	}
+
+	return isValidRGBChannels(args[:3]) && isValidAlpha(args[3])
+}
+
+// IsValidHSL validates if a string is a hsl color (e.g. hsl(360, 100%, 50%)).
+func IsValidHSL(s string) bool {
+	var args [3]string
+	if !functionArgs(s, "hsl", args[:]) {
+		return false
+	}
+
+	return isIntInRange(args[0], 360) && isPercentage(args[1]) && isPercentage(args[2])
+}
+
+// IsValidDataURI validates if a string is a data URI as defined by RFC 2397
+// (e.g. data:text/plain;base64,SGVsbG8=). Base64 data must be valid base64.
+func IsValidDataURI(s string) bool {
+	rest, found := strings.CutPrefix(s, "data:")
+	if !found {
+		return false
+	}
+
+	metadata, data, found := strings.Cut(rest, ",")
+	if !found {
+		return false
+	}
+
+	metadata, base64 := strings.CutSuffix(metadata, ";base64")
+	if metadata != "" && !IsValidMIMEType(metadata) {
+		return false
+	}
+
+	return !base64 || IsValidBase64(data)
+}
+
+// IsValidMIMEType validates the syntax of a MIME type as defined by RFC 6838, with optional
+// parameters (e.g. application/json or text/plain; charset=utf-8).
+func IsValidMIMEType(s string) bool {
+	mediaType, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return false
+	}
+
+	typeName, subtypeName, found := strings.Cut(mediaType, "/")
+
+	return found && isRestrictedName(typeName) && isRestrictedName(subtypeName)
+}
+
+func isValidBase64(s, extraChars string, padded bool) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	if padded {
+		if len(s)%4 != 0 {
+			return false
+		}
+		if s[len(s)-1] == '=' {
+			s = s[:len(s)-1]
+			if s[len(s)-1] == '=' {
+				s = s[:len(s)-1]
+			}
+		}
+	}
+
+	if len(s)%4 == 1 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isAlphanumeric(c) && c != extraChars[0] && c != extraChars[1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// functionArgs splits a functional notation (e.g. rgb(1, 2, 3)) in exactly len(args) arguments.
+func functionArgs(s, name string, args []string) bool {
+	rest, found := strings.CutPrefix(s, name+"(")
+	if !found {
+		return false
+	}
+
+	rest, found = strings.CutSuffix(rest, ")")
+	if !found {
+		return false
+	}
+
+	for i := range args {
+		arg, next, found := strings.Cut(rest, ",")
+		if found == (i == len(args)-1) {
+			return false
+		}
+		args[i] = strings.TrimSpace(arg)
+		rest = next
+	}
+
+	return true
+}
+
+func isValidRGBChannels(channels []string) bool {
+	if strings.HasSuffix(channels[0], "%") {
+		for _, channel := range channels {
+			if !isPercentage(channel) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, channel := range channels {
+		if !isIntInRange(channel, 255) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isValidAlpha(s string) bool {
+	integer, fraction, found := strings.Cut(s, ".")
+	if found && !IsNumber(fraction) {
+		return false
+	}
+
+	switch integer {
+	case "0":
+		return true
+	case "1":
+		return strings.Trim(fraction, "0") == ""
+	}
+
+	return false
+}
+
+func isPercentage(s string) bool {
+	value, found := strings.CutSuffix(s, "%")
+
+	return found && isIntInRange(value, 100)
+}
+
+// isIntInRange validates if s is an integer without leading zeros between 0 and max.
+func isIntInRange(s string, max int) bool {
+	if !IsNumber(s) || len(s) > 3 || (len(s) > 1 && s[0] == '0') {
+		return false
+	}
+
+	value := 0
+	for i := 0; i < len(s); i++ {
+		value = value*10 + int(s[i]-'0')
+	}
+
+	return value <= max
+}
+
+// isRestrictedName validates a RFC 6838 type or subtype name.
+func isRestrictedName(s string) bool {
+	if len(s) == 0 || len(s) > 127 || !isAlphanumeric(s[0]) {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if !isAlphanumeric(s[i]) && !strings.ContainsRune("!#$&-^_.+", rune(s[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isDigit(c) && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,14 @@
+package types
+
+// CountTrue returns how many values are true.
+// It's used to check how many fields of a group (e.g. exactly_one_of) are present.
+func CountTrue(values ...bool) int {
+	count := 0
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,40 @@
+package types
+
+import "testing"
+
+func TestCountTrue(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []bool
+		want   int
+	}{
+		{
+			name:   "no values",
+			values: nil,
+			want:   0,
+		},
+		{
+			name:   "all false",
+			values: []bool{false, false, false},
+			want:   0,
+		},
+		{
+			name:   "one true",
+			values: []bool{false, true, false},
+			want:   1,
+		},
+		{
+			name:   "all true",
+			values: []bool{true, true, true},
+			want:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountTrue(tt.values...); got != tt.want {
+				t.Errorf("CountTrue(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
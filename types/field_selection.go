@@ -0,0 +1,69 @@
+package types
+
+import "strings"
+
+// FieldSelection defines the fields checked by partial validators.
+// Fields are referenced by name, and nested fields by their path (e.g. "Address.City").
+// The zero value selects all fields.
+type FieldSelection struct {
+	fields []string
+	only   bool
+}
+
+// SelectFields returns a selection with only the fields (and their nested fields).
+func SelectFields(fields ...string) FieldSelection {
+	return FieldSelection{
+		fields: fields,
+		only:   true,
+	}
+}
+
+// ExceptFields returns a selection with all fields except the fields (and their nested fields).
+func ExceptFields(fields ...string) FieldSelection {
+	return FieldSelection{
+		fields: fields,
+		only:   false,
+	}
+}
+
+// Has reports whether the field path must be checked.
+// With SelectFields, a path is selected if it, one of its parents or one of its nested fields is in the selection.
+// With ExceptFields, a path is selected if neither it nor one of its parents is in the selection.
+func (s FieldSelection) Has(path string) bool {
+	for _, field := range s.fields {
+		if field == path || isParentPath(field, path) {
+			return s.only
+		}
+
+		if s.only && isParentPath(path, field) {
+			return true
+		}
+	}
+
+	return !s.only
+}
+
+// Nested returns the selection to be used by the validator of the nested struct field.
+func (s FieldSelection) Nested(field string) FieldSelection {
+	nested := FieldSelection{
+		only: s.only,
+	}
+
+	for _, f := range s.fields {
+		if s.only && f == field {
+			// The whole nested struct is selected.
+			return FieldSelection{}
+		}
+
+		if nestedField, ok := strings.CutPrefix(f, field+"."); ok {
+			nested.fields = append(nested.fields, nestedField)
+		}
+	}
+
+	return nested
+}
+
+// isParentPath reports whether parent is a parent path of path (e.g. "Address" and "Address.City").
+func isParentPath(parent, path string) bool {
+	return len(path) > len(parent) && path[len(parent)] == '.' && strings.HasPrefix(path, parent)
+}
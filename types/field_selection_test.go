@@ -0,0 +1,138 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldSelectionHas(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection FieldSelection
+		path      string
+		want      bool
+	}{
+		{
+			name:      "zero value selects all fields",
+			selection: FieldSelection{},
+			path:      "Name",
+			want:      true,
+		},
+		{
+			name:      "selected field",
+			selection: SelectFields("Name", "Age"),
+			path:      "Age",
+			want:      true,
+		},
+		{
+			name:      "not selected field",
+			selection: SelectFields("Name", "Age"),
+			path:      "Email",
+			want:      false,
+		},
+		{
+			name:      "field with the same prefix is not selected",
+			selection: SelectFields("Name"),
+			path:      "NameSuffix",
+			want:      false,
+		},
+		{
+			name:      "nested field of a selected field",
+			selection: SelectFields("Address"),
+			path:      "Address.City",
+			want:      true,
+		},
+		{
+			name:      "parent of a selected nested field",
+			selection: SelectFields("Address.City"),
+			path:      "Address",
+			want:      true,
+		},
+		{
+			name:      "sibling of a selected nested field",
+			selection: SelectFields("Address.City"),
+			path:      "Address.Street",
+			want:      false,
+		},
+		{
+			name:      "excluded field",
+			selection: ExceptFields("Name"),
+			path:      "Name",
+			want:      false,
+		},
+		{
+			name:      "not excluded field",
+			selection: ExceptFields("Name"),
+			path:      "Age",
+			want:      true,
+		},
+		{
+			name:      "nested field of an excluded field",
+			selection: ExceptFields("Address"),
+			path:      "Address.City",
+			want:      false,
+		},
+		{
+			name:      "parent of an excluded nested field",
+			selection: ExceptFields("Address.City"),
+			path:      "Address",
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selection.Has(tt.path); got != tt.want {
+				t.Errorf("FieldSelection.Has(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldSelectionNested(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection FieldSelection
+		field     string
+		want      FieldSelection
+	}{
+		{
+			name:      "zero value selects all nested fields",
+			selection: FieldSelection{},
+			field:     "Address",
+			want:      FieldSelection{},
+		},
+		{
+			name:      "whole nested struct selected",
+			selection: SelectFields("Name", "Address"),
+			field:     "Address",
+			want:      FieldSelection{},
+		},
+		{
+			name:      "nested fields selected",
+			selection: SelectFields("Name", "Address.City", "Address.Geo.Lat"),
+			field:     "Address",
+			want:      SelectFields("City", "Geo.Lat"),
+		},
+		{
+			name:      "nested fields excluded",
+			selection: ExceptFields("Name", "Address.City"),
+			field:     "Address",
+			want:      ExceptFields("City"),
+		},
+		{
+			name:      "no nested fields excluded",
+			selection: ExceptFields("Name"),
+			field:     "Address",
+			want:      FieldSelection{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selection.Nested(tt.field); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FieldSelection.Nested(%q) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IsValidLatitude validates if a string is a decimal latitude between -90 and 90 (e.g. -23.5505).
+func IsValidLatitude(s string) bool {
+	return isDecimalInRange(s, 90)
+}
+
+// IsValidLongitude validates if a string is a decimal longitude between -180 and 180 (e.g. -46.6333).
+func IsValidLongitude(s string) bool {
+	return isDecimalInRange(s, 180)
+}
+
+// IsValidSemver validates if a string is a version as defined by Semantic Versioning 2.0.0
+// (e.g. 1.2.3, 1.0.0-rc.1 or 1.0.0+build.5). The "v" prefix is not accepted.
+func IsValidSemver(s string) bool {
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild && !isValidSemverIdentifiers(build, false) {
+		return false
+	}
+
+	s, prerelease, hasPrerelease := strings.Cut(s, "-")
+	if hasPrerelease && !isValidSemverIdentifiers(prerelease, true) {
+		return false
+	}
+
+	major, rest, found := strings.Cut(s, ".")
+	if !found {
+		return false
+	}
+
+	minor, patch, found := strings.Cut(rest, ".")
+	if !found {
+		return false
+	}
+
+	return isNumericIdentifier(major) && isNumericIdentifier(minor) && isNumericIdentifier(patch)
+}
+
+// cronField has the limits of a cron expression field, with optional names (e.g. JAN for months).
+type cronField struct {
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	cronSeconds    = cronField{min: 0, max: 59}
+	cronMinutes    = cronField{min: 0, max: 59}
+	cronHours      = cronField{min: 0, max: 23}
+	cronDaysOfMon  = cronField{min: 1, max: 31}
+	cronMonths     = cronField{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronDaysOfWeek = cronField{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// IsValidCron validates if a string is a cron expression with 5 fields (minute, hour, day of month,
+// month and day of week), or 6 fields with seconds first (e.g. */15 9-18 * * MON-FRI).
+// Fields have lists, ranges and steps, and the macros @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight and @hourly are accepted.
+func IsValidCron(s string) bool {
+	switch s {
+	case "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly":
+		return true
+	}
+
+	var fields [6]string
+	n := 0
+	for field := range strings.FieldsSeq(s) {
+		if n == len(fields) {
+			return false
+		}
+		fields[n] = field
+		n++
+	}
+
+	limits := []cronField{cronMinutes, cronHours, cronDaysOfMon, cronMonths, cronDaysOfWeek}
+	switch n {
+	case 5:
+	case 6:
+		limits = []cronField{cronSeconds, cronMinutes, cronHours, cronDaysOfMon, cronMonths, cronDaysOfWeek}
+	default:
+		return false
+	}
+
+	for i, limit := range limits {
+		if !isValidCronField(fields[i], limit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isValidCronField(s string, limit cronField) bool {
+	for {
+		item, rest, more := strings.Cut(s, ",")
+
+		item, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if _, ok := cronValue(step, cronField{min: 1, max: limit.max}); !ok {
+				return false
+			}
+		}
+
+		if item != "*" {
+			low, high, isRange := strings.Cut(item, "-")
+			lowValue, ok := cronValue(low, limit)
+			if !ok {
+				return false
+			}
+			if isRange {
+				highValue, ok := cronValue(high, limit)
+				if !ok || highValue < lowValue {
+					return false
+				}
+			}
+		}
+
+		if !more {
+			return true
+		}
+		s = rest
+	}
+}
+
+func cronValue(s string, limit cronField) (int, bool) {
+	for i, name := range limit.names {
+		if strings.EqualFold(s, name) {
+			return limit.min + i, true
+		}
+	}
+
+	if !IsNumber(s) || len(s) > 2 {
+		return 0, false
+	}
+
+	value, _ := strconv.Atoi(s)
+
+	return value, value >= limit.min && value <= limit.max
+}
+
+func isDecimalInRange(s string, limit float64) bool {
+	if !IsNumeric(s) {
+		return false
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+
+	return err == nil && value >= -limit && value <= limit
+}
+
+// isValidSemverIdentifiers validates dot separated pre-release or build identifiers.
+// Numeric pre-release identifiers can't have leading zeros.
+func isValidSemverIdentifiers(s string, prerelease bool) bool {
+	for {
+		identifier, rest, more := strings.Cut(s, ".")
+		if len(identifier) == 0 {
+			return false
+		}
+
+		for i := 0; i < len(identifier); i++ {
+			if !isAlphanumeric(identifier[i]) && identifier[i] != '-' {
+				return false
+			}
+		}
+
+		if prerelease && IsNumber(identifier) && !isNumericIdentifier(identifier) {
+			return false
+		}
+
+		if !more {
+			return true
+		}
+		s = rest
+	}
+}
+
+// isNumericIdentifier validates a number without leading zeros.
+func isNumericIdentifier(s string) bool {
+	return IsNumber(s) && (len(s) == 1 || s[0] != '0')
+}
@@ -0,0 +1,48 @@
+package types
+
+import "testing"
+
+func TestGeoAndVersionValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "latitude",
+			validate: IsValidLatitude,
+			valid:    []string{"0", "-23.5505", "+45", "90", "-90.000"},
+			invalid:  []string{"", "90.0001", "-91", "1e1", "NaN", "23,5", "abc"},
+		},
+		{
+			name:     "longitude",
+			validate: IsValidLongitude,
+			valid:    []string{"0", "-46.6333", "180", "-180.0"},
+			invalid:  []string{"", "180.1", "-181", "Inf", "1.2.3"},
+		},
+		{
+			name:     "semver",
+			validate: IsValidSemver,
+			valid: []string{
+				"0.0.0", "1.2.3", "10.20.30", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-0.3.7", "1.0.0-x.7.z.92",
+				"1.0.0-alpha+001", "1.0.0+20130313144700", "1.0.0-beta+exp.sha.5114f85", "1.0.0-alpha-a.b-c",
+			},
+			invalid: []string{
+				"", "1", "1.2", "v1.2.3", "01.2.3", "1.02.3", "1.2.03", "1.2.3-", "1.2.3-01", "1.2.3-alpha..1",
+				"1.2.3+", "1.2.3+build..1", "1.2.3-alpha_1", "1.2.3.4", "-1.2.3", "1.2.3 ",
+			},
+		},
+		{
+			name:     "cron",
+			validate: IsValidCron,
+			valid: []string{
+				"* * * * *", "*/15 9-18 * * MON-FRI", "0 0 1 1 *", "0 0 * * 7", "30 2 1,15 * *",
+				"0 0 12 * JAN,jul *", "0 */5 * * * *", "59 59 23 31 12 6", "@daily", "@hourly",
+				"0-30/10 * * * *",
+			},
+			invalid: []string{
+				"", "* * * *", "* * * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+				"* * * * 8", "*/0 * * * *", "30-10 * * * *", "* * * * MON-", "1,,2 * * * *", "@reboot",
+				"a * * * *", "61 * * * * *", "001 * * * *",
+			},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
@@ -0,0 +1,14 @@
+package types
+
+import "slices"
+
+// InGroups reports whether at least one of the groups is in the active groups.
+func InGroups(activeGroups []string, groups ...string) bool {
+	for _, group := range groups {
+		if slices.Contains(activeGroups, group) {
+			return true
+		}
+	}
+
+	return false
+}
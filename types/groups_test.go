@@ -0,0 +1,45 @@
+package types
+
+import "testing"
+
+func TestInGroups(t *testing.T) {
+	tests := []struct {
+		name         string
+		activeGroups []string
+		groups       []string
+		want         bool
+	}{
+		{
+			name:         "no active groups",
+			activeGroups: nil,
+			groups:       []string{"create"},
+			want:         false,
+		},
+		{
+			name:         "group is active",
+			activeGroups: []string{"create"},
+			groups:       []string{"create"},
+			want:         true,
+		},
+		{
+			name:         "one of the groups is active",
+			activeGroups: []string{"patch", "update"},
+			groups:       []string{"create", "update"},
+			want:         true,
+		},
+		{
+			name:         "group is not active",
+			activeGroups: []string{"update"},
+			groups:       []string{"create"},
+			want:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InGroups(tt.activeGroups, tt.groups...); got != tt.want {
+				t.Errorf("InGroups(%v, %v) = %v, want %v", tt.activeGroups, tt.groups, got, tt.want)
+			}
+		})
+	}
+}
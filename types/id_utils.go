@@ -0,0 +1,128 @@
+package types
+
+// IsValidUUID validates if a string is a UUID in the canonical lowercase form
+// (e.g. 6ba7b810-9dad-11d1-80b4-00c04fd430c8).
+func IsValidUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isLowerHex(s[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IsValidUUIDVersion validates if a string is a UUID with the version and the RFC 9562 variant
+// (e.g. version 4: f47ac10b-58cc-4372-a567-0e02b2c3d479).
+func IsValidUUIDVersion(s string, version byte) bool {
+	if !IsValidUUID(s) || s[14] != '0'+version {
+		return false
+	}
+
+	switch s[19] {
+	case '8', '9', 'a', 'b':
+		return true
+	}
+
+	return false
+}
+
+// IsValidUUID3 validates if a string is a version 3 (MD5 name based) UUID.
+func IsValidUUID3(s string) bool {
+	return IsValidUUID(s) && s[14] == '3'
+}
+
+// IsValidUUID4 validates if a string is a version 4 (random) UUID.
+func IsValidUUID4(s string) bool {
+	return IsValidUUIDVersion(s, 4)
+}
+
+// IsValidUUID5 validates if a string is a version 5 (SHA-1 name based) UUID.
+func IsValidUUID5(s string) bool {
+	return IsValidUUIDVersion(s, 5)
+}
+
+// IsValidUUID7 validates if a string is a version 7 (Unix epoch time based) UUID.
+func IsValidUUID7(s string) bool {
+	return IsValidUUIDVersion(s, 7)
+}
+
+// IsValidUUIDBytes validates if a byte slice holds a binary UUID (16 bytes). A version other
+// than 0 also requires the version nibble and the RFC 9562 variant bits.
+func IsValidUUIDBytes(b []byte, version byte) bool {
+	if len(b) != 16 {
+		return false
+	}
+
+	if version == 0 {
+		return true
+	}
+
+	return b[6]>>4 == version && b[8]&0xc0 == 0x80
+}
+
+// IsZeroBytes validates if all bytes of a byte slice are zero.
+func IsZeroBytes(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidULID validates if a string is a ULID: 26 characters of Crockford's base32
+// (case insensitive), with a timestamp that fits in 48 bits (e.g. 01ARZ3NDEKTSV4RRFFQ69G5FAV).
+func IsValidULID(s string) bool {
+	if len(s) != 26 || s[0] > '7' {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+
+		switch {
+		case c >= '0' && c <= '9':
+		case c >= 'A' && c <= 'Z' && c != 'I' && c != 'L' && c != 'O' && c != 'U':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidMongoDBObjectID validates if a string is a MongoDB ObjectID: 24 lowercase hex
+// characters (e.g. 507f1f77bcf86cd799439011).
+func IsValidMongoDBObjectID(s string) bool {
+	if len(s) != 24 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if !isLowerHex(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isLowerHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f')
+}
@@ -0,0 +1,101 @@
+package types
+
+import "testing"
+
+func TestIDValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "uuid",
+			validate: IsValidUUID,
+			valid:    []string{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "00000000-0000-0000-0000-000000000000", "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+			invalid:  []string{"", "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", "6ba7b8109dad11d180b400c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430c", "6ba7b810-9dad-11d1-80b4_00c04fd430c8", "6ba7b810-9dad-11d1-80b4-00c04fd430cg"},
+		},
+		{
+			name:     "uuid3",
+			validate: IsValidUUID3,
+			valid:    []string{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", "6fa459ea-ee8a-3ca4-894e-db77e160355e"},
+			invalid:  []string{"", "f47ac10b-58cc-4372-a567-0e02b2c3d479", "a987fbc9-4bed-5078-af07-9141ba07c9f3"},
+		},
+		{
+			name:     "uuid4",
+			validate: IsValidUUID4,
+			valid:    []string{"f47ac10b-58cc-4372-a567-0e02b2c3d479", "57b73598-8764-4ad0-a76a-679bb6640eb1"},
+			invalid:  []string{"", "f47ac10b-58cc-3372-a567-0e02b2c3d479", "f47ac10b-58cc-4372-c567-0e02b2c3d479", "f47ac10b-58cc-4372-a567-0e02b2c3d47"},
+		},
+		{
+			name:     "uuid5",
+			validate: IsValidUUID5,
+			valid:    []string{"886313e1-3b8a-5372-9b90-0c9aee199e5d", "987fbc97-4bed-5078-af07-9141ba07c9f3"},
+			invalid:  []string{"", "f47ac10b-58cc-4372-a567-0e02b2c3d479", "987fbc97-4bed-5078-7f07-9141ba07c9f3"},
+		},
+		{
+			name:     "uuid7",
+			validate: IsValidUUID7,
+			valid:    []string{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", "01890a5d-ac96-774b-bcce-b302099a8057"},
+			invalid:  []string{"", "f47ac10b-58cc-4372-a567-0e02b2c3d479", "017f22e2-79b0-7cc3-08c4-dc0c0c07398f"},
+		},
+		{
+			name:     "ulid",
+			validate: IsValidULID,
+			valid:    []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+			invalid:  []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "01ARZ3NDEKTSV4RRFFQ69G5FAVX", "01ARZ3NDEKTSV4RRFFQ69G5FAI", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "8ZZZZZZZZZZZZZZZZZZZZZZZZZ", "01ARZ3NDEKTSV4RRFFQ69G5FA-"},
+		},
+		{
+			name:     "mongodb",
+			validate: IsValidMongoDBObjectID,
+			valid:    []string{"507f1f77bcf86cd799439011", "000000000000000000000000"},
+			invalid:  []string{"", "507f1f77bcf86cd79943901", "507f1f77bcf86cd7994390111", "507F1F77BCF86CD799439011", "507f1f77bcf86cd79943901g"},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
+
+func TestUUIDBytesValidations(t *testing.T) {
+	uuid4 := []byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+	uuid7 := []byte{0x01, 0x7f, 0x22, 0xe2, 0x79, 0xb0, 0x7c, 0xc3, 0x98, 0xc4, 0xdc, 0x0c, 0x0c, 0x07, 0x39, 0x8f}
+	badVariant := []byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xc5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}
+	zero := make([]byte, 16)
+
+	tests := []struct {
+		b       []byte
+		version byte
+		want    bool
+	}{
+		{uuid4, 0, true},
+		{uuid4, 4, true},
+		{uuid4, 7, false},
+		{uuid7, 7, true},
+		{uuid7, 4, false},
+		{badVariant, 0, true},
+		{badVariant, 4, false},
+		{zero, 0, true},
+		{zero, 4, false},
+		{uuid4[:15], 0, false},
+		{nil, 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidUUIDBytes(tt.b, tt.version); got != tt.want {
+			t.Errorf("IsValidUUIDBytes(%x, %d) = %v, want %v", tt.b, tt.version, got, tt.want)
+		}
+	}
+
+	if !IsZeroBytes(zero) || !IsZeroBytes(nil) {
+		t.Errorf("IsZeroBytes(zero) = false, want true")
+	}
+	if IsZeroBytes(uuid4) {
+		t.Errorf("IsZeroBytes(%x) = true, want false", uuid4)
+	}
+}
+
+func TestIDValidationsDoNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		IsValidUUID4("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+		IsValidULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		IsValidMongoDBObjectID("507f1f77bcf86cd799439011")
+	})
+	if allocs != 0 {
+		t.Errorf("allocations = %v, want 0", allocs)
+	}
+}
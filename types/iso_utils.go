@@ -0,0 +1,171 @@
+package types
+
+import "strings"
+
+// IsValidISO3166NumericString validates if a string is an ISO 3166-1 numeric country code with
+// three digits (e.g. 076).
+func IsValidISO3166NumericString(s string) bool {
+	return len(s) == 3 && IsNumber(s) && IsValidISO3166Numeric(threeDigits(s))
+}
+
+// IsValidISO4217NumericString validates if a string is an ISO 4217 numeric currency code with
+// three digits (e.g. 986).
+func IsValidISO4217NumericString(s string) bool {
+	return len(s) == 3 && IsNumber(s) && IsValidISO4217Numeric(threeDigits(s))
+}
+
+// IsValidBCP47LanguageTag validates if a string is a BCP 47 language tag (e.g. pt-BR, zh-Hant-TW
+// or es-419). Subtags are case insensitive; language, script and region subtags must be in the ISO tables.
+func IsValidBCP47LanguageTag(s string) bool {
+	subtag, rest, more := strings.Cut(s, "-")
+
+	// Private use tag (e.g. x-whatever).
+	if isSingleton(subtag, 'x') {
+		return more && isValidPrivateUse(rest)
+	}
+
+	if !isLanguageSubtag(subtag) {
+		return false
+	}
+
+	// Optional subtags must be in order: extlang, script, region, variants, extensions and private use.
+	const (
+		extlang = iota
+		script
+		region
+		variant
+	)
+
+	next := extlang
+	extlangs := 0
+	for more {
+		subtag, rest, more = strings.Cut(rest, "-")
+
+		switch {
+		case next == extlang && extlangs < 3 && len(subtag) == 3 && IsAlpha(subtag):
+			extlangs++
+		case next <= script && len(subtag) == 4 && IsAlpha(subtag):
+			var title [4]byte
+			for i := range title {
+				title[i] = toLower(subtag[i])
+			}
+			title[0] -= 'a' - 'A'
+			if !isISO15924(string(title[:])) {
+				return false
+			}
+			next = region
+		case next <= region && len(subtag) == 2 && IsAlpha(subtag):
+			upper := [2]byte{toUpper(subtag[0]), toUpper(subtag[1])}
+			if !IsValidISO3166Alpha2(string(upper[:])) {
+				return false
+			}
+			next = variant
+		case next <= region && len(subtag) == 3 && IsNumber(subtag):
+			next = variant
+		case isVariantSubtag(subtag):
+			next = variant
+		case isSingleton(subtag, 'x'):
+			return more && isValidPrivateUse(rest)
+		case len(subtag) == 1 && isAlphanumeric(subtag[0]):
+			return more && isValidExtensions(rest)
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// isValidExtensions validates the subtags after an extension singleton, which can be followed by
+// other extensions and private use subtags (e.g. u-ca-buddhist-x-private).
+func isValidExtensions(s string) bool {
+	subtags := 0
+	for {
+		subtag, rest, more := strings.Cut(s, "-")
+		switch {
+		case isSingleton(subtag, 'x'):
+			return subtags > 0 && more && isValidPrivateUse(rest)
+		case len(subtag) == 1 && isAlphanumeric(subtag[0]):
+			if subtags == 0 || !more {
+				return false
+			}
+			subtags = 0
+		case len(subtag) >= 2 && len(subtag) <= 8 && IsAlphanumeric(subtag):
+			subtags++
+		default:
+			return false
+		}
+
+		if !more {
+			return subtags > 0
+		}
+		s = rest
+	}
+}
+
+func isValidPrivateUse(s string) bool {
+	for {
+		subtag, rest, more := strings.Cut(s, "-")
+		if len(subtag) == 0 || len(subtag) > 8 || !IsAlphanumeric(subtag) {
+			return false
+		}
+		if !more {
+			return true
+		}
+		s = rest
+	}
+}
+
+func isLanguageSubtag(s string) bool {
+	if !IsAlpha(s) {
+		return false
+	}
+
+	switch len(s) {
+	case 2:
+		lower := [2]byte{toLower(s[0]), toLower(s[1])}
+
+		return isISO639Alpha2(string(lower[:]))
+	case 3:
+		lower := [3]byte{toLower(s[0]), toLower(s[1]), toLower(s[2])}
+		// qaa to qtz are reserved for private use.
+		return isISO639Alpha3(string(lower[:])) || (lower[0] == 'q' && lower[1] <= 't')
+	}
+
+	return false
+}
+
+func isVariantSubtag(s string) bool {
+	switch {
+	case len(s) >= 5 && len(s) <= 8:
+		return IsAlphanumeric(s)
+	case len(s) == 4:
+		return isDigit(s[0]) && IsAlphanumeric(s)
+	}
+
+	return false
+}
+
+func isSingleton(s string, singleton byte) bool {
+	return len(s) == 1 && toLower(s[0]) == singleton
+}
+
+func threeDigits(s string) int {
+	return int(s[0]-'0')*100 + int(s[1]-'0')*10 + int(s[2]-'0')
+}
+
+func toLower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+
+	return c
+}
+
+func toUpper(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+
+	return c
+}
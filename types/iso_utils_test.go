@@ -0,0 +1,74 @@
+package types
+
+import "testing"
+
+func TestISOCodeValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "iso3166_1_alpha2",
+			validate: IsValidISO3166Alpha2,
+			valid:    []string{"BR", "US", "PT", "AQ", "SS"},
+			invalid:  []string{"", "br", "XX", "BRA", "UK", "B"},
+		},
+		{
+			name:     "iso3166_1_alpha3",
+			validate: IsValidISO3166Alpha3,
+			valid:    []string{"BRA", "USA", "PRT", "SSD"},
+			invalid:  []string{"", "bra", "BR", "XXX", "BRAZ"},
+		},
+		{
+			name:     "iso3166_1_numeric",
+			validate: IsValidISO3166NumericString,
+			valid:    []string{"076", "840", "620", "004"},
+			invalid:  []string{"", "76", "0076", "999", "07a", "-76"},
+		},
+		{
+			name:     "iso4217",
+			validate: IsValidISO4217,
+			valid:    []string{"BRL", "USD", "EUR", "XAU", "ZWG", "XCG"},
+			invalid:  []string{"", "brl", "BR", "XYZ", "HRK", "BRLL"},
+		},
+		{
+			name:     "iso4217_numeric",
+			validate: IsValidISO4217NumericString,
+			valid:    []string{"986", "840", "978", "036"},
+			invalid:  []string{"", "98", "0986", "000", "191", "98a"},
+		},
+		{
+			name:     "bcp47_language_tag",
+			validate: IsValidBCP47LanguageTag,
+			valid: []string{
+				"pt", "pt-BR", "PT-br", "en-US", "zh-Hant-TW", "zh-hant", "es-419", "yue", "zh-yue-HK",
+				"sr-Latn-RS", "de-CH-1901", "sl-rozaj-biske", "en-US-u-ca-gregory", "en-a-bbb-x-a-ccc",
+				"x-whatever", "qaa", "und", "de-DE-u-co-phonebk",
+			},
+			invalid: []string{
+				"", "p", "xx", "eng", "english", "pt-", "-BR", "pt--BR", "pt-XX", "pt-Xxxx", "pt-BR-BR",
+				"pt-BR-Hant", "en-u", "en-u-x-private", "x", "x-", "x-toolongsubtag", "en-US-u-", "pt_BR",
+			},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
+
+func TestISONumericCodes(t *testing.T) {
+	if !IsValidISO3166Numeric(76) || IsValidISO3166Numeric(999) {
+		t.Error("IsValidISO3166Numeric must accept 76 and reject 999")
+	}
+
+	if !IsValidISO4217Numeric(986) || IsValidISO4217Numeric(0) {
+		t.Error("IsValidISO4217Numeric must accept 986 and reject 0")
+	}
+}
+
+func TestISOCodeValidationsDoNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		IsValidISO3166Alpha2("BR")
+		IsValidISO4217("BRL")
+		IsValidBCP47LanguageTag("zh-Hant-TW-u-ca-chinese")
+	})
+	if allocs != 0 {
+		t.Errorf("allocations = %v, want 0", allocs)
+	}
+}
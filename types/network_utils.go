@@ -0,0 +1,162 @@
+package types
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+)
+
+// IsValidIP validates if a string is an IPv4 or IPv6 address (without zone).
+func IsValidIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+
+	return err == nil && addr.Zone() == ""
+}
+
+// IsValidIPv4 validates if a string is an IPv4 address (e.g. 192.168.0.1).
+// IPv4-mapped IPv6 addresses (e.g. ::ffff:192.168.0.1) are IPv4 addresses too.
+func IsValidIPv4(s string) bool {
+	addr, err := netip.ParseAddr(s)
+
+	return err == nil && addr.Zone() == "" && addr.Unmap().Is4()
+}
+
+// IsValidIPv6 validates if a string is an IPv6 address (without zone).
+// IPv4-mapped addresses (e.g. ::ffff:192.168.0.1) are IPv4 addresses.
+func IsValidIPv6(s string) bool {
+	addr, err := netip.ParseAddr(s)
+
+	return err == nil && addr.Is6() && !addr.Is4In6() && addr.Zone() == ""
+}
+
+// IsPrivateIP validates if a string is an IP address of a private network (RFC 1918 for IPv4 and
+// RFC 4193 for IPv6, e.g. 192.168.0.1 or fd00::1).
+func IsPrivateIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+
+	return err == nil && addr.IsPrivate()
+}
+
+// IsPublicIP validates if a string is a global unicast IP address that isn't private (e.g. 8.8.8.8).
+func IsPublicIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+
+	return err == nil && addr.IsGlobalUnicast() && !addr.IsPrivate()
+}
+
+// IsValidCIDR validates if a string is an IPv4 or IPv6 network in CIDR notation (e.g. 10.0.0.0/8).
+func IsValidCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+
+	return err == nil
+}
+
+// IsValidCIDRv4 validates if a string is an IPv4 network in CIDR notation
+// (including IPv4-mapped IPv6 networks, e.g. ::ffff:10.0.0.0/104).
+func IsValidCIDRv4(s string) bool {
+	prefix, err := netip.ParsePrefix(s)
+
+	return err == nil && IsIPv4Prefix(prefix)
+}
+
+// IsIPv4Prefix validates if a prefix is an IPv4 network. IPv4-mapped IPv6 networks must keep
+// the 96 bits of the mapping (e.g. ::ffff:10.0.0.0/104 is 10.0.0.0/8, but ::ffff:10.0.0.0/8 isn't IPv4).
+func IsIPv4Prefix(p netip.Prefix) bool {
+	if !p.IsValid() {
+		return false
+	}
+
+	return p.Addr().Is4() || p.Addr().Is4In6() && p.Bits() >= 96
+}
+
+// IsValidCIDRv6 validates if a string is an IPv6 network in CIDR notation.
+func IsValidCIDRv6(s string) bool {
+	prefix, err := netip.ParsePrefix(s)
+
+	return err == nil && prefix.Addr().Is6() && !prefix.Addr().Is4In6()
+}
+
+// IsValidMAC validates if a string is an IEEE 802 MAC-48, EUI-48, EUI-64 or 20-octet
+// IP over InfiniBand link-layer address (e.g. 00:00:5e:00:53:01).
+func IsValidMAC(s string) bool {
+	_, err := net.ParseMAC(s)
+
+	return err == nil
+}
+
+// IsValidHostname validates if a string is a hostname as defined by RFC 1123
+// (labels with letters, digits and hyphens, not starting or ending with hyphen).
+func IsValidHostname(s string) bool {
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	labelLen := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.':
+			if labelLen == 0 || s[i-1] == '-' {
+				return false
+			}
+			labelLen = 0
+			continue
+		case c == '-':
+			if labelLen == 0 {
+				return false
+			}
+		case !isAlphanumeric(c):
+			return false
+		}
+
+		labelLen++
+		if labelLen > 63 {
+			return false
+		}
+	}
+
+	return labelLen > 0 && s[len(s)-1] != '-'
+}
+
+// IsValidFQDN validates if a string is a fully qualified domain name (e.g. www.example.com or www.example.com.).
+// It must have at least two labels, and the top-level label must start with a letter.
+func IsValidFQDN(s string) bool {
+	if len(s) > 0 && s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+
+	if !IsValidHostname(s) {
+		return false
+	}
+
+	lastDot := -1
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '.' {
+			lastDot = i
+			break
+		}
+	}
+	if lastDot == -1 {
+		return false
+	}
+
+	c := s[lastDot+1]
+
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// IsValidHostnamePort validates if a string is a host (hostname or IP) and a port (1-65535)
+// (e.g. example.com:443 or [::1]:8080).
+func IsValidHostnamePort(s string) bool {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil {
+		return false
+	}
+
+	portNumber, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNumber == 0 {
+		return false
+	}
+
+	return IsValidHostname(host) || IsValidIP(host)
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestNetworkValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "ip",
+			validate: IsValidIP,
+			valid:    []string{"192.168.0.1", "0.0.0.0", "::1", "2001:db8::68", "::ffff:192.168.0.1"},
+			invalid:  []string{"", "256.0.0.1", "192.168.0", "192.168.0.1/24", "fe80::1%eth0", "2001:db8::g", "example.com"},
+		},
+		{
+			name:     "ipv4",
+			validate: IsValidIPv4,
+			valid:    []string{"192.168.0.1", "255.255.255.255", "::ffff:192.168.0.1"},
+			invalid:  []string{"", "::1", "::ffff:c0a8:1%eth0", "192.168.00.1", "1.2.3.4.5"},
+		},
+		{
+			name:     "ipv6",
+			validate: IsValidIPv6,
+			valid:    []string{"::1", "2001:db8::68", "fe80::1"},
+			invalid:  []string{"", "192.168.0.1", "::ffff:192.168.0.1", "fe80::1%eth0", "2001:db8:::1"},
+		},
+		{
+			name:     "private ip",
+			validate: IsPrivateIP,
+			valid:    []string{"10.1.2.3", "172.16.0.1", "192.168.0.1", "fd00::1", "::ffff:192.168.0.1"},
+			invalid:  []string{"", "8.8.8.8", "172.32.0.1", "127.0.0.1", "2001:db8::1", "192.168.0.0/16"},
+		},
+		{
+			name:     "public ip",
+			validate: IsPublicIP,
+			valid:    []string{"8.8.8.8", "2606:4700::1111"},
+			invalid:  []string{"", "10.0.0.1", "127.0.0.1", "169.254.0.1", "224.0.0.1", "fd00::1", "::1"},
+		},
+		{
+			name:     "cidr",
+			validate: IsValidCIDR,
+			valid:    []string{"10.0.0.0/8", "192.168.0.1/32", "2001:db8::/32"},
+			invalid:  []string{"", "10.0.0.0", "10.0.0.0/33", "2001:db8::/129", "10.0.0.0/a"},
+		},
+		{
+			name:     "cidrv4",
+			validate: IsValidCIDRv4,
+			valid:    []string{"10.0.0.0/8", "0.0.0.0/0", "::ffff:10.0.0.0/104"},
+			invalid:  []string{"", "2001:db8::/32", "10.0.0.0/33", "::ffff:10.0.0.0/8", "::ffff:10.0.0.0/95"},
+		},
+		{
+			name:     "cidrv6",
+			validate: IsValidCIDRv6,
+			valid:    []string{"2001:db8::/32", "::/0"},
+			invalid:  []string{"", "10.0.0.0/8", "::ffff:10.0.0.0/104", "2001:db8::/129"},
+		},
+		{
+			name:     "mac",
+			validate: IsValidMAC,
+			valid:    []string{"00:00:5e:00:53:01", "00-00-5E-00-53-01", "0000.5e00.5301", "02:00:5e:10:00:00:00:01"},
+			invalid:  []string{"", "00:00:5e:00:53", "00:00:5e:00:53:zz", "0000:5e00:5301"},
+		},
+		{
+			name:     "hostname",
+			validate: IsValidHostname,
+			valid:    []string{"localhost", "example.com", "my-host.example.com", "1host", "a", strings.Repeat("a", 63) + ".com"},
+			invalid:  []string{"", "-host", "host-", "host-.com", "my_host", "host..com", ".host", "host.", strings.Repeat("a", 64) + ".com", strings.Repeat("a.", 127) + "aa"},
+		},
+		{
+			name:     "fqdn",
+			validate: IsValidFQDN,
+			valid:    []string{"example.com", "www.example.com", "www.example.com.", "x1.example.co"},
+			invalid:  []string{"", "localhost", "example.123", "example..com", "-example.com", "example.com..", "."},
+		},
+		{
+			name:     "hostname_port",
+			validate: IsValidHostnamePort,
+			valid:    []string{"example.com:443", "localhost:8080", "192.168.0.1:80", "[::1]:65535"},
+			invalid:  []string{"", "example.com", "example.com:0", "example.com:65536", "example.com:http", ":80", "my_host:80", "::1:80"},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
+
+func TestIsIPv4Prefix(t *testing.T) {
+	tests := []struct {
+		prefix netip.Prefix
+		want   bool
+	}{
+		{prefix: netip.MustParsePrefix("10.0.0.0/8"), want: true},
+		{prefix: netip.MustParsePrefix("::ffff:10.0.0.0/104"), want: true},
+		{prefix: netip.MustParsePrefix("::ffff:0.0.0.0/96"), want: true},
+		{prefix: netip.MustParsePrefix("::ffff:10.0.0.0/8"), want: false},
+		{prefix: netip.MustParsePrefix("2001:db8::/32"), want: false},
+		{prefix: netip.Prefix{}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsIPv4Prefix(tt.prefix); got != tt.want {
+			t.Errorf("IsIPv4Prefix(%v) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
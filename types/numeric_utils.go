@@ -0,0 +1,64 @@
+package types
+
+import (
+	"math"
+	"strconv"
+)
+
+// IsFinite validates if a float is not NaN or infinite.
+func IsFinite[T float32 | float64](f T) bool {
+	return !math.IsNaN(float64(f)) && !math.IsInf(float64(f), 0)
+}
+
+// IsMultipleOf validates if a float is a multiple of m (e.g. 0.75 is a multiple of 0.25). The remainder
+// is compared with a tolerance scaled to m, plus the rounding error of f in its float precision.
+func IsMultipleOf[T float32 | float64](f, m T) bool {
+	if !IsFinite(f) || !IsFinite(m) || m == 0 {
+		return false
+	}
+
+	remainder := math.Remainder(float64(f), float64(m))
+	tolerance := epsilon(f)*math.Abs(float64(m)) + roundingError(f)
+
+	return math.Abs(remainder) <= tolerance
+}
+
+// HasMaxDecimals validates if the shortest decimal representation of a float has at most
+// max decimal places (e.g. 1.25 has 2 decimal places).
+func HasMaxDecimals[T float32 | float64](f T, max int) bool {
+	if !IsFinite(f) {
+		return false
+	}
+
+	bitSize := 64
+	if _, ok := any(f).(float32); ok {
+		bitSize = 32
+	}
+
+	var buf [64]byte
+	formatted := strconv.AppendFloat(buf[:0], float64(f), 'f', -1, bitSize)
+	for i, c := range formatted {
+		if c == '.' {
+			return len(formatted)-i-1 <= max
+		}
+	}
+
+	return true
+}
+
+func epsilon[T float32 | float64](f T) float64 {
+	if _, ok := any(f).(float32); ok {
+		return 1e-6
+	}
+
+	return 1e-9
+}
+
+// roundingError returns the maximum rounding error of a float in its precision.
+func roundingError[T float32 | float64](f T) float64 {
+	if _, ok := any(f).(float32); ok {
+		return math.Abs(float64(f)) * 0x1p-23
+	}
+
+	return math.Abs(float64(f)) * 0x1p-52
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIsFinite(t *testing.T) {
+	if !IsFinite(1.5) || !IsFinite(float32(-2)) || !IsFinite(0.0) {
+		t.Error("IsFinite must accept finite numbers")
+	}
+
+	if IsFinite(math.NaN()) || IsFinite(math.Inf(1)) || IsFinite(float32(math.Inf(-1))) {
+		t.Error("IsFinite must reject NaN and infinite numbers")
+	}
+}
+
+func TestIsMultipleOf(t *testing.T) {
+	tests := []struct {
+		f    float64
+		m    float64
+		want bool
+	}{
+		{f: 0.75, m: 0.25, want: true},
+		{f: 0.3, m: 0.1, want: true},
+		{f: 10, m: 2.5, want: true},
+		{f: -1.5, m: 0.5, want: true},
+		{f: 0, m: 0.5, want: true},
+		{f: 1e12, m: 0.01, want: true},
+		{f: 0.7, m: 0.25, want: false},
+		{f: 1.001, m: 0.5, want: false},
+		{f: 1, m: 0, want: false},
+		{f: math.NaN(), m: 1, want: false},
+		{f: math.Inf(1), m: 1, want: false},
+		{f: 600000001.5, m: 1, want: false},
+		{f: 1e10 + 0.5, m: 1, want: false},
+		{f: 5, m: math.Inf(1), want: false},
+		{f: 5, m: math.NaN(), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsMultipleOf(tt.f, tt.m); got != tt.want {
+			t.Errorf("IsMultipleOf(%v, %v) = %v, want %v", tt.f, tt.m, got, tt.want)
+		}
+	}
+
+	if !IsMultipleOf(float32(0.3), 0.1) || IsMultipleOf(float32(0.35), 0.1) {
+		t.Error("IsMultipleOf must use the float32 precision")
+	}
+}
+
+func TestHasMaxDecimals(t *testing.T) {
+	tests := []struct {
+		f    float64
+		max  int
+		want bool
+	}{
+		{f: 1.25, max: 2, want: true},
+		{f: 10, max: 0, want: true},
+		{f: 0.1, max: 1, want: true},
+		{f: -3.5, max: 2, want: true},
+		{f: 1.255, max: 2, want: false},
+		{f: 0.1, max: 0, want: false},
+		{f: math.NaN(), max: 2, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := HasMaxDecimals(tt.f, tt.max); got != tt.want {
+			t.Errorf("HasMaxDecimals(%v, %v) = %v, want %v", tt.f, tt.max, got, tt.want)
+		}
+	}
+
+	if !HasMaxDecimals(float32(0.1), 1) {
+		t.Error("HasMaxDecimals must use the float32 precision")
+	}
+}
@@ -0,0 +1,145 @@
+package types
+
+// cardBrand is a range of card number prefixes (e.g. 51 to 55 for Mastercard) with its valid lengths.
+type cardBrand struct {
+	name       string
+	prefixLow  int
+	prefixHigh int
+	prefixLen  int
+	minLen     int
+	maxLen     int
+}
+
+// cardBrands has the prefixes of the main card brands. Brands like Elo and Hipercard
+// are inside the Visa, Discover and Maestro ranges.
+var cardBrands = []cardBrand{
+	{name: "Visa", prefixLow: 4, prefixHigh: 4, prefixLen: 1, minLen: 13, maxLen: 19},
+	{name: "Mastercard", prefixLow: 51, prefixHigh: 55, prefixLen: 2, minLen: 16, maxLen: 16},
+	{name: "Mastercard", prefixLow: 2221, prefixHigh: 2720, prefixLen: 4, minLen: 16, maxLen: 16},
+	{name: "American Express", prefixLow: 34, prefixHigh: 34, prefixLen: 2, minLen: 15, maxLen: 15},
+	{name: "American Express", prefixLow: 37, prefixHigh: 37, prefixLen: 2, minLen: 15, maxLen: 15},
+	{name: "Diners Club", prefixLow: 300, prefixHigh: 305, prefixLen: 3, minLen: 14, maxLen: 19},
+	{name: "Diners Club", prefixLow: 36, prefixHigh: 36, prefixLen: 2, minLen: 14, maxLen: 19},
+	{name: "Diners Club", prefixLow: 38, prefixHigh: 39, prefixLen: 2, minLen: 14, maxLen: 19},
+	{name: "Discover", prefixLow: 6011, prefixHigh: 6011, prefixLen: 4, minLen: 16, maxLen: 19},
+	{name: "Discover", prefixLow: 644, prefixHigh: 649, prefixLen: 3, minLen: 16, maxLen: 19},
+	{name: "Discover", prefixLow: 65, prefixHigh: 65, prefixLen: 2, minLen: 16, maxLen: 19},
+	{name: "JCB", prefixLow: 3528, prefixHigh: 3589, prefixLen: 4, minLen: 16, maxLen: 19},
+	{name: "UnionPay", prefixLow: 62, prefixHigh: 62, prefixLen: 2, minLen: 16, maxLen: 19},
+	{name: "Maestro", prefixLow: 50, prefixHigh: 50, prefixLen: 2, minLen: 12, maxLen: 19},
+	{name: "Maestro", prefixLow: 56, prefixHigh: 69, prefixLen: 2, minLen: 12, maxLen: 19},
+}
+
+// ibanLengths has the IBAN length by country, as defined by the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IsValidLuhnChecksum validates if a string has only digits (at least two) and a valid Luhn check digit.
+func IsValidLuhnChecksum(s string) bool {
+	return len(s) >= 2 && IsNumber(s) && luhnSum(s)%10 == 0
+}
+
+// IsValidCreditCard validates if a string is a card number of a known brand, with valid length and
+// Luhn check digit. Digits can be grouped by spaces (e.g. 4111 1111 1111 1111).
+func IsValidCreditCard(s string) bool {
+	var digits [19]byte
+	n := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == ' ' && i > 0 && i < len(s)-1 && s[i-1] != ' ':
+		case isDigit(s[i]) && n < len(digits):
+			digits[n] = s[i]
+			n++
+		default:
+			return false
+		}
+	}
+
+	number := string(digits[:n])
+	for _, brand := range cardBrands {
+		if n < brand.minLen || n > brand.maxLen {
+			continue
+		}
+
+		prefix := 0
+		for i := 0; i < brand.prefixLen; i++ {
+			prefix = prefix*10 + int(number[i]-'0')
+		}
+
+		if prefix >= brand.prefixLow && prefix <= brand.prefixHigh {
+			return luhnSum(number)%10 == 0
+		}
+	}
+
+	return false
+}
+
+// IsValidIBAN validates if a string is an International Bank Account Number with the length of its
+// country and a valid mod-97 checksum. Uppercase letters and the electronic format (without spaces) are expected.
+func IsValidIBAN(s string) bool {
+	if len(s) < 4 || ibanLengths[s[:2]] != len(s) || !isDigit(s[2]) || !isDigit(s[3]) {
+		return false
+	}
+
+	rest := 0
+	for i := 0; i < len(s); i++ {
+		// The first four characters are moved to the end.
+		c := s[(i+4)%len(s)]
+		switch {
+		case isDigit(c):
+			rest = (rest*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			rest = (rest*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return rest == 1
+}
+
+// IsValidBIC validates if a string is a Business Identifier Code (SWIFT code) as defined by ISO 9362:
+// bank (4 letters), country (2 letters), location (2 letters or digits) and optional branch (3 letters or digits).
+func IsValidBIC(s string) bool {
+	if len(s) != 8 && len(s) != 11 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 'A' || c > 'Z' {
+			if i < 6 || !isDigit(c) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func luhnSum(digits string) int {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum
+}
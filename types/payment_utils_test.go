@@ -0,0 +1,79 @@
+package types
+
+import "testing"
+
+func TestPaymentValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "luhn_checksum",
+			validate: IsValidLuhnChecksum,
+			valid:    []string{"79927398713", "4111111111111111", "00", "18"},
+			invalid:  []string{"", "0", "79927398710", "7992739871a", "7992 7398 713", "-79927398713"},
+		},
+		{
+			name:     "credit_card",
+			validate: IsValidCreditCard,
+			valid: []string{
+				// Visa
+				"4111111111111111", "4012888888881881", "4222222222222", "4111 1111 1111 1111",
+				// Mastercard
+				"5555555555554444", "5105105105105100", "2223003122003222",
+				// American Express
+				"378282246310005", "371449635398431",
+				// Diners Club
+				"30569309025904", "38520000023237",
+				// Discover
+				"6011111111111117", "6011000990139424",
+				// JCB
+				"3530111333300000", "3566002020360505",
+				// UnionPay
+				"6200000000000005",
+				// Maestro
+				"6759649826438453",
+			},
+			invalid: []string{
+				"",
+				"4111111111111112",     // invalid check digit
+				"411111111111111",      // invalid length for Visa
+				"5555555555554",        // invalid length for Mastercard
+				"3782822463100050",     // invalid length for American Express
+				"1111111111111117",     // unknown brand
+				"79927398713",          // too short
+				"4111-1111-1111-1111",  // invalid separator
+				"4111  1111 1111 1111", // double space
+				" 4111111111111111",    // leading space
+				"4111111111111111 ",    // trailing space
+				"41111111111111111111", // too long
+			},
+		},
+		{
+			name:     "iban",
+			validate: IsValidIBAN,
+			valid: []string{
+				"GB82WEST12345698765432", "DE89370400440532013000", "BR1800360305000010009795493C1",
+				"FR1420041010050500013M02606", "NL91ABNA0417164300", "NO9386011117947", "CH9300762011623852957",
+				"MT84MALT011000012345MTLCAST001S", "PT50000201231234567890154", "SA0380000000608010167519",
+				"IT60X0542811101000000123456", "BE68539007547034",
+			},
+			invalid: []string{
+				"",
+				"GB82",
+				"GB82WEST12345698765431",      // invalid checksum
+				"GB82WEST1234569876543",       // invalid length for GB
+				"XX82WEST12345698765432",      // unknown country
+				"gb82west12345698765432",      // lowercase
+				"GB82 WEST 1234 5698 7654 32", // print format
+				"GBA2WEST12345698765432",      // invalid check digits
+				"GB82WEST1234569876543!",      // invalid character
+			},
+		},
+		{
+			name:     "bic",
+			validate: IsValidBIC,
+			valid:    []string{"DEUTDEFF", "DEUTDEFF500", "BOFAUS3N", "ITAUBRSPXXX", "NEDSZAJJ"},
+			invalid:  []string{"", "DEUTDEF", "DEUTDEFF5", "DEUTDEFF5000", "DEU1DEFF", "DEUTD3FF", "deutdeff", "DEUTDEFF_00"},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
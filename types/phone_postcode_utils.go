@@ -0,0 +1,115 @@
+package types
+
+// postcodeMasks has the postal code formats by ISO 3166-1 alpha-2 country code, where '#' is a
+// digit, '@' is an uppercase letter, '?' is a digit or uppercase letter and other characters are literals.
+var postcodeMasks = map[string][]string{
+	"AR": {"@####@@@", "####"},
+	"AT": {"####"},
+	"AU": {"####"},
+	"BE": {"####"},
+	"BG": {"####"},
+	"BR": {"#####-###", "########"},
+	"CA": {"@#@ #@#", "@#@#@#"},
+	"CH": {"####"},
+	"CL": {"#######", "###-####"},
+	"CN": {"######"},
+	"CO": {"######"},
+	"CZ": {"### ##", "#####"},
+	"DE": {"#####"},
+	"DK": {"####"},
+	"EE": {"#####"},
+	"ES": {"#####"},
+	"FI": {"#####"},
+	"FR": {"#####"},
+	"GB": {"@# #@@", "@## #@@", "@@# #@@", "@@## #@@", "@#@ #@@", "@@#@ #@@", "@##@@", "@###@@", "@@##@@", "@@###@@", "@#@#@@", "@@#@#@@"},
+	"GR": {"### ##", "#####"},
+	"HR": {"#####"},
+	"HU": {"####"},
+	"IE": {"@#? ????", "@#?????"},
+	"IN": {"######", "### ###"},
+	"IT": {"#####"},
+	"JP": {"###-####", "#######"},
+	"KR": {"#####"},
+	"LT": {"LT-#####", "#####"},
+	"LU": {"L-####", "####"},
+	"LV": {"LV-####"},
+	"MX": {"#####"},
+	"NL": {"#### @@", "####@@"},
+	"NO": {"####"},
+	"NZ": {"####"},
+	"PE": {"#####"},
+	"PH": {"####"},
+	"PL": {"##-###"},
+	"PT": {"####-###"},
+	"PY": {"######"},
+	"RO": {"######"},
+	"RU": {"######"},
+	"SE": {"### ##", "#####"},
+	"SG": {"######"},
+	"SI": {"SI-####", "####"},
+	"SK": {"### ##", "#####"},
+	"TR": {"#####"},
+	"UA": {"#####"},
+	"US": {"#####", "#####-####"},
+	"UY": {"#####"},
+	"ZA": {"####"},
+}
+
+// IsValidE164 validates if a string is a phone number in E.164 format: '+' and up to 15 digits,
+// without leading zero (e.g. +5511987654321).
+func IsValidE164(s string) bool {
+	if len(s) < 8 || len(s) > 16 || s[0] != '+' || s[1] == '0' {
+		return false
+	}
+
+	return IsNumber(s[1:])
+}
+
+// HasPostcodeFormat reports whether there are postal code formats for the ISO 3166-1 alpha-2 country code.
+func HasPostcodeFormat(country string) bool {
+	_, ok := postcodeMasks[country]
+
+	return ok
+}
+
+// IsValidPostcode validates if a string is a postal code of the ISO 3166-1 alpha-2 country code
+// (e.g. 01310-100 for BR). Countries without postal code formats are invalid.
+func IsValidPostcode(s, country string) bool {
+	for _, mask := range postcodeMasks[country] {
+		if matchMask(s, mask) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchMask(s, mask string) bool {
+	if len(s) != len(mask) {
+		return false
+	}
+
+	for i := 0; i < len(mask); i++ {
+		c := s[i]
+		switch mask[i] {
+		case '#':
+			if !isDigit(c) {
+				return false
+			}
+		case '@':
+			if c < 'A' || c > 'Z' {
+				return false
+			}
+		case '?':
+			if !isDigit(c) && (c < 'A' || c > 'Z') {
+				return false
+			}
+		default:
+			if c != mask[i] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,70 @@
+package types
+
+import "testing"
+
+func TestIsValidE164(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "+5511987654321", want: true},
+		{value: "+14155552671", want: true},
+		{value: "+1234567", want: true},
+		{value: "+123456789012345", want: true},
+		{value: "", want: false},
+		{value: "5511987654321", want: false},
+		{value: "+0511987654321", want: false},
+		{value: "+123456", want: false},
+		{value: "+1234567890123456", want: false},
+		{value: "+55 11 98765-4321", want: false},
+		{value: "+55119876543a1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := IsValidE164(tt.value); got != tt.want {
+				t.Errorf("IsValidE164(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidPostcode(t *testing.T) {
+	tests := []struct {
+		country string
+		valid   []string
+		invalid []string
+	}{
+		{country: "BR", valid: []string{"01310-100", "01310100"}, invalid: []string{"", "01310-10", "0131-0100", "01310_100"}},
+		{country: "US", valid: []string{"94105", "94105-1234"}, invalid: []string{"9410", "94105-123", "94105 1234"}},
+		{country: "GB", valid: []string{"SW1A 1AA", "M1 1AE", "B33 8TH", "CR2 6XH", "DN55 1PT", "EC1A1BB"}, invalid: []string{"sw1a 1aa", "SW1A-1AA", "1W1A 1AA"}},
+		{country: "CA", valid: []string{"K1A 0B1", "K1A0B1"}, invalid: []string{"K1A 0B", "11A 0B1"}},
+		{country: "NL", valid: []string{"1012 AB", "1012AB"}, invalid: []string{"1012 A", "AB 1012"}},
+		{country: "PT", valid: []string{"1000-001"}, invalid: []string{"1000001", "1000-01"}},
+		{country: "IE", valid: []string{"D02 X285", "D6W 1234"}, invalid: []string{"002 X285"}},
+		{country: "XX", invalid: []string{"12345"}},
+	}
+
+	for _, tt := range tests {
+		for _, value := range tt.valid {
+			t.Run(tt.country+" valid "+value, func(t *testing.T) {
+				if !IsValidPostcode(value, tt.country) {
+					t.Errorf("IsValidPostcode(%q, %q) = false, want true", value, tt.country)
+				}
+			})
+		}
+		for _, value := range tt.invalid {
+			t.Run(tt.country+" invalid "+value, func(t *testing.T) {
+				if IsValidPostcode(value, tt.country) {
+					t.Errorf("IsValidPostcode(%q, %q) = true, want false", value, tt.country)
+				}
+			})
+		}
+	}
+}
+
+func TestHasPostcodeFormat(t *testing.T) {
+	if !HasPostcodeFormat("BR") || HasPostcodeFormat("XX") || HasPostcodeFormat("br") {
+		t.Error("HasPostcodeFormat must accept BR and reject XX and br")
+	}
+}
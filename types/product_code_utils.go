@@ -0,0 +1,118 @@
+package types
+
+// IsValidISBN10 validates if a string is an ISBN-10 with valid check digit (0-9 or X).
+// Hyphens and spaces are ignored (e.g. 0-306-40615-2).
+func IsValidISBN10(s string) bool {
+	var isbn [10]byte
+	if !compactCode(s, isbn[:]) {
+		return false
+	}
+
+	sum := 0
+	for i, c := range isbn {
+		switch {
+		case isDigit(c):
+			sum += (10 - i) * int(c-'0')
+		case c == 'X' && i == 9:
+			sum += 10
+		default:
+			return false
+		}
+	}
+
+	return sum%11 == 0
+}
+
+// IsValidISBN13 validates if a string is an ISBN-13 (978 or 979 prefix) with valid check digit.
+// Hyphens and spaces are ignored (e.g. 978-0-306-40615-7).
+func IsValidISBN13(s string) bool {
+	var isbn [13]byte
+	if !compactCode(s, isbn[:]) {
+		return false
+	}
+
+	if isbn[0] != '9' || isbn[1] != '7' || (isbn[2] != '8' && isbn[2] != '9') {
+		return false
+	}
+
+	return isValidGTIN(string(isbn[:]))
+}
+
+// IsValidISSN validates if a string is an ISSN with valid check digit (0-9 or X), with or without
+// the hyphen (e.g. 0378-5955).
+func IsValidISSN(s string) bool {
+	var issn [8]byte
+	switch {
+	case len(s) == 9 && s[4] == '-':
+		copy(issn[:4], s[:4])
+		copy(issn[4:], s[5:])
+	case len(s) == 8:
+		copy(issn[:], s)
+	default:
+		return false
+	}
+
+	sum := 0
+	for i, c := range issn[:7] {
+		if !isDigit(c) {
+			return false
+		}
+		sum += (8 - i) * int(c-'0')
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return issn[7] == 'X'
+	}
+
+	return issn[7] == byte(check)+'0'
+}
+
+// IsValidEAN8 validates if a string is an EAN-8 barcode number with valid check digit.
+func IsValidEAN8(s string) bool {
+	return len(s) == 8 && isValidGTIN(s)
+}
+
+// IsValidEAN13 validates if a string is an EAN-13 barcode number with valid check digit.
+func IsValidEAN13(s string) bool {
+	return len(s) == 13 && isValidGTIN(s)
+}
+
+// IsValidUPC validates if a string is an UPC-A barcode number (12 digits) with valid check digit.
+func IsValidUPC(s string) bool {
+	return len(s) == 12 && isValidGTIN(s)
+}
+
+// isValidGTIN validates the digits and the check digit (the last one) of a GTIN family code
+// (EAN-8, UPC-A, EAN-13 and ISBN-13).
+func isValidGTIN(s string) bool {
+	if !IsNumber(s) {
+		return false
+	}
+
+	sum := 0
+	weight := 3
+	for i := len(s) - 2; i >= 0; i-- {
+		sum += weight * int(s[i]-'0')
+		weight = 4 - weight
+	}
+
+	return s[len(s)-1] == byte((10-sum%10)%10)+'0'
+}
+
+// compactCode copies s to code without hyphens and spaces.
+func compactCode(s string, code []byte) bool {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '-' || s[i] == ' ' {
+			continue
+		}
+		if n == len(code) {
+			return false
+		}
+		code[n] = s[i]
+		n++
+	}
+
+	return n == len(code)
+}
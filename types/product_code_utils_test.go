@@ -0,0 +1,46 @@
+package types
+
+import "testing"
+
+func TestProductCodeValidations(t *testing.T) {
+	tests := []stringValidationTest{
+		{
+			name:     "isbn10",
+			validate: IsValidISBN10,
+			valid:    []string{"0306406152", "0-306-40615-2", "0 306 40615 2", "080442957X", "0-8044-2957-X"},
+			invalid:  []string{"", "0306406153", "030640615", "03064061522", "X306406152", "080442957x", "03064O6152"},
+		},
+		{
+			name:     "isbn13",
+			validate: IsValidISBN13,
+			valid:    []string{"9780306406157", "978-0-306-40615-7", "979 1234 5678 96"},
+			invalid:  []string{"", "9780306406158", "4006381333931", "978030640615", "97803064061577", "978030640615X"},
+		},
+		{
+			name:     "issn",
+			validate: IsValidISSN,
+			valid:    []string{"0378-5955", "03785955", "2434-561X", "2049-3630"},
+			invalid:  []string{"", "0378-5956", "0378_5955", "2434-561x", "037-85955", "0378-59555", "X378-5955"},
+		},
+		{
+			name:     "ean8",
+			validate: IsValidEAN8,
+			valid:    []string{"73513537", "96385074"},
+			invalid:  []string{"", "73513538", "7351353", "735135370", "7351353a"},
+		},
+		{
+			name:     "ean13",
+			validate: IsValidEAN13,
+			valid:    []string{"4006381333931", "5901234123457", "9780306406157"},
+			invalid:  []string{"", "4006381333932", "400638133393", "4006-381333931", "400638133393a"},
+		},
+		{
+			name:     "upc",
+			validate: IsValidUPC,
+			valid:    []string{"036000291452", "012345678905"},
+			invalid:  []string{"", "036000291453", "03600029145", "0360002914520", "03600029145a"},
+		},
+	}
+
+	runStringValidationTests(t, tests)
+}
@@ -14,6 +14,26 @@ func EqualFold(s, t string) bool {
 	return strings.EqualFold(s, t)
 }
 
+// Contains reports whether substr is within s.
+func Contains(s, substr string) bool {
+	return strings.Contains(s, substr)
+}
+
+// ContainsAny reports whether any of the characters in chars are within s.
+func ContainsAny(s, chars string) bool {
+	return strings.ContainsAny(s, chars)
+}
+
+// HasPrefix reports whether s begins with prefix.
+func HasPrefix(s, prefix string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// HasSuffix reports whether s ends with suffix.
+func HasSuffix(s, suffix string) bool {
+	return strings.HasSuffix(s, suffix)
+}
+
 // IsValidEmail validates if a string is a valid email format
 // Returns true for valid email format, false otherwise
 func IsValidEmail(email string) bool {
@@ -40,3 +60,15 @@ func SliceNotContains[S ~[]E, V ~[]E, E comparable](s S, v V) bool {
 
 	return true
 }
+
+// IsZeroSlice validates if all elements of a slice (e.g. an array sliced with [:]) are zero values.
+func IsZeroSlice[S ~[]E, E comparable](s S) bool {
+	var zero E
+	for _, item := range s {
+		if item != zero {
+			return false
+		}
+	}
+
+	return true
+}
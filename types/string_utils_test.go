@@ -73,3 +73,35 @@ func TestIsValidEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestIsZeroSlice(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  bool
+	}{
+		{
+			name:  "empty slice",
+			input: []string{},
+			want:  true,
+		},
+		{
+			name:  "only zero values",
+			input: []string{"", ""},
+			want:  true,
+		},
+		{
+			name:  "one non zero value",
+			input: []string{"", "a"},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsZeroSlice(tt.input); got != tt.want {
+				t.Errorf("IsZeroSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
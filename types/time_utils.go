@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// validTimezones caches the time zone names already loaded by IsValidTimezone. Only valid names are
+// cached, so the cache is limited by the time zone database and not by the validated values.
+var validTimezones sync.Map
+
+// IsValidDatetime validates if a string can be parsed with the time layout (e.g. 2006-01-02).
+func IsValidDatetime(s, layout string) bool {
+	_, err := time.Parse(layout, s)
+
+	return err == nil
+}
+
+// IsValidTimezone validates if a string is an IANA time zone name (e.g. America/Sao_Paulo or UTC).
+// The generated validators embed the time zone database (time/tzdata) only in the packages that use it.
+func IsValidTimezone(s string) bool {
+	if s == "" || strings.EqualFold(s, "local") {
+		return false
+	}
+
+	if _, ok := validTimezones.Load(s); ok {
+		return true
+	}
+
+	if _, err := time.LoadLocation(s); err != nil {
+		return false
+	}
+
+	validTimezones.Store(s, struct{}{})
+
+	return true
+}
+
+// IsValidDuration validates if a string is a duration accepted by time.ParseDuration (e.g. 1h30m).
+func IsValidDuration(s string) bool {
+	_, err := time.ParseDuration(s)
+
+	return err == nil
+}
+
+// IsValidDatetimeLayout validates if a string is a time layout with at least one layout element
+// (e.g. 2006-01-02T15:04:05Z07:00).
+func IsValidDatetimeLayout(layout string) bool {
+	sample := time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC).Format(layout)
+	if sample == layout {
+		return false
+	}
+
+	return IsValidDatetime(sample, layout)
+}
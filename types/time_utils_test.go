@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	// Embedded IANA time zone database, so the tests don't depend on the host.
+	_ "time/tzdata"
+)
+
+func TestIsValidDatetime(t *testing.T) {
+	tests := []struct {
+		value  string
+		layout string
+		want   bool
+	}{
+		{value: "2025-12-31", layout: "2006-01-02", want: true},
+		{value: "2025-02-30", layout: "2006-01-02", want: false},
+		{value: "31/12/2025", layout: "2006-01-02", want: false},
+		{value: "31/12/2025 23:59", layout: "02/01/2006 15:04", want: true},
+		{value: "2025-12-31T23:59:59-03:00", layout: "2006-01-02T15:04:05Z07:00", want: true},
+		{value: "", layout: "2006-01-02", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout+" "+tt.value, func(t *testing.T) {
+			if got := IsValidDatetime(tt.value, tt.layout); got != tt.want {
+				t.Errorf("IsValidDatetime(%q, %q) = %v, want %v", tt.value, tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidDatetimeLayout(t *testing.T) {
+	tests := []struct {
+		layout string
+		want   bool
+	}{
+		{layout: "2006-01-02", want: true},
+		{layout: "15:04", want: true},
+		{layout: "2006-01-02T15:04:05Z07:00", want: true},
+		{layout: "Jan _2, 2006", want: true},
+		{layout: "", want: false},
+		{layout: "YYYY-MM-DD", want: false},
+		{layout: "literal", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			if got := IsValidDatetimeLayout(tt.layout); got != tt.want {
+				t.Errorf("IsValidDatetimeLayout(%q) = %v, want %v", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidTimezone(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "America/Sao_Paulo", want: true},
+		{value: "Europe/Lisbon", want: true},
+		{value: "UTC", want: true},
+		{value: "", want: false},
+		{value: "Local", want: false},
+		{value: "America/Atlantis", want: false},
+		{value: "../etc/passwd", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			// The second call uses the cache of the valid time zones.
+			for range 2 {
+				if got := IsValidTimezone(tt.value); got != tt.want {
+					t.Errorf("IsValidTimezone(%q) = %v, want %v", tt.value, got, tt.want)
+				}
+			}
+
+			if _, cached := validTimezones.Load(tt.value); cached != tt.want {
+				t.Errorf("IsValidTimezone(%q) cached = %v, want %v", tt.value, cached, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidDuration(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "1h30m", want: true},
+		{value: "-1.5s", want: true},
+		{value: "0", want: true},
+		{value: "300ms", want: true},
+		{value: "", want: false},
+		{value: "1d", want: false},
+		{value: "10", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := IsValidDuration(tt.value); got != tt.want {
+				t.Errorf("IsValidDuration(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"net/url"
+	"strings"
+)
+
+// IsValidURL validates if a string is an absolute URL with a host (e.g. https://example.com/path).
+// File URLs (file:///path) don't need a host, but need a path.
+func IsValidURL(s string) bool {
+	u, ok := parseAbsoluteURL(s)
+
+	return ok && IsValidParsedURL(u)
+}
+
+// IsValidURI validates if a string is an absolute URI (e.g. https://example.com or mailto:user@example.com).
+func IsValidURI(s string) bool {
+	u, ok := parseAbsoluteURL(s)
+
+	return ok && IsValidParsedURI(u)
+}
+
+// IsValidHTTPURL validates if a string is an absolute http or https URL with a host.
+func IsValidHTTPURL(s string) bool {
+	return IsValidURLWithScheme(s, []string{"http", "https"})
+}
+
+// IsValidURLWithScheme validates if a string is an absolute URL with a host and one of the schemes.
+func IsValidURLWithScheme(s string, schemes []string) bool {
+	u, ok := parseAbsoluteURL(s)
+
+	return ok && IsValidParsedURLWithScheme(u, schemes)
+}
+
+// IsValidParsedURL validates if a parsed URL is absolute with a host, like IsValidURL.
+func IsValidParsedURL(u *url.URL) bool {
+	if u.Scheme == "" {
+		return false
+	}
+
+	if strings.EqualFold(u.Scheme, "file") {
+		return u.Path != ""
+	}
+
+	return u.Hostname() != ""
+}
+
+// IsValidParsedURI validates if a parsed URL is an absolute URI, like IsValidURI.
+func IsValidParsedURI(u *url.URL) bool {
+	return u.Scheme != "" && (u.Host != "" || u.Opaque != "" || u.Path != "")
+}
+
+// IsValidParsedHTTPURL validates if a parsed URL is an absolute http or https URL with a host.
+func IsValidParsedHTTPURL(u *url.URL) bool {
+	return IsValidParsedURLWithScheme(u, []string{"http", "https"})
+}
+
+// IsValidParsedURLWithScheme validates if a parsed URL has a host and one of the schemes.
+func IsValidParsedURLWithScheme(u *url.URL, schemes []string) bool {
+	if u.Hostname() == "" {
+		return false
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEmptyURL validates if a URL has its zero value.
+func IsEmptyURL(u *url.URL) bool {
+	return *u == url.URL{}
+}
+
+func parseAbsoluteURL(s string) (*url.URL, bool) {
+	if s == "" || strings.ContainsAny(s, " \t\r\n") {
+		return nil, false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" {
+		return nil, false
+	}
+
+	return u, true
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestIsValidURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "https url", url: "https://example.com/path?q=1#top", want: true},
+		{name: "url with port", url: "http://localhost:8080", want: true},
+		{name: "ftp url", url: "ftp://files.example.com/file.txt", want: true},
+		{name: "file url", url: "file:///etc/hosts", want: true},
+		{name: "empty", url: "", want: false},
+		{name: "relative url", url: "/path/to/resource", want: false},
+		{name: "url without scheme", url: "example.com", want: false},
+		{name: "url without host", url: "https://", want: false},
+		{name: "url with only a port", url: "http://:80", want: false},
+		{name: "opaque uri", url: "mailto:user@example.com", want: false},
+		{name: "file url without path", url: "file://", want: false},
+		{name: "url with spaces", url: "https://example.com/a b", want: false},
+		{name: "invalid url", url: "https://exa mple.com", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidURL(tt.url); got != tt.want {
+				t.Errorf("IsValidURL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidURI(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+		want bool
+	}{
+		{name: "https uri", uri: "https://example.com", want: true},
+		{name: "mailto uri", uri: "mailto:user@example.com", want: true},
+		{name: "urn", uri: "urn:isbn:0451450523", want: true},
+		{name: "file uri", uri: "file:///etc/hosts", want: true},
+		{name: "empty", uri: "", want: false},
+		{name: "relative uri", uri: "/path/to/resource", want: false},
+		{name: "scheme only", uri: "https:", want: false},
+		{name: "invalid uri", uri: "%zz://example.com", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidURI(tt.uri); got != tt.want {
+				t.Errorf("IsValidURI(%q) = %v, want %v", tt.uri, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidHTTPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "http url", url: "http://example.com", want: true},
+		{name: "https url", url: "HTTPS://example.com/callback", want: true},
+		{name: "ftp url", url: "ftp://example.com", want: false},
+		{name: "https url without host", url: "https:///path", want: false},
+		{name: "http url with only a port", url: "http://:80/path", want: false},
+		{name: "relative url", url: "//example.com/path", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidHTTPURL(tt.url); got != tt.want {
+				t.Errorf("IsValidHTTPURL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidURLWithScheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		schemes []string
+		want    bool
+	}{
+		{name: "https url", url: "https://example.com", schemes: []string{"https"}, want: true},
+		{name: "one of the schemes", url: "wss://example.com/socket", schemes: []string{"https", "wss"}, want: true},
+		{name: "another scheme", url: "http://example.com", schemes: []string{"https"}, want: false},
+		{name: "url without host", url: "https:/path", schemes: []string{"https"}, want: false},
+		{name: "url with only a port", url: "https://:443", schemes: []string{"https"}, want: false},
+		{name: "no schemes", url: "https://example.com", schemes: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidURLWithScheme(tt.url, tt.schemes); got != tt.want {
+				t.Errorf("IsValidURLWithScheme(%q, %v) = %v, want %v", tt.url, tt.schemes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsedURLValidations(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      url.URL
+		validate func(*url.URL) bool
+		want     bool
+	}{
+		{name: "url", url: url.URL{Scheme: "https", Host: "example.com"}, validate: IsValidParsedURL, want: true},
+		{name: "file url", url: url.URL{Scheme: "file", Path: "/etc/hosts"}, validate: IsValidParsedURL, want: true},
+		{name: "url without scheme", url: url.URL{Host: "example.com"}, validate: IsValidParsedURL, want: false},
+		{name: "url without host", url: url.URL{Scheme: "https", Path: "/path"}, validate: IsValidParsedURL, want: false},
+		{name: "url with only a port", url: url.URL{Scheme: "http", Host: ":80"}, validate: IsValidParsedURL, want: false},
+		{name: "http url with only a port", url: url.URL{Scheme: "http", Host: ":80"}, validate: IsValidParsedHTTPURL, want: false},
+		{name: "opaque uri", url: url.URL{Scheme: "mailto", Opaque: "user@example.com"}, validate: IsValidParsedURI, want: true},
+		{name: "relative uri", url: url.URL{Path: "/path"}, validate: IsValidParsedURI, want: false},
+		{name: "http url", url: url.URL{Scheme: "HTTP", Host: "example.com"}, validate: IsValidParsedHTTPURL, want: true},
+		{name: "ftp url", url: url.URL{Scheme: "ftp", Host: "example.com"}, validate: IsValidParsedHTTPURL, want: false},
+		{name: "empty url", url: url.URL{}, validate: IsEmptyURL, want: true},
+		{name: "url with only a path", url: url.URL{Path: "/"}, validate: IsEmptyURL, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.validate(&tt.url); got != tt.want {
+				t.Errorf("validate(%q) = %v, want %v", tt.url.String(), got, tt.want)
+			}
+		})
+	}
+
+	u := &url.URL{Scheme: "wss", Host: "example.com"}
+	if !IsValidParsedURLWithScheme(u, []string{"https", "wss"}) || IsValidParsedURLWithScheme(u, []string{"https"}) {
+		t.Errorf("IsValidParsedURLWithScheme(%q) must accept only the wss scheme", u.String())
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import "testing"
+
+// stringValidationTest has the values accepted (valid) and rejected (invalid) by a string validation.
+type stringValidationTest struct {
+	name     string
+	validate func(string) bool
+	valid    []string
+	invalid  []string
+}
+
+// runStringValidationTests checks each value of the tests in a subtest (e.g. "uuid valid <value>").
+func runStringValidationTests(t *testing.T, tests []stringValidationTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		for _, value := range tt.valid {
+			t.Run(tt.name+" valid "+value, func(t *testing.T) {
+				if !tt.validate(value) {
+					t.Errorf("%s(%q) = false, want true", tt.name, value)
+				}
+			})
+		}
+		for _, value := range tt.invalid {
+			t.Run(tt.name+" invalid "+value, func(t *testing.T) {
+				if tt.validate(value) {
+					t.Errorf("%s(%q) = true, want false", tt.name, value)
+				}
+			})
+		}
+	}
+}